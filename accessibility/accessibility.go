@@ -16,6 +16,7 @@ const (
 	RoleButton      Role = "button"
 	RoleCheckbox    Role = "checkbox"
 	RoleRadio       Role = "radio"
+	RoleRadioGroup  Role = "radiogroup"
 	RoleTextbox     Role = "textbox"
 	RoleList        Role = "list"
 	RoleListItem    Role = "listitem"
@@ -23,6 +24,7 @@ const (
 	RoleRow         Role = "row"
 	RoleCell        Role = "cell"
 	RoleSlider      Role = "slider"
+	RoleSpinButton  Role = "spinbutton"
 	RoleTree        Role = "tree"
 	RoleTreeItem    Role = "treeitem"
 	RoleMenu        Role = "menu"
@@ -37,6 +39,12 @@ const (
 	RoleGroup       Role = "group"
 	RoleText        Role = "text"
 	RoleChart       Role = "chart"
+	RoleListBox     Role = "listbox"
+	RoleOption      Role = "option"
+	RoleComboBox    Role = "combobox"
+	RoleSwitch      Role = "switch"
+	RoleLink        Role = "link"
+	RoleHeading     Role = "heading"
 )
 
 // Accessible is implemented by widgets that expose accessibility metadata.
@@ -130,6 +138,8 @@ type Base struct {
 	Description string
 	State       StateSet
 	Value       *ValueInfo
+	DescribedBy string
+	Live        LiveRegion
 }
 
 // AccessibleRole returns the current role.
@@ -274,9 +284,29 @@ func (a *SimpleAnnouncer) Announce(message string, priority Priority) {
 	}
 }
 
-// AnnounceChange announces the widget state.
+// AnnounceChange announces the widget state. Widgets whose live region is
+// LiveOff are skipped and LiveAssertive ones are announced assertively.
 func (a *SimpleAnnouncer) AnnounceChange(widget Accessible) {
+	if message, priority, ok := changeAnnouncement(widget); ok {
+		a.Announce(message, priority)
+	}
+}
+
+// changeAnnouncement returns the message and priority AnnounceChange uses,
+// or false when nothing should be announced.
+func changeAnnouncement(widget Accessible) (string, Priority, bool) {
+	priority, ok := LiveRegionOf(widget).Priority()
+	if !ok {
+		return "", priority, false
+	}
 	message := FormatChange(widget)
+	return message, priority, message != ""
+}
+
+// AnnounceFocus announces a widget receiving focus, including its role
+// name and the resolved described-by text.
+func (a *SimpleAnnouncer) AnnounceFocus(widget Accessible, description string) {
+	message := FormatFocus(widget, description)
 	if message == "" {
 		return
 	}
@@ -0,0 +1,193 @@
+package accessibility
+
+import "strings"
+
+// LiveRegion controls how changes to a widget are announced.
+type LiveRegion int
+
+const (
+	// LiveDefault behaves like LivePolite.
+	LiveDefault LiveRegion = iota
+	// LiveOff suppresses change announcements.
+	LiveOff
+	// LivePolite queues announcements until the reader is idle.
+	LivePolite
+	// LiveAssertive interrupts the reader immediately.
+	LiveAssertive
+)
+
+// String returns the ARIA name of the live region setting.
+func (l LiveRegion) String() string {
+	switch l {
+	case LiveOff:
+		return "off"
+	case LiveAssertive:
+		return "assertive"
+	default:
+		return "polite"
+	}
+}
+
+// Priority returns the announcement priority for the live region. It
+// reports false when changes should not be announced.
+func (l LiveRegion) Priority() (Priority, bool) {
+	switch l {
+	case LiveOff:
+		return PriorityPolite, false
+	case LiveAssertive:
+		return PriorityAssertive, true
+	default:
+		return PriorityPolite, true
+	}
+}
+
+// ARIA is implemented by widgets that expose ARIA-style properties in
+// addition to the Accessible metadata. The role comes from AccessibleRole.
+type ARIA interface {
+	Accessible
+	AriaLabel() string
+	AriaDescribedBy() string
+	AriaLive() LiveRegion
+	AriaExpanded() *bool
+}
+
+// FocusAnnouncer is implemented by announcers that describe focus changes
+// differently from state changes. description is the text of the widget
+// named by AriaDescribedBy, if any.
+type FocusAnnouncer interface {
+	AnnounceFocus(widget Accessible, description string)
+}
+
+// AriaLabel returns the accessible name.
+func (b *Base) AriaLabel() string {
+	if b == nil {
+		return ""
+	}
+	return b.Label
+}
+
+// AriaDescribedBy returns the ID of the widget describing this one.
+func (b *Base) AriaDescribedBy() string {
+	if b == nil {
+		return ""
+	}
+	return b.DescribedBy
+}
+
+// AriaLive returns the live region setting.
+func (b *Base) AriaLive() LiveRegion {
+	if b == nil {
+		return LiveDefault
+	}
+	return b.Live
+}
+
+// AriaExpanded returns the expanded state, or nil when not applicable.
+func (b *Base) AriaExpanded() *bool {
+	if b == nil {
+		return nil
+	}
+	return b.State.Expanded
+}
+
+// SetDescribedBy updates the ID of the describing widget.
+func (b *Base) SetDescribedBy(id string) {
+	if b == nil {
+		return
+	}
+	b.DescribedBy = id
+}
+
+// SetLive updates the live region setting.
+func (b *Base) SetLive(live LiveRegion) {
+	if b == nil {
+		return
+	}
+	b.Live = live
+}
+
+// LiveRegionOf returns the widget's live region setting.
+func LiveRegionOf(widget Accessible) LiveRegion {
+	if aria, ok := widget.(ARIA); ok && aria != nil {
+		return aria.AriaLive()
+	}
+	return LiveDefault
+}
+
+// AnnounceLive announces message on behalf of widget, honoring its live
+// region setting.
+func AnnounceLive(announcer Announcer, widget Accessible, message string) {
+	if announcer == nil {
+		return
+	}
+	priority, ok := LiveRegionOf(widget).Priority()
+	if !ok {
+		return
+	}
+	announcer.Announce(message, priority)
+}
+
+var roleNames = map[Role]string{
+	RoleCheckbox:    "check box",
+	RoleRadio:       "radio button",
+	RoleRadioGroup:  "radio group",
+	RoleTextbox:     "text box",
+	RoleListItem:    "list item",
+	RoleTreeItem:    "tree item",
+	RoleMenuItem:    "menu item",
+	RoleTabList:     "tab list",
+	RoleTabPanel:    "tab panel",
+	RoleProgressBar: "progress bar",
+	RoleListBox:     "list box",
+	RoleComboBox:    "combo box",
+	RoleSpinButton:  "spin button",
+}
+
+// Name returns the spoken name of the role, such as "check box".
+func (r Role) Name() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return string(r)
+}
+
+// FormatFocus builds the message read when focus enters a widget: label,
+// role name, description, state, and value.
+func FormatFocus(widget Accessible, description string) string {
+	if widget == nil {
+		return ""
+	}
+	label := strings.TrimSpace(widget.AccessibleLabel())
+	if aria, ok := widget.(ARIA); ok {
+		if ariaLabel := strings.TrimSpace(aria.AriaLabel()); ariaLabel != "" {
+			label = ariaLabel
+		}
+	}
+	var parts []string
+	if label != "" {
+		parts = append(parts, label)
+	}
+	if role := strings.TrimSpace(widget.AccessibleRole().Name()); role != "" {
+		parts = append(parts, role)
+	}
+	if desc := strings.TrimSpace(widget.AccessibleDescription()); desc != "" {
+		parts = append(parts, desc)
+	}
+	if desc := strings.TrimSpace(description); desc != "" {
+		parts = append(parts, desc)
+	}
+	if stateParts := widget.AccessibleState().Strings(); len(stateParts) > 0 {
+		parts = append(parts, strings.Join(stateParts, " "))
+	}
+	if value := widget.AccessibleValue(); value != nil {
+		if text := strings.TrimSpace(value.Text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+var (
+	_ ARIA           = (*Base)(nil)
+	_ FocusAnnouncer = (*SimpleAnnouncer)(nil)
+)
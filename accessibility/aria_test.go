@@ -0,0 +1,59 @@
+package accessibility
+
+import "testing"
+
+func TestFormatFocusRoleName(t *testing.T) {
+	base := &Base{
+		Role:  RoleCheckbox,
+		Label: "Remember me",
+		State: StateSet{Checked: BoolPtr(false)},
+	}
+	got := FormatFocus(base, "Keeps you signed in")
+	want := "Remember me, check box, Keeps you signed in, unchecked"
+	if got != want {
+		t.Fatalf("FormatFocus = %q, want %q", got, want)
+	}
+	if RoleListBox.Name() != "list box" || RoleButton.Name() != "button" {
+		t.Fatalf("unexpected role names %q %q", RoleListBox.Name(), RoleButton.Name())
+	}
+}
+
+func TestAnnouncerLiveRegions(t *testing.T) {
+	a := &SimpleAnnouncer{}
+	base := &Base{Role: RoleStatus, Label: "Saved"}
+
+	a.AnnounceChange(base)
+	base.SetLive(LiveAssertive)
+	a.AnnounceChange(base)
+	base.SetLive(LiveOff)
+	a.AnnounceChange(base)
+	AnnounceLive(a, base, "ignored")
+	base.SetLive(LivePolite)
+	AnnounceLive(a, base, "Upload complete")
+
+	history := a.History()
+	if len(history) != 3 {
+		t.Fatalf("expected 3 announcements, got %v", history)
+	}
+	if history[0].Priority != PriorityPolite || history[1].Priority != PriorityAssertive {
+		t.Fatalf("unexpected priorities: %v", history)
+	}
+	if history[2].Message != "Upload complete" {
+		t.Fatalf("unexpected live message %q", history[2].Message)
+	}
+}
+
+func TestBaseARIA(t *testing.T) {
+	base := &Base{Label: "Files", State: StateSet{Expanded: BoolPtr(true)}}
+	base.SetDescribedBy("files-help")
+	var aria ARIA = base
+	if aria.AriaLabel() != "Files" || aria.AriaDescribedBy() != "files-help" {
+		t.Fatalf("unexpected ARIA properties")
+	}
+	if expanded := aria.AriaExpanded(); expanded == nil || !*expanded {
+		t.Fatalf("expected expanded")
+	}
+	if aria.AriaLive() != LiveDefault || LiveDefault.String() != "polite" {
+		t.Fatalf("expected default live region to be polite")
+	}
+}
@@ -0,0 +1,116 @@
+//go:build linux
+
+package atspi
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+)
+
+const (
+	rootPath     = "/org/a11y/atspi/accessible/root"
+	objectEvents = "org.a11y.atspi.Event.Object"
+)
+
+// Politeness levels carried in detail1 of object:announcement, matching
+// AtspiLive.
+const (
+	livePolite    = 1
+	liveAssertive = 2
+)
+
+func init() {
+	accessibility.RegisterPlatformAnnouncer("atspi", func() (accessibility.Announcer, error) {
+		announcer, err := New()
+		if err != nil {
+			return nil, err
+		}
+		return announcer, nil
+	})
+}
+
+// Announcer emits announcements on the AT-SPI2 accessibility bus and
+// records them locally.
+type Announcer struct {
+	*accessibility.ForwardingAnnouncer
+	conn *conn
+}
+
+// New connects to the accessibility bus and returns an announcer.
+func New() (*Announcer, error) {
+	address, err := busAddress()
+	if err != nil {
+		return nil, err
+	}
+	c, err := dial(address)
+	if err != nil {
+		return nil, err
+	}
+	go c.serve()
+	return newAnnouncer(c), nil
+}
+
+func newAnnouncer(c *conn) *Announcer {
+	a := &Announcer{conn: c}
+	a.ForwardingAnnouncer = accessibility.NewForwardingAnnouncer(a.post)
+	return a
+}
+
+// Close disconnects from the accessibility bus.
+func (a *Announcer) Close() error {
+	if a == nil || a.conn == nil {
+		return nil
+	}
+	return a.conn.close()
+}
+
+func (a *Announcer) post(announcement accessibility.Announcement) error {
+	politeness := int32(livePolite)
+	if announcement.Priority == accessibility.PriorityAssertive {
+		politeness = liveAssertive
+	}
+	return a.conn.emit(rootPath, objectEvents, "Announcement", "siiva{sv}", announcementBody(announcement.Message, politeness))
+}
+
+// announcementBody marshals the (detail, detail1, detail2, any_data,
+// properties) arguments of an AT-SPI event.
+func announcementBody(msg string, politeness int32) []byte {
+	e := &encoder{}
+	e.string("")
+	e.int32(politeness)
+	e.int32(0)
+	e.signature("s")
+	e.string(msg)
+	e.uint32(0)
+	e.align(8)
+	return e.buf
+}
+
+// busAddress finds the accessibility bus, asking the session bus's
+// org.a11y.Bus service when AT_SPI_BUS_ADDRESS is not set.
+func busAddress() (string, error) {
+	if address := os.Getenv("AT_SPI_BUS_ADDRESS"); address != "" {
+		return address, nil
+	}
+	session := os.Getenv("DBUS_SESSION_BUS_ADDRESS")
+	if session == "" {
+		runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+		if runtimeDir == "" {
+			return "", errors.New("atspi: no session bus address")
+		}
+		session = "unix:path=" + filepath.Join(runtimeDir, "bus")
+	}
+	c, err := dial(session)
+	if err != nil {
+		return "", err
+	}
+	defer c.close()
+	reply, err := c.call("org.a11y.Bus", "/org/a11y/bus", "org.a11y.Bus", "GetAddress")
+	if err != nil {
+		return "", err
+	}
+	return reply.bodyString()
+}
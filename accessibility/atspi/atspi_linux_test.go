@@ -0,0 +1,119 @@
+//go:build linux
+
+package atspi
+
+import (
+	"bufio"
+	"encoding/binary"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+)
+
+func TestParseAddress(t *testing.T) {
+	cases := map[string]string{
+		"unix:path=/run/user/1000/at-spi/bus_0,guid=abc": "/run/user/1000/at-spi/bus_0",
+		"unix:abstract=/tmp/dbus-XYZ":                    "@/tmp/dbus-XYZ",
+		"unix:path=/tmp/with%20space":                    "/tmp/with space",
+	}
+	for addr, want := range cases {
+		got, err := parseAddress(addr)
+		if err != nil || got != want {
+			t.Fatalf("parseAddress(%q) = %q, %v; want %q", addr, got, err, want)
+		}
+	}
+	if _, err := parseAddress("tcp:host=localhost,port=1"); err == nil {
+		t.Fatalf("expected tcp address to be rejected")
+	}
+}
+
+func TestAnnouncerEmitsSignal(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	received := make(chan *message, 1)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- fakeBus(server, received)
+	}()
+
+	c, err := open(client)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	a := newAnnouncer(c)
+	a.Announce("Saved", accessibility.PriorityAssertive)
+
+	if err := <-errs; err != nil {
+		t.Fatalf("fake bus: %v", err)
+	}
+	if err := a.Err(); err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	m := <-received
+	if m.typ != msgSignal || m.path != rootPath || m.iface != objectEvents || m.member != "Announcement" || m.sig != "siiva{sv}" {
+		t.Fatalf("unexpected signal %+v", m)
+	}
+	d := &decoder{buf: m.body, order: binary.LittleEndian}
+	detail, detail1, detail2 := d.string(), d.uint32(), d.uint32()
+	variantSig, text := d.signature(), d.string()
+	props := d.uint32()
+	if d.err != nil {
+		t.Fatalf("decode body: %v", d.err)
+	}
+	if detail != "" || detail1 != liveAssertive || detail2 != 0 || variantSig != "s" || text != "Saved" || props != 0 {
+		t.Fatalf("unexpected body %q %d %d %q %q %d", detail, detail1, detail2, variantSig, text, props)
+	}
+	if history := a.History(); len(history) != 1 || history[0].Message != "Saved" {
+		t.Fatalf("unexpected history %v", history)
+	}
+}
+
+// fakeBus accepts one client, answers Hello, and forwards the next message.
+func fakeBus(nc net.Conn, received chan<- *message) error {
+	r := bufio.NewReader(nc)
+	if _, err := r.ReadString('\n'); err != nil {
+		return err
+	}
+	if _, err := nc.Write([]byte("OK 0123456789abcdef\r\n")); err != nil {
+		return err
+	}
+	if line, err := r.ReadString('\n'); err != nil || !strings.HasPrefix(line, "BEGIN") {
+		return err
+	}
+	hello, err := readMessage(r)
+	if err != nil {
+		return err
+	}
+	name := &encoder{}
+	name.string(":1.42")
+	reply := &message{typ: msgMethodReturn, serial: 1, replySerial: hello.serial, sig: "s", body: name.buf}
+	if _, err := nc.Write(reply.marshal()); err != nil {
+		return err
+	}
+	m, err := readMessage(r)
+	if err != nil {
+		return err
+	}
+	received <- m
+	return nil
+}
+
+func TestBodyStringReply(t *testing.T) {
+	e := &encoder{}
+	e.string("unix:path=/tmp/a11y")
+	raw := (&message{typ: msgMethodReturn, serial: 7, replySerial: 3, sig: "s", body: e.buf}).marshal()
+	m, err := readMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("readMessage: %v", err)
+	}
+	if m.replySerial != 3 || m.serial != 7 {
+		t.Fatalf("unexpected serials %d %d", m.serial, m.replySerial)
+	}
+	if got, err := m.bodyString(); err != nil || got != "unix:path=/tmp/a11y" {
+		t.Fatalf("bodyString = %q, %v", got, err)
+	}
+}
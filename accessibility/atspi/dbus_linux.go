@@ -0,0 +1,447 @@
+//go:build linux
+
+package atspi
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// D-Bus message types.
+const (
+	msgMethodCall   = 1
+	msgMethodReturn = 2
+	msgError        = 3
+	msgSignal       = 4
+)
+
+// D-Bus header field codes.
+const (
+	fieldPath        = 1
+	fieldInterface   = 2
+	fieldMember      = 3
+	fieldErrorName   = 4
+	fieldReplySerial = 5
+	fieldDestination = 6
+	fieldSender      = 7
+	fieldSignature   = 8
+)
+
+const (
+	flagNoReplyExpected = 0x1
+	maxMessageSize      = 128 << 20
+	ioTimeout           = 2 * time.Second
+)
+
+// message is a D-Bus message with its body already marshalled.
+type message struct {
+	typ         byte
+	flags       byte
+	serial      uint32
+	path        string
+	iface       string
+	member      string
+	errName     string
+	replySerial uint32
+	dest        string
+	sender      string
+	sig         string
+	body        []byte
+	order       binary.ByteOrder
+}
+
+// encoder writes little-endian D-Bus wire data. Alignment is relative to
+// the start of buf, so bodies must be encoded separately from headers.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) align(n int) {
+	for len(e.buf)%n != 0 {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *encoder) byte(b byte) {
+	e.buf = append(e.buf, b)
+}
+
+func (e *encoder) uint32(v uint32) {
+	e.align(4)
+	e.buf = binary.LittleEndian.AppendUint32(e.buf, v)
+}
+
+func (e *encoder) int32(v int32) {
+	e.uint32(uint32(v))
+}
+
+func (e *encoder) string(s string) {
+	e.uint32(uint32(len(s)))
+	e.buf = append(e.buf, s...)
+	e.buf = append(e.buf, 0)
+}
+
+func (e *encoder) signature(s string) {
+	e.buf = append(e.buf, byte(len(s)))
+	e.buf = append(e.buf, s...)
+	e.buf = append(e.buf, 0)
+}
+
+func (m *message) marshal() []byte {
+	e := &encoder{}
+	e.buf = append(e.buf, 'l', m.typ, m.flags, 1)
+	e.uint32(uint32(len(m.body)))
+	e.uint32(m.serial)
+	lenPos := len(e.buf)
+	e.uint32(0)
+	e.align(8)
+	start := len(e.buf)
+	field := func(code byte, sig string, write func()) {
+		e.align(8)
+		e.byte(code)
+		e.signature(sig)
+		write()
+	}
+	stringField := func(code byte, sig, value string) {
+		if value == "" {
+			return
+		}
+		field(code, sig, func() {
+			if sig == "g" {
+				e.signature(value)
+			} else {
+				e.string(value)
+			}
+		})
+	}
+	stringField(fieldPath, "o", m.path)
+	stringField(fieldInterface, "s", m.iface)
+	stringField(fieldMember, "s", m.member)
+	stringField(fieldErrorName, "s", m.errName)
+	if m.replySerial != 0 {
+		field(fieldReplySerial, "u", func() { e.uint32(m.replySerial) })
+	}
+	stringField(fieldDestination, "s", m.dest)
+	stringField(fieldSender, "s", m.sender)
+	stringField(fieldSignature, "g", m.sig)
+	binary.LittleEndian.PutUint32(e.buf[lenPos:], uint32(len(e.buf)-start))
+	e.align(8)
+	return append(e.buf, m.body...)
+}
+
+// decoder reads D-Bus wire data in either byte order.
+type decoder struct {
+	buf   []byte
+	pos   int
+	order binary.ByteOrder
+	err   error
+}
+
+func (d *decoder) align(n int) {
+	d.pos = (d.pos + n - 1) &^ (n - 1)
+}
+
+func (d *decoder) need(n int) bool {
+	if d.err == nil && d.pos+n > len(d.buf) {
+		d.err = io.ErrUnexpectedEOF
+	}
+	return d.err == nil
+}
+
+func (d *decoder) byte() byte {
+	if !d.need(1) {
+		return 0
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b
+}
+
+func (d *decoder) uint32() uint32 {
+	d.align(4)
+	if !d.need(4) {
+		return 0
+	}
+	v := d.order.Uint32(d.buf[d.pos:])
+	d.pos += 4
+	return v
+}
+
+func (d *decoder) string() string {
+	n := int(d.uint32())
+	if !d.need(n + 1) {
+		return ""
+	}
+	s := string(d.buf[d.pos : d.pos+n])
+	d.pos += n + 1
+	return s
+}
+
+func (d *decoder) signature() string {
+	n := int(d.byte())
+	if !d.need(n + 1) {
+		return ""
+	}
+	s := string(d.buf[d.pos : d.pos+n])
+	d.pos += n + 1
+	return s
+}
+
+func readMessage(r io.Reader) (*message, error) {
+	fixed := make([]byte, 16)
+	if _, err := io.ReadFull(r, fixed); err != nil {
+		return nil, err
+	}
+	var order binary.ByteOrder
+	switch fixed[0] {
+	case 'l':
+		order = binary.LittleEndian
+	case 'B':
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("atspi: invalid byte order %q", fixed[0])
+	}
+	bodyLen := int(order.Uint32(fixed[4:]))
+	headerLen := 16 + int(order.Uint32(fixed[12:]))
+	padded := (headerLen + 7) &^ 7
+	if bodyLen > maxMessageSize || headerLen > maxMessageSize {
+		return nil, errors.New("atspi: message too large")
+	}
+	full := make([]byte, padded+bodyLen)
+	copy(full, fixed)
+	if _, err := io.ReadFull(r, full[16:]); err != nil {
+		return nil, err
+	}
+
+	m := &message{typ: fixed[1], flags: fixed[2], serial: order.Uint32(fixed[8:]), order: order}
+	d := &decoder{buf: full[:headerLen], pos: 16, order: order}
+	for d.pos < headerLen && d.err == nil {
+		d.align(8)
+		code := d.byte()
+		sig := d.signature()
+		var value string
+		switch sig {
+		case "s", "o":
+			value = d.string()
+		case "g":
+			value = d.signature()
+		case "u":
+			v := d.uint32()
+			if code == fieldReplySerial {
+				m.replySerial = v
+			}
+			continue
+		default:
+			return nil, fmt.Errorf("atspi: unsupported header field type %q", sig)
+		}
+		switch code {
+		case fieldPath:
+			m.path = value
+		case fieldInterface:
+			m.iface = value
+		case fieldMember:
+			m.member = value
+		case fieldErrorName:
+			m.errName = value
+		case fieldDestination:
+			m.dest = value
+		case fieldSender:
+			m.sender = value
+		case fieldSignature:
+			m.sig = value
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	m.body = full[padded:]
+	return m, nil
+}
+
+// bodyString decodes a message body that starts with a string.
+func (m *message) bodyString() (string, error) {
+	if !strings.HasPrefix(m.sig, "s") {
+		return "", fmt.Errorf("atspi: unexpected reply signature %q", m.sig)
+	}
+	order := m.order
+	if order == nil {
+		order = binary.LittleEndian
+	}
+	d := &decoder{buf: m.body, order: order}
+	s := d.string()
+	return s, d.err
+}
+
+// conn is a minimal D-Bus connection: it authenticates, calls
+// argument-less methods, and emits signals.
+type conn struct {
+	nc     net.Conn
+	r      *bufio.Reader
+	mu     sync.Mutex
+	serial uint32
+}
+
+// dial connects to the first reachable address in a D-Bus address list.
+func dial(address string) (*conn, error) {
+	var errs []error
+	for _, addr := range strings.Split(address, ";") {
+		if strings.TrimSpace(addr) == "" {
+			continue
+		}
+		path, err := parseAddress(addr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		nc, err := net.DialTimeout("unix", path, ioTimeout)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		c, err := open(nc)
+		if err != nil {
+			nc.Close()
+			errs = append(errs, err)
+			continue
+		}
+		return c, nil
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("atspi: empty bus address")
+	}
+	return nil, errors.Join(errs...)
+}
+
+// parseAddress returns the socket path for a unix D-Bus address.
+func parseAddress(addr string) (string, error) {
+	transport, params, ok := strings.Cut(addr, ":")
+	if !ok || transport != "unix" {
+		return "", fmt.Errorf("atspi: unsupported bus address %q", addr)
+	}
+	for _, param := range strings.Split(params, ",") {
+		key, value, _ := strings.Cut(param, "=")
+		value, err := url.PathUnescape(value)
+		if err != nil {
+			return "", fmt.Errorf("atspi: bad bus address %q: %w", addr, err)
+		}
+		switch key {
+		case "path":
+			return value, nil
+		case "abstract":
+			return "@" + value, nil
+		}
+	}
+	return "", fmt.Errorf("atspi: unsupported bus address %q", addr)
+}
+
+// open authenticates over nc and registers with the bus.
+func open(nc net.Conn) (*conn, error) {
+	c := &conn{nc: nc, r: bufio.NewReader(nc)}
+	if err := c.auth(); err != nil {
+		return nil, err
+	}
+	if _, err := c.call("org.freedesktop.DBus", "/org/freedesktop/DBus", "org.freedesktop.DBus", "Hello"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *conn) auth() error {
+	_ = c.nc.SetDeadline(time.Now().Add(ioTimeout))
+	defer c.nc.SetDeadline(time.Time{})
+	uid := hex.EncodeToString([]byte(strconv.Itoa(os.Getuid())))
+	if _, err := io.WriteString(c.nc, "\x00AUTH EXTERNAL "+uid+"\r\n"); err != nil {
+		return err
+	}
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "OK") {
+		return fmt.Errorf("atspi: authentication rejected: %s", strings.TrimSpace(line))
+	}
+	_, err = io.WriteString(c.nc, "BEGIN\r\n")
+	return err
+}
+
+func (c *conn) send(m *message) (uint32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.serial++
+	m.serial = c.serial
+	_ = c.nc.SetWriteDeadline(time.Now().Add(ioTimeout))
+	_, err := c.nc.Write(m.marshal())
+	return m.serial, err
+}
+
+// call invokes a method without arguments and waits for its reply. It must
+// not be used once serve is running.
+func (c *conn) call(dest, path, iface, member string) (*message, error) {
+	serial, err := c.send(&message{typ: msgMethodCall, dest: dest, path: path, iface: iface, member: member})
+	if err != nil {
+		return nil, err
+	}
+	_ = c.nc.SetReadDeadline(time.Now().Add(ioTimeout))
+	defer c.nc.SetReadDeadline(time.Time{})
+	for {
+		reply, err := readMessage(c.r)
+		if err != nil {
+			return nil, err
+		}
+		if reply.replySerial != serial {
+			continue
+		}
+		if reply.typ == msgError {
+			detail, _ := reply.bodyString()
+			return nil, fmt.Errorf("atspi: %s.%s: %s %s", iface, member, reply.errName, detail)
+		}
+		return reply, nil
+	}
+}
+
+// emit sends a signal with a pre-marshalled body.
+func (c *conn) emit(path, iface, member, sig string, body []byte) error {
+	_, err := c.send(&message{typ: msgSignal, path: path, iface: iface, member: member, sig: sig, body: body})
+	return err
+}
+
+// serve drains incoming messages so the bus never stalls on a full socket,
+// answering method calls with UnknownMethod so callers do not wait for a
+// timeout.
+func (c *conn) serve() {
+	for {
+		m, err := readMessage(c.r)
+		if err != nil {
+			return
+		}
+		if m.typ != msgMethodCall || m.flags&flagNoReplyExpected != 0 {
+			continue
+		}
+		e := &encoder{}
+		e.string("no accessible objects are exported")
+		_, _ = c.send(&message{
+			typ:         msgError,
+			flags:       flagNoReplyExpected,
+			errName:     "org.freedesktop.DBus.Error.UnknownMethod",
+			replySerial: m.serial,
+			dest:        m.sender,
+			sig:         "s",
+			body:        e.buf,
+		})
+	}
+}
+
+func (c *conn) close() error {
+	return c.nc.Close()
+}
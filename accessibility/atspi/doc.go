@@ -0,0 +1,13 @@
+// Package atspi forwards accessibility announcements to Linux screen
+// readers such as Orca by emitting AT-SPI2 object:announcement events on the
+// accessibility bus.
+//
+// Import it for side effects to make it available to
+// accessibility.NewPlatformAnnouncer:
+//
+//	import _ "github.com/odvcencio/fluffyui/accessibility/atspi"
+//
+// The package speaks just enough of the D-Bus wire protocol to reach the
+// bus and emit events; it does not publish an accessible object tree. On
+// other platforms the package is empty.
+package atspi
@@ -0,0 +1,127 @@
+package accessibility
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// PlatformFactory creates an announcer backed by a native screen reader API.
+type PlatformFactory func() (Announcer, error)
+
+type platformEntry struct {
+	name    string
+	factory PlatformFactory
+}
+
+var (
+	platformMu      sync.Mutex
+	platformEntries []platformEntry
+)
+
+// RegisterPlatformAnnouncer makes a native announcer available to
+// NewPlatformAnnouncer. Platform packages such as accessibility/voiceover
+// and accessibility/atspi register themselves when imported, so enable them
+// with a blank import on the platforms they support.
+func RegisterPlatformAnnouncer(name string, factory PlatformFactory) {
+	if factory == nil {
+		return
+	}
+	platformMu.Lock()
+	defer platformMu.Unlock()
+	for i, entry := range platformEntries {
+		if entry.name == name {
+			platformEntries[i].factory = factory
+			return
+		}
+	}
+	platformEntries = append(platformEntries, platformEntry{name: name, factory: factory})
+}
+
+// NewPlatformAnnouncer returns the first registered native announcer that
+// initializes, falling back to a SimpleAnnouncer. The announcer is always
+// usable; a non-nil error explains why native announcers were skipped.
+func NewPlatformAnnouncer() (Announcer, error) {
+	platformMu.Lock()
+	entries := append([]platformEntry(nil), platformEntries...)
+	platformMu.Unlock()
+
+	var errs []error
+	for _, entry := range entries {
+		announcer, err := entry.factory()
+		if err == nil && announcer != nil {
+			return announcer, nil
+		}
+		if err == nil {
+			err = errors.New("unavailable")
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", entry.name, err))
+	}
+	return &SimpleAnnouncer{}, errors.Join(errs...)
+}
+
+// PostFunc delivers an announcement to a native screen reader.
+type PostFunc func(Announcement) error
+
+// ForwardingAnnouncer records announcements like SimpleAnnouncer and
+// forwards each one to a native screen reader through a PostFunc. Platform
+// packages build on it.
+type ForwardingAnnouncer struct {
+	SimpleAnnouncer
+	post PostFunc
+
+	errMu   sync.Mutex
+	lastErr error
+}
+
+// NewForwardingAnnouncer creates an announcer that forwards through post.
+func NewForwardingAnnouncer(post PostFunc) *ForwardingAnnouncer {
+	return &ForwardingAnnouncer{post: post}
+}
+
+// Announce records and forwards a message.
+func (a *ForwardingAnnouncer) Announce(message string, priority Priority) {
+	if a == nil {
+		return
+	}
+	msg := strings.TrimSpace(message)
+	if msg == "" {
+		return
+	}
+	a.SimpleAnnouncer.Announce(msg, priority)
+	if a.post == nil {
+		return
+	}
+	err := a.post(Announcement{Message: msg, Priority: priority})
+	a.errMu.Lock()
+	a.lastErr = err
+	a.errMu.Unlock()
+}
+
+// AnnounceChange announces the widget state, honoring its live region.
+func (a *ForwardingAnnouncer) AnnounceChange(widget Accessible) {
+	if message, priority, ok := changeAnnouncement(widget); ok {
+		a.Announce(message, priority)
+	}
+}
+
+// AnnounceFocus announces a widget receiving focus.
+func (a *ForwardingAnnouncer) AnnounceFocus(widget Accessible, description string) {
+	a.Announce(FormatFocus(widget, description), PriorityPolite)
+}
+
+// Err returns the error from the most recent forward, if any.
+func (a *ForwardingAnnouncer) Err() error {
+	if a == nil {
+		return nil
+	}
+	a.errMu.Lock()
+	defer a.errMu.Unlock()
+	return a.lastErr
+}
+
+var (
+	_ Announcer      = (*ForwardingAnnouncer)(nil)
+	_ FocusAnnouncer = (*ForwardingAnnouncer)(nil)
+)
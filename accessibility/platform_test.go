@@ -0,0 +1,44 @@
+package accessibility
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPlatformAnnouncerFallback(t *testing.T) {
+	RegisterPlatformAnnouncer("broken", func() (Announcer, error) {
+		return nil, errors.New("no screen reader")
+	})
+	announcer, err := NewPlatformAnnouncer()
+	if err == nil {
+		t.Fatalf("expected error describing the skipped platform")
+	}
+	if _, ok := announcer.(*SimpleAnnouncer); !ok {
+		t.Fatalf("expected SimpleAnnouncer fallback, got %T", announcer)
+	}
+
+	var posted []Announcement
+	RegisterPlatformAnnouncer("broken", func() (Announcer, error) {
+		return NewForwardingAnnouncer(func(a Announcement) error {
+			posted = append(posted, a)
+			return nil
+		}), nil
+	})
+	announcer, err = NewPlatformAnnouncer()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	forwarding, ok := announcer.(*ForwardingAnnouncer)
+	if !ok {
+		t.Fatalf("expected registered announcer, got %T", announcer)
+	}
+	base := &Base{Role: RoleStatus, Label: "Saved", Live: LiveAssertive}
+	forwarding.AnnounceChange(base)
+	forwarding.AnnounceFocus(base, "")
+	if len(posted) != 2 || posted[0].Priority != PriorityAssertive || posted[1].Priority != PriorityPolite {
+		t.Fatalf("unexpected posts %v", posted)
+	}
+	if len(forwarding.History()) != 2 {
+		t.Fatalf("expected history to record posts")
+	}
+}
@@ -0,0 +1,158 @@
+package accessibility
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// QueuedAnnouncer paces announcements before passing them to another
+// announcer. Assertive messages interrupt: they are delivered at once and
+// drop any polite message still waiting. Polite messages are delivered at
+// most once per rate limit; while one waits, a newer polite message
+// replaces it, so rapid updates such as a running counter announce only
+// their latest value.
+type QueuedAnnouncer struct {
+	mu        sync.Mutex
+	next      Announcer
+	rateLimit time.Duration
+	last      time.Time
+	pending   []Announcement
+	timer     *time.Timer
+	now       func() time.Time
+}
+
+// NewQueuedAnnouncer creates a queue in front of next. Without a rate
+// limit, polite messages pass straight through.
+func NewQueuedAnnouncer(next Announcer) *QueuedAnnouncer {
+	return &QueuedAnnouncer{next: next, now: time.Now}
+}
+
+// SetRateLimit sets the minimum time between polite announcements. Zero
+// or less delivers every message and flushes any that are waiting.
+func (q *QueuedAnnouncer) SetRateLimit(d time.Duration) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.rateLimit = d
+	q.mu.Unlock()
+	if d <= 0 {
+		q.Flush()
+	}
+}
+
+// Pending returns a copy of the polite messages waiting to be delivered.
+func (q *QueuedAnnouncer) Pending() []Announcement {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return nil
+	}
+	out := make([]Announcement, len(q.pending))
+	copy(out, q.pending)
+	return out
+}
+
+// Clear drops the polite messages waiting to be delivered.
+func (q *QueuedAnnouncer) Clear() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.clearLocked()
+	q.mu.Unlock()
+}
+
+// Flush delivers the waiting polite messages now.
+func (q *QueuedAnnouncer) Flush() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	pending := q.pending
+	q.clearLocked()
+	if len(pending) > 0 {
+		q.last = q.clock()
+	}
+	next := q.next
+	q.mu.Unlock()
+	q.deliver(next, pending...)
+}
+
+// Announce queues a message according to its priority.
+func (q *QueuedAnnouncer) Announce(message string, priority Priority) {
+	if q == nil {
+		return
+	}
+	msg := strings.TrimSpace(message)
+	if msg == "" {
+		return
+	}
+	announcement := Announcement{Message: msg, Priority: priority}
+	q.mu.Lock()
+	next := q.next
+	now := q.clock()
+	if priority == PriorityAssertive {
+		q.clearLocked()
+		q.mu.Unlock()
+		q.deliver(next, announcement)
+		return
+	}
+	wait := q.rateLimit - now.Sub(q.last)
+	if q.rateLimit <= 0 || (wait <= 0 && len(q.pending) == 0) {
+		q.last = now
+		q.mu.Unlock()
+		q.deliver(next, announcement)
+		return
+	}
+	q.pending = []Announcement{announcement}
+	if q.timer == nil {
+		q.timer = time.AfterFunc(max(wait, 0), q.Flush)
+	}
+	q.mu.Unlock()
+}
+
+// AnnounceChange queues the widget state, honoring its live region.
+func (q *QueuedAnnouncer) AnnounceChange(widget Accessible) {
+	if message, priority, ok := changeAnnouncement(widget); ok {
+		q.Announce(message, priority)
+	}
+}
+
+// AnnounceFocus queues a focus announcement as a polite message.
+func (q *QueuedAnnouncer) AnnounceFocus(widget Accessible, description string) {
+	q.Announce(FormatFocus(widget, description), PriorityPolite)
+}
+
+func (q *QueuedAnnouncer) clock() time.Time {
+	if q.now != nil {
+		return q.now()
+	}
+	return time.Now()
+}
+
+func (q *QueuedAnnouncer) clearLocked() {
+	q.pending = nil
+	if q.timer != nil {
+		q.timer.Stop()
+		q.timer = nil
+	}
+}
+
+func (q *QueuedAnnouncer) deliver(next Announcer, announcements ...Announcement) {
+	if next == nil {
+		return
+	}
+	for _, announcement := range announcements {
+		next.Announce(announcement.Message, announcement.Priority)
+	}
+}
+
+var (
+	_ Announcer      = (*QueuedAnnouncer)(nil)
+	_ FocusAnnouncer = (*QueuedAnnouncer)(nil)
+)
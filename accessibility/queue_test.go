@@ -0,0 +1,84 @@
+package accessibility
+
+import (
+	"testing"
+	"time"
+)
+
+func messages(history []Announcement) []string {
+	out := make([]string, 0, len(history))
+	for _, entry := range history {
+		out = append(out, entry.Message)
+	}
+	return out
+}
+
+func TestQueuedAnnouncerCoalescesPolite(t *testing.T) {
+	sink := &SimpleAnnouncer{}
+	clock := time.Unix(100, 0)
+	queue := NewQueuedAnnouncer(sink)
+	queue.now = func() time.Time { return clock }
+	queue.SetRateLimit(time.Hour)
+
+	queue.Announce("Count 1", PriorityPolite)
+	queue.Announce("Count 2", PriorityPolite)
+	queue.Announce("Count 3", PriorityPolite)
+	if got := messages(sink.History()); len(got) != 1 || got[0] != "Count 1" {
+		t.Fatalf("delivered = %q, want only the first message", got)
+	}
+	if got := messages(queue.Pending()); len(got) != 1 || got[0] != "Count 3" {
+		t.Fatalf("pending = %q, want the latest message", got)
+	}
+
+	queue.Flush()
+	if got := messages(sink.History()); len(got) != 2 || got[1] != "Count 3" {
+		t.Fatalf("delivered = %q after flush", got)
+	}
+
+	queue.Announce("Count 4", PriorityPolite)
+	queue.Clear()
+	if queue.Pending() != nil {
+		t.Fatal("expected Clear to drop pending messages")
+	}
+	clock = clock.Add(time.Hour)
+	queue.Announce("Count 5", PriorityPolite)
+	if got := messages(sink.History()); len(got) != 3 || got[2] != "Count 5" {
+		t.Fatalf("delivered = %q, want the message once the limit passed", got)
+	}
+}
+
+func TestQueuedAnnouncerAssertiveInterrupts(t *testing.T) {
+	sink := &SimpleAnnouncer{}
+	queue := NewQueuedAnnouncer(sink)
+	queue.SetRateLimit(time.Hour)
+
+	queue.Announce("Saving", PriorityPolite)
+	queue.Announce("Saved", PriorityPolite)
+	queue.Announce("Disk full", PriorityAssertive)
+	history := sink.History()
+	if got := messages(history); len(got) != 2 || got[1] != "Disk full" {
+		t.Fatalf("delivered = %q", got)
+	}
+	if history[1].Priority != PriorityAssertive {
+		t.Fatalf("priority = %v, want assertive", history[1].Priority)
+	}
+	if queue.Pending() != nil {
+		t.Fatal("expected the assertive message to drop the stale polite one")
+	}
+}
+
+func TestQueuedAnnouncerDeliversAfterLimit(t *testing.T) {
+	sink := &SimpleAnnouncer{}
+	queue := NewQueuedAnnouncer(sink)
+	queue.SetRateLimit(10 * time.Millisecond)
+
+	queue.Announce("first", PriorityPolite)
+	queue.Announce("second", PriorityPolite)
+	deadline := time.Now().Add(time.Second)
+	for len(sink.History()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := messages(sink.History()); len(got) != 2 || got[1] != "second" {
+		t.Fatalf("delivered = %q, want the pending message after the limit", got)
+	}
+}
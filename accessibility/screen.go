@@ -0,0 +1,79 @@
+package accessibility
+
+import (
+	"sort"
+	"strings"
+)
+
+// Bounds is where a node is drawn, in cells.
+type Bounds struct {
+	X, Y, Width, Height int
+}
+
+// ScreenNode is a widget in a ScreenDescription with its accessibility
+// properties, bounds, and accessible children.
+type ScreenNode struct {
+	Base
+	Bounds   Bounds
+	Focused  bool
+	Children []ScreenNode
+}
+
+// ScreenDescription is the semantic tree of a screen with its text in
+// reading order, for audits, braille displays, and tests. runtime.ExportScreen
+// builds one from a widget tree.
+type ScreenDescription struct {
+	Nodes []ScreenNode
+	// Text has one line per visible node with a label or value, read top
+	// to bottom and left to right, formatted like a focus announcement.
+	Text string
+}
+
+// NewScreenDescription describes the node tree and derives its text.
+func NewScreenDescription(nodes []ScreenNode) ScreenDescription {
+	desc := ScreenDescription{Nodes: nodes}
+	var lines []string
+	for _, node := range desc.ReadingOrder() {
+		lines = append(lines, FormatFocus(&node, ""))
+	}
+	desc.Text = strings.Join(lines, "\n")
+	return desc
+}
+
+// ReadingOrder returns the visible nodes with a label or value, without
+// their children, ordered by row and then column. Nodes on the same cell
+// keep tree order, so a container is read before what it contains.
+func (d ScreenDescription) ReadingOrder() []ScreenNode {
+	var out []ScreenNode
+	var walk func(nodes []ScreenNode)
+	walk = func(nodes []ScreenNode) {
+		for _, node := range nodes {
+			if node.readable() {
+				flat := node
+				flat.Children = nil
+				out = append(out, flat)
+			}
+			walk(node.Children)
+		}
+	}
+	walk(d.Nodes)
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i].Bounds, out[j].Bounds
+		if a.Y != b.Y {
+			return a.Y < b.Y
+		}
+		return a.X < b.X
+	})
+	return out
+}
+
+// readable reports whether the node is on screen with something to read.
+func (n ScreenNode) readable() bool {
+	if n.Bounds.Width <= 0 || n.Bounds.Height <= 0 {
+		return false
+	}
+	if strings.TrimSpace(n.Label) != "" {
+		return true
+	}
+	return n.Value != nil && strings.TrimSpace(n.Value.Text) != ""
+}
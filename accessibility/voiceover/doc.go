@@ -0,0 +1,10 @@
+// Package voiceover forwards accessibility announcements to VoiceOver on
+// macOS through NSAccessibilityPostNotificationWithUserInfo.
+//
+// Import it for side effects to make it available to
+// accessibility.NewPlatformAnnouncer:
+//
+//	import _ "github.com/odvcencio/fluffyui/accessibility/voiceover"
+//
+// On other platforms the package is empty.
+package voiceover
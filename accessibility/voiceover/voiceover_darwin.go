@@ -0,0 +1,121 @@
+//go:build darwin
+
+package voiceover
+
+import (
+	"runtime"
+	"sync"
+	"unsafe"
+
+	"github.com/ebitengine/purego"
+	"github.com/odvcencio/fluffyui/accessibility"
+)
+
+// Notification and userInfo keys, matching the values of the AppKit
+// NSAccessibility* string constants.
+const (
+	announcementRequested = "AXAnnouncementRequested"
+	announcementKey       = "AXAnnouncementKey"
+	priorityKey           = "AXPriorityKey"
+)
+
+// NSAccessibilityPriorityLevel values.
+const (
+	priorityMedium = 50
+	priorityHigh   = 90
+)
+
+var (
+	loadOnce sync.Once
+	loadErr  error
+
+	objcGetClass            func(name *byte) uintptr
+	selRegisterName         func(name *byte) uintptr
+	objcAutoreleasePoolPush func() uintptr
+	objcAutoreleasePoolPop  func(pool uintptr)
+	objcMsgSend             func(obj, sel uintptr) uintptr
+	objcMsgSend1            func(obj, sel, a1 uintptr) uintptr
+	objcMsgSend2            func(obj, sel, a1, a2 uintptr) uintptr
+	postNotification        func(element, name, userInfo uintptr)
+)
+
+func init() {
+	accessibility.RegisterPlatformAnnouncer("voiceover", func() (accessibility.Announcer, error) {
+		announcer, err := New()
+		if err != nil {
+			return nil, err
+		}
+		return announcer, nil
+	})
+}
+
+// Announcer posts announcements to VoiceOver and records them locally.
+type Announcer struct {
+	*accessibility.ForwardingAnnouncer
+}
+
+// New loads AppKit and returns a VoiceOver announcer.
+func New() (*Announcer, error) {
+	if err := load(); err != nil {
+		return nil, err
+	}
+	return &Announcer{ForwardingAnnouncer: accessibility.NewForwardingAnnouncer(post)}, nil
+}
+
+func load() error {
+	loadOnce.Do(func() {
+		objc, err := purego.Dlopen("/usr/lib/libobjc.A.dylib", purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		if err != nil {
+			loadErr = err
+			return
+		}
+		appKit, err := purego.Dlopen("/System/Library/Frameworks/AppKit.framework/AppKit", purego.RTLD_NOW|purego.RTLD_GLOBAL)
+		if err != nil {
+			loadErr = err
+			return
+		}
+		purego.RegisterLibFunc(&objcGetClass, objc, "objc_getClass")
+		purego.RegisterLibFunc(&selRegisterName, objc, "sel_registerName")
+		purego.RegisterLibFunc(&objcAutoreleasePoolPush, objc, "objc_autoreleasePoolPush")
+		purego.RegisterLibFunc(&objcAutoreleasePoolPop, objc, "objc_autoreleasePoolPop")
+		purego.RegisterLibFunc(&objcMsgSend, objc, "objc_msgSend")
+		purego.RegisterLibFunc(&objcMsgSend1, objc, "objc_msgSend")
+		purego.RegisterLibFunc(&objcMsgSend2, objc, "objc_msgSend")
+		purego.RegisterLibFunc(&postNotification, appKit, "NSAccessibilityPostNotificationWithUserInfo")
+	})
+	return loadErr
+}
+
+func post(a accessibility.Announcement) error {
+	pool := objcAutoreleasePoolPush()
+	defer objcAutoreleasePoolPop(pool)
+
+	level := uintptr(priorityMedium)
+	if a.Priority == accessibility.PriorityAssertive {
+		level = priorityHigh
+	}
+	info := objcMsgSend(class("NSMutableDictionary"), sel("dictionary"))
+	objcMsgSend2(info, sel("setObject:forKey:"), nsString(a.Message), nsString(announcementKey))
+	objcMsgSend2(info, sel("setObject:forKey:"), objcMsgSend1(class("NSNumber"), sel("numberWithInteger:"), level), nsString(priorityKey))
+
+	app := objcMsgSend(class("NSApplication"), sel("sharedApplication"))
+	postNotification(app, nsString(announcementRequested), info)
+	return nil
+}
+
+func nsString(s string) uintptr {
+	cstr := append([]byte(s), 0)
+	str := objcMsgSend1(class("NSString"), sel("stringWithUTF8String:"), uintptr(unsafe.Pointer(&cstr[0])))
+	runtime.KeepAlive(cstr)
+	return str
+}
+
+func class(name string) uintptr {
+	cname := append([]byte(name), 0)
+	return objcGetClass((*byte)(unsafe.Pointer(&cname[0])))
+}
+
+func sel(name string) uintptr {
+	cname := append([]byte(name), 0)
+	return selRegisterName((*byte)(unsafe.Pointer(&cname[0])))
+}
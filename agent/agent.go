@@ -716,6 +716,36 @@ func accessibleChoice(acc accessibility.Accessible) string {
 	return acc.AccessibleLabel()
 }
 
+// AppState serializes the Persistable widget state of the app. It is
+// included in "snapshot" responses when include_state is set.
+func (a *Agent) AppState() ([]byte, error) {
+	if a == nil {
+		return nil, ErrNoApp
+	}
+	a.mu.Lock()
+	app := a.app
+	a.mu.Unlock()
+	if app == nil {
+		return nil, ErrNoApp
+	}
+	return app.Snapshot()
+}
+
+// RestoreAppState hands state captured by AppState to the app, waking a
+// pending runtime.App.RestoreFromAgent call if there is one.
+func (a *Agent) RestoreAppState(data []byte) error {
+	if a == nil {
+		return ErrNoApp
+	}
+	a.mu.Lock()
+	app := a.app
+	a.mu.Unlock()
+	if app == nil {
+		return ErrNoApp
+	}
+	return app.DeliverRestore(data)
+}
+
 // SendKeyMsg injects a raw key message into the app.
 func (a *Agent) SendKeyMsg(msg runtime.KeyMsg) error {
 	if a == nil {
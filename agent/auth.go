@@ -0,0 +1,162 @@
+//go:build !js
+
+package agent
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+// PermSet is a bitfield of agent permissions granted to a token.
+type PermSet uint32
+
+const (
+	// PermSnapshot allows reading snapshots.
+	PermSnapshot PermSet = 1 << iota
+	// PermAction allows key, mouse, and paste input.
+	PermAction
+	// PermResize allows resizing the terminal.
+	PermResize
+	// PermText allows typing text and capturing screen text.
+	PermText
+	// PermAdmin grants every permission and allows managing tokens.
+	PermAdmin
+)
+
+// PermAll grants every permission.
+const PermAll = PermSnapshot | PermAction | PermResize | PermText | PermAdmin
+
+var permNames = []struct {
+	perm PermSet
+	name string
+}{
+	{PermSnapshot, "snapshot"},
+	{PermAction, "action"},
+	{PermResize, "resize"},
+	{PermText, "text"},
+	{PermAdmin, "admin"},
+}
+
+// Has reports whether p grants perm. PermAdmin implies every permission.
+func (p PermSet) Has(perm PermSet) bool {
+	if p&PermAdmin != 0 {
+		return true
+	}
+	return p&perm == perm
+}
+
+// String returns a comma-separated list of permission names.
+func (p PermSet) String() string {
+	var names []string
+	for _, entry := range permNames {
+		if p&entry.perm != 0 {
+			names = append(names, entry.name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// ParsePermissions converts permission names into a PermSet.
+func ParsePermissions(names []string) (PermSet, error) {
+	var set PermSet
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for _, entry := range permNames {
+			if entry.name == name {
+				set |= entry.perm
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("unknown permission %q", name)
+		}
+	}
+	return set, nil
+}
+
+// Token authenticates a client and scopes what it may do.
+type Token struct {
+	Value       string
+	Permissions PermSet
+}
+
+// authRequired reports whether clients must present a token.
+func (s *Server) authRequired() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.opts.Token != "" || len(s.tokens) > 0
+}
+
+// lookupToken returns the permissions for value.
+func (s *Server) lookupToken(value string) (PermSet, bool) {
+	if value == "" {
+		return 0, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.opts.Token != "" && subtle.ConstantTimeCompare([]byte(value), []byte(s.opts.Token)) == 1 {
+		return PermAll, true
+	}
+	for _, token := range s.tokens {
+		if subtle.ConstantTimeCompare([]byte(value), []byte(token.Value)) == 1 {
+			return token.Permissions, true
+		}
+	}
+	return 0, false
+}
+
+// AddToken registers a token at runtime.
+func (s *Server) AddToken(token Token) error {
+	if s == nil {
+		return fmt.Errorf("agent server is nil")
+	}
+	if strings.TrimSpace(token.Value) == "" {
+		return fmt.Errorf("token value is required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, existing := range s.tokens {
+		if existing.Value == token.Value {
+			s.tokens[i] = token
+			return nil
+		}
+	}
+	s.tokens = append(s.tokens, token)
+	return nil
+}
+
+func newSession(s *Server) *session {
+	if s.authRequired() {
+		return &session{}
+	}
+	return &session{authed: true, perms: PermAll}
+}
+
+// requiredPermission maps a request to the permission it needs.
+func requiredPermission(req request) PermSet {
+	switch req.Type {
+	case "snapshot":
+		if req.IncludeText {
+			return PermSnapshot | PermText
+		}
+		return PermSnapshot
+	case "key", "mouse", "paste", "restore":
+		return PermAction
+	case "text":
+		return PermText
+	case "resize":
+		return PermResize
+	case "token.add":
+		return PermAdmin
+	case "wait_condition":
+		if req.Condition != nil && req.Condition.needsText() {
+			return PermSnapshot | PermText
+		}
+		return PermSnapshot
+	default:
+		return 0
+	}
+}
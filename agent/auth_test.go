@@ -0,0 +1,89 @@
+package agent
+
+import (
+	"context"
+	"testing"
+)
+
+func TestServerScopedTokens(t *testing.T) {
+	srv, err := NewServer(ServerOptions{
+		Addr:  "tcp:127.0.0.1:0",
+		Agent: New(Config{}),
+		Tokens: []Token{
+			{Value: "reader", Permissions: PermSnapshot},
+			{Value: "root", Permissions: PermAdmin},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	ctx := context.Background()
+
+	sess := newSession(srv)
+	if resp := srv.handleRequest(ctx, sess, request{Type: "snapshot"}); resp.Error != "unauthorized" {
+		t.Fatalf("expected unauthorized before hello, got %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "hello"}); resp.Error != "unauthorized" {
+		t.Fatalf("expected missing token to be unauthorized, got %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "hello", Token: "bogus"}); resp.Error != "unauthorized" {
+		t.Fatalf("expected invalid token to be unauthorized, got %#v", resp)
+	}
+
+	if resp := srv.handleRequest(ctx, sess, request{Type: "hello", Token: "reader"}); !resp.OK {
+		t.Fatalf("hello failed: %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "snapshot"}); !resp.OK {
+		t.Fatalf("snapshot failed: %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "resize", Width: 10, Height: 5}); resp.Error != "forbidden" {
+		t.Fatalf("expected resize to be forbidden, got %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "token.add", NewToken: "x"}); resp.Error != "forbidden" {
+		t.Fatalf("expected token.add to be forbidden, got %#v", resp)
+	}
+
+	admin := newSession(srv)
+	if resp := srv.handleRequest(ctx, admin, request{Type: "hello", Token: "root"}); !resp.OK {
+		t.Fatalf("admin hello failed: %#v", resp)
+	}
+	resp := srv.handleRequest(ctx, admin, request{
+		Type:        "token.add",
+		NewToken:    "resizer",
+		Permissions: []string{"resize", "snapshot"},
+	})
+	if !resp.OK {
+		t.Fatalf("token.add failed: %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, admin, request{Type: "token.add", NewToken: "y", Permissions: []string{"bogus"}}); resp.Error != "invalid_permissions" {
+		t.Fatalf("expected invalid_permissions, got %#v", resp)
+	}
+
+	added := newSession(srv)
+	if resp := srv.handleRequest(ctx, added, request{Type: "hello", Token: "resizer"}); !resp.OK {
+		t.Fatalf("hello with added token failed: %#v", resp)
+	}
+	if added.perms != PermResize|PermSnapshot {
+		t.Fatalf("perms = %v, want resize,snapshot", added.perms)
+	}
+}
+
+func TestServerWithoutTokensAllowsAll(t *testing.T) {
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	sess := newSession(srv)
+	if !sess.authed || sess.perms != PermAll {
+		t.Fatalf("expected open session, got %#v", sess)
+	}
+}
+
+func TestPermSetString(t *testing.T) {
+	if got := (PermSnapshot | PermText).String(); got != "snapshot,text" {
+		t.Fatalf("String() = %q", got)
+	}
+	if !PermAdmin.Has(PermResize) {
+		t.Fatalf("expected admin to imply resize")
+	}
+}
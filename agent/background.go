@@ -30,7 +30,8 @@ type BackgroundTask struct {
 	progress    atomic.Int64 // 0-100
 
 	// Execution
-	fn BackgroundTaskFunc
+	fn         BackgroundTaskFunc
+	onProgress func(t *BackgroundTask)
 }
 
 // TaskStatus represents the status of a background task
@@ -172,7 +173,12 @@ func (t *BackgroundTask) SetProgress(p int) {
 	if p > 100 {
 		p = 100
 	}
-	t.progress.Store(int64(p))
+	if t.progress.Swap(int64(p)) == int64(p) {
+		return
+	}
+	if t.onProgress != nil {
+		t.onProgress(t)
+	}
 }
 
 // Error returns the error if the task failed
@@ -303,8 +309,9 @@ type BackgroundTaskManager struct {
 	maxTasksPerSession int
 
 	// Callbacks
-	onTaskStart func(t *BackgroundTask)
-	onTaskDone  func(t *BackgroundTask)
+	onTaskStart    func(t *BackgroundTask)
+	onTaskDone     func(t *BackgroundTask)
+	onTaskProgress func(t *BackgroundTask)
 }
 
 // NewBackgroundTaskManager creates a new task manager
@@ -356,6 +363,7 @@ func (m *BackgroundTaskManager) Submit(id, name, description, sessionID string,
 	}
 
 	task := NewBackgroundTask(id, name, description, sessionID, fn)
+	task.onProgress = m.notifyProgress
 
 	// Start the task
 	if err := task.Start(); err != nil {
@@ -506,6 +514,26 @@ func (m *BackgroundTaskManager) SetTaskDoneCallback(fn func(t *BackgroundTask))
 	m.onTaskDone = fn
 }
 
+// SetTaskProgressCallback sets a callback for when a task's progress changes
+func (m *BackgroundTaskManager) SetTaskProgressCallback(fn func(t *BackgroundTask)) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onTaskProgress = fn
+}
+
+// notifyProgress forwards a task's progress change to the callback
+func (m *BackgroundTaskManager) notifyProgress(t *BackgroundTask) {
+	m.mu.RLock()
+	fn := m.onTaskProgress
+	m.mu.RUnlock()
+	if fn != nil {
+		fn(t)
+	}
+}
+
 // BackgroundJob is a convenience type for simple background jobs
 type BackgroundJob struct {
 	manager *BackgroundTaskManager
@@ -0,0 +1,372 @@
+//go:build !js
+
+package agent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Framing selects the wire encoding used by the real-time WebSocket server.
+type Framing int
+
+const (
+	// FramingJSON sends JSON text frames.
+	FramingJSON Framing = iota
+	// FramingMsgpack sends MessagePack binary frames.
+	FramingMsgpack
+)
+
+// WebSocket subprotocol names used to negotiate framing.
+const (
+	SubprotocolJSON    = "fluffy-json"
+	SubprotocolMsgpack = "fluffy-msgpack"
+)
+
+// String returns the subprotocol name for the framing.
+func (f Framing) String() string {
+	if f == FramingMsgpack {
+		return SubprotocolMsgpack
+	}
+	return SubprotocolJSON
+}
+
+// framingForSubprotocol maps a negotiated subprotocol to a framing.
+func framingForSubprotocol(proto string, fallback Framing) Framing {
+	switch proto {
+	case SubprotocolJSON:
+		return FramingJSON
+	case SubprotocolMsgpack:
+		return FramingMsgpack
+	default:
+		return fallback
+	}
+}
+
+// subprotocolsFor lists supported subprotocols with the preferred one first.
+func subprotocolsFor(preferred Framing) []string {
+	if preferred == FramingMsgpack {
+		return []string{SubprotocolMsgpack, SubprotocolJSON}
+	}
+	return []string{SubprotocolJSON, SubprotocolMsgpack}
+}
+
+// encodeFrame serializes v using the framing. Output is deterministic. JSON
+// keeps struct fields in declaration order; msgpack goes through a generic
+// map, so every object, struct or map, is written with its keys sorted.
+func encodeFrame(f Framing, v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if f != FramingMsgpack {
+		return data, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := writeMsgpack(&buf, generic); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeFrame deserializes data into v using the framing.
+func decodeFrame(f Framing, data []byte, v any) error {
+	if f != FramingMsgpack {
+		return json.Unmarshal(data, v)
+	}
+	r := &msgpackReader{data: data}
+	generic, err := r.read()
+	if err != nil {
+		return err
+	}
+	if r.pos != len(data) {
+		return errors.New("msgpack: trailing data")
+	}
+	asJSON, err := json.Marshal(generic)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(asJSON, v)
+}
+
+func writeMsgpack(buf *bytes.Buffer, v any) error {
+	switch val := v.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if val {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		if i, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+			writeMsgpackInt(buf, i)
+			return nil
+		}
+		f, err := val.Float64()
+		if err != nil {
+			return err
+		}
+		buf.WriteByte(0xcb)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(f))
+	case string:
+		n := len(val)
+		switch {
+		case n < 32:
+			buf.WriteByte(0xa0 | byte(n))
+		case n <= math.MaxUint8:
+			buf.WriteByte(0xd9)
+			buf.WriteByte(byte(n))
+		case n <= math.MaxUint16:
+			buf.WriteByte(0xda)
+			_ = binary.Write(buf, binary.BigEndian, uint16(n))
+		default:
+			buf.WriteByte(0xdb)
+			_ = binary.Write(buf, binary.BigEndian, uint32(n))
+		}
+		buf.WriteString(val)
+	case []any:
+		writeMsgpackLen(buf, len(val), 0x90, 0xdc, 0xdd)
+		for _, item := range val {
+			if err := writeMsgpack(buf, item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for key := range val {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		writeMsgpackLen(buf, len(keys), 0x80, 0xde, 0xdf)
+		for _, key := range keys {
+			if err := writeMsgpack(buf, key); err != nil {
+				return err
+			}
+			if err := writeMsgpack(buf, val[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("msgpack: unsupported type %T", v)
+	}
+	return nil
+}
+
+func writeMsgpackLen(buf *bytes.Buffer, n int, fix, b16, b32 byte) {
+	switch {
+	case n < 16:
+		buf.WriteByte(fix | byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(b16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(b32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+func writeMsgpackInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i < 128:
+		buf.WriteByte(byte(i))
+	case i < 0 && i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		buf.WriteByte(0xd1)
+		_ = binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		buf.WriteByte(0xd2)
+		_ = binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		_ = binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+var errMsgpackShort = errors.New("msgpack: unexpected end of data")
+
+type msgpackReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *msgpackReader) next(n int) ([]byte, error) {
+	if n < 0 || r.pos+n > len(r.data) {
+		return nil, errMsgpackShort
+	}
+	out := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return out, nil
+}
+
+func (r *msgpackReader) uint(n int) (uint64, error) {
+	b, err := r.next(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+func (r *msgpackReader) read() (any, error) {
+	b, err := r.next(1)
+	if err != nil {
+		return nil, err
+	}
+	c := b[0]
+	switch {
+	case c <= 0x7f:
+		return int64(c), nil
+	case c >= 0xe0:
+		return int64(int8(c)), nil
+	case c&0xf0 == 0x80:
+		return r.readMap(int(c & 0x0f))
+	case c&0xf0 == 0x90:
+		return r.readArray(int(c & 0x0f))
+	case c&0xe0 == 0xa0:
+		return r.readString(int(c & 0x1f))
+	}
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xd9:
+		n, err := r.uint(1)
+		if err != nil {
+			return nil, err
+		}
+		return r.readString(int(n))
+	case 0xc5, 0xda:
+		n, err := r.uint(2)
+		if err != nil {
+			return nil, err
+		}
+		return r.readString(int(n))
+	case 0xc6, 0xdb:
+		n, err := r.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return r.readString(int(n))
+	case 0xca:
+		bits, err := r.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(uint32(bits))), nil
+	case 0xcb:
+		bits, err := r.uint(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(bits), nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		v, err := r.uint(1 << (c - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (c - 0xd0)
+		v, err := r.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := 64 - 8*size
+		return int64(v<<shift) >> shift, nil
+	case 0xdc:
+		n, err := r.uint(2)
+		if err != nil {
+			return nil, err
+		}
+		return r.readArray(int(n))
+	case 0xdd:
+		n, err := r.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return r.readArray(int(n))
+	case 0xde:
+		n, err := r.uint(2)
+		if err != nil {
+			return nil, err
+		}
+		return r.readMap(int(n))
+	case 0xdf:
+		n, err := r.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return r.readMap(int(n))
+	}
+	return nil, fmt.Errorf("msgpack: unsupported format 0x%02x", c)
+}
+
+func (r *msgpackReader) readString(n int) (any, error) {
+	b, err := r.next(n)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func (r *msgpackReader) readArray(n int) (any, error) {
+	if n > len(r.data)-r.pos {
+		return nil, errMsgpackShort
+	}
+	out := make([]any, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (r *msgpackReader) readMap(n int) (any, error) {
+	if n > len(r.data)-r.pos {
+		return nil, errMsgpackShort
+	}
+	out := make(map[string]any, n)
+	for i := 0; i < n; i++ {
+		k, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("msgpack: map key must be a string, got %T", k)
+		}
+		v, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		out[key] = v
+	}
+	return out, nil
+}
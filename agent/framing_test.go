@@ -0,0 +1,145 @@
+//go:build !js
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/odvcencio/fluffyui/backend/sim"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/widgets"
+)
+
+func TestMsgpackRoundTrip(t *testing.T) {
+	in := wsResponse{
+		Type: "snapshot",
+		ID:   "42",
+		OK:   true,
+		Data: map[string]any{
+			"zeta":  []any{1, -3, 300, -70000, 1 << 40, 1.5, "x", nil, true},
+			"alpha": map[string]any{"b": "two", "a": false},
+			"long":  string(bytes.Repeat([]byte("y"), 300)),
+		},
+	}
+	data, err := encodeFrame(FramingMsgpack, in)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	again, err := encodeFrame(FramingMsgpack, in)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Fatalf("expected deterministic encoding")
+	}
+
+	var out struct {
+		Type string         `json:"type"`
+		ID   string         `json:"id"`
+		OK   bool           `json:"ok"`
+		Data map[string]any `json:"data"`
+	}
+	if err := decodeFrame(FramingMsgpack, data, &out); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if out.Type != "snapshot" || out.ID != "42" || !out.OK {
+		t.Fatalf("unexpected envelope: %#v", out)
+	}
+	zeta := out.Data["zeta"].([]any)
+	want := []any{1.0, -3.0, 300.0, -70000.0, float64(1 << 40), 1.5, "x", nil, true}
+	for i, v := range want {
+		if zeta[i] != v {
+			t.Fatalf("zeta[%d] = %#v, want %#v", i, zeta[i], v)
+		}
+	}
+	if got := out.Data["long"].(string); len(got) != 300 {
+		t.Fatalf("long string length = %d", len(got))
+	}
+	if err := decodeFrame(FramingMsgpack, data[:len(data)-1], &out); err == nil {
+		t.Fatalf("expected error for truncated data")
+	}
+}
+
+func TestServerWebSocketFraming(t *testing.T) {
+	be := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{Backend: be})
+	app.SetRoot(widgets.NewLabel("Framed"))
+	runAppForTest(t, app)
+	time.Sleep(50 * time.Millisecond)
+
+	server, err := NewServer(ServerOptions{
+		Addr:   "unix:" + filepath.Join(t.TempDir(), "agent.sock"),
+		App:    app,
+		WSAddr: "127.0.0.1:0",
+	})
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = server.Serve(ctx)
+	}()
+	defer server.Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for server.WebSocketAddr() == "" {
+		if time.Now().After(deadline) {
+			t.Fatal("websocket listener did not start")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	url := "ws://" + server.WebSocketAddr() + "/"
+	for _, tc := range []struct {
+		protocols []string
+		want      Framing
+		frameType int
+	}{
+		{nil, FramingJSON, websocket.TextMessage},
+		{[]string{SubprotocolJSON}, FramingJSON, websocket.TextMessage},
+		{[]string{SubprotocolMsgpack}, FramingMsgpack, websocket.BinaryMessage},
+	} {
+		dialer := websocket.Dialer{Subprotocols: tc.protocols, HandshakeTimeout: time.Second}
+		conn, _, err := dialer.Dial(url, nil)
+		if err != nil {
+			t.Fatalf("dial %v: %v", tc.protocols, err)
+		}
+		if len(tc.protocols) > 0 && conn.Subprotocol() != tc.protocols[0] {
+			t.Fatalf("negotiated %q, want %q", conn.Subprotocol(), tc.protocols[0])
+		}
+
+		ping, err := encodeFrame(tc.want, wsMessage{Type: "ping", ID: "1"})
+		if err != nil {
+			t.Fatalf("encode ping: %v", err)
+		}
+		if err := conn.WriteMessage(tc.frameType, ping); err != nil {
+			t.Fatalf("write ping: %v", err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		for {
+			frameType, data, err := conn.ReadMessage()
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if frameType != tc.frameType {
+				t.Fatalf("frame type = %d, want %d", frameType, tc.frameType)
+			}
+			var resp wsResponse
+			if err := decodeFrame(tc.want, data, &resp); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			if resp.Type == "pong" {
+				if resp.ID != "1" || !resp.OK {
+					t.Fatalf("unexpected pong: %#v", resp)
+				}
+				break
+			}
+		}
+		conn.Close()
+	}
+}
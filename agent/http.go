@@ -0,0 +1,214 @@
+//go:build !js
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxHTTPBodyBytes = 2 * 1024 * 1024
+
+// HTTPHandler exposes the agent API as REST endpoints:
+//
+//	POST /api/action    body is a JSONL-style request, e.g. {"type":"key","key":"enter"}
+//	GET  /api/snapshot  current snapshot (?include_text=1 for screen text)
+//	GET  /api/widgets   accessibility tree
+//	GET  /api/events    server-sent event stream of UI changes
+//
+// When a token is configured, callers authenticate with an
+// "Authorization: Bearer <token>" header or a "token" query parameter.
+func (s *Server) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/action", s.handleHTTPAction)
+	mux.HandleFunc("/api/snapshot", s.handleHTTPSnapshot)
+	mux.HandleFunc("/api/widgets", s.handleHTTPWidgets)
+	mux.HandleFunc("/api/events", s.handleHTTPEvents)
+	return mux
+}
+
+func (s *Server) serveHTTP(ctx context.Context) error {
+	ln, unixPath, err := listenHTTPAddr(s.opts.HTTPAddr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Handler:           s.HTTPHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	s.mu.Lock()
+	s.httpServer = srv
+	s.httpUnixPath = unixPath
+	s.mu.Unlock()
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	return nil
+}
+
+// listenHTTPAddr listens on a plain "host:port" or a unix:/tcp: address.
+// For unix sockets it also returns the socket path, which the caller
+// removes on shutdown.
+func listenHTTPAddr(addr string) (net.Listener, string, error) {
+	if strings.HasPrefix(addr, "unix:") || strings.HasPrefix(addr, "tcp:") {
+		return listenAgentAddr(addr)
+	}
+	ln, err := net.Listen("tcp", addr)
+	return ln, "", err
+}
+
+// httpSession authenticates a single HTTP request.
+func (s *Server) httpSession(r *http.Request) (*session, bool) {
+	token := r.URL.Query().Get("token")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	sess := &session{}
+	resp := s.handleRequest(r.Context(), sess, request{Type: "hello", Token: token})
+	return sess, resp.OK
+}
+
+func (s *Server) handleHTTPAction(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeHTTPJSON(w, http.StatusMethodNotAllowed, response{OK: false, Error: "method_not_allowed"})
+		return
+	}
+	sess, ok := s.httpSession(r)
+	if !ok {
+		writeHTTPJSON(w, http.StatusUnauthorized, response{OK: false, Error: "unauthorized"})
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxHTTPBodyBytes))
+	if err != nil {
+		writeHTTPJSON(w, http.StatusBadRequest, response{OK: false, Error: "bad_request", Message: err.Error()})
+		return
+	}
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeHTTPJSON(w, http.StatusBadRequest, response{OK: false, Error: "bad_json", Message: err.Error()})
+		return
+	}
+	resp := s.handleRequest(r.Context(), sess, req)
+	status := http.StatusOK
+	if !resp.OK {
+		status = http.StatusBadRequest
+		if resp.Error == "unauthorized" {
+			status = http.StatusUnauthorized
+		}
+	}
+	writeHTTPJSON(w, status, resp)
+}
+
+func (s *Server) httpSnapshot(w http.ResponseWriter, r *http.Request) (*Snapshot, bool) {
+	if r.Method != http.MethodGet {
+		writeHTTPJSON(w, http.StatusMethodNotAllowed, response{OK: false, Error: "method_not_allowed"})
+		return nil, false
+	}
+	sess, ok := s.httpSession(r)
+	if !ok {
+		writeHTTPJSON(w, http.StatusUnauthorized, response{OK: false, Error: "unauthorized"})
+		return nil, false
+	}
+	includeText, _ := strconv.ParseBool(r.URL.Query().Get("include_text"))
+	resp := s.handleRequest(r.Context(), sess, request{Type: "snapshot", IncludeText: includeText})
+	if !resp.OK {
+		writeHTTPJSON(w, http.StatusBadRequest, resp)
+		return nil, false
+	}
+	return resp.Snapshot, true
+}
+
+func (s *Server) handleHTTPSnapshot(w http.ResponseWriter, r *http.Request) {
+	snap, ok := s.httpSnapshot(w, r)
+	if !ok {
+		return
+	}
+	writeHTTPJSON(w, http.StatusOK, snap)
+}
+
+func (s *Server) handleHTTPWidgets(w http.ResponseWriter, r *http.Request) {
+	snap, ok := s.httpSnapshot(w, r)
+	if !ok {
+		return
+	}
+	widgets := snap.Widgets
+	if widgets == nil {
+		widgets = []WidgetInfo{}
+	}
+	writeHTTPJSON(w, http.StatusOK, widgets)
+}
+
+func (s *Server) handleHTTPEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeHTTPJSON(w, http.StatusMethodNotAllowed, response{OK: false, Error: "method_not_allowed"})
+		return
+	}
+	if _, ok := s.httpSession(r); !ok {
+		writeHTTPJSON(w, http.StatusUnauthorized, response{OK: false, Error: "unauthorized"})
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPJSON(w, http.StatusInternalServerError, response{OK: false, Error: "streaming_unsupported"})
+		return
+	}
+
+	notifier := s.eventNotifier()
+	sub := notifier.Subscribe("", AllEventsFilter())
+	if sub == nil {
+		writeHTTPJSON(w, http.StatusServiceUnavailable, response{OK: false, Error: "subscribe_failed"})
+		return
+	}
+	defer notifier.Unsubscribe(sub.ID)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.done:
+			return
+		case event := <-sub.Events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// eventNotifier lazily starts the change notifier backing /api/events.
+func (s *Server) eventNotifier() *RealTimeNotifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.notifier == nil {
+		s.notifier = NewRealTimeNotifier(s.agent)
+		s.notifier.Start()
+	}
+	return s.notifier
+}
+
+func writeHTTPJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+}
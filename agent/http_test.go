@@ -0,0 +1,185 @@
+package agent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPActionPing(t *testing.T) {
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	ts := httptest.NewServer(srv.HTTPHandler())
+	defer ts.Close()
+
+	res, err := http.Post(ts.URL+"/api/action", "application/json", strings.NewReader(`{"id":7,"type":"ping"}`))
+	if err != nil {
+		t.Fatalf("post error: %v", err)
+	}
+	defer res.Body.Close()
+	var resp response
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || !resp.OK || resp.ID != 7 {
+		t.Fatalf("status = %d, response = %#v", res.StatusCode, resp)
+	}
+
+	res, err = http.Get(ts.URL + "/api/action")
+	if err != nil {
+		t.Fatalf("get error: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("GET /api/action status = %d", res.StatusCode)
+	}
+}
+
+func TestHTTPSnapshotAndWidgets(t *testing.T) {
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{Width: 30, Height: 5})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	ts := httptest.NewServer(srv.HTTPHandler())
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/api/snapshot")
+	if err != nil {
+		t.Fatalf("get snapshot: %v", err)
+	}
+	var snap Snapshot
+	err = json.NewDecoder(res.Body).Decode(&snap)
+	res.Body.Close()
+	if err != nil {
+		t.Fatalf("decode snapshot: %v", err)
+	}
+	if snap.Width != 30 || snap.Height != 5 {
+		t.Fatalf("snapshot size = %dx%d, want 30x5", snap.Width, snap.Height)
+	}
+
+	res, err = http.Get(ts.URL + "/api/widgets")
+	if err != nil {
+		t.Fatalf("get widgets: %v", err)
+	}
+	defer res.Body.Close()
+	var widgets []WidgetInfo
+	if err := json.NewDecoder(res.Body).Decode(&widgets); err != nil {
+		t.Fatalf("decode widgets: %v", err)
+	}
+	if widgets == nil {
+		t.Fatalf("expected empty widget array, got null")
+	}
+}
+
+func TestHTTPAuth(t *testing.T) {
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{}), Token: "secret"})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	ts := httptest.NewServer(srv.HTTPHandler())
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/api/snapshot")
+	if err != nil {
+		t.Fatalf("get snapshot: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", res.StatusCode)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/api/snapshot", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("get snapshot: %v", err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", res.StatusCode)
+	}
+}
+
+func TestHTTPEventsStream(t *testing.T) {
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	defer srv.Close()
+	ts := httptest.NewServer(srv.HTTPHandler())
+	defer ts.Close()
+
+	res, err := http.Get(ts.URL + "/api/events")
+	if err != nil {
+		t.Fatalf("get events: %v", err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("content type = %q", ct)
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "event: ") {
+			if got := strings.TrimPrefix(line, "event: "); got != EventSnapshot {
+				t.Fatalf("first event = %q, want %q", got, EventSnapshot)
+			}
+			return
+		}
+	}
+	t.Fatalf("stream ended without events: %v", scanner.Err())
+}
+
+func TestHTTPUnixSocketRemovedOnClose(t *testing.T) {
+	dir := t.TempDir()
+	httpPath := filepath.Join(dir, "http.sock")
+	for run := 0; run < 2; run++ {
+		srv, err := NewServer(ServerOptions{
+			Addr:     "unix:" + filepath.Join(dir, "agent.sock"),
+			HTTPAddr: "unix:" + httpPath,
+			Agent:    New(Config{}),
+		})
+		if err != nil {
+			t.Fatalf("NewServer error: %v", err)
+		}
+		done := make(chan error, 1)
+		go func() { done <- srv.Serve(context.Background()) }()
+
+		client := &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", httpPath)
+			},
+		}}
+		deadline := time.Now().Add(2 * time.Second)
+		for {
+			res, err := client.Post("http://agent/api/action", "application/json", strings.NewReader(`{"type":"ping"}`))
+			if err == nil {
+				res.Body.Close()
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("run %d: HTTP over unix socket: %v", run, err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if err := srv.Close(); err != nil {
+			t.Fatalf("Close error: %v", err)
+		}
+		<-done
+		if _, err := os.Stat(httpPath); !os.IsNotExist(err) {
+			t.Fatalf("run %d: HTTP socket still present after Close (stat err = %v)", run, err)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"os"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -90,8 +92,19 @@ const (
 	EventLayoutChanged = "layout_changed"
 	EventSnapshot      = "snapshot"
 	EventHeartbeat     = "heartbeat"
+	EventTaskProgress  = "task.progress"
+	EventTaskDone      = "task.done"
 )
 
+// TaskEvent reports background task progress to subscribed clients
+type TaskEvent struct {
+	Type     string `json:"type"`
+	ID       string `json:"id"`
+	Progress *int   `json:"progress,omitempty"`
+	Status   string `json:"status,omitempty"` // "ok" or "error" for task.done
+	Error    string `json:"error,omitempty"`
+}
+
 // NewRealTimeNotifier creates a new real-time notifier
 func NewRealTimeNotifier(agent *Agent) *RealTimeNotifier {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -384,7 +397,16 @@ func (n *RealTimeNotifier) hasWidgetChanges(old, new []WidgetInfo) bool {
 	if len(old) != len(new) {
 		return true
 	}
-	// Simple check - could be more sophisticated
+	for i := range new {
+		o, w := old[i], new[i]
+		if o.ID != w.ID || o.Label != w.Label || o.Value != w.Value ||
+			o.State != w.State || o.Focused != w.Focused {
+			return true
+		}
+		if n.hasWidgetChanges(o.Children, w.Children) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -493,6 +515,15 @@ func generateSubscriberID() string {
 type RealTimeServer struct {
 	*EnhancedServer
 	notifier *RealTimeNotifier
+
+	wsMu       sync.Mutex
+	wsServer   *http.Server
+	wsAddr     string
+	wsUnixPath string
+
+	taskMu        sync.Mutex
+	taskListeners map[int]func(TaskEvent)
+	taskNext      int
 }
 
 // NewRealTimeServer creates a new real-time capable server
@@ -508,6 +539,8 @@ func NewRealTimeServer(opts EnhancedServerOptions) (*RealTimeServer, error) {
 		EnhancedServer: server,
 		notifier:       notifier,
 	}
+	server.taskManager.SetTaskProgressCallback(rts.publishTaskProgress)
+	server.taskManager.SetTaskDoneCallback(rts.publishTaskDone)
 
 	// Hook into the render loop for change detection
 	if opts.App != nil {
@@ -528,10 +561,60 @@ func (rts *RealTimeServer) Start() error {
 
 // Stop stops the real-time server
 func (rts *RealTimeServer) Stop() error {
+	rts.wsMu.Lock()
+	wsServer := rts.wsServer
+	rts.wsServer = nil
+	wsUnixPath := rts.wsUnixPath
+	rts.wsUnixPath = ""
+	rts.wsMu.Unlock()
+	if wsServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		_ = wsServer.Shutdown(ctx)
+		cancel()
+		_ = wsServer.Close()
+	}
+	if wsUnixPath != "" {
+		_ = os.Remove(wsUnixPath)
+	}
 	rts.notifier.Stop()
 	return rts.EnhancedServer.Stop()
 }
 
+// serveWebSocket starts a WebSocket listener on addr that uses framing
+// when the client does not request a subprotocol.
+func (rts *RealTimeServer) serveWebSocket(addr string, framing Framing) error {
+	ln, unixPath, err := listenHTTPAddr(addr)
+	if err != nil {
+		return err
+	}
+	srv := &http.Server{
+		Handler:           newRealTimeWebSocketServer(rts, nil, framing),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	rts.wsMu.Lock()
+	rts.wsServer = srv
+	rts.wsAddr = ln.Addr().String()
+	rts.wsUnixPath = unixPath
+	rts.wsMu.Unlock()
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	return nil
+}
+
+// webSocketAddr returns the address of the WebSocket listener, if any.
+func (rts *RealTimeServer) webSocketAddr() string {
+	if rts == nil {
+		return ""
+	}
+	rts.wsMu.Lock()
+	defer rts.wsMu.Unlock()
+	if rts.wsServer == nil {
+		return ""
+	}
+	return rts.wsAddr
+}
+
 // Subscribe creates a real-time subscription
 func (rts *RealTimeServer) Subscribe(sessionID string, filters EventFilters) *RealTimeSubscriber {
 	return rts.notifier.Subscribe(sessionID, filters)
@@ -542,6 +625,53 @@ func (rts *RealTimeServer) Unsubscribe(id string) {
 	rts.notifier.Unsubscribe(id)
 }
 
+// SubscribeTasks registers fn for progress and completion events of all
+// background tasks. It returns a function that removes the listener.
+func (rts *RealTimeServer) SubscribeTasks(fn func(TaskEvent)) func() {
+	if rts == nil || fn == nil {
+		return func() {}
+	}
+	rts.taskMu.Lock()
+	if rts.taskListeners == nil {
+		rts.taskListeners = make(map[int]func(TaskEvent))
+	}
+	id := rts.taskNext
+	rts.taskNext++
+	rts.taskListeners[id] = fn
+	rts.taskMu.Unlock()
+	return func() {
+		rts.taskMu.Lock()
+		delete(rts.taskListeners, id)
+		rts.taskMu.Unlock()
+	}
+}
+
+func (rts *RealTimeServer) publishTaskProgress(t *BackgroundTask) {
+	progress := t.Progress()
+	rts.publishTask(TaskEvent{Type: EventTaskProgress, ID: t.ID, Progress: &progress})
+}
+
+func (rts *RealTimeServer) publishTaskDone(t *BackgroundTask) {
+	event := TaskEvent{Type: EventTaskDone, ID: t.ID, Status: "ok"}
+	if err := t.Error(); err != nil {
+		event.Status = "error"
+		event.Error = err.Error()
+	}
+	rts.publishTask(event)
+}
+
+func (rts *RealTimeServer) publishTask(event TaskEvent) {
+	rts.taskMu.Lock()
+	listeners := make([]func(TaskEvent), 0, len(rts.taskListeners))
+	for _, fn := range rts.taskListeners {
+		listeners = append(listeners, fn)
+	}
+	rts.taskMu.Unlock()
+	for _, fn := range listeners {
+		fn(event)
+	}
+}
+
 // hookIntoRenderLoop hooks into the app's render loop
 func (rts *RealTimeServer) hookIntoRenderLoop(app *runtime.App) {
 	// Note: This is a simplified version. In practice, you'd want to
@@ -4,10 +4,12 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/odvcencio/fluffyui/backend/sim"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/widgets"
@@ -196,3 +198,72 @@ func TestRealTimeNotifierBroadcast(t *testing.T) {
 		t.Fatal("timeout waiting for event")
 	}
 }
+
+func TestRealTimeTaskProgressStreaming(t *testing.T) {
+	be := sim.New(40, 10)
+	app := runtime.NewApp(runtime.AppConfig{Backend: be})
+	app.SetRoot(widgets.NewLabel("Tasks"))
+	runAppForTest(t, app)
+	time.Sleep(50 * time.Millisecond)
+
+	opts := DefaultEnhancedServerOptions()
+	opts.Addr = "unix:" + filepath.Join(t.TempDir(), "realtime.sock")
+	opts.App = app
+	server, err := NewRealTimeServer(opts)
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	if err := server.Start(); err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	defer server.Stop()
+	if err := server.serveWebSocket("127.0.0.1:0", FramingJSON); err != nil {
+		t.Fatalf("failed to serve websocket: %v", err)
+	}
+
+	release := make(chan struct{})
+	task, err := server.SubmitBackgroundTask("job", "", "", func(ctx context.Context, task *BackgroundTask) error {
+		<-release
+		task.SetProgress(42)
+		return errors.New("boom")
+	})
+	if err != nil {
+		t.Fatalf("submit: %v", err)
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+server.webSocketAddr()+"/", nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	if err := conn.WriteJSON(map[string]string{"type": "task.subscribe", "id": task.ID}); err != nil {
+		t.Fatalf("subscribe: %v", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	var got []map[string]any
+	for {
+		var frame map[string]any
+		if err := conn.ReadJSON(&frame); err != nil {
+			t.Fatalf("read: %v (got %v)", err, got)
+		}
+		switch frame["type"] {
+		case "task.subscribed":
+			close(release)
+		case EventTaskProgress, EventTaskDone:
+			got = append(got, frame)
+		}
+		if frame["type"] == EventTaskDone {
+			break
+		}
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected progress and done events, got %v", got)
+	}
+	if got[0]["id"] != task.ID || got[0]["progress"] != float64(42) {
+		t.Fatalf("unexpected progress event: %v", got[0])
+	}
+	if got[1]["status"] != "error" || got[1]["error"] != "boom" {
+		t.Fatalf("unexpected done event: %v", got[1])
+	}
+}
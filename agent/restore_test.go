@@ -0,0 +1,60 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+type stateWidget struct {
+	testWidget
+	key   string
+	value string
+}
+
+func (s *stateWidget) Key() string { return s.key }
+
+func (s *stateWidget) MarshalState() ([]byte, error) { return json.Marshal(s.value) }
+
+func (s *stateWidget) UnmarshalState(data []byte) error { return json.Unmarshal(data, &s.value) }
+
+func TestServerRestoreDeliversState(t *testing.T) {
+	source := runtime.NewApp(runtime.AppConfig{Root: &stateWidget{key: "draft", value: "hello"}})
+	state, err := source.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+
+	target := &stateWidget{key: "draft"}
+	app := runtime.NewApp(runtime.AppConfig{Root: target})
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{App: app})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	ctx := context.Background()
+	sess := newSession(srv)
+
+	if resp := srv.handleRequest(ctx, sess, request{Type: "restore"}); resp.Error != "missing_state" {
+		t.Fatalf("expected missing_state, got %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "restore", State: state}); !resp.OK {
+		t.Fatalf("restore failed: %#v", resp)
+	}
+
+	t.Setenv(runtime.RestoreEnv, "1")
+	if err := app.RestoreFromAgentTimeout(time.Second); err != nil {
+		t.Fatalf("RestoreFromAgent: %v", err)
+	}
+	if target.value != "hello" {
+		t.Fatalf("value = %q, want hello", target.value)
+	}
+}
+
+func TestRestoreRequiresActionPermission(t *testing.T) {
+	if perms := requiredPermission(request{Type: "restore"}); perms != PermAction {
+		t.Fatalf("restore permission = %v, want action", perms)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
@@ -22,13 +23,27 @@ import (
 // ServerOptions configures the agent interaction server.
 // TestMode should only be enabled in tests; it bypasses text gating.
 type ServerOptions struct {
-	Addr            string
-	App             *runtime.App
-	Agent           *Agent
-	AllowText       bool
-	TestMode        bool
-	Token           string
+	Addr      string
+	App       *runtime.App
+	Agent     *Agent
+	AllowText bool
+	TestMode  bool
+	Token     string
+	// Tokens authenticate clients with scoped permissions. When Token or
+	// Tokens is set, clients must send a matching token in "hello".
+	// Token grants every permission.
+	Tokens          []Token
 	SnapshotTimeout time.Duration
+	// HTTPAddr, when set, also serves the REST API (see HTTPHandler).
+	// Accepts "host:port" or the same unix:/tcp: forms as Addr.
+	HTTPAddr string
+	// WSAddr, when set, also serves the real-time WebSocket API (see
+	// RealTimeWebSocketServer). Accepts the same forms as HTTPAddr.
+	WSAddr string
+	// WSFraming is used when a WebSocket client does not request a
+	// subprotocol. Clients may pick one with Sec-WebSocket-Protocol:
+	// fluffy-json or fluffy-msgpack.
+	WSFraming Framing
 }
 
 // Capabilities describes server features exposed to clients.
@@ -39,10 +54,17 @@ type Capabilities struct {
 
 // Server exposes an out-of-process JSONL API for agent interaction.
 type Server struct {
-	opts     ServerOptions
-	agent    *Agent
-	listener net.Listener
-	unixPath string
+	opts       ServerOptions
+	agent      *Agent
+	listener   net.Listener
+	unixPath   string
+	httpServer *http.Server
+	// httpUnixPath is the socket file of a unix: HTTPAddr.
+	httpUnixPath string
+	// realtime backs the WebSocket listener when WSAddr is set.
+	realtime *RealTimeServer
+	notifier *RealTimeNotifier
+	tokens   []Token
 	mu       sync.Mutex
 }
 
@@ -56,8 +78,9 @@ func NewServer(opts ServerOptions) (*Server, error) {
 		return nil, err
 	}
 	return &Server{
-		opts:  normalized,
-		agent: agent,
+		opts:   normalized,
+		agent:  agent,
+		tokens: append([]Token(nil), normalized.Tokens...),
 	}, nil
 }
 
@@ -95,6 +118,17 @@ func (s *Server) Serve(ctx context.Context) error {
 
 	defer s.Close()
 
+	if strings.TrimSpace(s.opts.HTTPAddr) != "" {
+		if err := s.serveHTTP(ctx); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(s.opts.WSAddr) != "" {
+		if err := s.serveWebSocket(); err != nil {
+			return err
+		}
+	}
+
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
@@ -117,15 +151,35 @@ func (s *Server) Close() error {
 	s.listener = nil
 	unixPath := s.unixPath
 	s.unixPath = ""
+	httpServer := s.httpServer
+	s.httpServer = nil
+	httpUnixPath := s.httpUnixPath
+	s.httpUnixPath = ""
+	realtime := s.realtime
+	s.realtime = nil
+	notifier := s.notifier
+	s.notifier = nil
 	s.mu.Unlock()
 
 	var err error
 	if ln != nil {
 		err = ln.Close()
 	}
+	if httpServer != nil {
+		_ = httpServer.Close()
+	}
+	if realtime != nil {
+		_ = realtime.Stop()
+	}
+	if notifier != nil {
+		notifier.Stop()
+	}
 	if unixPath != "" {
 		_ = os.Remove(unixPath)
 	}
+	if httpUnixPath != "" {
+		_ = os.Remove(httpUnixPath)
+	}
 	return err
 }
 
@@ -145,19 +199,31 @@ type request struct {
 	Ctrl        bool   `json:"ctrl,omitempty"`
 	Shift       bool   `json:"shift,omitempty"`
 	IncludeText bool   `json:"include_text,omitempty"`
+	// IncludeState adds the app's Persistable state to "snapshot"; State
+	// carries it back for "restore".
+	IncludeState bool            `json:"include_state,omitempty"`
+	State        json.RawMessage `json:"state,omitempty"`
+	// NewToken and Permissions are used by "token.add".
+	NewToken    string   `json:"new_token,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	// Condition and TimeoutMS are used by "wait_condition".
+	Condition *WaitCondition `json:"condition,omitempty"`
+	TimeoutMS int            `json:"timeout_ms,omitempty"`
 }
 
 type response struct {
-	ID           int           `json:"id,omitempty"`
-	OK           bool          `json:"ok,omitempty"`
-	Error        string        `json:"error,omitempty"`
-	Message      string        `json:"message,omitempty"`
-	Snapshot     *Snapshot     `json:"snapshot,omitempty"`
-	Capabilities *Capabilities `json:"capabilities,omitempty"`
+	ID           int             `json:"id,omitempty"`
+	OK           bool            `json:"ok,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	Message      string          `json:"message,omitempty"`
+	Snapshot     *Snapshot       `json:"snapshot,omitempty"`
+	Capabilities *Capabilities   `json:"capabilities,omitempty"`
+	State        json.RawMessage `json:"state,omitempty"`
 }
 
 type session struct {
 	authed bool
+	perms  PermSet
 }
 
 func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
@@ -169,7 +235,7 @@ func (s *Server) handleConn(ctx context.Context, conn net.Conn) {
 	scanner := bufio.NewScanner(conn)
 	scanner.Buffer(make([]byte, 0, 64*1024), 2*1024*1024)
 
-	sess := &session{authed: s.opts.Token == ""}
+	sess := newSession(s)
 
 	for scanner.Scan() {
 		line := scanner.Bytes()
@@ -201,10 +267,20 @@ func (s *Server) handleRequest(ctx context.Context, sess *session, req request)
 		return response{ID: req.ID, OK: false, Error: "unauthorized", Message: "authentication required"}
 	}
 
+	if need := requiredPermission(req); need != 0 && !sess.perms.Has(need) {
+		return response{ID: req.ID, OK: false, Error: "forbidden", Message: "token lacks " + need.String() + " permission"}
+	}
+
 	switch req.Type {
 	case "hello":
-		if s.opts.Token != "" && req.Token != s.opts.Token {
-			return response{ID: req.ID, OK: false, Error: "unauthorized", Message: "invalid token"}
+		if s.authRequired() {
+			perms, ok := s.lookupToken(req.Token)
+			if !ok {
+				return response{ID: req.ID, OK: false, Error: "unauthorized", Message: "invalid token"}
+			}
+			sess.perms = perms
+		} else {
+			sess.perms = PermAll
 		}
 		sess.authed = true
 		return response{
@@ -235,7 +311,23 @@ func (s *Server) handleRequest(ctx context.Context, sess *session, req request)
 		if err != nil {
 			return response{ID: req.ID, OK: false, Error: "snapshot_failed", Message: err.Error()}
 		}
-		return response{ID: req.ID, OK: true, Snapshot: &snap}
+		resp := response{ID: req.ID, OK: true, Snapshot: &snap}
+		if req.IncludeState {
+			state, err := s.agent.AppState()
+			if err != nil {
+				return response{ID: req.ID, OK: false, Error: "state_failed", Message: err.Error()}
+			}
+			resp.State = state
+		}
+		return resp
+	case "restore":
+		if len(req.State) == 0 {
+			return response{ID: req.ID, OK: false, Error: "missing_state"}
+		}
+		if err := s.agent.RestoreAppState(req.State); err != nil {
+			return response{ID: req.ID, OK: false, Error: "restore_failed", Message: err.Error()}
+		}
+		return response{ID: req.ID, OK: true}
 	case "key":
 		press, err := parseKeyPress(req.Key)
 		if err != nil {
@@ -295,6 +387,30 @@ func (s *Server) handleRequest(ctx context.Context, sess *session, req request)
 			return response{ID: req.ID, OK: false, Error: "send_resize_failed", Message: err.Error()}
 		}
 		return response{ID: req.ID, OK: true}
+	case "token.add":
+		perms, err := ParsePermissions(req.Permissions)
+		if err != nil {
+			return response{ID: req.ID, OK: false, Error: "invalid_permissions", Message: err.Error()}
+		}
+		if err := s.AddToken(Token{Value: req.NewToken, Permissions: perms}); err != nil {
+			return response{ID: req.ID, OK: false, Error: "invalid_token", Message: err.Error()}
+		}
+		return response{ID: req.ID, OK: true}
+	case "wait_condition":
+		if req.Condition == nil || req.Condition.Empty() {
+			return response{ID: req.ID, OK: false, Error: "missing_condition"}
+		}
+		if req.Condition.needsText() && !s.opts.AllowText && !s.opts.TestMode {
+			return response{ID: req.ID, OK: false, Error: "text_disabled"}
+		}
+		timeout := time.Duration(req.TimeoutMS) * time.Millisecond
+		if _, err := s.waitCondition(ctx, *req.Condition, timeout); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return response{ID: req.ID, OK: false, Error: "timeout", Message: "condition not met"}
+			}
+			return response{ID: req.ID, OK: false, Error: "wait_failed", Message: err.Error()}
+		}
+		return response{ID: req.ID, OK: true}
 	default:
 		return response{ID: req.ID, OK: false, Error: "unknown_type"}
 	}
@@ -520,6 +520,8 @@ func (s *EnhancedServer) handleRequest(ctx context.Context, sess *serverSession,
 		return s.handlePaste(req)
 	case "resize":
 		return s.handleResize(req)
+	case "restore":
+		return s.handleRestore(req)
 	case "background_task":
 		return s.handleBackgroundTask(sess, req)
 	case "task_status":
@@ -583,7 +585,26 @@ func (s *EnhancedServer) handleSnapshot(ctx context.Context, req request) respon
 	if err != nil {
 		return response{ID: req.ID, OK: false, Error: "snapshot_failed", Message: err.Error()}
 	}
-	return response{ID: req.ID, OK: true, Snapshot: &snap}
+	resp := response{ID: req.ID, OK: true, Snapshot: &snap}
+	if req.IncludeState {
+		state, err := s.agent.AppState()
+		if err != nil {
+			return response{ID: req.ID, OK: false, Error: "state_failed", Message: err.Error()}
+		}
+		resp.State = state
+	}
+	return resp
+}
+
+// handleRestore delivers app state captured by a previous process
+func (s *EnhancedServer) handleRestore(req request) response {
+	if len(req.State) == 0 {
+		return response{ID: req.ID, OK: false, Error: "missing_state"}
+	}
+	if err := s.agent.RestoreAppState(req.State); err != nil {
+		return response{ID: req.ID, OK: false, Error: "restore_failed", Message: err.Error()}
+	}
+	return response{ID: req.ID, OK: true}
 }
 
 // handleKey handles key input
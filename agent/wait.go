@@ -0,0 +1,117 @@
+//go:build !js
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+// defaultWaitTimeout applies to "wait_condition" requests without timeout_ms.
+const defaultWaitTimeout = 5 * time.Second
+
+// errWaitClosed reports that the server shut down while a wait was pending.
+var errWaitClosed = errors.New("server closed")
+
+// WaitCondition describes UI state that a "wait_condition" request blocks on.
+// Every non-empty field must hold at the same time. Labels and text match
+// case-insensitively by substring, like FindByLabel.
+type WaitCondition struct {
+	// WidgetLabel waits for a widget whose label contains this text.
+	WidgetLabel string `json:"widget_label,omitempty"`
+	// WidgetGone waits until no widget label contains this text.
+	WidgetGone string `json:"widget_gone,omitempty"`
+	// Focused waits until the focused widget's label contains this text.
+	Focused string `json:"focused,omitempty"`
+	// Value waits until the WidgetLabel widget has exactly this value.
+	Value *string `json:"value,omitempty"`
+	// Text waits for this text to appear on screen.
+	Text string `json:"text,omitempty"`
+	// TextGone waits for this text to leave the screen.
+	TextGone string `json:"text_gone,omitempty"`
+}
+
+// Empty reports whether the condition has no criteria.
+func (c WaitCondition) Empty() bool {
+	return c.WidgetLabel == "" && c.WidgetGone == "" && c.Focused == "" &&
+		c.Value == nil && c.Text == "" && c.TextGone == ""
+}
+
+// needsText reports whether evaluating the condition requires screen text.
+func (c WaitCondition) needsText() bool {
+	return c.Text != "" || c.TextGone != ""
+}
+
+// Matches reports whether snap satisfies the condition.
+func (c WaitCondition) Matches(snap Snapshot) bool {
+	if c.WidgetLabel != "" || c.Value != nil {
+		w := findByLabelIn(snap.Widgets, c.WidgetLabel)
+		if w == nil {
+			return false
+		}
+		if c.Value != nil && w.Value != *c.Value {
+			return false
+		}
+	}
+	if c.WidgetGone != "" && findByLabelIn(snap.Widgets, c.WidgetGone) != nil {
+		return false
+	}
+	if c.Focused != "" {
+		if snap.Focused == nil || !strings.Contains(strings.ToLower(snap.Focused.Label), strings.ToLower(c.Focused)) {
+			return false
+		}
+	}
+	if c.Text != "" && !strings.Contains(snap.Text, c.Text) {
+		return false
+	}
+	if c.TextGone != "" && strings.Contains(snap.Text, c.TextGone) {
+		return false
+	}
+	return true
+}
+
+// waitCondition blocks until cond holds, re-evaluating it whenever the
+// change notifier reports a UI event rather than polling on a timer.
+func (s *Server) waitCondition(ctx context.Context, cond WaitCondition, timeout time.Duration) (Snapshot, error) {
+	if timeout <= 0 {
+		timeout = defaultWaitTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	notifier := s.eventNotifier()
+	sub := notifier.Subscribe("", AllEventsFilter())
+	if sub == nil {
+		return Snapshot{}, errWaitClosed
+	}
+	defer notifier.Unsubscribe(sub.ID)
+
+	opts := SnapshotOptions{IncludeText: cond.needsText()}
+	check := func() (Snapshot, bool, error) {
+		snap, err := s.agent.SnapshotWithContext(ctx, opts)
+		if err != nil {
+			return snap, false, err
+		}
+		return snap, cond.Matches(snap), nil
+	}
+
+	snap, ok, err := check()
+	for {
+		if ok {
+			return snap, nil
+		}
+		if err != nil && ctx.Err() != nil {
+			return snap, ctx.Err()
+		}
+		select {
+		case <-ctx.Done():
+			return snap, ctx.Err()
+		case <-sub.done:
+			return snap, errWaitClosed
+		case <-sub.Events:
+			snap, ok, err = check()
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package agent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/backend/sim"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/widgets"
+)
+
+func TestWaitConditionMatches(t *testing.T) {
+	done := "done"
+	snap := Snapshot{
+		Text: "Saved 3 files",
+		Widgets: []WidgetInfo{
+			{Label: "Status", Value: "done", Children: []WidgetInfo{{Label: "Save complete"}}},
+		},
+		Focused: &WidgetInfo{Label: "Status"},
+	}
+	cases := []struct {
+		cond WaitCondition
+		want bool
+	}{
+		{WaitCondition{WidgetLabel: "save complete"}, true},
+		{WaitCondition{WidgetLabel: "missing"}, false},
+		{WaitCondition{WidgetGone: "spinner"}, true},
+		{WaitCondition{WidgetGone: "status"}, false},
+		{WaitCondition{WidgetLabel: "Status", Value: &done}, true},
+		{WaitCondition{Focused: "status", Text: "Saved"}, true},
+		{WaitCondition{TextGone: "Saved"}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.cond.Matches(snap); got != tc.want {
+			t.Fatalf("%+v: Matches = %v, want %v", tc.cond, got, tc.want)
+		}
+	}
+	if !(WaitCondition{}).Empty() || (WaitCondition{Value: &done}).Empty() {
+		t.Fatalf("unexpected Empty result")
+	}
+}
+
+func TestServerWaitCondition(t *testing.T) {
+	app := runtime.NewApp(runtime.AppConfig{Backend: sim.New(40, 5)})
+	app.SetRoot(widgets.NewLabel("Saving"))
+	runAppForTest(t, app)
+	time.Sleep(50 * time.Millisecond)
+
+	srv, err := NewServer(ServerOptions{Addr: "tcp:127.0.0.1:0", Agent: New(Config{App: app})})
+	if err != nil {
+		t.Fatalf("NewServer error: %v", err)
+	}
+	defer srv.Close()
+	ctx := context.Background()
+	sess := newSession(srv)
+
+	if resp := srv.handleRequest(ctx, sess, request{Type: "wait_condition"}); resp.Error != "missing_condition" {
+		t.Fatalf("expected missing_condition, got %#v", resp)
+	}
+	if resp := srv.handleRequest(ctx, sess, request{Type: "wait_condition", Condition: &WaitCondition{Text: "x"}}); resp.Error != "text_disabled" {
+		t.Fatalf("expected text_disabled, got %#v", resp)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = app.Call(context.Background(), func(app *runtime.App) error {
+			app.SetRoot(widgets.NewLabel("Save complete"))
+			return nil
+		})
+	}()
+	start := time.Now()
+	resp := srv.handleRequest(ctx, sess, request{
+		ID:        1,
+		Type:      "wait_condition",
+		Condition: &WaitCondition{WidgetLabel: "save complete"},
+		TimeoutMS: 5000,
+	})
+	if !resp.OK || resp.ID != 1 {
+		t.Fatalf("expected condition to be met, got %#v", resp)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("wait took %v", elapsed)
+	}
+
+	resp = srv.handleRequest(ctx, sess, request{
+		Type:      "wait_condition",
+		Condition: &WaitCondition{WidgetLabel: "never"},
+		TimeoutMS: 100,
+	})
+	if resp.Error != "timeout" {
+		t.Fatalf("expected timeout, got %#v", resp)
+	}
+}
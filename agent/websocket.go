@@ -54,8 +54,9 @@ func NewWebSocketServerWithOptions(opts WebSocketOptions) (*WebSocketServer, err
 		return nil, err
 	}
 	srv := &Server{
-		opts:  normalized,
-		agent: agent,
+		opts:   normalized,
+		agent:  agent,
+		tokens: append([]Token(nil), normalized.Tokens...),
 	}
 	return &WebSocketServer{
 		server:         srv,
@@ -83,7 +84,7 @@ func (s *WebSocketServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.connections.Store(conn, wrapped)
 	defer s.connections.Delete(conn)
 
-	sess := &session{authed: s.server.opts.Token == ""}
+	sess := newSession(s.server)
 	for {
 		_, message, err := conn.ReadMessage()
 		if err != nil {
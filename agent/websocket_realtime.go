@@ -20,6 +20,7 @@ type RealTimeWebSocketServer struct {
 	upgrader       websocket.Upgrader
 	connections    sync.Map // map[*websocket.Conn]*realTimeConnection
 	allowedOrigins []string
+	framing        Framing
 }
 
 // RealTimeWSOptions configures the real-time WebSocket server
@@ -33,8 +34,12 @@ type realTimeConnection struct {
 	conn       *websocket.Conn
 	sessionID  string
 	subscriber *RealTimeSubscriber
+	framing    Framing
 	writeMu    sync.Mutex
 	done       chan struct{}
+
+	taskMu sync.Mutex
+	tasks  map[string]struct{} // task IDs subscribed via task.subscribe
 }
 
 // wsMessage represents an incoming WebSocket message
@@ -60,10 +65,54 @@ func NewRealTimeWebSocketServer(opts RealTimeWSOptions) (*RealTimeWebSocketServe
 		return nil, err
 	}
 
+	return newRealTimeWebSocketServer(server, opts.AllowedOrigins, FramingJSON), nil
+}
+
+func newRealTimeWebSocketServer(server *RealTimeServer, allowedOrigins []string, framing Framing) *RealTimeWebSocketServer {
 	return &RealTimeWebSocketServer{
 		server:         server,
-		allowedOrigins: opts.AllowedOrigins,
-	}, nil
+		upgrader:       websocket.Upgrader{Subprotocols: subprotocolsFor(framing)},
+		allowedOrigins: allowedOrigins,
+		framing:        framing,
+	}
+}
+
+// serveWebSocket starts the WebSocket listener configured by WSAddr. The
+// JSONL listener is left to Serve; the real-time server only backs the
+// WebSocket connections.
+func (s *Server) serveWebSocket() error {
+	opts := DefaultEnhancedServerOptions()
+	opts.Addr = s.opts.Addr
+	opts.App = s.opts.App
+	opts.Agent = s.agent
+	opts.AllowText = s.opts.AllowText
+	opts.TestMode = s.opts.TestMode
+	opts.Token = s.opts.Token
+	opts.SnapshotTimeout = s.opts.SnapshotTimeout
+	rts, err := NewRealTimeServer(opts)
+	if err != nil {
+		return err
+	}
+	rts.notifier.Start()
+	if err := rts.serveWebSocket(s.opts.WSAddr, s.opts.WSFraming); err != nil {
+		_ = rts.Stop()
+		return err
+	}
+	s.mu.Lock()
+	s.realtime = rts
+	s.mu.Unlock()
+	return nil
+}
+
+// WebSocketAddr returns the address of the WebSocket listener, if any.
+func (s *Server) WebSocketAddr() string {
+	if s == nil {
+		return ""
+	}
+	s.mu.Lock()
+	rts := s.realtime
+	s.mu.Unlock()
+	return rts.webSocketAddr()
 }
 
 // Start begins the WebSocket server
@@ -100,11 +149,11 @@ func (s *RealTimeWebSocketServer) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	s.handleConnection(conn)
+	s.handleConnection(conn, framingForSubprotocol(conn.Subprotocol(), s.framing))
 }
 
 // handleConnection manages a single WebSocket connection
-func (s *RealTimeWebSocketServer) handleConnection(conn *websocket.Conn) {
+func (s *RealTimeWebSocketServer) handleConnection(conn *websocket.Conn, framing Framing) {
 	defer conn.Close()
 
 	sessionID := generateSessionID()
@@ -120,12 +169,20 @@ func (s *RealTimeWebSocketServer) handleConnection(conn *websocket.Conn) {
 		conn:       conn,
 		sessionID:  sessionID,
 		subscriber: subscriber,
+		framing:    framing,
 		done:       make(chan struct{}),
 	}
 
 	s.connections.Store(conn, connInfo)
 	defer s.connections.Delete(conn)
 
+	unsubTasks := s.server.SubscribeTasks(func(event TaskEvent) {
+		if connInfo.watchesTask(event.ID, event.Type == EventTaskDone) {
+			_ = s.writeFrame(connInfo, event)
+		}
+	})
+	defer unsubTasks()
+
 	// Start goroutines for reading and writing
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -175,14 +232,19 @@ func (s *RealTimeWebSocketServer) readLoop(ctx context.Context, conn *realTimeCo
 		default:
 		}
 
-		var msg wsMessage
-		if err := conn.conn.ReadJSON(&msg); err != nil {
+		_, data, err := conn.conn.ReadMessage()
+		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				// Log error
 			}
 			close(conn.done)
 			return
 		}
+		var msg wsMessage
+		if err := decodeFrame(conn.framing, data, &msg); err != nil {
+			_ = s.writeResponse(conn, wsResponse{Type: "error", Error: err.Error()})
+			continue
+		}
 
 		go s.handleMessage(ctx, conn, msg)
 	}
@@ -228,6 +290,12 @@ func (s *RealTimeWebSocketServer) handleMessage(ctx context.Context, conn *realT
 	case "task":
 		resp = s.handleTask(conn, msg)
 
+	case "task.subscribe":
+		resp = s.handleTaskSubscribe(conn, msg, true)
+
+	case "task.unsubscribe":
+		resp = s.handleTaskSubscribe(conn, msg, false)
+
 	default:
 		resp.Type = "error"
 		resp.Error = "unknown message type: " + msg.Type
@@ -499,12 +567,64 @@ func (s *RealTimeWebSocketServer) handleTask(conn *realTimeConnection, msg wsMes
 	}
 }
 
+// handleTaskSubscribe starts or stops streaming events for the task in msg.ID
+func (s *RealTimeWebSocketServer) handleTaskSubscribe(conn *realTimeConnection, msg wsMessage, subscribe bool) wsResponse {
+	if msg.ID == "" {
+		return wsResponse{Type: "error", Error: "task id is required"}
+	}
+	conn.taskMu.Lock()
+	if subscribe {
+		if conn.tasks == nil {
+			conn.tasks = make(map[string]struct{})
+		}
+		conn.tasks[msg.ID] = struct{}{}
+	} else {
+		delete(conn.tasks, msg.ID)
+	}
+	conn.taskMu.Unlock()
+	if subscribe {
+		return wsResponse{Type: "task.subscribed", ID: msg.ID, OK: true}
+	}
+	return wsResponse{Type: "task.unsubscribed", ID: msg.ID, OK: true}
+}
+
+// watchesTask reports whether the connection subscribed to id. Finished
+// tasks are forgotten once their done event is delivered.
+func (c *realTimeConnection) watchesTask(id string, done bool) bool {
+	c.taskMu.Lock()
+	defer c.taskMu.Unlock()
+	if _, ok := c.tasks[id]; !ok {
+		return false
+	}
+	if done {
+		delete(c.tasks, id)
+	}
+	return true
+}
+
 // writeResponse sends a response to the client
 func (s *RealTimeWebSocketServer) writeResponse(conn *realTimeConnection, resp wsResponse) error {
+	return s.writeFrame(conn, resp)
+}
+
+// writeFrame encodes v with the connection's framing and sends it
+func (s *RealTimeWebSocketServer) writeFrame(conn *realTimeConnection, v any) error {
+	data, err := encodeFrame(conn.framing, v)
+	if err != nil {
+		return err
+	}
 	conn.writeMu.Lock()
 	defer conn.writeMu.Unlock()
 
-	return conn.conn.WriteJSON(resp)
+	return conn.conn.WriteMessage(conn.messageType(), data)
+}
+
+// messageType returns the WebSocket frame type for the connection's framing.
+func (c *realTimeConnection) messageType() int {
+	if c.framing == FramingMsgpack {
+		return websocket.BinaryMessage
+	}
+	return websocket.TextMessage
 }
 
 // checkOrigin validates request origin
@@ -533,15 +653,20 @@ func (s *RealTimeWebSocketServer) Broadcast(msg any) {
 		return
 	}
 
-	data, err := json.Marshal(msg)
-	if err != nil {
-		return
-	}
-
+	encoded := make(map[Framing][]byte, 2)
 	s.connections.Range(func(key, value any) bool {
 		conn := value.(*realTimeConnection)
+		data, ok := encoded[conn.framing]
+		if !ok {
+			var err error
+			data, err = encodeFrame(conn.framing, msg)
+			if err != nil {
+				return false
+			}
+			encoded[conn.framing] = data
+		}
 		conn.writeMu.Lock()
-		err := conn.conn.WriteMessage(websocket.TextMessage, data)
+		err := conn.conn.WriteMessage(conn.messageType(), data)
 		conn.writeMu.Unlock()
 		if err != nil {
 			conn.conn.Close()
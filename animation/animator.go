@@ -16,6 +16,7 @@ type Animator struct {
 	tweens    map[animationKey]*Tween
 	springs   map[animationKey]*Spring
 	particles []*ParticleSystem
+	now       func() time.Time
 
 	mu sync.Mutex
 }
@@ -28,6 +29,24 @@ func NewAnimator() *Animator {
 	}
 }
 
+// SetTimeSource sets the clock tweens start from and Update reads. Nil
+// restores the wall clock.
+func (a *Animator) SetTimeSource(now func() time.Time) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.now = now
+}
+
+func (a *Animator) currentTime() time.Time {
+	if a.now != nil {
+		return a.now()
+	}
+	return time.Now()
+}
+
 // Animate starts a tween animation.
 func (a *Animator) Animate(target any, property string, getValue func() Animatable, setValue func(Animatable), endValue Animatable, cfg TweenConfig) *Tween {
 	if a == nil {
@@ -41,7 +60,7 @@ func (a *Animator) Animate(target any, property string, getValue func() Animatab
 		existing.Stop()
 	}
 	tween := NewTween(getValue, setValue, endValue, cfg)
-	tween.Start()
+	tween.StartAt(a.currentTime())
 	a.tweens[key] = tween
 	return tween
 }
@@ -72,13 +91,24 @@ func (a *Animator) AddParticleSystem(ps *ParticleSystem) {
 
 // Update advances all animations, returns true if any are active.
 func (a *Animator) Update(dt float64) bool {
+	if a == nil {
+		return false
+	}
+	a.mu.Lock()
+	now := a.currentTime()
+	a.mu.Unlock()
+	return a.UpdateAt(now, dt)
+}
+
+// UpdateAt advances tweens to now and springs and particles by dt seconds,
+// returning true if any animations are active.
+func (a *Animator) UpdateAt(now time.Time, dt float64) bool {
 	if a == nil {
 		return false
 	}
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	now := time.Now()
 	active := false
 
 	for key, tween := range a.tweens {
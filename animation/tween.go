@@ -53,10 +53,16 @@ func NewTween(getValue func() Animatable, setValue func(Animatable), endValue An
 
 // Start begins the animation.
 func (t *Tween) Start() {
+	t.StartAt(time.Now())
+}
+
+// StartAt begins the animation as of now, for tweens driven by a clock
+// other than the wall clock.
+func (t *Tween) StartAt(now time.Time) {
 	if t == nil {
 		return
 	}
-	t.startTime = time.Now()
+	t.startTime = now
 	if t.getValue != nil {
 		t.startValue = t.getValue()
 	}
@@ -44,6 +44,81 @@ func TestColorRGBAndRGB(t *testing.T) {
 	}
 }
 
+func TestColorDowngrade(t *testing.T) {
+	cases := []struct {
+		in    Color
+		to256 Color
+		to16  Color
+	}{
+		{ColorRGB(0, 0, 0), 16, ColorBlack},
+		{ColorRGB(255, 255, 255), 231, ColorBrightWhite},
+		{ColorRGB(255, 0, 0), 196, ColorBrightRed},
+		{ColorRGB(205, 0, 0), 160, ColorRed},
+		{ColorRGB(0, 255, 0), 46, ColorBrightGreen},
+		{ColorRGB(95, 135, 175), 67, ColorBrightBlack},
+		{ColorRGB(128, 128, 128), 244, ColorBrightBlack},
+		{ColorRGB(238, 238, 238), 255, ColorWhite},
+		{Color(196), 196, ColorBrightRed},
+		{ColorYellow, ColorYellow, ColorYellow},
+		{ColorDefault, ColorDefault, ColorDefault},
+	}
+	for _, tc := range cases {
+		if got := tc.in.To256(); got != tc.to256 {
+			t.Errorf("%#x To256 = %d, want %d", int32(tc.in), got, tc.to256)
+		}
+		if got := tc.in.To16(); got != tc.to16 {
+			t.Errorf("%#x To16 = %d, want %d", int32(tc.in), got, tc.to16)
+		}
+	}
+}
+
+func TestStyleDowngrade(t *testing.T) {
+	style := DefaultStyle().Foreground(ColorRGB(255, 0, 0)).Background(ColorRGB(0, 0, 0)).Bold(true)
+	if got := style.Downgrade(ProfileTrueColor); got != style {
+		t.Fatalf("truecolor should leave style unchanged")
+	}
+	if got := style.Downgrade(Profile256); got.FG() != 196 || got.BG() != 16 || got.Attributes() != AttrBold {
+		t.Fatalf("unexpected 256-color style: %+v", got)
+	}
+	if got := style.Downgrade(ProfileMono); got.FG() != ColorDefault || got.BG() != ColorDefault || got.Attributes() != AttrBold {
+		t.Fatalf("mono should keep attributes only: %+v", got)
+	}
+
+	defer SetColorProfile(ProfileTrueColor)
+	SetColorProfile(Profile16)
+	if CurrentColorProfile() != Profile16 {
+		t.Fatalf("expected profile 16, got %v", CurrentColorProfile())
+	}
+}
+
+func TestStringWidth(t *testing.T) {
+	cases := []struct {
+		in    string
+		width int
+	}{
+		{"", 0},
+		{"hello", 5},
+		{"📁 docs", 7},
+		{"e\u0301", 1},
+		{"日本", 4},
+		{"👨\u200d👩\u200d👧", 2},
+	}
+	for _, tc := range cases {
+		if got := StringWidth(tc.in); got != tc.width {
+			t.Errorf("StringWidth(%q) = %d, want %d", tc.in, got, tc.width)
+		}
+	}
+	if got := TruncateWidth("📁 docs", 2); got != "📁" {
+		t.Errorf("TruncateWidth = %q", got)
+	}
+	if got := TruncateWidth("a📁", 2); got != "a" {
+		t.Errorf("TruncateWidth should not split wide cluster, got %q", got)
+	}
+	if got := ClusterRune("e\u0301"); got != 'é' {
+		t.Errorf("ClusterRune = %q, want é", got)
+	}
+}
+
 func TestStyleAttributesAndAccessors(t *testing.T) {
 	style := DefaultStyle()
 	if style.FG() != ColorDefault || style.BG() != ColorDefault {
@@ -0,0 +1,159 @@
+package backend
+
+import "sync/atomic"
+
+// ColorProfile describes the colors a terminal can display.
+type ColorProfile int32
+
+const (
+	// ProfileTrueColor renders 24-bit colors unchanged.
+	ProfileTrueColor ColorProfile = iota
+	// Profile256 limits output to the xterm 256-color palette.
+	Profile256
+	// Profile16 limits output to the 16 basic ANSI colors.
+	Profile16
+	// ProfileMono drops all colors.
+	ProfileMono
+)
+
+// String returns the profile name.
+func (p ColorProfile) String() string {
+	switch p {
+	case ProfileTrueColor:
+		return "truecolor"
+	case Profile256:
+		return "256"
+	case Profile16:
+		return "16"
+	case ProfileMono:
+		return "mono"
+	default:
+		return "unknown"
+	}
+}
+
+var colorProfile atomic.Int32
+
+// SetColorProfile sets the profile that terminal backends downgrade colors
+// to when rendering. The default is ProfileTrueColor.
+func SetColorProfile(p ColorProfile) {
+	colorProfile.Store(int32(p))
+}
+
+// CurrentColorProfile returns the profile set with SetColorProfile.
+func CurrentColorProfile() ColorProfile {
+	return ColorProfile(colorProfile.Load())
+}
+
+// Convert maps c to the closest color available in the profile.
+func (p ColorProfile) Convert(c Color) Color {
+	if c == ColorDefault {
+		return c
+	}
+	switch p {
+	case Profile256:
+		return c.To256()
+	case Profile16:
+		return c.To16()
+	case ProfileMono:
+		return ColorDefault
+	default:
+		return c
+	}
+}
+
+// Downgrade returns s with both colors converted to profile p.
+func (s Style) Downgrade(p ColorProfile) Style {
+	if p == ProfileTrueColor {
+		return s
+	}
+	s.fg = p.Convert(s.fg)
+	s.bg = p.Convert(s.bg)
+	return s
+}
+
+// To256 returns the nearest xterm 256-color palette entry for an RGB color.
+// Palette colors are returned unchanged. Only the 6x6x6 cube and grayscale
+// ramp are candidates, since entries 0-15 vary between terminal themes.
+func (c Color) To256() Color {
+	if c == ColorDefault || !c.IsRGB() {
+		return c
+	}
+	r8, g8, b8 := c.RGB()
+	r, g, b := int(r8), int(g8), int(b8)
+
+	ri, gi, bi := cubeIndex(r), cubeIndex(g), cubeIndex(b)
+	best := Color(16 + 36*ri + 6*gi + bi)
+	bestDist := colorDistance(r, g, b, cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	grayStep := min(23, max(0, ((r+g+b)/3-3)/10))
+	level := 8 + 10*grayStep
+	if d := colorDistance(r, g, b, level, level, level); d < bestDist {
+		best = Color(232 + grayStep)
+	}
+	return best
+}
+
+// To16 returns the nearest basic ANSI color for an RGB or 256-palette color,
+// using the xterm default values for the 16 colors.
+func (c Color) To16() Color {
+	if c == ColorDefault || (!c.IsRGB() && c < 16) {
+		return c
+	}
+	var r, g, b int
+	if c.IsRGB() {
+		r8, g8, b8 := c.RGB()
+		r, g, b = int(r8), int(g8), int(b8)
+	} else {
+		r, g, b = paletteRGB(int(c))
+	}
+	best, bestDist := 0, -1
+	for i, entry := range ansi16RGB {
+		if d := colorDistance(r, g, b, entry[0], entry[1], entry[2]); bestDist < 0 || d < bestDist {
+			best, bestDist = i, d
+		}
+	}
+	return Color(best)
+}
+
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+var ansi16RGB = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+func cubeIndex(v int) int {
+	if v < 48 {
+		return 0
+	}
+	if v < 115 {
+		return 1
+	}
+	return (v - 35) / 40
+}
+
+// paletteRGB returns the xterm default RGB value of a palette entry.
+func paletteRGB(index int) (r, g, b int) {
+	switch {
+	case index < 16:
+		entry := ansi16RGB[index]
+		return entry[0], entry[1], entry[2]
+	case index < 232:
+		index -= 16
+		return cubeLevels[index/36], cubeLevels[(index/6)%6], cubeLevels[index%6]
+	default:
+		level := 8 + 10*(index-232)
+		return level, level, level
+	}
+}
+
+// colorDistance is the "redmean" weighted RGB distance, a cheap
+// approximation of perceived color difference.
+func colorDistance(r1, g1, b1, r2, g2, b2 int) int {
+	rmean := (r1 + r2) / 2
+	dr, dg, db := r1-r2, g1-g2, b1-b2
+	return ((512+rmean)*dr*dr)>>8 + 4*dg*dg + ((767-rmean)*db*db)>>8
+}
@@ -16,8 +16,9 @@ import (
 // Backend is a testable backend using tcell's simulation screen.
 type Backend struct {
 	*tcell.Backend
-	screen tcellv2.SimulationScreen
-	mu     sync.Mutex
+	screen        tcellv2.SimulationScreen
+	mu            sync.Mutex
+	width, height int
 }
 
 // New creates a new simulation backend with the given dimensions.
@@ -28,13 +29,28 @@ func New(width, height int) *Backend {
 	return &Backend{
 		Backend: tcell.NewWithScreen(screen),
 		screen:  screen,
+		width:   width,
+		height:  height,
 	}
 }
 
+// Init initializes the screen, keeping the size given to New or Resize.
+// tcell's simulation screen otherwise resets to 80x25 on init.
+func (s *Backend) Init() error {
+	if err := s.Backend.Init(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.screen.SetSize(s.width, s.height)
+	return nil
+}
+
 // Resize changes the simulation screen size.
 func (s *Backend) Resize(width, height int) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	s.width, s.height = width, height
 	s.screen.SetSize(width, height)
 }
 
@@ -68,6 +84,7 @@ func (s *Backend) InjectMouseAction(x, y int, button terminal.MouseButton, actio
 // InjectResize injects a resize event.
 func (s *Backend) InjectResize(width, height int) {
 	s.mu.Lock()
+	s.width, s.height = width, height
 	s.screen.SetSize(width, height)
 	s.mu.Unlock()
 	s.PostEvent(terminal.ResizeEvent{Width: width, Height: height})
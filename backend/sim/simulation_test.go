@@ -217,3 +217,15 @@ func TestBackend_Styles(t *testing.T) {
 		t.Error("Expected bold attribute to be set")
 	}
 }
+
+func TestBackend_InitKeepsSize(t *testing.T) {
+	sim := New(30, 6)
+	if err := sim.Init(); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer sim.Fini()
+
+	if w, h := sim.Size(); w != 30 || h != 6 {
+		t.Errorf("Expected 30x6 after Init, got %dx%d", w, h)
+	}
+}
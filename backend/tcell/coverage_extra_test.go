@@ -89,6 +89,31 @@ func TestBackendSimulationBasics(t *testing.T) {
 	be.Beep()
 }
 
+func TestBackendColorProfile(t *testing.T) {
+	screen := tcell.NewSimulationScreen("")
+	be := NewWithScreen(screen)
+	if err := be.Init(); err != nil {
+		t.Fatalf("Init error: %v", err)
+	}
+	defer be.Fini()
+	defer backend.SetColorProfile(backend.ProfileTrueColor)
+
+	style := backend.DefaultStyle().Foreground(backend.ColorRGB(255, 0, 0))
+	backend.SetColorProfile(backend.Profile256)
+	be.SetContent(0, 0, 'A', nil, style)
+	_, got, _ := screen.Get(0, 0)
+	if fg, _, _ := got.Decompose(); fg != tcell.PaletteColor(196) {
+		t.Fatalf("expected 256-color foreground, got %v", fg)
+	}
+
+	backend.SetColorProfile(backend.ProfileTrueColor)
+	be.SetContent(0, 0, 'A', nil, style)
+	_, got, _ = screen.Get(0, 0)
+	if fg, _, _ := got.Decompose(); fg != tcell.NewRGBColor(255, 0, 0) {
+		t.Fatalf("expected RGB foreground, got %v", fg)
+	}
+}
+
 func TestBackendPostAndPollEvent(t *testing.T) {
 	screen := tcell.NewSimulationScreen("")
 	screen.SetSize(2, 1)
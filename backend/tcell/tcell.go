@@ -201,6 +201,12 @@ func (b *Backend) Beep() {
 	b.screen.Beep()
 }
 
+// SetClipboard asks the terminal to place text on the system clipboard
+// (OSC 52). Terminals without support ignore it.
+func (b *Backend) SetClipboard(text string) {
+	b.screen.SetClipboard([]byte(text))
+}
+
 // Sync forces a full redraw.
 func (b *Backend) Sync() {
 	b.screen.Sync()
@@ -209,6 +215,7 @@ func (b *Backend) Sync() {
 const defaultStyleCacheCap = 256
 
 func (b *Backend) cachedStyle(s backend.Style) tcell.Style {
+	s = s.Downgrade(backend.CurrentColorProfile())
 	if b.styleCache == nil {
 		b.styleCacheCap = defaultStyleCacheCap
 		b.styleCache = make(map[backend.Style]tcell.Style, b.styleCacheCap)
@@ -271,12 +278,25 @@ func convertColor(c backend.Color) tcell.Color {
 	return tcell.PaletteColor(int(c))
 }
 
+// isReportedCtrlM reports whether e is Ctrl+M sent as a letter with the
+// Ctrl modifier instead of as Enter.
+func isReportedCtrlM(e *tcell.EventKey) bool {
+	return e.Modifiers()&tcell.ModCtrl != 0 && (e.Rune() == 'm' || e.Rune() == 'M')
+}
+
 // convertEvent converts a tcell event to terminal.Event.
 func convertEvent(ev tcell.Event) terminal.Event {
 	switch e := ev.(type) {
 	case *tcell.EventKey:
+		key := convertKey(e.Key())
+		if key == terminal.KeyNone && isReportedCtrlM(e) {
+			// Terminals that report modifiers send Ctrl+M as a control
+			// key carrying the letter rather than as Enter. Other
+			// Ctrl+letter combinations stay KeyNone.
+			key = terminal.KeyRune
+		}
 		return terminal.KeyEvent{
-			Key:   convertKey(e.Key()),
+			Key:   key,
 			Rune:  e.Rune(),
 			Alt:   e.Modifiers()&tcell.ModAlt != 0,
 			Ctrl:  e.Modifiers()&tcell.ModCtrl != 0,
@@ -174,3 +174,21 @@ func TestReverseConvertMouseButton(t *testing.T) {
 		}
 	}
 }
+
+func TestConvertEventCtrlM(t *testing.T) {
+	// A bare Ctrl+M is a carriage return, which tcell parses as Enter.
+	bare, ok := convertEvent(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone)).(terminal.KeyEvent)
+	if !ok || bare.Key != terminal.KeyEnter || bare.Ctrl {
+		t.Fatalf("bare Ctrl+M = %+v, want plain Enter", bare)
+	}
+	// Terminals that report modifiers deliver it as a Ctrl+M rune.
+	reported, ok := convertEvent(tcell.NewEventKey(tcell.KeyRune, 'm', tcell.ModCtrl)).(terminal.KeyEvent)
+	if !ok || reported.Key != terminal.KeyRune || reported.Rune != 'm' || !reported.Ctrl {
+		t.Fatalf("reported Ctrl+M = %+v, want the rune m with Ctrl", reported)
+	}
+	// Other unmapped Ctrl+letters must not turn into typed runes.
+	other, ok := convertEvent(tcell.NewEventKey(tcell.KeyRune, 'g', tcell.ModCtrl)).(terminal.KeyEvent)
+	if !ok || other.Key == terminal.KeyRune {
+		t.Fatalf("Ctrl+G = %+v, want a non-rune key", other)
+	}
+}
@@ -0,0 +1,66 @@
+package backend
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// StringWidth returns the number of terminal cells s occupies. It measures
+// grapheme clusters, so combining marks add nothing and emoji count as two.
+func StringWidth(s string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return uniseg.StringWidth(s)
+		}
+	}
+	return len(s)
+}
+
+// ForEachCluster calls fn for each grapheme cluster in s with its display
+// width (0, 1, or 2). Iteration stops when fn returns false.
+func ForEachCluster(s string, fn func(cluster string, width int) bool) {
+	state := -1
+	for len(s) > 0 {
+		var cluster string
+		var width int
+		cluster, s, width, state = uniseg.FirstGraphemeClusterInString(s, state)
+		if !fn(cluster, width) {
+			return
+		}
+	}
+}
+
+// ClusterRune returns the single rune a cell stores for cluster. Base
+// characters with combining marks are composed where Unicode allows
+// (e + U+0301 becomes é); otherwise the first rune stands for the cluster.
+func ClusterRune(cluster string) rune {
+	r, size := utf8.DecodeRuneInString(cluster)
+	if size == len(cluster) {
+		return r
+	}
+	composed := norm.NFC.String(cluster)
+	if c, n := utf8.DecodeRuneInString(composed); n == len(composed) {
+		return c
+	}
+	return r
+}
+
+// TruncateWidth returns the longest prefix of s that fits in width cells
+// without splitting a grapheme cluster.
+func TruncateWidth(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	used, end := 0, 0
+	ForEachCluster(s, func(cluster string, w int) bool {
+		if used+w > width {
+			return false
+		}
+		used += w
+		end += len(cluster)
+		return true
+	})
+	return s[:end]
+}
@@ -1,6 +1,11 @@
 package clipboard
 
-import "testing"
+import (
+	"log"
+	"os"
+	"strings"
+	"testing"
+)
 
 func TestMemoryClipboardReadWrite(t *testing.T) {
 	cb := &MemoryClipboard{}
@@ -52,3 +57,68 @@ func TestUnavailableClipboard(t *testing.T) {
 		t.Fatalf("write failed: %v", err)
 	}
 }
+
+func TestOSC52Clipboard(t *testing.T) {
+	var sent []string
+	cb := NewOSC52(SetterFunc(func(text string) { sent = append(sent, text) }))
+	if err := cb.Write("copied"); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if len(sent) != 1 || sent[0] != "copied" {
+		t.Fatalf("expected write to reach setter, got %v", sent)
+	}
+	if got, _ := cb.Read(); got != "copied" {
+		t.Fatalf("read = %q, want local copy", got)
+	}
+
+	var buf strings.Builder
+	WriterSetter(&buf).SetClipboard("hi")
+	if buf.String() != "\x1b]52;c;aGk=\x07" {
+		t.Fatalf("unexpected sequence %q", buf.String())
+	}
+}
+
+func TestOSC52ClipboardTruncates(t *testing.T) {
+	var sent string
+	cb := NewOSC52(SetterFunc(func(text string) { sent = text }))
+	cb.SetMaxBytes(4)
+	var logs strings.Builder
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	if err := cb.Write("abéé"); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	if sent != "abé" {
+		t.Fatalf("sent %q, want rune-aligned truncation", sent)
+	}
+	if !strings.Contains(logs.String(), "truncated") {
+		t.Fatalf("expected truncation warning, got %q", logs.String())
+	}
+	if got, _ := cb.Read(); got != "abéé" {
+		t.Fatalf("read = %q, want full text", got)
+	}
+	if len(OSC52Sequence(strings.Repeat("x", MaxOSC52Bytes))) > 100010 {
+		t.Fatalf("default limit exceeds 100000 encoded bytes")
+	}
+}
+
+func TestDefaultClipboard(t *testing.T) {
+	setter := SetterFunc(func(string) {})
+	t.Setenv("FLUFFYUI_CLIPBOARD", "")
+	t.Setenv("TERM", "xterm-256color")
+	if _, ok := Default(setter).(*OSC52Clipboard); !ok {
+		t.Fatalf("expected OSC 52 clipboard for xterm")
+	}
+	if _, ok := Default(nil).(*MemoryClipboard); !ok {
+		t.Fatalf("expected memory fallback without a setter")
+	}
+	t.Setenv("TERM", "dumb")
+	if _, ok := Default(setter).(*MemoryClipboard); !ok {
+		t.Fatalf("expected memory fallback for dumb terminal")
+	}
+	t.Setenv("FLUFFYUI_CLIPBOARD", "osc52")
+	if _, ok := Default(setter).(*OSC52Clipboard); !ok {
+		t.Fatalf("expected override to force OSC 52")
+	}
+}
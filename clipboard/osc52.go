@@ -0,0 +1,137 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxOSC52Bytes is the default limit on text sent through OSC 52. Its
+// base64 encoding is 100000 bytes, the most that common terminals and
+// multiplexers accept in a single sequence.
+const MaxOSC52Bytes = 75000
+
+// Setter is implemented by backends that can set the terminal clipboard,
+// typically with the OSC 52 escape sequence.
+type Setter interface {
+	SetClipboard(text string)
+}
+
+// SetterFunc adapts a function to Setter.
+type SetterFunc func(text string)
+
+// SetClipboard calls f(text).
+func (f SetterFunc) SetClipboard(text string) {
+	if f != nil {
+		f(text)
+	}
+}
+
+// WriterSetter returns a Setter that writes an OSC 52 sequence to w.
+func WriterSetter(w io.Writer) Setter {
+	return SetterFunc(func(text string) {
+		if w != nil {
+			_, _ = io.WriteString(w, OSC52Sequence(text))
+		}
+	})
+}
+
+// OSC52Sequence returns the escape sequence that asks the terminal to
+// place text on the system clipboard.
+func OSC52Sequence(text string) string {
+	return "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+}
+
+// OSC52Clipboard copies to the terminal's system clipboard via OSC 52.
+// Most terminals refuse OSC 52 reads, so Read returns the last value
+// written by this clipboard.
+type OSC52Clipboard struct {
+	setter   Setter
+	memory   MemoryClipboard
+	maxBytes int
+}
+
+// NewOSC52 creates a clipboard that sends writes through setter.
+func NewOSC52(setter Setter) *OSC52Clipboard {
+	return &OSC52Clipboard{setter: setter, maxBytes: MaxOSC52Bytes}
+}
+
+// SetMaxBytes sets the largest text sent to the terminal. Longer writes are
+// truncated with a logged warning. Zero or less disables the limit.
+func (c *OSC52Clipboard) SetMaxBytes(n int) {
+	if c == nil {
+		return
+	}
+	c.maxBytes = n
+}
+
+// Read returns the last written value.
+func (c *OSC52Clipboard) Read() (string, error) {
+	if c == nil {
+		return "", nil
+	}
+	return c.memory.Read()
+}
+
+// Write sends text to the terminal clipboard and keeps a local copy. Text
+// over the size limit is truncated for the terminal only; Read still
+// returns the full text.
+func (c *OSC52Clipboard) Write(text string) error {
+	if c == nil {
+		return nil
+	}
+	if c.setter != nil {
+		sent := text
+		if c.maxBytes > 0 && len(sent) > c.maxBytes {
+			sent = truncateUTF8(sent, c.maxBytes)
+			log.Printf("clipboard: OSC 52 copy truncated from %d to %d bytes", len(text), len(sent))
+		}
+		c.setter.SetClipboard(sent)
+	}
+	return c.memory.Write(text)
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a rune.
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// Available reports whether the clipboard is available.
+func (c *OSC52Clipboard) Available() bool {
+	return c != nil
+}
+
+// OSC52Supported reports whether the terminal is likely to honor OSC 52.
+// FLUFFYUI_CLIPBOARD=osc52 or =memory overrides the guess.
+func OSC52Supported() bool {
+	switch strings.ToLower(strings.TrimSpace(os.Getenv("FLUFFYUI_CLIPBOARD"))) {
+	case "osc52":
+		return true
+	case "memory":
+		return false
+	}
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch term {
+	case "", "dumb", "linux":
+		return false
+	}
+	return true
+}
+
+// Default returns an OSC 52 clipboard writing through setter when the
+// terminal supports it, and an in-memory clipboard otherwise.
+func Default(setter Setter) Clipboard {
+	if setter != nil && OSC52Supported() {
+		return NewOSC52(setter)
+	}
+	return &MemoryClipboard{}
+}
@@ -4,33 +4,48 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 type addData struct {
 	TypeName string
+	Package  string
+	Title    string
 }
 
+// routesMarker marks where fluffy add page registers pages in routes.go.
+const routesMarker = "// fluffy:routes"
+
 func runAdd(args []string) error {
 	fs := flag.NewFlagSet("add", flag.ContinueOnError)
 	targetDir := fs.String("dir", ".", "target project directory")
 	force := fs.Bool("force", false, "overwrite existing files")
+	router := fs.String("router", "stack", "router to register pages with: tabbed, stack, or none")
 	fs.SetOutput(os.Stderr)
-	if err := fs.Parse(args); err != nil {
+	// Flags may come before or after the name, e.g. both
+	// fluffy add page --router tabbed Settings and
+	// fluffy add page Settings --router tabbed.
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
 		return err
 	}
-	if fs.NArg() < 2 {
-		return errors.New("usage: fluffy add widget|page <Name>")
+	if len(positional) != 2 {
+		return errors.New("usage: fluffy add widget|page <Name> [--router tabbed|stack|none]")
+	}
+	kind, name := positional[0], positional[1]
+	if strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid %s name %q", kind, name)
 	}
-	kind := fs.Arg(0)
-	name := fs.Arg(1)
 
 	switch kind {
 	case "widget":
 		return addWidget(*targetDir, name, *force)
 	case "page":
-		return addPage(*targetDir, name, *force)
+		return addPage(*targetDir, name, *router, *force)
 	default:
 		return fmt.Errorf("unknown add target: %s", kind)
 	}
@@ -50,18 +65,118 @@ func addWidget(root, name string, force bool) error {
 	return writeFile(filename, []byte(rendered), 0o644, force)
 }
 
-func addPage(root, name string, force bool) error {
+func addPage(root, name, router string, force bool) error {
+	switch router {
+	case "tabbed", "stack", "none":
+	default:
+		return fmt.Errorf("unknown router %q (want tabbed, stack, or none)", router)
+	}
 	typeName := toPascal(name)
 	if typeName == "" {
 		return errors.New("invalid page name")
 	}
-	data := addData{TypeName: typeName}
-	rendered, err := renderTemplate(pageTemplate, data)
+	data := addData{
+		TypeName: typeName,
+		Package:  strings.ToLower(typeName),
+		Title:    titleFromName(name),
+	}
+	dir := filepath.Join(root, "pages", data.Package)
+	for file, tmpl := range map[string]string{
+		"page.go":      pageTemplate,
+		"page_test.go": pageTestTemplate,
+	} {
+		rendered, err := renderTemplate(tmpl, data)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(dir, file), []byte(rendered), 0o644, force); err != nil {
+			return err
+		}
+	}
+	if router == "none" {
+		return nil
+	}
+	return registerPage(root, data, router)
+}
+
+// registerPage adds the page to routes.go, if the project has one, on the
+// line before the fluffy:routes marker. Stack routes go into a map of
+// constructors and tabbed routes into a []runtime.TabDef, so the marker
+// should sit inside the matching literal.
+func registerPage(root string, data addData, router string) error {
+	path := filepath.Join(root, "routes.go")
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	filename := filepath.Join(root, "pages", toSnake(name)+".go")
-	return writeFile(filename, []byte(rendered), 0o644, force)
+	var entry string
+	switch router {
+	case "tabbed":
+		entry = fmt.Sprintf("{Title: %q, Widget: %s.New%s()},", data.Title, data.Package, data.TypeName)
+	default:
+		entry = fmt.Sprintf("%q: func() runtime.Widget { return %s.New%s() },", data.Package, data.Package, data.TypeName)
+	}
+	src := string(raw)
+	markerAt := strings.Index(src, routesMarker)
+	if markerAt < 0 {
+		fmt.Fprintf(os.Stderr, "routes.go has no %q marker; register the page yourself:\n\t%s\n", routesMarker, entry)
+		return nil
+	}
+	lineStart := strings.LastIndex(src[:markerAt], "\n") + 1
+	indent := src[lineStart:markerAt]
+	src = src[:lineStart] + indent + entry + "\n" + src[lineStart:]
+
+	module, err := modulePath(root)
+	if err != nil {
+		return fmt.Errorf("register page: %w", err)
+	}
+	src = addImport(src, module+"/pages/"+data.Package)
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("register page: format routes.go: %w", err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// modulePath reads the module path from root/go.mod.
+func modulePath(root string) (string, error) {
+	raw, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(raw), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	return "", errors.New("go.mod has no module line")
+}
+
+// addImport adds importPath to src's imports unless it is already there.
+func addImport(src, importPath string) string {
+	quoted := strconv.Quote(importPath)
+	if strings.Contains(src, quoted) {
+		return src
+	}
+	if i := strings.Index(src, "import ("); i >= 0 {
+		i += len("import (")
+		return src[:i] + "\n\t" + quoted + src[i:]
+	}
+	if i := strings.Index(src, "\nimport \""); i >= 0 {
+		i += len("\nimport ")
+		end := i + strings.Index(src[i:], "\n")
+		if end < i {
+			end = len(src)
+		}
+		return src[:i] + "(\n\t" + quoted + "\n\t" + src[i:end] + "\n)" + src[end:]
+	}
+	if i := strings.Index(src, "\n"); strings.HasPrefix(src, "package ") && i >= 0 {
+		return src[:i+1] + "\nimport " + quoted + "\n" + src[i+1:]
+	}
+	return src
 }
 
 const widgetTemplate = `package widgets
@@ -103,7 +218,8 @@ func (w *{{.TypeName}}) HandleMessage(msg runtime.Message) runtime.HandleResult
 var _ runtime.Widget = (*{{.TypeName}})(nil)
 `
 
-const pageTemplate = `package pages
+const pageTemplate = `// Package {{.Package}} implements the {{.Title}} page.
+package {{.Package}}
 
 import (
 	"github.com/odvcencio/fluffyui/backend"
@@ -111,50 +227,87 @@ import (
 	ui "github.com/odvcencio/fluffyui/widgets"
 )
 
+// {{.TypeName}} is the {{.Title}} page. Routers mount it when it is shown and
+// unmount it when navigating away.
 type {{.TypeName}} struct {
 	ui.Component
-	title *ui.Label
+	title   *ui.Label
+	mounted bool
 }
 
+// New{{.TypeName}} creates the {{.Title}} page.
 func New{{.TypeName}}() *{{.TypeName}} {
 	p := &{{.TypeName}}{}
-	p.title = ui.NewLabel("{{.TypeName}}", ui.WithLabelStyle(backend.DefaultStyle().Bold(true)))
+	p.title = ui.NewLabel("{{.Title}}", ui.WithLabelStyle(backend.DefaultStyle().Bold(true)))
 	return p
 }
 
+// Mount runs when the page becomes visible. Start subscriptions here.
+func (p *{{.TypeName}}) Mount() {
+	p.mounted = true
+}
+
+// Unmount runs when the page is hidden or removed.
+func (p *{{.TypeName}}) Unmount() {
+	p.mounted = false
+}
+
 func (p *{{.TypeName}}) Measure(constraints runtime.Constraints) runtime.Size {
 	return constraints.MaxSize()
 }
 
 func (p *{{.TypeName}}) Layout(bounds runtime.Rect) {
 	p.Component.Layout(bounds)
-	if p.title != nil {
-		p.title.Layout(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1})
-	}
+	p.title.Layout(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1})
 }
 
 func (p *{{.TypeName}}) Render(ctx runtime.RenderContext) {
-	if p.title != nil {
-		p.title.Render(ctx)
-	}
+	p.title.Render(ctx)
 }
 
 func (p *{{.TypeName}}) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if p.title != nil {
-		if result := p.title.HandleMessage(msg); result.Handled {
-			return result
-		}
-	}
 	return runtime.Unhandled()
 }
 
 func (p *{{.TypeName}}) ChildWidgets() []runtime.Widget {
-	if p.title == nil {
-		return nil
-	}
 	return []runtime.Widget{p.title}
 }
 
 var _ runtime.Widget = (*{{.TypeName}})(nil)
+var _ runtime.Lifecycle = (*{{.TypeName}})(nil)
 var _ runtime.ChildProvider = (*{{.TypeName}})(nil)
 `
+
+const pageTestTemplate = `package {{.Package}}
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func Test{{.TypeName}}Renders(t *testing.T) {
+	page := New{{.TypeName}}()
+	runtime.MountTree(page)
+	if !page.mounted {
+		t.Fatal("expected Mount to run")
+	}
+
+	buf := runtime.NewBuffer(40, 5)
+	page.Layout(runtime.Rect{Width: 40, Height: 5})
+	page.Render(runtime.RenderContext{Buffer: buf, Bounds: runtime.Rect{Width: 40, Height: 5}})
+	var row strings.Builder
+	for x := 0; x < 40; x++ {
+		row.WriteRune(buf.Get(x, 0).Rune)
+	}
+	if !strings.Contains(row.String(), "{{.Title}}") {
+		t.Fatalf("title row = %q", row.String())
+	}
+
+	runtime.UnmountTree(page)
+	if page.mounted {
+		t.Fatal("expected Unmount to run")
+	}
+}
+`
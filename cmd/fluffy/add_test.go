@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunAddFlagOrder(t *testing.T) {
+	for name, args := range map[string][]string{
+		"flags first": {"page", "--router", "none", "--dir", "DIR", "Settings"},
+		"flags last":  {"page", "Settings", "--router", "none", "--dir", "DIR"},
+		"flags mixed": {"--dir", "DIR", "page", "Settings", "--router", "none"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for i, arg := range args {
+				if arg == "DIR" {
+					args[i] = dir
+				}
+			}
+			if err := runAdd(args); err != nil {
+				t.Fatalf("runAdd: %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "pages", "settings", "page.go")); err != nil {
+				t.Fatalf("expected pages/settings/page.go: %v", err)
+			}
+			entries, err := os.ReadDir(filepath.Join(dir, "pages"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t.Fatalf("expected only the settings page, got %d entries", len(entries))
+			}
+		})
+	}
+}
+
+func TestRunAddRejectsFlagAsName(t *testing.T) {
+	dir := t.TempDir()
+	if err := runAdd([]string{"--dir", dir, "page", "--help"}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("runAdd --help = %v, want flag.ErrHelp", err)
+	}
+	if err := runAdd([]string{"--dir", dir, "page", "--", "-settings"}); err == nil {
+		t.Fatal("expected a name starting with - to be rejected")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "pages")); !os.IsNotExist(err) {
+		t.Fatalf("expected no pages to be generated, stat err = %v", err)
+	}
+}
@@ -6,22 +6,27 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type projectData struct {
-	AppName    string
-	AppTitle   string
-	ModulePath string
+	AppName     string
+	AppTitle    string
+	ModulePath  string
+	PackageName string
+	TypeName    string
 }
 
 type projectTemplate struct {
 	dirs  []string
 	files map[string]string
+	// run is the command suggested after creation. Empty means "go run .".
+	run string
 }
 
 func runCreate(args []string) error {
 	fs := flag.NewFlagSet("create", flag.ContinueOnError)
-	templateName := fs.String("template", "minimal", "template: minimal, full, game, dashboard, form, data-viewer")
+	templateName := fs.String("template", "minimal", "template: minimal, full, game, dashboard, form, data-viewer, library")
 	modulePath := fs.String("module", "", "go module path")
 	force := fs.Bool("force", false, "overwrite existing files")
 	fs.SetOutput(os.Stderr)
@@ -31,8 +36,12 @@ func runCreate(args []string) error {
 	if fs.NArg() < 1 {
 		return errors.New("missing app name")
 	}
-
 	appName := fs.Arg(0)
+	// Accept flags after the name too, as in "fluffy create app --template game".
+	if err := fs.Parse(fs.Args()[1:]); err != nil {
+		return err
+	}
+
 	targetDir := appName
 	if empty, err := dirEmpty(targetDir); err != nil {
 		return err
@@ -45,9 +54,14 @@ func runCreate(args []string) error {
 	}
 
 	data := projectData{
-		AppName:    appName,
-		AppTitle:   titleFromName(appName),
-		ModulePath: *modulePath,
+		AppName:     appName,
+		AppTitle:    titleFromName(appName),
+		ModulePath:  *modulePath,
+		PackageName: packageFromName(appName),
+		TypeName:    toPascal(filepath.Base(appName)),
+	}
+	if data.TypeName == "" {
+		return fmt.Errorf("invalid name: %s", appName)
 	}
 
 	tmpl, err := selectTemplate(*templateName)
@@ -55,7 +69,15 @@ func runCreate(args []string) error {
 		return err
 	}
 
-	return createProject(targetDir, data, tmpl, *force)
+	if err := createProject(targetDir, data, tmpl, *force); err != nil {
+		return err
+	}
+	run := tmpl.run
+	if run == "" {
+		run = "go run ."
+	}
+	fmt.Printf("Created %s\n\nNext steps:\n  cd %s\n  go mod tidy\n  %s\n", targetDir, targetDir, run)
+	return nil
 }
 
 func selectTemplate(name string) (projectTemplate, error) {
@@ -72,6 +94,8 @@ func selectTemplate(name string) (projectTemplate, error) {
 		return formTemplate(), nil
 	case "data-viewer":
 		return dataViewerTemplate(), nil
+	case "library":
+		return libraryTemplate(), nil
 	default:
 		return projectTemplate{}, fmt.Errorf("unknown template: %s", name)
 	}
@@ -193,6 +217,31 @@ func dataViewerTemplate() projectTemplate {
 	}
 }
 
+// libraryTemplate scaffolds a reusable widget package instead of an app.
+func libraryTemplate() projectTemplate {
+	return projectTemplate{
+		dirs: []string{
+			"example",
+		},
+		files: map[string]string{
+			"go.mod":          goModTemplate,
+			"widget.go":       libraryWidgetTemplate,
+			"widget_test.go":  libraryWidgetTestTemplate,
+			"example/main.go": libraryExampleTemplate,
+		},
+		run: "go test ./...",
+	}
+}
+
+// packageFromName derives a Go package name from a project name.
+func packageFromName(name string) string {
+	pkg := strings.ReplaceAll(toSnake(filepath.Base(name)), "_", "")
+	if pkg == "" || (pkg[0] >= '0' && pkg[0] <= '9') {
+		pkg = "widget" + pkg
+	}
+	return pkg
+}
+
 const goModTemplate = `module {{.ModulePath}}
 
 go 1.24
@@ -661,6 +710,21 @@ colors:
   surface: "#16161c"
   text: "#f0eee8"
   accent: "#ffb74d"
+spacing:
+  xs: 1
+  sm: 2
+  md: 3
+  lg: 4
+  xl: 6
+typography:
+  heading:
+    bold: "true"
+  body:
+    foreground: "text"
+  caption:
+    dim: "true"
+  emphasis:
+    italic: "true"
 styles:
   app:
     foreground: "text"
@@ -672,3 +736,159 @@ styles:
     foreground: "background"
     background: "accent"
 `
+
+const libraryWidgetTemplate = `// Package {{.PackageName}} provides the {{.TypeName}} widget.
+package {{.PackageName}}
+
+import (
+	"fmt"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/state"
+	"github.com/odvcencio/fluffyui/terminal"
+	ui "github.com/odvcencio/fluffyui/widgets"
+)
+
+// {{.TypeName}} is a focusable counter. Press + or up to increment and - or
+// down to decrement.
+type {{.TypeName}} struct {
+	ui.Component
+	label string
+	count *state.Signal[int]
+}
+
+// New{{.TypeName}} creates a {{.TypeName}} showing label.
+func New{{.TypeName}}(label string) *{{.TypeName}} {
+	count := state.NewSignal(0)
+	count.SetEqualFunc(state.EqualComparable[int])
+	return &{{.TypeName}}{label: label, count: count}
+}
+
+// Count returns the current value.
+func (w *{{.TypeName}}) Count() int {
+	return w.count.Get()
+}
+
+// SetCount updates the value.
+func (w *{{.TypeName}}) SetCount(value int) {
+	w.count.Set(value)
+}
+
+// Mount re-renders when the count changes.
+func (w *{{.TypeName}}) Mount() {
+	w.Observe(w.count, w.Invalidate)
+}
+
+// Unmount releases subscriptions.
+func (w *{{.TypeName}}) Unmount() {
+	w.Subs.Clear()
+}
+
+// Measure requests a single line wide enough for the text.
+func (w *{{.TypeName}}) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.Constrain(runtime.Size{Width: len(w.text()), Height: 1})
+}
+
+// Render draws the label and count.
+func (w *{{.TypeName}}) Render(ctx runtime.RenderContext) {
+	bounds := w.Bounds()
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	style := backend.DefaultStyle()
+	if w.IsFocused() {
+		style = style.Reverse(true)
+	}
+	ctx.Buffer.SetString(bounds.X, bounds.Y, w.text(), style)
+}
+
+// HandleMessage adjusts the count from the keyboard.
+func (w *{{.TypeName}}) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	switch {
+	case key.Key == terminal.KeyUp, key.Key == terminal.KeyRune && key.Rune == '+':
+		w.count.Set(w.count.Get() + 1)
+	case key.Key == terminal.KeyDown, key.Key == terminal.KeyRune && key.Rune == '-':
+		w.count.Set(w.count.Get() - 1)
+	default:
+		return runtime.Unhandled()
+	}
+	return runtime.Handled()
+}
+
+// CanFocus reports that the widget accepts keyboard focus.
+func (w *{{.TypeName}}) CanFocus() bool {
+	return true
+}
+
+func (w *{{.TypeName}}) text() string {
+	return fmt.Sprintf("%s: %d", w.label, w.count.Get())
+}
+
+var _ runtime.Widget = (*{{.TypeName}})(nil)
+`
+
+const libraryWidgetTestTemplate = `package {{.PackageName}}
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+	flufftest "github.com/odvcencio/fluffyui/testing"
+)
+
+func Test{{.TypeName}}Render(t *testing.T) {
+	w := New{{.TypeName}}("Clicks")
+	w.SetCount(3)
+
+	be := flufftest.RenderWidgetOrFail(t, w, 20, 1)
+	defer be.Fini()
+	flufftest.AssertContains(t, be, "Clicks: 3")
+}
+
+func Test{{.TypeName}}Keys(t *testing.T) {
+	w := New{{.TypeName}}("Clicks")
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '+'})
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	w.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '-'})
+	if got := w.Count(); got != 1 {
+		t.Fatalf("Count() = %d, want 1", got)
+	}
+
+	be := flufftest.RenderWidgetOrFail(t, w, 20, 1)
+	defer be.Fini()
+	flufftest.AssertContains(t, be, "Clicks: 1")
+}
+`
+
+const libraryExampleTemplate = `package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/odvcencio/fluffyui/fluffy"
+
+	{{.PackageName}} "{{.ModulePath}}"
+)
+
+func main() {
+	app, err := fluffy.NewApp()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "app init failed: %v\n", err)
+		os.Exit(1)
+	}
+	app.SetRoot({{.PackageName}}.New{{.TypeName}}("{{.AppTitle}}"))
+
+	if err := app.Run(context.Background()); err != nil && err != context.Canceled {
+		fmt.Fprintf(os.Stderr, "app run failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+`
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	agentEnv   = "FLUFFYUI_AGENT"
+	tokenEnv   = "FLUFFYUI_AGENT_TOKEN"
+	restoreEnv = "FLUFFYUI_DEV_RESTORE"
+)
+
+// devState carries app state across `fluffy dev` restarts through the
+// child's agent socket: a "snapshot" with include_state before the old
+// process stops, and a "restore" once the new one is listening.
+type devState struct {
+	addr    string
+	token   string
+	ownSock string
+	timeout time.Duration
+}
+
+// newDevState reuses FLUFFYUI_AGENT when set and otherwise picks a private
+// unix socket for the child.
+func newDevState() *devState {
+	d := &devState{
+		addr:    strings.TrimSpace(os.Getenv(agentEnv)),
+		token:   strings.TrimSpace(os.Getenv(tokenEnv)),
+		timeout: 3 * time.Second,
+	}
+	if d.addr == "" {
+		d.ownSock = filepath.Join(os.TempDir(), fmt.Sprintf("fluffy-dev-%d.sock", os.Getpid()))
+		d.addr = "unix:" + d.ownSock
+	}
+	return d
+}
+
+// env returns the child environment. restore marks the child as expecting
+// a snapshot, which makes App.RestoreFromAgent wait for it.
+func (d *devState) env(restore bool) []string {
+	env := os.Environ()
+	if d.ownSock != "" {
+		env = append(env, agentEnv+"="+d.addr)
+	}
+	if restore {
+		env = append(env, restoreEnv+"=1")
+	}
+	return env
+}
+
+func (d *devState) close() {
+	if d.ownSock != "" {
+		_ = os.Remove(d.ownSock)
+	}
+}
+
+// capture asks the running child for its Persistable state. A child that
+// is not listening (no agent.EnableFromEnv) yields no state and no error.
+func (d *devState) capture() ([]byte, error) {
+	client, err := d.dial(false)
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer client.close()
+	resp, err := client.send(map[string]any{"type": "snapshot", "include_state": true})
+	if err != nil {
+		return nil, err
+	}
+	return resp.State, nil
+}
+
+// deliver waits for the restarted child to accept connections and sends it
+// the captured state.
+func (d *devState) deliver(state []byte) error {
+	client, err := d.dial(true)
+	if err != nil {
+		return err
+	}
+	defer client.close()
+	_, err = client.send(map[string]any{"type": "restore", "state": json.RawMessage(state)})
+	return err
+}
+
+func (d *devState) dial(wait bool) (*devClient, error) {
+	deadline := time.Now().Add(d.timeout)
+	for {
+		client, err := d.dialOnce()
+		if err == nil {
+			return client, nil
+		}
+		if !wait || time.Now().After(deadline) {
+			return nil, err
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func (d *devState) dialOnce() (*devClient, error) {
+	network, address, ok := strings.Cut(d.addr, ":")
+	if !ok || (network != "unix" && network != "tcp") {
+		return nil, fmt.Errorf("unsupported agent address %q", d.addr)
+	}
+	conn, err := net.DialTimeout(network, address, d.timeout)
+	if err != nil {
+		return nil, err
+	}
+	client := &devClient{conn: conn, rd: bufio.NewReader(conn)}
+	_ = conn.SetDeadline(time.Now().Add(d.timeout))
+	if _, err := client.send(map[string]any{"type": "hello", "token": d.token}); err != nil {
+		client.close()
+		return nil, err
+	}
+	return client, nil
+}
+
+type devClient struct {
+	conn   net.Conn
+	rd     *bufio.Reader
+	nextID int
+}
+
+type devResponse struct {
+	ID      int             `json:"id"`
+	OK      bool            `json:"ok"`
+	Error   string          `json:"error"`
+	Message string          `json:"message"`
+	State   json.RawMessage `json:"state"`
+}
+
+func (c *devClient) send(req map[string]any) (devResponse, error) {
+	c.nextID++
+	req["id"] = c.nextID
+	if err := json.NewEncoder(c.conn).Encode(req); err != nil {
+		return devResponse{}, err
+	}
+	for {
+		line, err := c.rd.ReadBytes('\n')
+		if err != nil {
+			return devResponse{}, err
+		}
+		var resp devResponse
+		if err := json.Unmarshal(line, &resp); err != nil || resp.ID != c.nextID {
+			continue
+		}
+		if !resp.OK {
+			if resp.Message != "" {
+				return resp, fmt.Errorf("%s %s: %s", req["type"], resp.Error, resp.Message)
+			}
+			return resp, fmt.Errorf("%s %s", req["type"], resp.Error)
+		}
+		return resp, nil
+	}
+}
+
+func (c *devClient) close() {
+	_ = c.conn.Close()
+}
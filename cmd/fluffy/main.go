@@ -79,12 +79,12 @@ func usage() {
 	fmt.Fprint(os.Stderr, `fluffy - FluffyUI developer tools
 
 usage:
-  fluffy dev [--watch path] [--ext .go,.fss] [--debounce 200ms] -- <cmd> [args...]
-  fluffy dev [--watch path] [--ext .go,.fss] [--debounce 200ms] --run <pkg-or-file>
-  fluffy create <name> [--template minimal|full|game] [--module path] [--force]
-  fluffy add widget|page <Name> [--dir path] [--force]
-  fluffy theme init|check|export [--path theme.yaml] [--output theme.css] [--force]
-  fluffy test [--visual] [--race] [--pkg ./...]
+  fluffy dev [--watch path] [--ext .go,.fss] [--debounce 200ms] [--no-state-restore] -- <cmd> [args...]
+  fluffy dev [--watch path] [--ext .go,.fss] [--debounce 200ms] [--no-state-restore] --run <pkg-or-file>
+  fluffy create <name> [--template minimal|full|game|dashboard|form|data-viewer|library] [--module path] [--force]
+  fluffy add widget|page <Name> [--dir path] [--router tabbed|stack|none] [--force]
+  fluffy theme init|check|export [--path theme.yaml] [--output theme.css|theme.go] [--package theme] [--force]
+  fluffy test [--visual] [--race] [--golden] [--update-golden] [--pkg ./...]
   fluffy record [--output file.cast|file.gif] [--export file] [--title title] [-- <cmd>]
 `)
 }
@@ -95,10 +95,12 @@ func runDev(args []string) error {
 	var exts string
 	var debounce time.Duration
 	var runTarget string
+	var noStateRestore bool
 	fs.Var(&watches, "watch", "watch path (repeatable)")
 	fs.StringVar(&exts, "ext", ".go,.fss,.yaml,.json", "comma-separated extensions")
 	fs.DurationVar(&debounce, "debounce", 200*time.Millisecond, "restart debounce window")
 	fs.StringVar(&runTarget, "run", "", "go run target (package, file, or module)")
+	fs.BoolVar(&noStateRestore, "no-state-restore", false, "restart without carrying app state over the agent socket")
 	fs.SetOutput(os.Stderr)
 
 	split := indexOf(args, "--")
@@ -141,7 +143,13 @@ func runDev(args []string) error {
 		watchLoop(watches, extSet, 500*time.Millisecond, debounce, restarts, stop)
 	}()
 
-	cmd, err := startCmd(cmdArgs)
+	var carry *devState
+	if !noStateRestore {
+		carry = newDevState()
+		defer carry.close()
+	}
+
+	cmd, err := startCmd(cmdArgs, carry, nil)
 	if err != nil {
 		close(stop)
 		wg.Wait()
@@ -155,8 +163,15 @@ func runDev(args []string) error {
 	for {
 		select {
 		case <-restarts:
+			var snapshot []byte
+			if carry != nil {
+				snapshot, err = carry.capture()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "fluffy dev: state not preserved: %v\n", err)
+				}
+			}
 			_ = stopCmd(cmd)
-			cmd, err = startCmd(cmdArgs)
+			cmd, err = startCmd(cmdArgs, carry, snapshot)
 			if err != nil {
 				close(stop)
 				wg.Wait()
@@ -171,14 +186,26 @@ func runDev(args []string) error {
 	}
 }
 
-func startCmd(args []string) (*exec.Cmd, error) {
+// startCmd starts the child. With a snapshot, the child is told to wait in
+// App.RestoreFromAgent and the state is delivered once its agent is up.
+func startCmd(args []string, carry *devState, snapshot []byte) (*exec.Cmd, error) {
 	cmd := exec.Command(args[0], args[1:]...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
+	if carry != nil {
+		cmd.Env = carry.env(len(snapshot) > 0)
+	}
 	if err := cmd.Start(); err != nil {
 		return nil, err
 	}
+	if carry != nil && len(snapshot) > 0 {
+		go func() {
+			if err := carry.deliver(snapshot); err != nil {
+				fmt.Fprintf(os.Stderr, "fluffy dev: state not restored: %v\n", err)
+			}
+		}()
+	}
 	return cmd, nil
 }
 
@@ -3,15 +3,25 @@ package main
 import (
 	"errors"
 	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
 )
 
+const goldenSuffix = "_golden_test.go"
+
 func runTest(args []string) error {
 	fs := flag.NewFlagSet("test", flag.ContinueOnError)
 	visual := fs.Bool("visual", false, "enable visual testing")
 	race := fs.Bool("race", false, "run with race detector")
 	pkg := fs.String("pkg", "./...", "packages to test")
+	golden := fs.Bool("golden", false, "run only the tests in *_golden_test.go files")
+	updateGolden := fs.Bool("update-golden", false, "regenerate golden casts from *_golden_test.go files")
 	fs.SetOutput(os.Stderr)
 	if err := fs.Parse(args); err != nil {
 		return err
@@ -24,13 +34,111 @@ func runTest(args []string) error {
 	if *race {
 		cmdArgs = append(cmdArgs, "-race")
 	}
-	cmdArgs = append(cmdArgs, pkgs...)
 
 	env := os.Environ()
 	if *visual {
 		env = append(env, "FLUFFYUI_VISUAL=1")
 	}
-	return runCommand(cmdArgs, env)
+	if !*golden && !*updateGolden {
+		return runCommand(append(cmdArgs, pkgs...), env)
+	}
+
+	targets, err := goldenTargets(pkgs)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return errors.New("no *" + goldenSuffix + " files found")
+	}
+	if *updateGolden {
+		env = append(env, "FLUFFYUI_UPDATE_SNAPSHOTS=1")
+	}
+	var failed error
+	for _, target := range targets {
+		args := append(append([]string{}, cmdArgs...), "-run", target.run, target.pkg)
+		if err := runCommand(args, env); err != nil && failed == nil {
+			failed = err
+		}
+	}
+	return failed
+}
+
+// goldenTarget is a package with golden tests and a -run pattern that
+// selects exactly those tests.
+type goldenTarget struct {
+	pkg string
+	run string
+}
+
+// goldenTargets finds *_golden_test.go files under local package patterns
+// such as ./... or ./widgets.
+func goldenTargets(patterns []string) ([]goldenTarget, error) {
+	tests := map[string][]string{}
+	for _, pattern := range patterns {
+		dir, recursive := strings.CutSuffix(pattern, "/...")
+		if pattern == "..." {
+			dir, recursive = ".", true
+		}
+		if !strings.HasPrefix(dir, ".") && !filepath.IsAbs(dir) {
+			continue
+		}
+		err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if path == dir {
+					return nil
+				}
+				name := d.Name()
+				if !recursive || name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, goldenSuffix) {
+				return nil
+			}
+			names, err := testFuncs(path)
+			if err != nil {
+				return err
+			}
+			pkgDir := filepath.Dir(path)
+			tests[pkgDir] = append(tests[pkgDir], names...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	targets := make([]goldenTarget, 0, len(tests))
+	for dir, names := range tests {
+		if len(names) == 0 {
+			continue
+		}
+		pkg := dir
+		if !filepath.IsAbs(pkg) {
+			pkg = "./" + filepath.ToSlash(filepath.Clean(dir))
+		}
+		targets = append(targets, goldenTarget{pkg: pkg, run: "^(" + strings.Join(names, "|") + ")$"})
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i].pkg < targets[j].pkg })
+	return targets, nil
+}
+
+func testFuncs(path string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Recv == nil && strings.HasPrefix(fn.Name.Name, "Test") {
+			names = append(names, fn.Name.Name)
+		}
+	}
+	return names, nil
 }
 
 func runCommand(args []string, env []string) error {
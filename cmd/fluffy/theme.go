@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"math"
 	"os"
 	"path/filepath"
@@ -11,13 +13,34 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/odvcencio/fluffyui/compositor"
+	fluffytheme "github.com/odvcencio/fluffyui/theme"
 	"gopkg.in/yaml.v3"
 )
 
+// themeFile is the theme.yaml schema. Unknown top-level keys are rejected.
 type themeFile struct {
-	Name   string                       `yaml:"name"`
-	Colors map[string]string            `yaml:"colors"`
-	Styles map[string]map[string]string `yaml:"styles"`
+	Name       string                       `yaml:"name"`
+	Base       string                       `yaml:"base"`
+	Colors     map[string]string            `yaml:"colors"`
+	Spacing    map[string]int               `yaml:"spacing"`
+	Typography map[string]map[string]string `yaml:"typography"`
+	Styles     map[string]map[string]string `yaml:"styles"`
+}
+
+const (
+	themePath       = "theme.yaml"
+	legacyThemePath = "themes/default.yaml"
+)
+
+// minContrastAA is the WCAG AA contrast ratio for normal text.
+const minContrastAA = 4.5
+
+// styleProps are the properties accepted under styles and typography.
+var styleProps = map[string]bool{
+	"foreground": true, "fg": true, "color": true,
+	"background": true, "bg": true,
+	"bold": true, "dim": true, "italic": true, "underline": true, "reverse": true,
 }
 
 type colorRGB struct {
@@ -48,7 +71,7 @@ func runTheme(args []string) error {
 
 func runThemeInit(args []string) error {
 	fs := flag.NewFlagSet("theme init", flag.ContinueOnError)
-	path := fs.String("path", "themes/default.yaml", "theme file path")
+	path := fs.String("path", themePath, "theme file path")
 	force := fs.Bool("force", false, "overwrite existing file")
 	fs.SetOutput(os.Stderr)
 	if err := fs.Parse(args); err != nil {
@@ -57,18 +80,32 @@ func runThemeInit(args []string) error {
 	return writeFile(*path, []byte(defaultThemeTemplate), 0o644, *force)
 }
 
+// defaultThemePath picks theme.yaml, falling back to the themes/default.yaml
+// that fluffy create scaffolds.
+func defaultThemePath() string {
+	if _, err := os.Stat(themePath); err != nil {
+		if _, err := os.Stat(legacyThemePath); err == nil {
+			return legacyThemePath
+		}
+	}
+	return themePath
+}
+
 func runThemeCheck(args []string) error {
 	fs := flag.NewFlagSet("theme check", flag.ContinueOnError)
-	path := fs.String("path", "themes/default.yaml", "theme file path")
+	path := fs.String("path", defaultThemePath(), "theme file path")
 	fs.SetOutput(os.Stderr)
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
-	theme, err := loadTheme(*path)
+	tf, compiled, err := loadTheme(*path)
 	if err != nil {
 		return err
 	}
-	issues := validateTheme(theme)
+	issues, warnings := validateTheme(tf, compiled)
+	for _, warning := range warnings {
+		fmt.Fprintln(os.Stderr, "warning:", warning)
+	}
 	if len(issues) == 0 {
 		fmt.Fprintln(os.Stdout, "theme check passed")
 		return nil
@@ -81,25 +118,33 @@ func runThemeCheck(args []string) error {
 
 func runThemeExport(args []string) error {
 	fs := flag.NewFlagSet("theme export", flag.ContinueOnError)
-	path := fs.String("path", "themes/default.yaml", "theme file path")
-	outPath := fs.String("output", "theme.css", "output css file")
+	path := fs.String("path", defaultThemePath(), "theme file path")
+	outPath := fs.String("output", "theme.css", "output file; a .go file gets a Go theme, anything else CSS")
+	pkg := fs.String("package", "theme", "package name for Go output")
 	force := fs.Bool("force", false, "overwrite existing file")
 	fs.SetOutput(os.Stderr)
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
-	theme, err := loadTheme(*path)
+	tf, compiled, err := loadTheme(*path)
 	if err != nil {
 		return err
 	}
-	css, err := exportThemeCSS(theme)
+	var out []byte
+	if strings.EqualFold(filepath.Ext(*outPath), ".go") {
+		out, err = exportThemeGo(compiled, *pkg, *path)
+	} else {
+		var css string
+		css, err = exportThemeCSS(tf)
+		out = []byte(css)
+	}
 	if err != nil {
 		return err
 	}
 	if err := ensureDir(filepath.Dir(*outPath)); err != nil {
 		return err
 	}
-	return writeFile(*outPath, []byte(css), 0o644, *force)
+	return writeFile(*outPath, out, 0o644, *force)
 }
 
 type themeCatalog struct {
@@ -172,7 +217,7 @@ func runThemeInstall(args []string) error {
 	if strings.TrimSpace(*source) == "" {
 		return errors.New("missing --source theme file")
 	}
-	if _, err := loadTheme(*source); err != nil {
+	if _, _, err := loadTheme(*source); err != nil {
 		return err
 	}
 	if err := ensureDir(*dir); err != nil {
@@ -186,14 +231,22 @@ func runThemeInstall(args []string) error {
 	return writeFile(target, content, 0o644, *force)
 }
 
-func loadTheme(path string) (themeFile, error) {
+// loadTheme parses path against the theme.yaml schema and compiles it with
+// theme.LoadYAML.
+func loadTheme(path string) (themeFile, *fluffytheme.Theme, error) {
 	raw, err := os.ReadFile(path)
 	if err != nil {
-		return themeFile{}, err
+		return themeFile{}, nil, err
 	}
 	var tf themeFile
-	if err := yaml.Unmarshal(raw, &tf); err != nil {
-		return themeFile{}, fmt.Errorf("parse theme: %w", err)
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	if err := dec.Decode(&tf); err != nil && !errors.Is(err, io.EOF) {
+		return themeFile{}, nil, fmt.Errorf("parse theme: %w", err)
+	}
+	compiled, err := fluffytheme.LoadYAML(bytes.NewReader(raw))
+	if err != nil {
+		return themeFile{}, nil, err
 	}
 	if tf.Colors == nil {
 		tf.Colors = map[string]string{}
@@ -201,41 +254,96 @@ func loadTheme(path string) (themeFile, error) {
 	if tf.Styles == nil {
 		tf.Styles = map[string]map[string]string{}
 	}
-	return tf, nil
+	return tf, compiled, nil
 }
 
-func validateTheme(tf themeFile) []string {
-	var issues []string
+// validateTheme returns schema issues, which fail the check, and contrast
+// warnings for pairs below WCAG AA.
+func validateTheme(tf themeFile, compiled *fluffytheme.Theme) (issues, warnings []string) {
 	if len(tf.Colors) == 0 {
 		issues = append(issues, "theme: no colors defined")
 	}
-	for name, value := range tf.Colors {
-		if _, _, err := resolveColor(value, tf.Colors); err != nil {
+	for _, name := range sortedNames(tf.Colors) {
+		if _, _, err := resolveColor(tf.Colors[name], tf.Colors); err != nil {
 			issues = append(issues, fmt.Sprintf("color %q: %v", name, err))
 		}
 	}
-	for selector, props := range tf.Styles {
-		fgValue := pickProp(props, "foreground", "fg", "color")
-		bgValue := pickProp(props, "background", "bg")
-		if fgValue == "" || bgValue == "" {
-			continue
-		}
-		fg, _, err := resolveColor(fgValue, tf.Colors)
-		if err != nil {
-			issues = append(issues, fmt.Sprintf("style %q foreground: %v", selector, err))
-			continue
+	checkStyles := func(kind string, styles map[string]map[string]string) {
+		for _, selector := range sortedNames(styles) {
+			props := styles[selector]
+			for _, prop := range sortedNames(props) {
+				if !styleProps[strings.ToLower(prop)] {
+					issues = append(issues, fmt.Sprintf("%s %q: unknown property %q", kind, selector, prop))
+				}
+			}
+			fgValue := pickProp(props, "foreground", "fg", "color")
+			bgValue := pickProp(props, "background", "bg")
+			if fgValue == "" || bgValue == "" {
+				continue
+			}
+			fg, _, err := resolveColor(fgValue, tf.Colors)
+			if err != nil {
+				issues = append(issues, fmt.Sprintf("%s %q foreground: %v", kind, selector, err))
+				continue
+			}
+			bg, _, err := resolveColor(bgValue, tf.Colors)
+			if err != nil {
+				issues = append(issues, fmt.Sprintf("%s %q background: %v", kind, selector, err))
+				continue
+			}
+			if contrast := contrastRatio(fg, bg); contrast < minContrastAA {
+				warnings = append(warnings, fmt.Sprintf("%s %q contrast %.2f below AA (%.1f)", kind, selector, contrast, minContrastAA))
+			}
 		}
-		bg, _, err := resolveColor(bgValue, tf.Colors)
-		if err != nil {
-			issues = append(issues, fmt.Sprintf("style %q background: %v", selector, err))
+	}
+	checkStyles("typography", tf.Typography)
+	checkStyles("style", tf.Styles)
+
+	if compiled == nil {
+		return issues, warnings
+	}
+	pairs := []struct {
+		name   string
+		fg, bg compositor.Color
+	}{
+		{"text_primary on background", compiled.TextPrimary.FG, compiled.Background.BG},
+		{"text_primary on surface", compiled.TextPrimary.FG, compiled.Surface.BG},
+		{"text_secondary on background", compiled.TextSecondary.FG, compiled.Background.BG},
+		{"text_inverse on accent", compiled.TextInverse.FG, compiled.Accent.FG},
+	}
+	for _, pair := range pairs {
+		fg, okFG := rgbOf(pair.fg)
+		bg, okBG := rgbOf(pair.bg)
+		if !okFG || !okBG {
 			continue
 		}
-		contrast := contrastRatio(fg, bg)
-		if contrast < 4.5 {
-			issues = append(issues, fmt.Sprintf("style %q contrast %.2f below AA (4.5)", selector, contrast))
+		if contrast := contrastRatio(fg, bg); contrast < minContrastAA {
+			warnings = append(warnings, fmt.Sprintf("%s contrast %.2f below AA (%.1f)", pair.name, contrast, minContrastAA))
 		}
 	}
-	return issues
+	return issues, warnings
+}
+
+// rgbOf converts a true-color value; palette and default colors depend on
+// the terminal and are skipped.
+func rgbOf(c compositor.Color) (colorRGB, bool) {
+	if c.Mode != compositor.ColorModeRGB {
+		return colorRGB{}, false
+	}
+	return colorRGB{
+		r: float64(c.Value >> 16 & 0xff),
+		g: float64(c.Value >> 8 & 0xff),
+		b: float64(c.Value & 0xff),
+	}, true
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func exportThemeCSS(tf themeFile) (string, error) {
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/compositor"
+	fluffytheme "github.com/odvcencio/fluffyui/theme"
+)
+
+var styleType = reflect.TypeOf(compositor.Style{})
+
+// exportThemeGo renders th as a Go file declaring `var Default = fluffy.Theme{...}`
+// in package pkg. source is the YAML path named in the generated header.
+func exportThemeGo(th *fluffytheme.Theme, pkg, source string) ([]byte, error) {
+	if th == nil {
+		return nil, errors.New("missing theme")
+	}
+	if !token.IsIdentifier(pkg) {
+		return nil, fmt.Errorf("invalid package name %q", pkg)
+	}
+	source = filepath.ToSlash(source)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by fluffy theme export from %s. DO NOT EDIT.\n", source)
+	fmt.Fprintf(&b, "// Edit %s and run `fluffy theme export --output <file>.go` to regenerate.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n")
+	b.WriteString("\t\"github.com/odvcencio/fluffyui/compositor\"\n")
+	b.WriteString("\t\"github.com/odvcencio/fluffyui/fluffy\"\n")
+	b.WriteString(")\n\n")
+	fmt.Fprintf(&b, "// Default is the %q theme.\n", th.Name)
+	b.WriteString("var Default = fluffy.Theme{\n")
+	if err := writeGoFields(&b, reflect.ValueOf(*th)); err != nil {
+		return nil, err
+	}
+	b.WriteString("}\n")
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated theme: %w", err)
+	}
+	return out, nil
+}
+
+// writeGoFields writes a keyed field for each exported field of v. Nested
+// theme structs are spelled through their fluffy.Theme* aliases.
+func writeGoFields(b *bytes.Buffer, v reflect.Value) error {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		switch {
+		case field.Type == styleType:
+			fmt.Fprintf(b, "%s: %s,\n", field.Name, goStyle(value.Interface().(compositor.Style)))
+		case field.Type.Kind() == reflect.String:
+			fmt.Fprintf(b, "%s: %q,\n", field.Name, value.String())
+		case field.Type.Kind() == reflect.Int:
+			fmt.Fprintf(b, "%s: %d,\n", field.Name, value.Int())
+		case field.Type.Kind() == reflect.Struct:
+			fmt.Fprintf(b, "%s: fluffy.Theme%s{\n", field.Name, field.Type.Name())
+			if err := writeGoFields(b, value); err != nil {
+				return err
+			}
+			b.WriteString("},\n")
+		default:
+			return fmt.Errorf("theme field %s: unsupported type %s", field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+func goStyle(s compositor.Style) string {
+	parts := []string{"FG: " + goColor(s.FG), "BG: " + goColor(s.BG)}
+	for _, attr := range []struct {
+		name string
+		on   bool
+	}{
+		{"Bold", s.Bold},
+		{"Dim", s.Dim},
+		{"Italic", s.Italic},
+		{"Underline", s.Underline},
+		{"Blink", s.Blink},
+		{"Reverse", s.Reverse},
+		{"Strikethrough", s.Strikethrough},
+	} {
+		if attr.on {
+			parts = append(parts, attr.name+": true")
+		}
+	}
+	return "compositor.Style{" + strings.Join(parts, ", ") + "}"
+}
+
+func goColor(c compositor.Color) string {
+	switch c.Mode {
+	case compositor.ColorModeNone:
+		return "compositor.ColorNone"
+	case compositor.ColorModeDefault:
+		return "compositor.ColorDefault"
+	case compositor.ColorModeRGB:
+		return fmt.Sprintf("compositor.Hex(0x%06x)", c.Value)
+	case compositor.ColorMode256:
+		return fmt.Sprintf("compositor.Color256(%d)", c.Value)
+	default:
+		return fmt.Sprintf("compositor.Color{Mode: compositor.ColorMode16, Value: %d}", c.Value)
+	}
+}
@@ -0,0 +1,229 @@
+// Package diff computes line and character diffs with Myers' O(ND)
+// algorithm. Within each change, removed items come before the items
+// added in their place, which is the order unified diffs print them in.
+package diff
+
+import "strings"
+
+// Op is the kind of change for a line or span.
+type Op int
+
+const (
+	// Equal marks text present in both inputs.
+	Equal Op = iota
+	// Insert marks text only in the new input.
+	Insert
+	// Delete marks text only in the old input.
+	Delete
+)
+
+// String returns the unified diff marker for op.
+func (op Op) String() string {
+	switch op {
+	case Insert:
+		return "+"
+	case Delete:
+		return "-"
+	}
+	return " "
+}
+
+// Line is a line of a line-level diff.
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Span is a run of characters of a character-level diff.
+type Span struct {
+	Op   Op
+	Text string
+}
+
+// Lines diffs two slices of lines.
+func Lines(a, b []string) []Line {
+	ops := Script(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+	lines := make([]Line, 0, len(ops))
+	i, j := 0, 0
+	for _, op := range ops {
+		switch op {
+		case Equal:
+			lines = append(lines, Line{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case Delete:
+			lines = append(lines, Line{Op: Delete, Text: a[i]})
+			i++
+		case Insert:
+			lines = append(lines, Line{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+// Text diffs two texts line by line.
+func Text(a, b string) []Line {
+	return Lines(SplitLines(a), SplitLines(b))
+}
+
+// SplitLines splits text into lines, ignoring a final newline.
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// Chars diffs two strings rune by rune, merging neighboring runes with the
+// same op into one span.
+func Chars(a, b string) []Span {
+	ra, rb := []rune(a), []rune(b)
+	ops := Script(len(ra), len(rb), func(i, j int) bool { return ra[i] == rb[j] })
+	var spans []Span
+	add := func(op Op, r rune) {
+		if n := len(spans); n > 0 && spans[n-1].Op == op {
+			spans[n-1].Text += string(r)
+			return
+		}
+		spans = append(spans, Span{Op: op, Text: string(r)})
+	}
+	i, j := 0, 0
+	for _, op := range ops {
+		switch op {
+		case Equal:
+			add(Equal, ra[i])
+			i++
+			j++
+		case Delete:
+			add(Delete, ra[i])
+			i++
+		case Insert:
+			add(Insert, rb[j])
+			j++
+		}
+	}
+	return spans
+}
+
+// Script returns the shortest edit script turning a sequence of n items
+// into one of m items, where equal reports whether item i of the first
+// equals item j of the second. Following the script, Equal and Delete
+// consume an item of the first sequence and Equal and Insert one of the
+// second.
+func Script(n, m int, equal func(i, j int) bool) []Op {
+	// Common ends never need the search.
+	prefix := 0
+	for prefix < n && prefix < m && equal(prefix, prefix) {
+		prefix++
+	}
+	suffix := 0
+	for suffix < n-prefix && suffix < m-prefix && equal(n-1-suffix, m-1-suffix) {
+		suffix++
+	}
+
+	ops := make([]Op, 0, n+m-prefix-suffix)
+	for k := 0; k < prefix; k++ {
+		ops = append(ops, Equal)
+	}
+	middle := myers(n-prefix-suffix, m-prefix-suffix, func(i, j int) bool {
+		return equal(prefix+i, prefix+j)
+	})
+	ops = append(ops, middle...)
+	for k := 0; k < suffix; k++ {
+		ops = append(ops, Equal)
+	}
+	return ops
+}
+
+// myers finds the shortest edit script by walking diagonals k = x - y
+// for increasing edit distance d, keeping the frontier of each round so
+// the path can be traced back.
+func myers(n, m int, equal func(i, j int) bool) []Op {
+	limit := n + m
+	if limit == 0 {
+		return nil
+	}
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds v[-d-1..d+1] as it was before round d.
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && equal(x, y) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(trace [][]int, n, m int) []Op {
+	var reversed []Op
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, Equal)
+			x--
+			y--
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			reversed = append(reversed, Insert)
+		} else {
+			reversed = append(reversed, Delete)
+		}
+		x, y = prevX, prevY
+	}
+
+	ops := make([]Op, len(reversed))
+	for i, op := range reversed {
+		ops[len(reversed)-1-i] = op
+	}
+	// Within each run of changes, put the deletions first.
+	for start := 0; start < len(ops); {
+		if ops[start] == Equal {
+			start++
+			continue
+		}
+		end, deletes := start, 0
+		for ; end < len(ops) && ops[end] != Equal; end++ {
+			if ops[end] == Delete {
+				deletes++
+			}
+		}
+		for i := start; i < end; i++ {
+			if i < start+deletes {
+				ops[i] = Delete
+			} else {
+				ops[i] = Insert
+			}
+		}
+		start = end
+	}
+	return ops
+}
@@ -0,0 +1,130 @@
+package diff
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	got := Lines([]string{"a", "b", "c", "d"}, []string{"a", "c", "x", "d"})
+	want := []Line{
+		{Op: Equal, Text: "a"},
+		{Op: Delete, Text: "b"},
+		{Op: Equal, Text: "c"},
+		{Op: Insert, Text: "x"},
+		{Op: Equal, Text: "d"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("lines = %+v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("line %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLinesDeletionsBeforeInsertions(t *testing.T) {
+	got := Text("keep\nold one\nold two\nend\n", "keep\nnew one\nnew two\nnew three\nend\n")
+	var ops strings.Builder
+	for _, line := range got {
+		ops.WriteString(line.Op.String())
+	}
+	if ops.String() != " --+++ " {
+		t.Fatalf("ops = %q, want removed lines before added ones", ops.String())
+	}
+}
+
+func TestLinesEdgeCases(t *testing.T) {
+	if got := Lines(nil, nil); len(got) != 0 {
+		t.Fatalf("empty diff = %+v", got)
+	}
+	if got := Text("", "a\nb"); len(got) != 2 || got[0].Op != Insert || got[1].Op != Insert {
+		t.Fatalf("all inserts = %+v", got)
+	}
+	if got := Text("a\nb", ""); len(got) != 2 || got[0].Op != Delete || got[1].Op != Delete {
+		t.Fatalf("all deletes = %+v", got)
+	}
+}
+
+// TestScriptIsShortest checks random inputs against an LCS table: the
+// script must rebuild both inputs and have the minimal edit count.
+func TestScriptIsShortest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		a := randomRunes(rng, rng.Intn(20))
+		b := randomRunes(rng, rng.Intn(20))
+		ops := Script(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+
+		var gotA, gotB []rune
+		edits, i, j := 0, 0, 0
+		for _, op := range ops {
+			switch op {
+			case Equal:
+				if a[i] != b[j] {
+					t.Fatalf("%q -> %q: Equal on %q and %q", string(a), string(b), a[i], b[j])
+				}
+				gotA, gotB = append(gotA, a[i]), append(gotB, b[j])
+				i++
+				j++
+			case Delete:
+				gotA = append(gotA, a[i])
+				i++
+				edits++
+			case Insert:
+				gotB = append(gotB, b[j])
+				j++
+				edits++
+			}
+		}
+		if string(gotA) != string(a) || string(gotB) != string(b) {
+			t.Fatalf("%q -> %q: script rebuilt %q -> %q", string(a), string(b), string(gotA), string(gotB))
+		}
+		if want := len(a) + len(b) - 2*lcs(a, b); edits != want {
+			t.Fatalf("%q -> %q: %d edits, want %d", string(a), string(b), edits, want)
+		}
+	}
+}
+
+func TestChars(t *testing.T) {
+	got := Chars("hello world", "hello there world")
+	want := []Span{
+		{Op: Equal, Text: "hello "},
+		{Op: Insert, Text: "there "},
+		{Op: Equal, Text: "world"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("spans = %+v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("span %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func randomRunes(rng *rand.Rand, n int) []rune {
+	out := make([]rune, n)
+	for i := range out {
+		out[i] = rune('a' + rng.Intn(3))
+	}
+	return out
+}
+
+func lcs(a, b []rune) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
@@ -32,22 +32,24 @@ func main() {
 type AccessibilityView struct {
 	widgets.Component
 	controls  *accessibilityControls
-	log       *widgets.Text
+	log       *widgets.LogPanel
 	splitter  *widgets.Splitter
-	messages  []string
 	announcer *accessibility.SimpleAnnouncer
 }
 
 func NewAccessibilityView(announcer *accessibility.SimpleAnnouncer) *AccessibilityView {
 	view := &AccessibilityView{announcer: announcer}
 	view.controls = newAccessibilityControls(announcer, view)
-	view.log = widgets.NewText("Announcements will appear here.")
+	view.log = widgets.NewLogPanel()
+	view.log.SetLabel("Announcements")
+	view.log.SetCapacity(200)
+	view.log.Debug("Announcements will appear here.")
 	view.splitter = widgets.NewSplitter(view.controls, view.log)
 	view.splitter.Ratio = 0.5
 
 	if announcer != nil {
 		announcer.SetOnMessage(func(msg accessibility.Announcement) {
-			view.appendLog(msg.Message)
+			view.appendLog(msg)
 		})
 	}
 
@@ -85,19 +87,15 @@ func (a *AccessibilityView) ChildWidgets() []runtime.Widget {
 	return []runtime.Widget{a.splitter}
 }
 
-func (a *AccessibilityView) appendLog(message string) {
-	if strings.TrimSpace(message) == "" {
+func (a *AccessibilityView) appendLog(msg accessibility.Announcement) {
+	if strings.TrimSpace(msg.Message) == "" || a.log == nil {
 		return
 	}
-	max := 8
-	a.messages = append(a.messages, message)
-	if len(a.messages) > max {
-		a.messages = a.messages[len(a.messages)-max:]
+	level := widgets.LogInfo
+	if msg.Priority == accessibility.PriorityAssertive {
+		level = widgets.LogWarn
 	}
-	if a.log != nil {
-		a.log.SetText(strings.Join(a.messages, "\n"))
-	}
-	a.Invalidate()
+	a.log.Log(level, msg.Message)
 }
 
 type accessibilityControls struct {
@@ -3,16 +3,12 @@ package main
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/odvcencio/fluffyui/text"
 )
 
 func truncPad(s string, width int) string {
-	if len(s) > width {
-		return s[:width]
-	}
-	for len(s) < width {
-		s += " "
-	}
-	return s
+	return text.Pad(s, width)
 }
 
 func shortName(name string) string {
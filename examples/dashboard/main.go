@@ -48,7 +48,7 @@ type DashboardView struct {
 	rightPanel  *widgets.Panel
 
 	sparkData   *state.Signal[[]float64]
-	latencyData *state.Signal[[]widgets.BarData]
+	latencyP50  []float64
 
 	requests int
 	errors   int
@@ -81,14 +81,12 @@ func NewDashboardView() *DashboardView {
 
 	view.sparkData = state.NewSignal([]float64{12, 18, 14, 22, 16, 24, 19})
 	view.spark = widgets.NewSparkline(view.sparkData)
-	view.latencyData = state.NewSignal([]widgets.BarData{
-		{Label: "Auth", Value: 32},
-		{Label: "Billing", Value: 45},
-		{Label: "Search", Value: 57},
-	})
-	view.latency = widgets.NewBarChart(view.latencyData)
+	view.latencyP50 = []float64{32, 45, 57}
+	view.latency = widgets.NewBarChart(nil)
 	view.latency.ShowLabels = true
 	view.latency.ShowValues = true
+	view.latency.SetCategories([]string{"Auth", "Billing", "Search"})
+	view.setLatency()
 
 	view.table = widgets.NewTable(
 		widgets.TableColumn{Title: "Service"},
@@ -210,27 +208,32 @@ func (d *DashboardView) onTick(now time.Time) {
 		values = append(values[1:], float64(d.requests%100))
 		return values
 	})
-	if d.latencyData != nil {
-		d.latencyData.Update(func(values []widgets.BarData) []widgets.BarData {
-			if len(values) == 0 {
-				return values
-			}
-			updated := make([]widgets.BarData, len(values))
-			for i, entry := range values {
-				delta := float64(rand.Intn(9) - 4)
-				value := entry.Value + delta
-				if value < 5 {
-					value = 5
-				}
-				updated[i] = widgets.BarData{Label: entry.Label, Value: value}
-			}
-			return updated
-		})
+	for i, value := range d.latencyP50 {
+		d.latencyP50[i] = max(5, value+float64(rand.Intn(9)-4))
 	}
+	d.setLatency()
 	d.updateMetrics()
 	d.Invalidate()
 }
 
+// setLatency shows p50, p90, and p99 latency per service.
+func (d *DashboardView) setLatency() {
+	if d.latency == nil {
+		return
+	}
+	p90 := make([]float64, len(d.latencyP50))
+	p99 := make([]float64, len(d.latencyP50))
+	for i, p50 := range d.latencyP50 {
+		p90[i] = p50 * 1.6
+		p99[i] = p50 * 2.4
+	}
+	d.latency.SetSeries([]widgets.BarSeries{
+		{Label: "p50", Values: append([]float64(nil), d.latencyP50...)},
+		{Label: "p90", Values: p90},
+		{Label: "p99", Values: p99},
+	})
+}
+
 func (d *DashboardView) updateMetrics() {
 	if d.requestsLabel != nil {
 		d.requestsLabel.SetText(fmt.Sprintf("Requests: %d", d.requests))
@@ -14,6 +14,7 @@ import (
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/state"
 	"github.com/odvcencio/fluffyui/terminal"
+	"github.com/odvcencio/fluffyui/text"
 	"github.com/odvcencio/fluffyui/widgets"
 )
 
@@ -303,22 +304,6 @@ func formatTime(t time.Time) string {
 	return t.Format("2006-01-02 15:04")
 }
 
-func truncateAndPad(text string, width int) string {
-	if width <= 0 {
-		return ""
-	}
-	if len(text) > width {
-		if width <= 3 {
-			return text[:width]
-		}
-		text = text[:width-3] + "..."
-	}
-	if len(text) < width {
-		pad := make([]byte, width-len(text))
-		for i := range pad {
-			pad[i] = ' '
-		}
-		text += string(pad)
-	}
-	return text
+func truncateAndPad(s string, width int) string {
+	return text.Pad(text.Truncate(s, width, "..."), width)
 }
@@ -114,8 +114,12 @@ func main() {
 	c.Println()
 	sample := fur.Markup("[bold green]Sample[/] output for export")
 	c.Println("  Text: " + fur.ExportText(sample, 40))
-	c.Println("  HTML available via fur.ExportHTML()")
-	c.Println("  SVG available via fur.ExportSVG()")
+	if html, err := fur.ExportHTML(sample, 40); err == nil {
+		c.Printf("  HTML: %d bytes\n", len(html))
+	}
+	if svg, err := fur.ExportSVG(sample, 40, fur.DefaultSVGLineHeight); err == nil {
+		c.Printf("  SVG: %d bytes\n", len(svg))
+	}
 	c.Println()
 
 	// Final
@@ -795,7 +795,7 @@ func (p *progressDemo) Render(ctx runtime.RenderContext) {
 
 	y += 2
 	// Process with spinner
-	spinChars := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	spinChars, _ := widgets.SpinnerPreset(widgets.SpinnerDots)
 	spinner := spinChars[p.frame%len(spinChars)]
 	ctx.Buffer.SetString(bounds.X+2, y, fmt.Sprintf("%s Process: ", spinner), backend.DefaultStyle().Foreground(backend.ColorYellow))
 	drawColoredGauge(ctx.Buffer, bounds.X+14, y, 40, progress3, backend.ColorYellow)
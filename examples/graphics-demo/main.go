@@ -147,7 +147,10 @@ type GraphicsDashboard struct {
 func NewGraphicsDashboard() *GraphicsDashboard {
 	demo := NewGraphicsDemo()
 	chart := widgets.NewLineChart()
+	chart.SetYAxis(widgets.AxisConfig{Min: 0, Max: 100, Grid: true})
 	gauge := widgets.NewAnimatedGauge(0, 100)
+	gauge.SetTicks(widgets.GaugeTicks{Major: 5, Minor: 1, Labels: true})
+	gauge.SetZones([]widgets.GaugeZone{{From: 80, To: 100, Color: backend.ColorRGB(230, 80, 60)}})
 	gauge.SetValue(50)
 
 	bottom := runtime.HBox(
@@ -35,7 +35,7 @@ type FormView struct {
 	emailLabel *widgets.Label
 	nameInput  *widgets.Input
 	emailInput *widgets.Input
-	newsletter *widgets.Checkbox
+	newsletter *widgets.Toggle
 	submitBtn  *widgets.Button
 	resetBtn   *widgets.Button
 	buttonRow  *demo.HBox
@@ -56,7 +56,7 @@ func NewFormView() *FormView {
 	view.emailLabel = widgets.NewLabel("Email")
 	view.nameInput = widgets.NewInput()
 	view.emailInput = widgets.NewInput()
-	view.newsletter = widgets.NewCheckbox("Subscribe to updates")
+	view.newsletter = widgets.NewToggle("Subscribe to updates")
 	view.status = widgets.NewLabel("Ready")
 	view.errors = widgets.NewText("")
 	view.errors.SetStyle(backend.DefaultStyle().Foreground(backend.ColorRed))
@@ -78,12 +78,8 @@ func NewFormView() *FormView {
 		form.Set("email", text)
 		view.validate()
 	})
-	view.newsletter.SetOnChange(func(value *bool) {
-		checked := false
-		if value != nil {
-			checked = *value
-		}
-		form.Set("newsletter", checked)
+	view.newsletter.SetOnChange(func(on bool) {
+		form.Set("newsletter", on)
 		view.validate()
 	})
 
@@ -233,8 +229,8 @@ func (f *FormView) reset() {
 		f.emailInput.SetText(toString(f.form.Get("email")))
 	}
 	if f.newsletter != nil {
-		value, _ := f.form.Get("newsletter").(bool)
-		f.newsletter.SetChecked(&value)
+		on, _ := f.form.Get("newsletter").(bool)
+		f.newsletter.SetOn(on)
 	}
 	f.status.SetText("Reset")
 	f.validate()
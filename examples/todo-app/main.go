@@ -10,6 +10,7 @@ import (
 	"github.com/odvcencio/fluffyui/examples/internal/demo"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/state"
+	"github.com/odvcencio/fluffyui/text"
 	"github.com/odvcencio/fluffyui/widgets"
 )
 
@@ -209,22 +210,6 @@ func (t *TodoView) syncStatus() {
 	t.status.SetText(fmt.Sprintf("%d items", count))
 }
 
-func truncateAndPad(text string, width int) string {
-	if width <= 0 {
-		return ""
-	}
-	if len(text) > width {
-		if width <= 3 {
-			return text[:width]
-		}
-		text = text[:width-3] + "..."
-	}
-	if len(text) < width {
-		pad := make([]byte, width-len(text))
-		for i := range pad {
-			pad[i] = ' '
-		}
-		text += string(pad)
-	}
-	return text
+func truncateAndPad(s string, width int) string {
+	return text.Pad(text.Truncate(s, width, "..."), width)
 }
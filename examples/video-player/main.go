@@ -56,7 +56,7 @@ func NewVideoPlayerView(path string) (*VideoPlayerView, error) {
 	view.title = widgets.NewLabel("Video Player", widgets.WithLabelStyle(backend.DefaultStyle().Bold(true)))
 	view.panel = widgets.NewPanel(player, widgets.WithPanelBorder(backend.DefaultStyle()))
 	view.panel.SetTitle(filepath.Base(path))
-	view.help = widgets.NewLabel("Space: play/pause  Ctrl+C: quit")
+	view.help = widgets.NewLabel("Space: play/pause  Left/Right: seek 10s  Shift+Left/Right: seek 60s  Ctrl+C: quit")
 	return view, nil
 }
 
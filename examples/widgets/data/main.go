@@ -8,6 +8,7 @@ import (
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/examples/internal/demo"
 	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
 	"github.com/odvcencio/fluffyui/widgets"
 )
 
@@ -136,22 +137,6 @@ func (d *DataView) ChildWidgets() []runtime.Widget {
 	return children
 }
 
-func truncateAndPad(text string, width int) string {
-	if width <= 0 {
-		return ""
-	}
-	if len(text) > width {
-		if width <= 3 {
-			return text[:width]
-		}
-		text = text[:width-3] + "..."
-	}
-	if len(text) < width {
-		pad := make([]byte, width-len(text))
-		for i := range pad {
-			pad[i] = ' '
-		}
-		text += string(pad)
-	}
-	return text
+func truncateAndPad(s string, width int) string {
+	return text.Pad(text.Truncate(s, width, "..."), width)
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/odvcencio/fluffyui/examples/internal/demo"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/state"
-	"github.com/odvcencio/fluffyui/terminal"
 	"github.com/odvcencio/fluffyui/toast"
 	"github.com/odvcencio/fluffyui/widgets"
 )
@@ -52,6 +51,7 @@ func NewFeedbackView() *FeedbackView {
 	view.progress = widgets.NewProgress()
 	view.progress.Value = 42
 	view.spinner = widgets.NewSpinner()
+	view.spinner.SetPreset(widgets.SpinnerDots)
 	view.sparkData = state.NewSignal([]float64{10, 12, 9, 14, 11, 15})
 	view.spark = widgets.NewSparkline(view.sparkData)
 
@@ -102,7 +102,12 @@ func (f *FeedbackView) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if key, ok := msg.(runtime.KeyMsg); ok {
 		switch key.Rune {
 		case 'd', 'D':
-			return runtime.WithCommand(runtime.PushOverlay{Widget: newDialogOverlay(), Modal: true})
+			confirm := widgets.Confirm("Confirm", "Proceed with deployment?", func(ok bool) {
+				if ok && f.toastMgr != nil {
+					f.toastMgr.Success("Deploy", "Deployment started")
+				}
+			})
+			return runtime.WithCommand(runtime.PushOverlay{Widget: confirm, Modal: true, Scrim: runtime.ScrimDim})
 		case 't', 'T':
 			if f.toastMgr != nil {
 				f.toastMgr.Info("Tip", "Keep pushing forward")
@@ -171,61 +176,3 @@ func (f *FeedbackView) onTick(now time.Time) {
 	}
 	f.Invalidate()
 }
-
-type dialogOverlay struct {
-	dialog *widgets.Dialog
-}
-
-func newDialogOverlay() *dialogOverlay {
-	d := widgets.NewDialog("Confirm", "Proceed with deployment?",
-		widgets.DialogButton{Label: "OK"},
-		widgets.DialogButton{Label: "Cancel"},
-	)
-	d.Focus()
-	return &dialogOverlay{dialog: d}
-}
-
-func (d *dialogOverlay) Measure(constraints runtime.Constraints) runtime.Size {
-	if d.dialog == nil {
-		return constraints.MinSize()
-	}
-	return d.dialog.Measure(constraints)
-}
-
-func (d *dialogOverlay) Layout(bounds runtime.Rect) {
-	if d.dialog == nil {
-		return
-	}
-	size := d.dialog.Measure(runtime.Constraints{MaxWidth: bounds.Width, MaxHeight: bounds.Height})
-	x := bounds.X + (bounds.Width-size.Width)/2
-	y := bounds.Y + (bounds.Height-size.Height)/2
-	d.dialog.Layout(runtime.Rect{X: x, Y: y, Width: size.Width, Height: size.Height})
-}
-
-func (d *dialogOverlay) Render(ctx runtime.RenderContext) {
-	if d.dialog != nil {
-		d.dialog.Render(ctx)
-	}
-}
-
-func (d *dialogOverlay) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if key, ok := msg.(runtime.KeyMsg); ok {
-		if key.Key == terminal.KeyEscape || key.Key == terminal.KeyEnter {
-			if d.dialog != nil {
-				_ = d.dialog.HandleMessage(msg)
-			}
-			return runtime.WithCommand(runtime.PopOverlay{})
-		}
-	}
-	if d.dialog != nil {
-		return d.dialog.HandleMessage(msg)
-	}
-	return runtime.Unhandled()
-}
-
-func (d *dialogOverlay) ChildWidgets() []runtime.Widget {
-	if d.dialog == nil {
-		return nil
-	}
-	return []runtime.Widget{d.dialog}
-}
@@ -9,6 +9,7 @@ import (
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/examples/internal/demo"
 	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
 	"github.com/odvcencio/fluffyui/widgets"
 )
 
@@ -39,6 +40,7 @@ type InputView struct {
 	selecter  *widgets.Select
 	radioFast *widgets.Radio
 	radioSlow *widgets.Radio
+	period    *widgets.SegmentedControl
 	status    *widgets.Label
 	buttons   *demo.HBox
 }
@@ -70,6 +72,8 @@ func NewInputView() *InputView {
 	group := widgets.NewRadioGroup()
 	view.radioFast = widgets.NewRadio("Fast", group)
 	view.radioSlow = widgets.NewRadio("Slow", group)
+	view.period = widgets.NewSegmentedControl("Day", "Week", "Month")
+	view.period.SetLabel("Period")
 	view.status = widgets.NewLabel("Ready")
 
 	primary := widgets.NewButton("Save", widgets.WithVariant(widgets.VariantPrimary), widgets.WithOnClick(func() {
@@ -128,6 +132,11 @@ func NewInputView() *InputView {
 		view.Invalidate()
 	})
 
+	view.period.SetOnChange(func(index int) {
+		view.status.SetText("Period: " + view.period.Options()[index])
+		view.Invalidate()
+	})
+
 	return view
 }
 
@@ -172,6 +181,7 @@ func (i *InputView) Layout(bounds runtime.Rect) {
 	line(i.selecter, 1)
 	line(i.radioFast, 1)
 	line(i.radioSlow, 1)
+	line(i.period, 1)
 	if i.buttons != nil {
 		i.buttons.Layout(runtime.Rect{X: bounds.X, Y: y, Width: bounds.Width, Height: 1})
 		y++
@@ -234,6 +244,9 @@ func (i *InputView) ChildWidgets() []runtime.Widget {
 	if i.radioSlow != nil {
 		children = append(children, i.radioSlow)
 	}
+	if i.period != nil {
+		children = append(children, i.period)
+	}
 	if i.buttons != nil {
 		children = append(children, i.buttons)
 	}
@@ -243,12 +256,6 @@ func (i *InputView) ChildWidgets() []runtime.Widget {
 	return children
 }
 
-func truncateText(text string, max int) string {
-	if len(text) <= max {
-		return text
-	}
-	if max <= 3 {
-		return text[:max]
-	}
-	return text[:max-3] + "..."
+func truncateText(s string, max int) string {
+	return text.Truncate(s, max, "...")
 }
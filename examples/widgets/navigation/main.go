@@ -67,6 +67,7 @@ func newMenuSection() runtime.Widget {
 	items := []*widgets.MenuItem{
 		{Title: "Dashboard", OnSelect: func() { status.SetText("Dashboard selected") }},
 		{Title: "Settings", OnSelect: func() { status.SetText("Settings selected") }},
+		{Separator: true},
 		{
 			Title: "More",
 			Children: []*widgets.MenuItem{
@@ -19,6 +19,7 @@ import (
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/style"
 	"github.com/odvcencio/fluffyui/theme"
+	"github.com/odvcencio/fluffyui/widgets"
 )
 
 // Bundle exposes default wiring plus keybinding helpers.
@@ -29,6 +30,20 @@ type Bundle struct {
 	Router   *keybind.KeyRouter
 }
 
+// RegisterShortcut binds a key sequence such as "ctrl+z" to a command ID
+// in the top keymap of the bundle.
+func (b *Bundle) RegisterShortcut(sequence, command string) error {
+	if b == nil || b.Keymaps == nil {
+		return fmt.Errorf("fluffy: bundle has no keymap stack")
+	}
+	keymap := b.Keymaps.Current()
+	if keymap == nil {
+		keymap = &keybind.Keymap{Name: "shortcuts"}
+		b.Keymaps.Push(keymap)
+	}
+	return keymap.Bind(sequence, command)
+}
+
 // AppOption customizes the default app wiring.
 type AppOption func(*appBuilder)
 
@@ -93,6 +108,7 @@ func newBuilder() (*appBuilder, error) {
 	keybind.RegisterStandardCommands(registry)
 	keybind.RegisterScrollCommands(registry)
 	keybind.RegisterClipboardCommands(registry)
+	keybind.RegisterUndoCommands(registry)
 
 	keymap := keybind.DefaultKeymap()
 	stack := &keybind.KeymapStack{}
@@ -103,7 +119,8 @@ func newBuilder() (*appBuilder, error) {
 	indicator := "> "
 	focusStyle := backend.DefaultStyle().Bold(true)
 	announcer := accessibility.Announcer(&accessibility.SimpleAnnouncer{})
-	clip := clipboard.Clipboard(&clipboard.MemoryClipboard{})
+	setter, _ := be.(clipboard.Setter)
+	clip := clipboard.Default(setter)
 	tick := time.Second / 30
 	sheet := theme.DefaultStylesheet()
 
@@ -120,6 +137,7 @@ func newBuilder() (*appBuilder, error) {
 		Clipboard:         clip,
 		Stylesheet:        sheet,
 		Recorder:          recorder,
+		TooltipRenderer:   widgets.TooltipRenderer{},
 		FocusRegistration: runtime.FocusRegistrationAuto,
 		FocusStyle: &accessibility.FocusStyle{
 			Indicator: indicator,
@@ -165,6 +183,17 @@ func WithTickRate(rate time.Duration) AppOption {
 	}
 }
 
+// WithClock times ticks, animations and effects against clock, such as a
+// runtime.ManualClock in tests.
+func WithClock(clock runtime.Clock) AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.Clock = clock
+	}
+}
+
 // WithFrameBudget sets a render frame budget for animation updates.
 func WithFrameBudget(budget time.Duration) AppOption {
 	return func(b *appBuilder) {
@@ -175,6 +204,28 @@ func WithFrameBudget(budget time.Duration) AppOption {
 	}
 }
 
+// WithTooltipDelay sets how long the pointer or focus must rest on a
+// widget before its tooltip shows. A negative delay disables tooltips.
+func WithTooltipDelay(delay time.Duration) AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.TooltipDelay = delay
+	}
+}
+
+// WithTooltipRenderer overrides how tooltips are drawn. The default,
+// widgets.TooltipRenderer, renders fur markup.
+func WithTooltipRenderer(renderer runtime.TooltipRenderer) AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.TooltipRenderer = renderer
+	}
+}
+
 // WithAnnouncer overrides the accessibility announcer.
 func WithAnnouncer(announcer accessibility.Announcer) AppOption {
 	return func(b *appBuilder) {
@@ -185,6 +236,38 @@ func WithAnnouncer(announcer accessibility.Announcer) AppOption {
 	}
 }
 
+// WithQuietAnnouncements stops focus changes and standard widgets from
+// announcing to screen readers on their own.
+func WithQuietAnnouncements() AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.QuietAnnouncements = true
+	}
+}
+
+// WithScrollConfig sets how scrolling widgets respond to the mouse wheel.
+func WithScrollConfig(cfg runtime.ScrollConfig) AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.Scroll = cfg
+	}
+}
+
+// WithDoubleClickInterval sets the longest gap between presses that still
+// counts as a double or triple click.
+func WithDoubleClickInterval(interval time.Duration) AppOption {
+	return func(b *appBuilder) {
+		if b == nil {
+			return
+		}
+		b.cfg.DoubleClickInterval = interval
+	}
+}
+
 // WithClipboard overrides the clipboard implementation.
 func WithClipboard(clip clipboard.Clipboard) AppOption {
 	return func(b *appBuilder) {
@@ -7,6 +7,16 @@ type Flex = runtime.Flex
 type FlexChild = runtime.FlexChild
 type FlexDirection = runtime.FlexDirection
 
+// Flow re-exports.
+type Flow = runtime.Flow
+type FlowAlign = runtime.FlowAlign
+
+const (
+	FlowStart  = runtime.FlowStart
+	FlowCenter = runtime.FlowCenter
+	FlowEnd    = runtime.FlowEnd
+)
+
 const (
 	Row    = runtime.Row
 	Column = runtime.Column
@@ -36,6 +46,29 @@ func HStack(children ...runtime.Widget) *runtime.Flex {
 	return runtime.HBox(flexChildren...)
 }
 
+// NewFlow creates a wrapping left-to-right container.
+func NewFlow(children ...runtime.Widget) *runtime.Flow {
+	return runtime.NewFlow(children...)
+}
+
+// Anchor re-exports.
+type AnchorLayout = runtime.AnchorLayout
+type Anchor = runtime.Anchor
+
+// NewAnchorLayout creates a layout that pins children over a base widget.
+func NewAnchorLayout() *runtime.AnchorLayout {
+	return runtime.NewAnchorLayout()
+}
+
+// Responsive re-exports.
+type Responsive = runtime.Responsive
+type Breakpoint = runtime.Breakpoint
+
+// NewResponsive creates a container that rebuilds its tree at breakpoints.
+func NewResponsive(breakpoints []runtime.Breakpoint) *runtime.Responsive {
+	return runtime.NewResponsive(breakpoints)
+}
+
 // VFlex creates a vertical flex container with explicit flex children.
 func VFlex(children ...runtime.FlexChild) *runtime.Flex {
 	return runtime.VBox(children...)
@@ -49,7 +82,11 @@ func HFlex(children ...runtime.FlexChild) *runtime.Flex {
 // Flex child helpers.
 func Fixed(w runtime.Widget) runtime.FlexChild { return runtime.Fixed(w) }
 func Flexible(w runtime.Widget, grow float64) runtime.FlexChild { return runtime.Flexible(w, grow) }
+func FlexibleShrink(w runtime.Widget, grow, shrink float64) runtime.FlexChild {
+	return runtime.FlexibleShrink(w, grow, shrink)
+}
 func Expanded(w runtime.Widget) runtime.FlexChild { return runtime.Expanded(w) }
+func Constrained(w runtime.Widget, min, max int) runtime.FlexChild { return runtime.Constrained(w, min, max) }
 func Sized(w runtime.Widget, basis int) runtime.FlexChild { return runtime.Sized(w, basis) }
 func Space() runtime.FlexChild { return runtime.Space() }
 func FixedSpace(size int) runtime.FlexChild { return runtime.FixedSpace(size) }
@@ -22,6 +22,7 @@ type (
 	Constraints     = runtime.Constraints
 	Size            = runtime.Size
 	Rect            = runtime.Rect
+	Point           = runtime.Point
 	RenderContext   = runtime.RenderContext
 	HandleResult    = runtime.HandleResult
 	Command         = runtime.Command
@@ -35,6 +36,10 @@ type (
 	PasteMsg        = runtime.PasteMsg
 	TickMsg         = runtime.TickMsg
 	CustomMsg       = runtime.CustomMsg
+	RouterMsg       = runtime.RouterMsg
+	StackRouter     = runtime.StackRouter
+	TabRouter       = runtime.TabRouter
+	TabDef          = runtime.TabDef
 
 	FocusRegistrationMode = runtime.FocusRegistrationMode
 )
@@ -48,6 +53,12 @@ var (
 	ApplyState   = runtime.ApplyState
 	SaveSnapshot = runtime.SaveSnapshot
 	LoadSnapshot = runtime.LoadSnapshot
+
+	ShowContextMenu     = runtime.ShowContextMenu
+	ShowContextMenuNear = runtime.ShowContextMenuNear
+
+	NewStackRouter = runtime.NewStackRouter
+	NewTabRouter   = runtime.NewTabRouter
 )
 
 // =============================================================================
@@ -71,6 +82,16 @@ func NewComputed[T any](compute func() T, deps ...state.Subscribable) *state.Com
 	return state.NewComputed(compute, deps...)
 }
 
+// =============================================================================
+// THEME RE-EXPORTS
+// =============================================================================
+
+type (
+	Theme           = theme.Theme
+	ThemeSpacing    = theme.Spacing
+	ThemeTypography = theme.Typography
+)
+
 // =============================================================================
 // STYLE HELPERS
 // =============================================================================
@@ -0,0 +1,353 @@
+package forms
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/odvcencio/fluffyui/state"
+)
+
+// AsyncField is implemented by fields that support async validators.
+type AsyncField interface {
+	Field
+	ValidateAsync(ctx context.Context) ([]ValidationError, error)
+	Validating() bool
+	OnValidating(fn func(inFlight bool))
+}
+
+// ValidationErrors is returned by Form.ValidateAsync when validation fails.
+type ValidationErrors []ValidationError
+
+// Error joins the validation messages.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		if err.Field != "" {
+			messages = append(messages, err.Field+": "+err.Message)
+		} else {
+			messages = append(messages, err.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+type asyncValidator struct {
+	fn func(ctx context.Context, value any) error
+}
+
+// AsyncValidator wraps a check that may block, such as asking a server
+// whether a username is taken. A non-nil error fails validation with the
+// error's message. Async validators are skipped by the synchronous Validate
+// and run in parallel once the synchronous validators pass.
+func AsyncValidator(fn func(ctx context.Context, value any) error) Validator {
+	return &asyncValidator{fn: fn}
+}
+
+// Validate is a no-op; async validators run through ValidateAsync.
+func (v *asyncValidator) Validate(any) *ValidationError {
+	return nil
+}
+
+// start runs the check in the background and delivers its result on the
+// returned channel.
+func (v *asyncValidator) start(ctx context.Context, value any) <-chan error {
+	result := make(chan error, 1)
+	if v.fn == nil {
+		result <- nil
+		return result
+	}
+	go func() {
+		result <- v.fn(ctx, value)
+	}()
+	return result
+}
+
+// asyncState tracks the in-flight async validation for a field.
+type asyncState struct {
+	mu           sync.Mutex
+	seq          uint64
+	cancel       context.CancelFunc
+	validating   *state.Signal[bool]
+	onValidating func(inFlight bool)
+}
+
+func newAsyncState() *asyncState {
+	return &asyncState{validating: state.NewSignal(false)}
+}
+
+// begin cancels any in-flight run and starts a new one.
+func (a *asyncState) begin(parent context.Context) (context.Context, uint64) {
+	ctx, cancel := context.WithCancel(parent)
+	a.mu.Lock()
+	if a.cancel != nil {
+		a.cancel()
+	}
+	a.seq++
+	seq := a.seq
+	a.cancel = cancel
+	a.mu.Unlock()
+	a.setValidating(true)
+	return ctx, seq
+}
+
+// end finishes run seq. It reports false when a newer run superseded it.
+func (a *asyncState) end(seq uint64) bool {
+	a.mu.Lock()
+	if seq != a.seq {
+		a.mu.Unlock()
+		return false
+	}
+	if a.cancel != nil {
+		a.cancel()
+		a.cancel = nil
+	}
+	a.mu.Unlock()
+	a.setValidating(false)
+	return true
+}
+
+// abort cancels the in-flight run, if any.
+func (a *asyncState) abort() {
+	a.mu.Lock()
+	if a.cancel == nil {
+		a.mu.Unlock()
+		return
+	}
+	a.cancel()
+	a.cancel = nil
+	a.seq++
+	a.mu.Unlock()
+	a.setValidating(false)
+}
+
+func (a *asyncState) setValidating(inFlight bool) {
+	if !a.validating.Set(inFlight) {
+		return
+	}
+	a.mu.Lock()
+	fn := a.onValidating
+	a.mu.Unlock()
+	if fn != nil {
+		fn(inFlight)
+	}
+}
+
+// Validating reports whether an async validation is in flight.
+func (f *FieldBase) Validating() bool {
+	if f == nil || f.async == nil {
+		return false
+	}
+	return f.async.validating.Get()
+}
+
+// ValidatingSignal returns the in-flight signal, for binding a spinner.
+func (f *FieldBase) ValidatingSignal() *state.Signal[bool] {
+	if f == nil || f.async == nil {
+		return nil
+	}
+	return f.async.validating
+}
+
+// OnValidating registers a callback fired when async validation starts
+// and stops. It may run on a validator goroutine.
+func (f *FieldBase) OnValidating(fn func(inFlight bool)) {
+	if f == nil || f.async == nil {
+		return
+	}
+	f.async.mu.Lock()
+	f.async.onValidating = fn
+	f.async.mu.Unlock()
+}
+
+// CancelAsync cancels any in-flight async validation.
+func (f *FieldBase) CancelAsync() {
+	if f == nil || f.async == nil {
+		return
+	}
+	f.async.abort()
+}
+
+// HasAsyncValidators reports whether any validator is async.
+func (f *FieldBase) HasAsyncValidators() bool {
+	return len(f.asyncValidators()) > 0
+}
+
+func (f *FieldBase) asyncValidators() []*asyncValidator {
+	if f == nil {
+		return nil
+	}
+	var out []*asyncValidator
+	for _, validator := range f.validators {
+		if async, ok := validator.(*asyncValidator); ok {
+			out = append(out, async)
+		}
+	}
+	return out
+}
+
+// ValidateValueAsync runs the async validators against value in parallel
+// and waits for them. Starting a new run cancels the previous one through
+// its context; a superseded or cancelled run returns the context error.
+func (f *FieldBase) ValidateValueAsync(ctx context.Context, value any) ([]ValidationError, error) {
+	validators := f.asyncValidators()
+	if len(validators) == 0 || f.async == nil {
+		return nil, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, seq := f.async.begin(ctx)
+	return f.finishAsync(ctx, seq, validators, value)
+}
+
+// StartValidateAsync runs the async validators against value in the
+// background and calls done with their errors. The run is registered before
+// returning, so a later run or CancelAsync supersedes it and done is not
+// called. done runs on a validator goroutine. It reports false when the
+// field has no async validators.
+func (f *FieldBase) StartValidateAsync(value any, done func(errs []ValidationError)) bool {
+	validators := f.asyncValidators()
+	if len(validators) == 0 || f.async == nil {
+		return false
+	}
+	ctx, seq := f.async.begin(context.Background())
+	go func() {
+		if errs, err := f.finishAsync(ctx, seq, validators, value); err == nil && done != nil {
+			done(errs)
+		}
+	}()
+	return true
+}
+
+// finishAsync waits for run seq and discards its result if superseded.
+func (f *FieldBase) finishAsync(ctx context.Context, seq uint64, validators []*asyncValidator, value any) ([]ValidationError, error) {
+	out, err := f.runAsyncValidators(ctx, validators, value)
+	if !f.async.end(seq) && err == nil {
+		err = context.Canceled
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (f *FieldBase) runAsyncValidators(ctx context.Context, validators []*asyncValidator, value any) ([]ValidationError, error) {
+	results := make([]<-chan error, len(validators))
+	for i, validator := range validators {
+		results[i] = validator.start(ctx, value)
+	}
+	var out []ValidationError
+	for _, result := range results {
+		var err error
+		select {
+		case err = <-result:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		var verr *ValidationError
+		if errors.As(err, &verr) {
+			item := *verr
+			if item.Field == "" {
+				item.Field = f.name
+			}
+			out = append(out, item)
+			continue
+		}
+		out = append(out, ValidationError{Field: f.name, Message: err.Error()})
+	}
+	return out, nil
+}
+
+// ValidateAsync runs the synchronous validators and, when they pass, the
+// async validators, updating the field errors.
+func (f *SimpleField) ValidateAsync(ctx context.Context) ([]ValidationError, error) {
+	if f == nil {
+		return nil, nil
+	}
+	if errs := f.Validate(); len(errs) > 0 {
+		f.CancelAsync()
+		return errs, nil
+	}
+	return f.validateAsyncValue(ctx, f.value)
+}
+
+func (f *SimpleField) validateAsyncValue(ctx context.Context, value any) ([]ValidationError, error) {
+	errs, err := f.ValidateValueAsync(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	f.setErrorsFromValidation(errs)
+	return errs, nil
+}
+
+// startAsync validates value in the background and records the errors.
+func (f *SimpleField) startAsync(value any) {
+	f.StartValidateAsync(value, f.setErrorsFromValidation)
+}
+
+// ValidateAsync validates every field, running async validators in
+// parallel, then the form validators. It returns ValidationErrors when
+// validation fails, or the context error if ctx ends first.
+func (f *Form) ValidateAsync(ctx context.Context) error {
+	if f == nil {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	type result struct {
+		errs []ValidationError
+		err  error
+	}
+	results := make([]chan result, len(f.order))
+	for i, name := range f.order {
+		field := f.fields[name]
+		ch := make(chan result, 1)
+		results[i] = ch
+		if async, ok := field.(AsyncField); ok {
+			go func() {
+				errs, err := async.ValidateAsync(ctx)
+				ch <- result{errs: errs, err: err}
+			}()
+			continue
+		}
+		var errs []ValidationError
+		if field != nil {
+			errs = field.Validate()
+		}
+		ch <- result{errs: errs}
+	}
+
+	var all ValidationErrors
+	var firstErr error
+	for _, ch := range results {
+		res := <-ch
+		if res.err != nil && firstErr == nil {
+			firstErr = res.err
+		}
+		all = append(all, res.errs...)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	values := f.Values()
+	for _, validator := range f.validators {
+		if validator == nil {
+			continue
+		}
+		all = append(all, validator.Validate(values)...)
+	}
+	f.valid.Set(len(all) == 0)
+	if len(all) > 0 {
+		return all
+	}
+	return nil
+}
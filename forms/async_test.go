@@ -0,0 +1,102 @@
+package forms
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFormValidateAsync(t *testing.T) {
+	taken := AsyncValidator(func(ctx context.Context, value any) error {
+		if value == "admin" {
+			return errors.New("username taken")
+		}
+		return nil
+	})
+	slow := AsyncValidator(func(ctx context.Context, value any) error {
+		select {
+		case <-time.After(20 * time.Millisecond):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	user := NewField("user", "admin", Required("required"), taken, slow)
+	form := NewForm(user, NewField("email", "a@b.c"))
+
+	var mu sync.Mutex
+	var transitions []bool
+	user.OnValidating(func(inFlight bool) {
+		mu.Lock()
+		transitions = append(transitions, inFlight)
+		mu.Unlock()
+	})
+
+	err := form.ValidateAsync(context.Background())
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Field != "user" || verrs[0].Message != "username taken" {
+		t.Fatalf("expected taken error, got %v", err)
+	}
+	if user.Valid() || form.ValidSignal().Get() || user.Validating() {
+		t.Fatalf("expected field and form to be invalid and idle")
+	}
+	mu.Lock()
+	if len(transitions) != 2 || !transitions[0] || transitions[1] {
+		t.Fatalf("expected in-flight true then false, got %v", transitions)
+	}
+	mu.Unlock()
+
+	form.Set("user", "")
+	if err := form.ValidateAsync(context.Background()); err == nil || user.Validating() {
+		t.Fatalf("expected sync failure without async run, got %v", err)
+	}
+
+	form.Set("user", "alice")
+	if err := form.ValidateAsync(context.Background()); err != nil {
+		t.Fatalf("expected valid form, got %v", err)
+	}
+}
+
+func TestAsyncValidationCancelledOnChange(t *testing.T) {
+	started := make(chan any, 2)
+	cancelled := make(chan any, 2)
+	check := AsyncValidator(func(ctx context.Context, value any) error {
+		started <- value
+		select {
+		case <-ctx.Done():
+			cancelled <- value
+			return ctx.Err()
+		case <-time.After(time.Second):
+			return errors.New("stale result")
+		}
+	})
+	field := NewField("user", "", check)
+
+	field.SetValue("first")
+	if got := <-started; got != "first" {
+		t.Fatalf("expected validation of first value, got %v", got)
+	}
+	if !field.Validating() {
+		t.Fatalf("expected field to be validating")
+	}
+	field.SetValue("second")
+	select {
+	case got := <-cancelled:
+		if got != "first" {
+			t.Fatalf("expected first run to be cancelled, got %v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected first validation to be cancelled")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := field.ValidateAsync(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context error, got %v", err)
+	}
+	if field.Validating() || !field.Valid() {
+		t.Fatalf("expected cancelled run to leave field idle without errors")
+	}
+}
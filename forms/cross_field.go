@@ -0,0 +1,119 @@
+package forms
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+)
+
+type crossFieldValidator struct {
+	other string
+	fn    func(thisValue, otherValue any) error
+	form  *Form
+}
+
+// CrossField validates a field against another field's current value. The
+// validator reads otherFieldID from the form the field belongs to, and the
+// form re-validates the field whenever otherFieldID changes. Attach it
+// directly to the field rather than nesting it in All or Any, so the form
+// can find it. Outside a form it always passes.
+func CrossField(otherFieldID string, fn func(thisValue, otherValue any) error) Validator {
+	return &crossFieldValidator{other: strings.TrimSpace(otherFieldID), fn: fn}
+}
+
+// ConfirmMatch ensures the value equals otherFieldID's value, as for a
+// "confirm password" field.
+func ConfirmMatch(otherFieldID string, message string) Validator {
+	message = fallbackMessage(message, "Fields do not match.")
+	return CrossField(otherFieldID, func(thisValue, otherValue any) error {
+		if !reflect.DeepEqual(thisValue, otherValue) {
+			return errors.New(message)
+		}
+		return nil
+	})
+}
+
+// Validate runs the check against the referenced field.
+func (v *crossFieldValidator) Validate(value any) *ValidationError {
+	if v == nil || v.fn == nil || v.form == nil || v.form.Field(v.other) == nil {
+		return nil
+	}
+	err := v.fn(value, v.form.Get(v.other))
+	if err == nil {
+		return nil
+	}
+	var verr *ValidationError
+	if errors.As(err, &verr) && verr != nil {
+		out := *verr
+		return &out
+	}
+	return &ValidationError{Message: err.Error()}
+}
+
+// formBinder is implemented by fields that take part in cross-field
+// validation.
+type formBinder interface {
+	bindForm(form *Form)
+	dependsOn(name string) bool
+}
+
+// bindForm attaches the field to form. Cross-field validators are replaced
+// with copies bound to form, so a validator shared between fields or forms
+// reads each field's own form.
+func (f *FieldBase) bindForm(form *Form) {
+	if f == nil {
+		return
+	}
+	f.form = form
+	validators := make([]Validator, len(f.validators))
+	for i, validator := range f.validators {
+		if cross, ok := validator.(*crossFieldValidator); ok {
+			bound := *cross
+			bound.form = form
+			validator = &bound
+		}
+		validators[i] = validator
+	}
+	f.validators = validators
+}
+
+// dependsOn reports whether a cross-field validator references name.
+func (f *FieldBase) dependsOn(name string) bool {
+	if f == nil {
+		return false
+	}
+	for _, validator := range f.validators {
+		if cross, ok := validator.(*crossFieldValidator); ok && cross.other == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyChange tells the owning form that the field value changed, so
+// fields with cross-field validators referencing it are re-validated.
+// SimpleField calls it from SetValue; custom fields should do the same.
+func (f *FieldBase) NotifyChange() {
+	if f == nil || f.form == nil {
+		return
+	}
+	f.form.fieldChanged(f.name)
+}
+
+// fieldChanged re-validates touched fields that depend on name.
+func (f *Form) fieldChanged(name string) {
+	if f == nil {
+		return
+	}
+	for _, other := range f.order {
+		if other == name {
+			continue
+		}
+		field := f.fields[other]
+		binder, ok := field.(formBinder)
+		if !ok || !binder.dependsOn(name) || !field.Touched() {
+			continue
+		}
+		field.Validate()
+	}
+}
@@ -0,0 +1,74 @@
+package forms
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConfirmMatch(t *testing.T) {
+	password := NewField("password", "")
+	confirm := NewField("confirm", "", ConfirmMatch("password", "mismatch"))
+	form := NewForm(password, confirm)
+
+	password.SetValue("secret")
+	if !confirm.Valid() {
+		t.Fatalf("expected untouched confirm field to stay valid")
+	}
+
+	confirm.SetValue("secret")
+	if !confirm.Valid() {
+		t.Fatalf("expected matching values to pass, got %v", confirm.Errors())
+	}
+
+	password.SetValue("changed")
+	if errs := confirm.Errors(); len(errs) != 1 || errs[0] != "mismatch" {
+		t.Fatalf("expected confirm to re-validate on password change, got %v", errs)
+	}
+
+	form.Set("password", "secret")
+	if !confirm.Valid() {
+		t.Fatalf("expected confirm to clear once values match, got %v", confirm.Errors())
+	}
+	if errs := form.Validate(); len(errs) != 0 {
+		t.Fatalf("expected valid form, got %v", errs)
+	}
+}
+
+func TestCrossField(t *testing.T) {
+	start := NewField("start", 1)
+	end := NewField("end", 0, CrossField("start", func(thisValue, otherValue any) error {
+		if thisValue.(int) < otherValue.(int) {
+			return errors.New("end before start")
+		}
+		return nil
+	}))
+	if errs := end.Validate(); len(errs) != 0 {
+		t.Fatalf("expected cross-field validator to pass outside a form, got %v", errs)
+	}
+
+	NewForm(start, end)
+	errs := end.Validate()
+	if len(errs) != 1 || errs[0].Field != "end" || errs[0].Message != "end before start" {
+		t.Fatalf("expected end error, got %v", errs)
+	}
+	end.SetValue(5)
+	start.SetValue(9)
+	if end.Valid() {
+		t.Fatalf("expected end to re-validate when start changes")
+	}
+}
+
+func TestCrossFieldSharedAcrossForms(t *testing.T) {
+	match := ConfirmMatch("password", "mismatch")
+	firstConfirm := NewField("confirm", "a", match)
+	first := NewForm(NewField("password", "a"), firstConfirm)
+	secondConfirm := NewField("confirm", "a", match)
+	NewForm(NewField("password", "b"), secondConfirm)
+
+	if errs := first.Validate(); len(errs) != 0 {
+		t.Fatalf("expected first form to validate against its own password, got %v", errs)
+	}
+	if errs := secondConfirm.Validate(); len(errs) != 1 {
+		t.Fatalf("expected second form to report a mismatch, got %v", errs)
+	}
+}
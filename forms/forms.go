@@ -17,6 +17,15 @@ type ValidationError struct {
 	Message string
 }
 
+// Error returns the message, so async validators can return a
+// *ValidationError to target another field.
+func (e *ValidationError) Error() string {
+	if e == nil {
+		return ""
+	}
+	return e.Message
+}
+
 // Field represents a form field.
 type Field interface {
 	Name() string
@@ -40,6 +49,8 @@ type FieldBase struct {
 	dirty      *state.Signal[bool]
 	touched    *state.Signal[bool]
 	errors     *state.Signal[[]string]
+	async      *asyncState
+	form       *Form
 }
 
 // NewFieldBase constructs a field base.
@@ -51,6 +62,7 @@ func NewFieldBase(name string, initial any, validators ...Validator) FieldBase {
 		dirty:      state.NewSignal(false),
 		touched:    state.NewSignal(false),
 		errors:     state.NewSignal([]string{}),
+		async:      newAsyncState(),
 	}
 }
 
@@ -121,6 +133,9 @@ func (f *FieldBase) SetValidators(validators ...Validator) {
 		return
 	}
 	f.validators = validators
+	if f.form != nil {
+		f.bindForm(f.form)
+	}
 }
 
 // MarkTouched marks the field as touched.
@@ -201,7 +216,12 @@ func (f *SimpleField) SetValue(value any) {
 	f.value = value
 	f.UpdateDirty(value)
 	f.MarkTouched()
+	f.CancelAsync()
 	f.updateErrors()
+	if f.Valid() {
+		f.startAsync(value)
+	}
+	f.NotifyChange()
 }
 
 // Validate runs validation on the current value.
@@ -219,6 +239,7 @@ func (f *SimpleField) Reset() {
 	if f == nil {
 		return
 	}
+	f.CancelAsync()
 	f.value = f.initial
 	f.ResetState()
 }
@@ -283,6 +304,9 @@ func (f *Form) AddField(field Field) {
 		f.order = append(f.order, name)
 	}
 	f.fields[name] = field
+	if binder, ok := field.(formBinder); ok {
+		binder.bindForm(f)
+	}
 	f.updateDirty()
 }
 
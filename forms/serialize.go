@@ -0,0 +1,124 @@
+package forms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// ToMap returns the field values keyed by field name. Values are copied as
+// stored, so the map is JSON-compatible when fields hold JSON types.
+func (f *Form) ToMap() map[string]any {
+	if f == nil {
+		return nil
+	}
+	out := make(map[string]any, len(f.order))
+	for _, name := range f.order {
+		if field := f.fields[name]; field != nil {
+			out[name] = field.Value()
+		}
+	}
+	return out
+}
+
+// FromMap sets fields from m and validates the form. Keys without a
+// matching field are ignored. Values are converted to the type of the
+// field's current value where possible, so numbers and arrays decoded from
+// JSON fit int and []string fields. The returned errors are
+// *ValidationError values: type mismatches first, then validation failures.
+func (f *Form) FromMap(m map[string]any) []error {
+	if f == nil {
+		return nil
+	}
+	var errs []error
+	for _, name := range f.order {
+		raw, ok := m[name]
+		if !ok {
+			continue
+		}
+		field := f.fields[name]
+		if field == nil {
+			continue
+		}
+		value, err := coerceValue(raw, field.Value())
+		if err != nil {
+			errs = append(errs, &ValidationError{Field: name, Message: err.Error()})
+			continue
+		}
+		field.SetValue(value)
+	}
+	f.updateDirty()
+	for _, verr := range f.Validate() {
+		errs = append(errs, &verr)
+	}
+	return errs
+}
+
+// ToJSON writes the form values as a JSON object.
+func (f *Form) ToJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(f.ToMap())
+}
+
+// FromJSON reads a JSON object and applies it with FromMap.
+func (f *Form) FromJSON(r io.Reader) []error {
+	var m map[string]any
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return []error{fmt.Errorf("decode form: %w", err)}
+	}
+	return f.FromMap(m)
+}
+
+// coerceValue converts value to the type of like.
+func coerceValue(value, like any) (any, error) {
+	if value == nil || like == nil {
+		return value, nil
+	}
+	target := reflect.TypeOf(like)
+	converted, err := convertTo(reflect.ValueOf(value), target)
+	if err != nil {
+		return nil, err
+	}
+	return converted.Interface(), nil
+}
+
+func convertTo(v reflect.Value, target reflect.Type) (reflect.Value, error) {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Type().AssignableTo(target) {
+		return v, nil
+	}
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.CanFloat() {
+			f := v.Float()
+			if f != math.Trunc(f) {
+				return reflect.Value{}, fmt.Errorf("expected an integer, got %v", f)
+			}
+			return reflect.ValueOf(f).Convert(target), nil
+		}
+		if v.CanInt() || v.CanUint() {
+			return v.Convert(target), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if v.CanFloat() || v.CanInt() || v.CanUint() {
+			return v.Convert(target), nil
+		}
+	case reflect.Slice:
+		if v.Kind() == reflect.Slice {
+			out := reflect.MakeSlice(target, v.Len(), v.Len())
+			for i := 0; i < v.Len(); i++ {
+				elem, err := convertTo(v.Index(i), target.Elem())
+				if err != nil {
+					return reflect.Value{}, err
+				}
+				out.Index(i).Set(elem)
+			}
+			return out, nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("expected %s, got %s", target, v.Type())
+}
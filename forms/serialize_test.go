@@ -0,0 +1,64 @@
+package forms
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFormMapRoundTrip(t *testing.T) {
+	form := NewForm(
+		NewField("name", "", Required("name required")),
+		NewField("age", 0),
+		NewField("tags", []string{}),
+		NewField("news", false),
+	)
+
+	errs := form.FromJSON(strings.NewReader(`{"name":"Ada","age":36,"tags":["a","b"],"news":true,"id":7}`))
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if form.Get("age") != 36 || form.Get("news") != true {
+		t.Fatalf("expected coerced values, got %#v", form.Values())
+	}
+	if tags, ok := form.Get("tags").([]string); !ok || len(tags) != 2 {
+		t.Fatalf("expected []string tags, got %#v", form.Get("tags"))
+	}
+	if !form.DirtySignal().Get() {
+		t.Fatalf("expected form to be dirty after FromMap")
+	}
+
+	var buf bytes.Buffer
+	if err := form.ToJSON(&buf); err != nil {
+		t.Fatalf("ToJSON error: %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != `{"age":36,"name":"Ada","news":true,"tags":["a","b"]}` {
+		t.Fatalf("unexpected JSON: %s", got)
+	}
+}
+
+func TestFormFromMapErrors(t *testing.T) {
+	form := NewForm(
+		NewField("name", "x", Required("name required")),
+		NewField("age", 0),
+	)
+	errs := form.FromMap(map[string]any{"name": "", "age": 1.5})
+	if len(errs) != 2 {
+		t.Fatalf("expected two errors, got %v", errs)
+	}
+	first, ok := errs[0].(*ValidationError)
+	if !ok || first.Field != "age" {
+		t.Fatalf("expected age type error first, got %v", errs[0])
+	}
+	second, ok := errs[1].(*ValidationError)
+	if !ok || second.Field != "name" || second.Message != "name required" {
+		t.Fatalf("expected name validation error, got %v", errs[1])
+	}
+	if form.Get("age") != 0 {
+		t.Fatalf("expected age to keep its value, got %v", form.Get("age"))
+	}
+
+	if errs := form.FromJSON(strings.NewReader("not json")); len(errs) != 1 {
+		t.Fatalf("expected decode error, got %v", errs)
+	}
+}
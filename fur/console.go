@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/odvcencio/fluffyui/compositor"
@@ -23,6 +24,10 @@ type Console struct {
 	markup  *MarkupParser
 	noColor bool
 	mu      sync.Mutex
+
+	autoWidth  bool
+	termWidth  atomic.Int64
+	stopResize func()
 }
 
 // Option configures a Console.
@@ -130,13 +135,66 @@ func (c *Console) Clear() {
 	_, _ = io.WriteString(c.out, compositor.ANSIClearScreen+compositor.ANSICursorHome)
 }
 
-// Width returns terminal width.
+// SetWidth fixes the wrapping width. Zero or less restores detection.
+func (c *Console) SetWidth(n int) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.width = max(n, 0)
+}
+
+// SetAutoWidth caches the terminal width and refreshes it when the
+// terminal is resized (SIGWINCH) instead of querying on every print. The
+// size comes from the console output, or stdout when the output is not a
+// terminal. A width set with SetWidth takes precedence.
+func (c *Console) SetAutoWidth(enabled bool) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if enabled == c.autoWidth {
+		return
+	}
+	c.autoWidth = enabled
+	if !enabled {
+		if c.stopResize != nil {
+			c.stopResize()
+			c.stopResize = nil
+		}
+		c.termWidth.Store(0)
+		return
+	}
+	out := c.out
+	refresh := func() {
+		width := terminalWidth(out)
+		if width <= 0 {
+			width = terminalWidth(os.Stdout)
+		}
+		c.termWidth.Store(int64(width))
+	}
+	refresh()
+	c.stopResize = watchResize(refresh)
+}
+
+// Width returns the wrapping width: the fixed width if set, else the
+// terminal width, else $COLUMNS, else 80.
 func (c *Console) Width() int {
 	if c == nil {
 		return 80
 	}
-	if c.width > 0 {
-		return c.width
+	c.mu.Lock()
+	width, auto := c.width, c.autoWidth
+	c.mu.Unlock()
+	if width > 0 {
+		return width
+	}
+	if auto {
+		if cached := int(c.termWidth.Load()); cached > 0 {
+			return cached
+		}
 	}
 	if detected := detectWidth(c.out); detected > 0 {
 		return detected
@@ -198,6 +256,20 @@ func (c *Console) themeMutedStyle() Style {
 }
 
 func detectWidth(w io.Writer) int {
+	if width := terminalWidth(w); width > 0 {
+		return width
+	}
+	if env := os.Getenv("COLUMNS"); env != "" {
+		if value, err := strconv.Atoi(env); err == nil && value > 0 {
+			return value
+		}
+	}
+	return 0
+}
+
+// terminalWidth asks the terminal behind w for its size (TIOCGWINSZ on
+// Unix). It returns 0 when w is not a terminal.
+func terminalWidth(w io.Writer) int {
 	if file, ok := w.(*os.File); ok {
 		fd := int(file.Fd())
 		if term.IsTerminal(fd) {
@@ -206,11 +278,6 @@ func detectWidth(w io.Writer) int {
 			}
 		}
 	}
-	if env := os.Getenv("COLUMNS"); env != "" {
-		if value, err := strconv.Atoi(env); err == nil && value > 0 {
-			return value
-		}
-	}
 	return 0
 }
 
@@ -220,12 +287,40 @@ func (c *Console) writeLines(lines []Line, newlineAfterLast bool) {
 	}
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	_, _ = io.WriteString(c.out, encodeLines(lines, c.noColor, newlineAfterLast))
+}
+
+// writeRenderable lays lines out for w and writes them. A width of zero
+// uses the console width of w. ANSI styling is included only when w is a
+// terminal.
+func writeRenderable(w io.Writer, width int, layout func(width int) []Line) error {
+	if width <= 0 {
+		width = detectWidth(w)
+	}
+	if width <= 0 {
+		width = Default().Width()
+	}
+	noColor := true
+	if file, ok := w.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		noColor = false
+	}
+	lines := layout(width)
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err := io.WriteString(w, encodeLines(lines, noColor, true))
+	return err
+}
+
+// encodeLines renders lines as text with ANSI styling unless noColor is
+// set.
+func encodeLines(lines []Line, noColor, newlineAfterLast bool) string {
 	var buf strings.Builder
 	current := DefaultStyle()
 	styleSet := false
 	for i, line := range lines {
 		for _, span := range line {
-			if c.noColor {
+			if noColor {
 				buf.WriteString(span.Text)
 				continue
 			}
@@ -236,7 +331,7 @@ func (c *Console) writeLines(lines []Line, newlineAfterLast bool) {
 			}
 			buf.WriteString(span.Text)
 		}
-		if !c.noColor && styleSet {
+		if !noColor && styleSet {
 			buf.WriteString(compositor.ANSIReset)
 			current = DefaultStyle()
 			styleSet = false
@@ -248,7 +343,7 @@ func (c *Console) writeLines(lines []Line, newlineAfterLast bool) {
 	if len(lines) == 0 && newlineAfterLast {
 		buf.WriteByte('\n')
 	}
-	_, _ = io.WriteString(c.out, buf.String())
+	return buf.String()
 }
 
 func callerLocation() string {
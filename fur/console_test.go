@@ -72,3 +72,60 @@ func TestConsoleRender(t *testing.T) {
 		t.Errorf("output should contain text, got %q", got)
 	}
 }
+
+func TestConsoleWrapIndentAndMarkup(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithOutput(&buf), WithNoColor())
+	c.SetWidth(16)
+
+	c.Println("  - [bold]wrapped[/] item with several words")
+
+	want := "  - wrapped item\n  with several\n  words\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if c.Width() != 16 {
+		t.Fatalf("width = %d, want 16", c.Width())
+	}
+	c.SetWidth(0)
+	t.Setenv("COLUMNS", "33")
+	if c.Width() != 33 {
+		t.Fatalf("width = %d, want COLUMNS fallback", c.Width())
+	}
+}
+
+func TestConsoleWrapMarkupSpans(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithOutput(&buf), WithWidth(10))
+
+	c.Println("plain [bold]bold words here[/] end")
+
+	got := buf.String()
+	if strings.Contains(got, "[bold") || strings.Contains(got, "[/") {
+		t.Fatalf("markup leaked into output: %q", got)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		if strings.Count(line, "\x1b[0m") < 1 && strings.Contains(line, "\x1b[") {
+			t.Fatalf("line %q leaves styling open", line)
+		}
+	}
+}
+
+func TestWrapLineBreakOpportunities(t *testing.T) {
+	lines := wrapLine(Line{{Text: "well-known 日本語テキスト"}}, 6)
+	var got []string
+	for _, line := range lines {
+		var b strings.Builder
+		for _, span := range line {
+			b.WriteString(span.Text)
+		}
+		got = append(got, b.String())
+	}
+	want := []string{"well-", "known", "日本語", "テキス", "ト"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if stringWidth("café") != 4 {
+		t.Fatalf("combining marks should not add width")
+	}
+}
@@ -3,6 +3,8 @@ package fur
 import (
 	"fmt"
 	"html"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/odvcencio/fluffyui/compositor"
@@ -17,15 +19,18 @@ const (
 	ExportSVGFormat  ExportFormat = "svg"
 )
 
+// DefaultSVGLineHeight is the line height ExportSVG uses when none is given.
+const DefaultSVGLineHeight = 20
+
 // Export renders a renderable to the requested format.
-func Export(r Renderable, width int, format ExportFormat) string {
+func Export(r Renderable, width int, format ExportFormat) (string, error) {
 	switch format {
 	case ExportHTMLFormat:
 		return ExportHTML(r, width)
 	case ExportSVGFormat:
-		return ExportSVG(r, width)
+		return ExportSVG(r, width, DefaultSVGLineHeight)
 	default:
-		return ExportText(r, width)
+		return ExportText(r, width), nil
 	}
 }
 
@@ -47,12 +52,42 @@ func ExportText(r Renderable, width int) string {
 	return out.String()
 }
 
-// ExportHTML renders HTML output with inline styles.
-func ExportHTML(r Renderable, width int) string {
+// exportLines renders r for export, splitting any span text that still
+// contains newlines so each Line is one visual row.
+func exportLines(r Renderable, maxWidth int) ([]Line, error) {
+	if maxWidth < 0 {
+		return nil, fmt.Errorf("fur: export width %d is negative", maxWidth)
+	}
 	if r == nil {
-		return ""
+		return nil, nil
+	}
+	var lines []Line
+	for _, line := range r.Render(maxWidth) {
+		current := Line{}
+		for _, span := range line {
+			parts := strings.Split(span.Text, "\n")
+			for i, part := range parts {
+				if i > 0 {
+					lines = append(lines, current)
+					current = Line{}
+				}
+				if part != "" {
+					current = append(current, Span{Text: part, Style: span.Style})
+				}
+			}
+		}
+		lines = append(lines, current)
+	}
+	return lines, nil
+}
+
+// ExportHTML renders a <pre> block in which each styled span becomes a
+// <span> with inline CSS for its colors and font attributes.
+func ExportHTML(r Renderable, maxWidth int) (string, error) {
+	lines, err := exportLines(r, maxWidth)
+	if err != nil || r == nil {
+		return "", err
 	}
-	lines := r.Render(width)
 	var out strings.Builder
 	out.WriteString("<pre style=\"font-family: ui-monospace, Menlo, Consolas, monospace; font-size: 14px; line-height: 1.4;\">\n")
 	for i, line := range lines {
@@ -74,54 +109,84 @@ func ExportHTML(r Renderable, width int) string {
 		}
 	}
 	out.WriteString("\n</pre>")
-	return out.String()
+	return out.String(), nil
 }
 
-// ExportSVG renders SVG output with inline styles.
-func ExportSVG(r Renderable, width int) string {
-	if r == nil {
-		return ""
+// ExportSVG renders an SVG image with one <text> element per line. Spans
+// are positioned by cell column so wide characters keep columns aligned,
+// and background colors are drawn as rectangles. A lineHeight of zero uses
+// DefaultSVGLineHeight; the font size and cell width scale with it.
+func ExportSVG(r Renderable, maxWidth, lineHeight int) (string, error) {
+	if lineHeight < 0 {
+		return "", fmt.Errorf("fur: SVG line height %d is negative", lineHeight)
 	}
-	lines := r.Render(width)
-	maxWidth := 0
-	for _, line := range lines {
-		if w := lineWidth(line); w > maxWidth {
-			maxWidth = w
-		}
+	if lineHeight == 0 {
+		lineHeight = DefaultSVGLineHeight
 	}
-	charWidth := 8
-	lineHeight := 16
-	svgWidth := maxWidth * charWidth
-	svgHeight := len(lines) * lineHeight
-	if svgWidth <= 0 {
-		svgWidth = charWidth
+	lines, err := exportLines(r, maxWidth)
+	if err != nil || r == nil {
+		return "", err
 	}
-	if svgHeight <= 0 {
-		svgHeight = lineHeight
+	fontSize := float64(lineHeight) * 0.7
+	charWidth := fontSize * 0.6
+	cols := 0
+	for _, line := range lines {
+		cols = max(cols, lineWidth(line))
 	}
+	svgWidth := math.Ceil(float64(max(cols, 1)) * charWidth)
+	svgHeight := max(len(lines), 1) * lineHeight
+
 	var out strings.Builder
-	out.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">", svgWidth, svgHeight))
-	out.WriteString("<style>text{font-family: ui-monospace, Menlo, Consolas, monospace; font-size: 14px;}</style>")
+	fmt.Fprintf(&out, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%s\" height=\"%d\">", svgNumber(svgWidth), svgHeight)
+	fmt.Fprintf(&out, "<style>text{font-family: ui-monospace, Menlo, Consolas, monospace; font-size: %spx; white-space: pre;}</style>", svgNumber(fontSize))
 	for i, line := range lines {
-		y := (i + 1) * lineHeight
-		out.WriteString(fmt.Sprintf("<text x=\"0\" y=\"%d\" xml:space=\"preserve\">", y))
+		top := i * lineHeight
+		col := 0
+		for _, span := range line {
+			width := stringWidth(span.Text)
+			if color, ok := svgBackground(span.Style); ok && width > 0 {
+				fmt.Fprintf(&out, "<rect x=\"%s\" y=\"%d\" width=\"%s\" height=\"%d\" fill=\"%s\"/>",
+					svgNumber(float64(col)*charWidth), top, svgNumber(float64(width)*charWidth), lineHeight, color)
+			}
+			col += width
+		}
+		if len(line) == 0 {
+			continue
+		}
+		baseline := float64(top) + float64(lineHeight)*0.75
+		fmt.Fprintf(&out, "<text x=\"0\" y=\"%s\" xml:space=\"preserve\">", svgNumber(baseline))
+		col = 0
 		for _, span := range line {
-			style := styleToSVG(span.Style)
 			text := html.EscapeString(span.Text)
-			if style == "" {
-				out.WriteString(text)
-				continue
+			out.WriteString("<tspan x=\"")
+			out.WriteString(svgNumber(float64(col) * charWidth))
+			out.WriteString("\"")
+			if style := styleToSVG(span.Style); style != "" {
+				out.WriteString(" ")
+				out.WriteString(style)
 			}
-			out.WriteString("<tspan ")
-			out.WriteString(style)
 			out.WriteString(">")
 			out.WriteString(text)
 			out.WriteString("</tspan>")
+			col += stringWidth(span.Text)
 		}
 		out.WriteString("</text>")
 	}
 	out.WriteString("</svg>")
-	return out.String()
+	return out.String(), nil
+}
+
+// svgNumber formats a coordinate with at most two decimals.
+func svgNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+func svgBackground(style Style) (string, bool) {
+	bg := style.bg
+	if style.reverse {
+		bg = style.fg
+	}
+	return colorToHex(bg)
 }
 
 func styleToCSS(style Style) string {
@@ -33,7 +33,10 @@ func TestExportTextMultiline(t *testing.T) {
 
 func TestExportHTML(t *testing.T) {
 	r := Markup("[bold]Bold[/] text")
-	output := ExportHTML(r, 40)
+	output, err := ExportHTML(r, 40)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	if !strings.Contains(output, "<pre") {
 		t.Error("expected pre tag in HTML output")
@@ -48,7 +51,10 @@ func TestExportHTML(t *testing.T) {
 
 func TestExportHTMLEscaping(t *testing.T) {
 	r := Text("<script>alert('xss')</script>")
-	output := ExportHTML(r, 80)
+	output, err := ExportHTML(r, 80)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	if strings.Contains(output, "<script>") {
 		t.Error("HTML should escape script tags")
@@ -59,7 +65,10 @@ func TestExportHTMLEscaping(t *testing.T) {
 }
 
 func TestExportHTMLNil(t *testing.T) {
-	output := ExportHTML(nil, 40)
+	output, err := ExportHTML(nil, 40)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 	if output != "" {
 		t.Errorf("expected empty string for nil, got %q", output)
 	}
@@ -67,7 +76,10 @@ func TestExportHTMLNil(t *testing.T) {
 
 func TestExportHTMLWithStyles(t *testing.T) {
 	r := Markup("[red]Red[/] and [bold]Bold[/]")
-	output := ExportHTML(r, 40)
+	output, err := ExportHTML(r, 40)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	if !strings.Contains(output, "style=") {
 		t.Error("expected inline styles in HTML output")
@@ -76,7 +88,10 @@ func TestExportHTMLWithStyles(t *testing.T) {
 
 func TestExportSVG(t *testing.T) {
 	r := Text("SVG Test")
-	output := ExportSVG(r, 40)
+	output, err := ExportSVG(r, 40, 0)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	if !strings.HasPrefix(output, "<svg") {
 		t.Error("expected svg element")
@@ -90,7 +105,10 @@ func TestExportSVG(t *testing.T) {
 }
 
 func TestExportSVGNil(t *testing.T) {
-	output := ExportSVG(nil, 40)
+	output, err := ExportSVG(nil, 40, 0)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 	if output != "" {
 		t.Errorf("expected empty string for nil, got %q", output)
 	}
@@ -98,7 +116,10 @@ func TestExportSVGNil(t *testing.T) {
 
 func TestExportSVGEscaping(t *testing.T) {
 	r := Text("Test <>&\"")
-	output := ExportSVG(r, 40)
+	output, err := ExportSVG(r, 40, 0)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	if strings.Contains(output, "Test <>&\"") {
 		t.Error("SVG should escape special characters")
@@ -108,17 +129,26 @@ func TestExportSVGEscaping(t *testing.T) {
 func TestExportGeneric(t *testing.T) {
 	r := Text("Test")
 
-	textOut := Export(r, 40, ExportTextFormat)
+	textOut, err := Export(r, 40, ExportTextFormat)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 	if textOut != "Test" {
 		t.Errorf("text export failed: %q", textOut)
 	}
 
-	htmlOut := Export(r, 40, ExportHTMLFormat)
+	htmlOut, err := Export(r, 40, ExportHTMLFormat)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 	if !strings.Contains(htmlOut, "<pre") {
 		t.Error("HTML export should contain pre tag")
 	}
 
-	svgOut := Export(r, 40, ExportSVGFormat)
+	svgOut, err := Export(r, 40, ExportSVGFormat)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 	if !strings.HasPrefix(svgOut, "<svg") {
 		t.Error("SVG export should start with svg tag")
 	}
@@ -126,10 +156,68 @@ func TestExportGeneric(t *testing.T) {
 
 func TestExportDefaultFormat(t *testing.T) {
 	r := Text("Default")
-	output := Export(r, 40, "unknown")
+	output, err := Export(r, 40, "unknown")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
 
 	// Unknown format should fall back to text
 	if output != "Default" {
 		t.Errorf("expected text fallback, got %q", output)
 	}
 }
+
+func TestExportHTMLColorsAndAttributes(t *testing.T) {
+	r := Markup("[bold italic #ff8800 on #000080]Hot[/]\nplain")
+	output, err := ExportHTML(r, 40)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	for _, want := range []string{"color: #ff8800", "background-color: #000080", "font-weight: 700", "font-style: italic", "Hot</span>\nplain"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in %q", want, output)
+		}
+	}
+}
+
+func TestExportSVGMultiline(t *testing.T) {
+	r := Markup("one\n[on blue]two[/]\nthree")
+	output, err := ExportSVG(r, 40, 10)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if got := strings.Count(output, "<text "); got != 3 {
+		t.Fatalf("expected 3 text elements, got %d in %q", got, output)
+	}
+	if !strings.Contains(output, `height="30"`) {
+		t.Errorf("expected height of 3 lines, got %q", output)
+	}
+	if !strings.Contains(output, `<rect x="0" y="10"`) {
+		t.Errorf("expected background rect on second line, got %q", output)
+	}
+	if !strings.Contains(output, `y="17.5"`) {
+		t.Errorf("expected second baseline at 17.5, got %q", output)
+	}
+}
+
+func TestExportSVGColumns(t *testing.T) {
+	r := Markup("日本[red]x[/]")
+	output, err := ExportSVG(r, 40, 20)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	// Two wide runes put the red span at column 4; cells are 8.4px wide.
+	if !strings.Contains(output, `<tspan x="33.6" fill="#cd0000">x</tspan>`) {
+		t.Errorf("expected red span at column 4, got %q", output)
+	}
+}
+
+func TestExportInvalidSizes(t *testing.T) {
+	r := Text("x")
+	if _, err := ExportHTML(r, -1); err == nil {
+		t.Error("expected error for negative width")
+	}
+	if _, err := ExportSVG(r, 40, -1); err == nil {
+		t.Error("expected error for negative line height")
+	}
+}
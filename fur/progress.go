@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 )
 
-// Progress renders a simple progress bar.
+// Progress renders a simple progress bar. It is safe to update from one
+// goroutine while a Live display renders it from another.
 type Progress struct {
+	mu          sync.Mutex
 	total       int
 	current     int
 	label       string
@@ -27,7 +30,9 @@ func (p *Progress) WithLabel(label string) *Progress {
 	if p == nil {
 		return p
 	}
+	p.mu.Lock()
 	p.label = label
+	p.mu.Unlock()
 	return p
 }
 
@@ -36,7 +41,9 @@ func (p *Progress) WithShowPercent(show bool) *Progress {
 	if p == nil {
 		return p
 	}
+	p.mu.Lock()
 	p.showPercent = show
+	p.mu.Unlock()
 	return p
 }
 
@@ -45,7 +52,49 @@ func (p *Progress) Set(value int) {
 	if p == nil {
 		return
 	}
+	p.mu.Lock()
 	p.current = value
+	p.mu.Unlock()
+}
+
+// Advance adds delta to the current progress value.
+func (p *Progress) Advance(delta int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.current += delta
+	p.mu.Unlock()
+}
+
+// Current returns the current progress value.
+func (p *Progress) Current() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.current
+}
+
+// Total returns the progress total.
+func (p *Progress) Total() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.total
+}
+
+// Done reports whether the current value has reached the total.
+func (p *Progress) Done() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.current >= p.total
 }
 
 // Render renders the progress bar.
@@ -53,14 +102,24 @@ func (p *Progress) Render(width int) []Line {
 	if p == nil {
 		return nil
 	}
+	p.mu.Lock()
+	label := p.label
+	p.mu.Unlock()
+	return p.render(width, label)
+}
+
+// render draws the bar with label in place of p.label, letting a
+// ProgressGroup pad labels to a shared width.
+func (p *Progress) render(width int, label string) []Line {
 	if width <= 0 {
 		width = 40
 	}
-	total := p.total
+	p.mu.Lock()
+	total, current, showPercent := p.total, p.current, p.showPercent
+	p.mu.Unlock()
 	if total <= 0 {
 		total = 1
 	}
-	current := p.current
 	if current < 0 {
 		current = 0
 	}
@@ -69,11 +128,11 @@ func (p *Progress) Render(width int) []Line {
 	}
 	ratio := float64(current) / float64(total)
 	prefix := ""
-	if p.label != "" {
-		prefix = p.label + " "
+	if label != "" {
+		prefix = label + " "
 	}
 	percent := ""
-	if p.showPercent {
+	if showPercent {
 		percent = fmt.Sprintf(" %3.0f%%", ratio*100)
 	}
 	barWidth := width - stringWidth(prefix) - stringWidth(percent) - 2
@@ -0,0 +1,185 @@
+package fur
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRemoveDelay is how long a removed bar stays visible.
+const DefaultRemoveDelay = time.Second
+
+// ProgressGroup stacks named progress bars in a single Live display, for
+// example one bar per parallel download.
+type ProgressGroup struct {
+	mu          sync.Mutex
+	bars        []groupBar
+	removeDelay time.Duration
+	live        *Live
+	now         func() time.Time
+}
+
+type groupBar struct {
+	label    string
+	progress *Progress
+	removeAt time.Time
+}
+
+// NewProgressGroup creates an empty group.
+func NewProgressGroup() *ProgressGroup {
+	return &ProgressGroup{
+		removeDelay: DefaultRemoveDelay,
+		now:         time.Now,
+	}
+}
+
+// WithRemoveDelay sets how long Remove leaves a bar on screen.
+func (g *ProgressGroup) WithRemoveDelay(d time.Duration) *ProgressGroup {
+	if g == nil {
+		return g
+	}
+	g.mu.Lock()
+	g.removeDelay = max(d, 0)
+	g.mu.Unlock()
+	return g
+}
+
+// Add creates a bar labeled label and appends it to the group. Adding an
+// existing label replaces its bar in place.
+func (g *ProgressGroup) Add(label string, total int) *Progress {
+	if g == nil {
+		return nil
+	}
+	bar := NewProgress(total).WithLabel(label)
+	g.mu.Lock()
+	replaced := false
+	for i := range g.bars {
+		if g.bars[i].label == label {
+			g.bars[i] = groupBar{label: label, progress: bar}
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		g.bars = append(g.bars, groupBar{label: label, progress: bar})
+	}
+	g.mu.Unlock()
+	g.refresh()
+	return bar
+}
+
+// Get returns the bar for label, or nil.
+func (g *ProgressGroup) Get(label string) *Progress {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, bar := range g.bars {
+		if bar.label == label {
+			return bar.progress
+		}
+	}
+	return nil
+}
+
+// Remove takes a bar out of the group after the remove delay, so its
+// final state stays visible briefly.
+func (g *ProgressGroup) Remove(label string) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	for i := range g.bars {
+		if g.bars[i].label == label && g.bars[i].removeAt.IsZero() {
+			g.bars[i].removeAt = g.now().Add(g.removeDelay)
+		}
+	}
+	g.prune()
+	g.mu.Unlock()
+	g.refresh()
+}
+
+// Labels returns the labels of the bars still shown, in display order.
+func (g *ProgressGroup) Labels() []string {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.prune()
+	labels := make([]string, len(g.bars))
+	for i, bar := range g.bars {
+		labels[i] = bar.label
+	}
+	return labels
+}
+
+// prune drops bars whose removal time has passed. Callers hold g.mu.
+func (g *ProgressGroup) prune() {
+	now := g.now()
+	kept := g.bars[:0]
+	for _, bar := range g.bars {
+		if !bar.removeAt.IsZero() && !now.Before(bar.removeAt) {
+			continue
+		}
+		kept = append(kept, bar)
+	}
+	clear(g.bars[len(kept):])
+	g.bars = kept
+}
+
+// Render renders one line per bar with labels padded to a common width so
+// the bars line up.
+func (g *ProgressGroup) Render(width int) []Line {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	g.prune()
+	bars := append([]groupBar(nil), g.bars...)
+	g.mu.Unlock()
+
+	labelWidth := 0
+	for _, bar := range bars {
+		labelWidth = max(labelWidth, stringWidth(bar.label))
+	}
+	lines := make([]Line, 0, len(bars))
+	for _, bar := range bars {
+		label := bar.label + strings.Repeat(" ", labelWidth-stringWidth(bar.label))
+		lines = append(lines, bar.progress.render(width, label)...)
+	}
+	return lines
+}
+
+// Live returns the shared live display for the group, creating it on
+// first use.
+func (g *ProgressGroup) Live() *Live {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.live == nil {
+		g.live = NewLive(g)
+	}
+	return g.live
+}
+
+// Start runs the group's live display until Stop or ctx is done.
+func (g *ProgressGroup) Start(ctx context.Context) error {
+	return g.Live().Start(ctx)
+}
+
+// Stop ends the group's live display.
+func (g *ProgressGroup) Stop() {
+	g.Live().Stop()
+}
+
+func (g *ProgressGroup) refresh() {
+	g.mu.Lock()
+	live := g.live
+	g.mu.Unlock()
+	live.Refresh()
+}
@@ -0,0 +1,97 @@
+package fur
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestProgressGroupRendersAlignedBars(t *testing.T) {
+	g := NewProgressGroup()
+	a := g.Add("a.zip", 10)
+	g.Add("large.iso", 10)
+	a.Set(5)
+
+	lines := g.Render(40)
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	first, second := extractText(lines[:1]), extractText(lines[1:])
+	if !strings.HasPrefix(first, "a.zip     [") || !strings.HasPrefix(second, "large.iso [") {
+		t.Errorf("labels not aligned: %q / %q", first, second)
+	}
+	if !strings.Contains(first, "50%") {
+		t.Errorf("expected 50%% in %q", first)
+	}
+	if stringWidth(first) != stringWidth(second) {
+		t.Errorf("bar widths differ: %q / %q", first, second)
+	}
+}
+
+func TestProgressGroupRemoveAfterDelay(t *testing.T) {
+	now := time.Unix(0, 0)
+	g := NewProgressGroup().WithRemoveDelay(time.Second)
+	g.now = func() time.Time { return now }
+	g.Add("one", 1)
+	g.Add("two", 1)
+
+	g.Remove("one")
+	if got := g.Labels(); len(got) != 2 {
+		t.Fatalf("removed bar should stay during the delay, got %v", got)
+	}
+	now = now.Add(time.Second)
+	if got := g.Labels(); len(got) != 1 || got[0] != "two" {
+		t.Fatalf("labels after delay = %v, want [two]", got)
+	}
+	if g.Get("one") != nil {
+		t.Error("Get should not return a removed bar")
+	}
+}
+
+func TestProgressGroupAddReplaces(t *testing.T) {
+	g := NewProgressGroup()
+	g.Add("job", 5)
+	bar := g.Add("job", 8)
+	if got := g.Labels(); len(got) != 1 {
+		t.Fatalf("labels = %v, want one bar", got)
+	}
+	if g.Get("job") != bar || bar.Total() != 8 {
+		t.Error("Add should replace the existing bar")
+	}
+}
+
+func TestProgressGroupLive(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithOutput(&buf), WithNoColor(), WithWidth(40))
+	g := NewProgressGroup()
+	g.Live().WithConsole(c).WithRate(5 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"x", "y"} {
+		bar := g.Add(name, 3)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 3 {
+				bar.Advance(1)
+			}
+		}()
+	}
+	done := make(chan error, 1)
+	go func() { done <- g.Start(context.Background()) }()
+	wg.Wait()
+	time.Sleep(20 * time.Millisecond)
+	g.Stop()
+	if err := <-done; err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	c.mu.Lock()
+	out := buf.String()
+	c.mu.Unlock()
+	if !strings.Contains(out, "x [") || !strings.Contains(out, "y [") || !strings.Contains(out, "100%") {
+		t.Errorf("unexpected live output %q", out)
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package fur
+
+// watchResize is a no-op where SIGWINCH is unavailable; the width is read
+// once when auto width is enabled.
+func watchResize(fn func()) func() {
+	return func() {}
+}
@@ -0,0 +1,44 @@
+//go:build unix
+
+package fur
+
+import (
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestWatchResize(t *testing.T) {
+	fired := make(chan struct{}, 1)
+	stop := watchResize(func() {
+		select {
+		case fired <- struct{}{}:
+		default:
+		}
+	})
+	defer stop()
+	if err := syscall.Kill(os.Getpid(), syscall.SIGWINCH); err != nil {
+		t.Fatalf("kill: %v", err)
+	}
+	select {
+	case <-fired:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("resize callback did not run")
+	}
+}
+
+func TestConsoleAutoWidth(t *testing.T) {
+	c := New(WithOutput(os.Stderr))
+	t.Setenv("COLUMNS", "42")
+	c.SetAutoWidth(true)
+	defer c.SetAutoWidth(false)
+	// Not a terminal under go test, so detection falls back to COLUMNS.
+	if c.Width() != 42 {
+		t.Fatalf("width = %d, want 42", c.Width())
+	}
+	c.SetWidth(20)
+	if c.Width() != 20 {
+		t.Fatalf("fixed width should win, got %d", c.Width())
+	}
+}
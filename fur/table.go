@@ -0,0 +1,337 @@
+package fur
+
+import (
+	"io"
+	"strings"
+)
+
+// BorderStyle selects the lines drawn around and between table cells.
+type BorderStyle uint8
+
+const (
+	// BorderBox draws box-drawing lines.
+	BorderBox BorderStyle = iota
+	// BorderRounded draws box-drawing lines with rounded corners.
+	BorderRounded
+	// BorderASCII draws +, -, and | so output survives any encoding.
+	BorderASCII
+	// BorderNone separates columns with spaces only.
+	BorderNone
+)
+
+// ASCIIBoxDrawings uses plain ASCII characters.
+var ASCIIBoxDrawings = BoxDrawings{
+	Horizontal:  "-",
+	Vertical:    "|",
+	TopLeft:     "+",
+	TopRight:    "+",
+	BottomLeft:  "+",
+	BottomRight: "+",
+	LeftT:       "+",
+	RightT:      "+",
+	TopT:        "+",
+	BottomT:     "+",
+	Cross:       "+",
+}
+
+const tableMinColWidth = 3
+
+// Table prints rows of markup cells with auto-sized columns.
+type Table struct {
+	headers     []string
+	rows        []tableRow
+	border      BorderStyle
+	align       map[int]Alignment
+	width       int
+	borderStyle Style
+}
+
+type tableRow struct {
+	cells     []string
+	separator bool
+}
+
+// NewTable creates a table with optional headers.
+func NewTable(headers ...string) *Table {
+	return &Table{
+		headers:     append([]string(nil), headers...),
+		align:       make(map[int]Alignment),
+		borderStyle: Style{}.Foreground(ColorBrightBlack),
+	}
+}
+
+// AddRow appends a row. Cells may contain markup such as "[red]down[/]".
+func (t *Table) AddRow(cells ...string) {
+	if t == nil {
+		return
+	}
+	t.rows = append(t.rows, tableRow{cells: append([]string(nil), cells...)})
+}
+
+// AddSeparator appends a horizontal rule between rows.
+func (t *Table) AddSeparator() {
+	if t == nil {
+		return
+	}
+	t.rows = append(t.rows, tableRow{separator: true})
+}
+
+// SetBorderStyle selects the border characters.
+func (t *Table) SetBorderStyle(style BorderStyle) {
+	if t == nil {
+		return
+	}
+	t.border = style
+}
+
+// SetColumnAlign aligns the cells of a zero-based column.
+func (t *Table) SetColumnAlign(col int, align Alignment) {
+	if t == nil || col < 0 {
+		return
+	}
+	t.align[col] = align
+}
+
+// SetWidth caps the table width. Zero uses the console width of the
+// writer passed to Render.
+func (t *Table) SetWidth(width int) {
+	if t == nil {
+		return
+	}
+	t.width = max(width, 0)
+}
+
+// Render writes the table to w. ANSI styling is included only when w is a
+// terminal.
+func (t *Table) Render(w io.Writer) error {
+	if t == nil || w == nil {
+		return nil
+	}
+	return writeRenderable(w, t.width, t.Lines)
+}
+
+// Renderable adapts the table for Console.Render, which supplies the
+// console width and color settings.
+func (t *Table) Renderable() Renderable {
+	return tableRenderable{table: t}
+}
+
+type tableRenderable struct {
+	table *Table
+}
+
+func (r tableRenderable) Render(width int) []Line {
+	return r.table.Lines(width)
+}
+
+// Lines lays the table out within width columns. Cells wrap when the
+// natural widths do not fit.
+func (t *Table) Lines(width int) []Line {
+	if t == nil {
+		return nil
+	}
+	cols := len(t.headers)
+	for _, row := range t.rows {
+		cols = max(cols, len(row.cells))
+	}
+	if cols == 0 {
+		return nil
+	}
+	parser := DefaultMarkupParser()
+	parse := func(cells []string, header bool) [][]Line {
+		parsed := make([][]Line, cols)
+		for i := range parsed {
+			if i >= len(cells) {
+				continue
+			}
+			lines := parser.Parse(cells[i])
+			if header {
+				for _, line := range lines {
+					for j := range line {
+						line[j].Style = line[j].Style.Bold()
+					}
+				}
+			}
+			parsed[i] = lines
+		}
+		return parsed
+	}
+	var header [][]Line
+	if len(t.headers) > 0 {
+		header = parse(t.headers, true)
+	}
+	body := make([][][]Line, len(t.rows))
+	for i, row := range t.rows {
+		if !row.separator {
+			body[i] = parse(row.cells, false)
+		}
+	}
+
+	widths := make([]int, cols)
+	measure := func(cells [][]Line) {
+		for i, lines := range cells {
+			for _, line := range lines {
+				widths[i] = max(widths[i], lineWidth(line))
+			}
+		}
+	}
+	measure(header)
+	for _, cells := range body {
+		measure(cells)
+	}
+	t.fitWidths(widths, width)
+
+	box := t.boxDrawings()
+	var out []Line
+	if box != nil {
+		out = append(out, t.ruleLine(widths, box.TopLeft, box.TopT, box.TopRight, box.Horizontal))
+	}
+	if header != nil {
+		out = append(out, t.rowLines(header, widths)...)
+		out = append(out, t.separatorLine(widths))
+	}
+	for i, row := range t.rows {
+		if row.separator {
+			out = append(out, t.separatorLine(widths))
+			continue
+		}
+		out = append(out, t.rowLines(body[i], widths)...)
+	}
+	if box != nil {
+		out = append(out, t.ruleLine(widths, box.BottomLeft, box.BottomT, box.BottomRight, box.Horizontal))
+	}
+	return out
+}
+
+// overhead returns the columns used by borders and padding.
+func (t *Table) overhead(cols int) int {
+	if t.border == BorderNone {
+		return 2 * (cols - 1)
+	}
+	return 3*cols + 1
+}
+
+// fitWidths shrinks the widest columns until the table fits in width.
+func (t *Table) fitWidths(widths []int, width int) {
+	if width <= 0 {
+		return
+	}
+	total := t.overhead(len(widths))
+	for _, w := range widths {
+		total += w
+	}
+	for total > width {
+		widest := -1
+		for i, w := range widths {
+			if w > tableMinColWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			return
+		}
+		widths[widest]--
+		total--
+	}
+}
+
+func (t *Table) boxDrawings() *BoxDrawings {
+	switch t.border {
+	case BorderNone:
+		return nil
+	case BorderASCII:
+		return &ASCIIBoxDrawings
+	case BorderRounded:
+		return &RoundedBoxDrawings
+	default:
+		return &SharpBoxDrawings
+	}
+}
+
+func (t *Table) ruleLine(widths []int, left, middle, right, horizontal string) Line {
+	var b strings.Builder
+	b.WriteString(left)
+	for i, w := range widths {
+		b.WriteString(strings.Repeat(horizontal, w+2))
+		if i < len(widths)-1 {
+			b.WriteString(middle)
+		}
+	}
+	b.WriteString(right)
+	return Line{{Text: b.String(), Style: t.borderStyle}}
+}
+
+func (t *Table) separatorLine(widths []int) Line {
+	if box := t.boxDrawings(); box != nil {
+		return t.ruleLine(widths, box.LeftT, box.Cross, box.RightT, box.Horizontal)
+	}
+	parts := make([]string, len(widths))
+	for i, w := range widths {
+		parts[i] = strings.Repeat("─", w)
+	}
+	return Line{{Text: strings.Join(parts, "  "), Style: t.borderStyle}}
+}
+
+// rowLines wraps each cell to its column and emits one Line per visual
+// row.
+func (t *Table) rowLines(cells [][]Line, widths []int) []Line {
+	wrapped := make([][]Line, len(widths))
+	height := 1
+	for i, lines := range cells {
+		wrapped[i] = wrapLines(lines, widths[i])
+		height = max(height, len(wrapped[i]))
+	}
+	box := t.boxDrawings()
+	out := make([]Line, 0, height)
+	for y := 0; y < height; y++ {
+		var line Line
+		if box != nil {
+			line = append(line, Span{Text: box.Vertical, Style: t.borderStyle})
+		}
+		for i, w := range widths {
+			var cell Line
+			if y < len(wrapped[i]) {
+				cell = truncateLine(wrapped[i][y], w)
+			}
+			if box != nil {
+				line = append(line, Span{Text: " ", Style: DefaultStyle()})
+			} else if i > 0 {
+				line = append(line, Span{Text: "  ", Style: DefaultStyle()})
+			}
+			line = append(line, alignLine(cell, w, t.align[i])...)
+			if box != nil {
+				line = append(line, Span{Text: " ", Style: DefaultStyle()})
+				line = append(line, Span{Text: box.Vertical, Style: t.borderStyle})
+			}
+		}
+		if box == nil {
+			line = trimTrailingSpace(line)
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+// alignLine pads line to width according to align.
+func alignLine(line Line, width int, align Alignment) Line {
+	pad := width - lineWidth(line)
+	if pad <= 0 {
+		return line
+	}
+	left := 0
+	switch align {
+	case AlignRight:
+		left = pad
+	case AlignCenter:
+		left = pad / 2
+	}
+	var out Line
+	if left > 0 {
+		out = append(out, Span{Text: strings.Repeat(" ", left), Style: DefaultStyle()})
+	}
+	out = append(out, line...)
+	if right := pad - left; right > 0 {
+		out = append(out, Span{Text: strings.Repeat(" ", right), Style: DefaultStyle()})
+	}
+	return out
+}
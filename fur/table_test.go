@@ -0,0 +1,100 @@
+package fur
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTableRenderBox(t *testing.T) {
+	table := NewTable("Name", "Count")
+	table.AddRow("alpha", "1")
+	table.AddRow("[red]beta[/]", "22")
+	table.SetColumnAlign(1, AlignRight)
+
+	var buf bytes.Buffer
+	if err := table.Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := strings.Join([]string{
+		"┌───────┬───────┐",
+		"│ Name  │ Count │",
+		"├───────┼───────┤",
+		"│ alpha │     1 │",
+		"│ beta  │    22 │",
+		"└───────┴───────┘",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTableRenderASCIIWithSeparator(t *testing.T) {
+	table := NewTable("A", "B")
+	table.SetBorderStyle(BorderASCII)
+	table.AddRow("1", "2")
+	table.AddSeparator()
+	table.AddRow("3", "4")
+
+	var buf bytes.Buffer
+	if err := table.Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := strings.Join([]string{
+		"+---+---+",
+		"| A | B |",
+		"+---+---+",
+		"| 1 | 2 |",
+		"+---+---+",
+		"| 3 | 4 |",
+		"+---+---+",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTableRenderNoBorder(t *testing.T) {
+	table := NewTable("Key", "Value")
+	table.SetBorderStyle(BorderNone)
+	table.AddRow("a", "x")
+	table.SetColumnAlign(1, AlignCenter)
+
+	var buf bytes.Buffer
+	if err := table.Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := "Key  Value\n───  ─────\na      x\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestTableWrapsToWidth(t *testing.T) {
+	table := NewTable("ID", "Description")
+	table.AddRow("1", "a long description that must wrap")
+	table.SetWidth(24)
+
+	lines := table.Lines(24)
+	if len(lines) < 5 {
+		t.Fatalf("expected wrapped rows, got %d lines", len(lines))
+	}
+	for _, line := range lines {
+		if w := lineWidth(line); w > 24 {
+			t.Errorf("line %v is %d wide, want <= 24", line, w)
+		}
+	}
+}
+
+func TestTableConsoleRender(t *testing.T) {
+	var buf bytes.Buffer
+	c := New(WithOutput(&buf), WithNoColor(), WithWidth(40))
+	table := NewTable("X")
+	table.AddRow("y")
+	c.Render(table.Renderable())
+	if !strings.Contains(buf.String(), "│ y │") {
+		t.Errorf("got %q", buf.String())
+	}
+}
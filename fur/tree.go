@@ -0,0 +1,136 @@
+package fur
+
+import (
+	"io"
+	"strings"
+)
+
+const defaultTreeIndent = 4
+
+// TreeNode is a node in a Tree.
+type TreeNode struct {
+	Label    Renderable
+	Children []*TreeNode
+}
+
+// Add appends a child labeled label and returns it.
+func (n *TreeNode) Add(label Renderable) *TreeNode {
+	if n == nil {
+		return nil
+	}
+	child := &TreeNode{Label: label}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// Tree prints a hierarchy with box-drawing guides, the static counterpart
+// of widgets.Tree.
+type Tree struct {
+	root       *TreeNode
+	indent     int
+	width      int
+	guideStyle Style
+}
+
+// NewTree creates a tree rooted at root.
+func NewTree(root *TreeNode) *Tree {
+	return &Tree{
+		root:       root,
+		indent:     defaultTreeIndent,
+		guideStyle: Style{}.Foreground(ColorBrightBlack),
+	}
+}
+
+// SetIndent sets the columns each level is indented by. The minimum is 2,
+// which leaves room for a guide and a space.
+func (t *Tree) SetIndent(n int) {
+	if t == nil {
+		return
+	}
+	t.indent = max(n, 2)
+}
+
+// SetWidth caps the width labels wrap at. Zero uses the console width of
+// the writer passed to Render.
+func (t *Tree) SetWidth(width int) {
+	if t == nil {
+		return
+	}
+	t.width = max(width, 0)
+}
+
+// Render writes the tree to w. ANSI styling is included only when w is a
+// terminal.
+func (t *Tree) Render(w io.Writer) error {
+	if t == nil || w == nil {
+		return nil
+	}
+	return writeRenderable(w, t.width, t.Lines)
+}
+
+// Renderable adapts the tree for Console.Render.
+func (t *Tree) Renderable() Renderable {
+	return treeRenderable{tree: t}
+}
+
+type treeRenderable struct {
+	tree *Tree
+}
+
+func (r treeRenderable) Render(width int) []Line {
+	return r.tree.Lines(width)
+}
+
+// Lines lays the tree out within width columns. Labels that span several
+// lines keep the guides of their level on each line.
+func (t *Tree) Lines(width int) []Line {
+	if t == nil || t.root == nil {
+		return nil
+	}
+	var out []Line
+	out = t.appendNode(out, t.root, "", "", width)
+	return out
+}
+
+// appendNode emits node's label with first before its first line and rest
+// before the others, then its children indented under rest.
+func (t *Tree) appendNode(out []Line, node *TreeNode, first, rest string, width int) []Line {
+	var label []Line
+	if node.Label != nil {
+		labelWidth := 0
+		if width > 0 {
+			labelWidth = max(width-stringWidth(rest), 1)
+		}
+		label = node.Label.Render(labelWidth)
+	}
+	if len(label) == 0 {
+		label = []Line{{}}
+	}
+	for i, line := range label {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if prefix == "" {
+			out = append(out, line)
+			continue
+		}
+		out = append(out, append(Line{{Text: prefix, Style: t.guideStyle}}, line...))
+	}
+
+	children := make([]*TreeNode, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child != nil {
+			children = append(children, child)
+		}
+	}
+	bar := strings.Repeat("─", t.indent-2)
+	for i, child := range children {
+		if i == len(children)-1 {
+			out = t.appendNode(out, child, rest+"└"+bar+" ", rest+strings.Repeat(" ", t.indent), width)
+		} else {
+			out = t.appendNode(out, child, rest+"├"+bar+" ", rest+"│"+strings.Repeat(" ", t.indent-1), width)
+		}
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package fur
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTreeRender(t *testing.T) {
+	root := &TreeNode{Label: Markup("[bold]project[/]")}
+	src := root.Add(Text("src"))
+	src.Add(Text("main.go"))
+	src.Add(Text("util.go"))
+	root.Add(Markup("[red]README.md[/]"))
+
+	var buf bytes.Buffer
+	if err := NewTree(root).Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := strings.Join([]string{
+		"project",
+		"├── src",
+		"│   ├── main.go",
+		"│   └── util.go",
+		"└── README.md",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTreeSetIndent(t *testing.T) {
+	root := &TreeNode{Label: Text("root"), Children: []*TreeNode{
+		{Label: Text("a"), Children: []*TreeNode{{Label: Text("b")}}},
+		{Label: Text("c")},
+	}}
+	tree := NewTree(root)
+	tree.SetIndent(2)
+
+	var buf bytes.Buffer
+	if err := tree.Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	want := "root\n├ a\n│ └ b\n└ c\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestTreeLinesAppliesMarkupAndWraps(t *testing.T) {
+	root := &TreeNode{Label: Text("root")}
+	root.Add(Markup("[red]alpha beta[/]"))
+	root.Add(Text("z"))
+
+	lines := NewTree(root).Lines(10)
+	want := "root\n├── alpha\n│   beta\n└── z\n"
+	if got := extractText(lines); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	label := lines[1][len(lines[1])-1]
+	if label.Text != "alpha" || label.Style.fg != ColorRed {
+		t.Fatalf("label span = %+v, want red alpha", label)
+	}
+}
@@ -3,7 +3,7 @@ package fur
 import (
 	"strings"
 
-	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
 )
 
 const defaultTabWidth = 4
@@ -33,6 +33,9 @@ func wrapLines(lines []Line, width int) []Line {
 	return out
 }
 
+// wrapLine breaks a styled line at Unicode line-break opportunities.
+// Continuation lines repeat the visual indent of the first line. Markup is
+// parsed into spans before wrapping, so tags are never split.
 func wrapLine(line Line, width int) []Line {
 	if width <= 0 {
 		return []Line{line}
@@ -40,13 +43,24 @@ func wrapLine(line Line, width int) []Line {
 	if len(line) == 0 {
 		return []Line{line}
 	}
+	indent := lineIndent(line)
+	if indent >= width {
+		indent = 0
+	}
 	var out []Line
 	var current Line
 	currentWidth := 0
+	lineStart := true
+	leading := indent > 0
 	flush := func() {
-		out = append(out, current)
+		out = append(out, trimTrailingSpace(current))
 		current = nil
 		currentWidth = 0
+		if indent > 0 {
+			appendSpan(&current, Span{Text: strings.Repeat(" ", indent), Style: DefaultStyle()})
+			currentWidth = indent
+		}
+		lineStart = true
 	}
 	for _, span := range line {
 		tokens := splitTokens(span.Text)
@@ -55,75 +69,128 @@ func wrapLine(line Line, width int) []Line {
 				continue
 			}
 			isSpace := isSpaceToken(token)
-			if isSpace && currentWidth == 0 {
+			tokWidth := stringWidth(token)
+			if leading && isSpace {
+				appendSpan(&current, Span{Text: token, Style: span.Style})
+				currentWidth += tokWidth
+				continue
+			}
+			leading = false
+			if isSpace && lineStart {
 				continue
 			}
-			tokWidth := stringWidth(token)
 			if tokWidth == 0 {
+				appendSpan(&current, Span{Text: token, Style: span.Style})
 				continue
 			}
 			if currentWidth+tokWidth <= width {
 				appendSpan(&current, Span{Text: token, Style: span.Style})
 				currentWidth += tokWidth
+				lineStart = false
 				continue
 			}
 			if isSpace {
 				flush()
 				continue
 			}
-			if tokWidth > width {
-				for _, r := range token {
-					if r == '\t' {
-						for i := 0; i < defaultTabWidth; i++ {
-							if currentWidth+1 > width {
-								flush()
-							}
-							appendSpan(&current, Span{Text: " ", Style: span.Style})
-							currentWidth++
-						}
-						continue
+			if tokWidth > width-indent {
+				forEachCluster(token, func(cluster string, cw int) {
+					if cluster == "\t" {
+						cluster = strings.Repeat(" ", defaultTabWidth)
 					}
-					rw := runewidth.RuneWidth(r)
-					if rw == 0 {
-						rw = 1
-					}
-					if currentWidth+rw > width {
+					if currentWidth+cw > width && !lineStart {
 						flush()
 					}
-					appendSpan(&current, Span{Text: string(r), Style: span.Style})
-					currentWidth += rw
-				}
+					appendSpan(&current, Span{Text: cluster, Style: span.Style})
+					currentWidth += cw
+					lineStart = false
+				})
 				continue
 			}
-			flush()
+			if !lineStart {
+				flush()
+			}
 			appendSpan(&current, Span{Text: token, Style: span.Style})
-			currentWidth = tokWidth
+			currentWidth += tokWidth
+			lineStart = false
 		}
 	}
-	if len(current) > 0 || len(out) == 0 {
-		out = append(out, current)
+	if !lineStart || len(out) == 0 {
+		out = append(out, trimTrailingSpace(current))
 	}
 	return out
 }
 
+// trimTrailingSpace drops whitespace left at the end of a wrapped line.
+func trimTrailingSpace(line Line) Line {
+	for len(line) > 0 {
+		last := &line[len(line)-1]
+		last.Text = strings.TrimRight(last.Text, " \t")
+		if last.Text != "" {
+			break
+		}
+		line = line[:len(line)-1]
+	}
+	return line
+}
+
+// lineIndent returns the width of the line's leading whitespace.
+func lineIndent(line Line) int {
+	indent := 0
+	for _, span := range line {
+		for _, r := range span.Text {
+			switch r {
+			case ' ':
+				indent++
+			case '\t':
+				indent += defaultTabWidth
+			default:
+				return indent
+			}
+		}
+	}
+	return 0
+}
+
+// splitTokens splits text into whitespace runs and words, further breaking
+// words at Unicode line-break opportunities such as after hyphens or
+// between ideographs.
 func splitTokens(text string) []string {
 	var tokens []string
 	var current strings.Builder
 	var inSpace bool
+	emit := func() {
+		if current.Len() == 0 {
+			return
+		}
+		if inSpace {
+			tokens = append(tokens, current.String())
+		} else {
+			tokens = appendLineSegments(tokens, current.String())
+		}
+		current.Reset()
+	}
 	for _, r := range text {
 		space := isWhitespace(r)
 		if current.Len() == 0 {
 			inSpace = space
 		}
 		if space != inSpace && current.Len() > 0 {
-			tokens = append(tokens, current.String())
-			current.Reset()
+			emit()
 			inSpace = space
 		}
 		current.WriteRune(r)
 	}
-	if current.Len() > 0 {
-		tokens = append(tokens, current.String())
+	emit()
+	return tokens
+}
+
+func appendLineSegments(tokens []string, word string) []string {
+	state := -1
+	for word != "" {
+		var segment string
+		segment, word, _, state = uniseg.FirstLineSegmentInString(word, state)
+		tokens = append(tokens, segment)
 	}
 	return tokens
 }
@@ -143,18 +210,25 @@ func isWhitespace(r rune) bool {
 
 func stringWidth(text string) int {
 	width := 0
-	for _, r := range text {
-		if r == '\t' {
-			width += defaultTabWidth
-			continue
-		}
-		rw := runewidth.RuneWidth(r)
-		if rw == 0 {
-			rw = 1
+	forEachCluster(text, func(_ string, w int) {
+		width += w
+	})
+	return width
+}
+
+// forEachCluster calls fn for each grapheme cluster with its display
+// width. Tabs count as defaultTabWidth columns.
+func forEachCluster(text string, fn func(cluster string, width int)) {
+	state := -1
+	for text != "" {
+		var cluster string
+		var width int
+		cluster, text, width, state = uniseg.FirstGraphemeClusterInString(text, state)
+		if cluster == "\t" {
+			width = defaultTabWidth
 		}
-		width += rw
+		fn(cluster, width)
 	}
-	return width
 }
 
 func lineWidth(line Line) int {
@@ -191,27 +265,25 @@ func truncateLine(line Line, width int) Line {
 	}
 	var out Line
 	currentWidth := 0
+	full := false
 	for _, span := range line {
-		for _, r := range span.Text {
-			if r == '\t' {
-				for i := 0; i < defaultTabWidth; i++ {
-					if currentWidth+1 > width {
-						return out
-					}
-					appendSpan(&out, Span{Text: " ", Style: span.Style})
-					currentWidth++
-				}
-				continue
+		forEachCluster(span.Text, func(cluster string, cw int) {
+			if full {
+				return
 			}
-			rw := runewidth.RuneWidth(r)
-			if rw == 0 {
-				rw = 1
+			if cluster == "\t" {
+				cw = min(cw, width-currentWidth)
+				cluster = strings.Repeat(" ", cw)
 			}
-			if currentWidth+rw > width {
-				return out
+			if currentWidth+cw > width {
+				full = true
+				return
 			}
-			appendSpan(&out, Span{Text: string(r), Style: span.Style})
-			currentWidth += rw
+			appendSpan(&out, Span{Text: cluster, Style: span.Style})
+			currentWidth += cw
+		})
+		if full {
+			break
 		}
 	}
 	return out
@@ -10,11 +10,13 @@ require (
 	github.com/mark3labs/mcp-go v0.43.2
 	github.com/mattn/go-runewidth v0.0.19
 	github.com/oklog/ulid/v2 v2.1.1
+	github.com/rivo/uniseg v0.4.7
 	github.com/u2takey/ffmpeg-go v0.5.0
 	github.com/yuin/goldmark v1.7.16
 	golang.org/x/image v0.35.0
 	golang.org/x/sys v0.38.0
 	golang.org/x/term v0.37.0
+	golang.org/x/text v0.33.0
 	golang.org/x/time v0.14.0
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -31,12 +33,10 @@ require (
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
 	github.com/lucasb-eyer/go-colorful v1.3.0 // indirect
 	github.com/mailru/easyjson v0.7.7 // indirect
-	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/spf13/cast v1.7.1 // indirect
 	github.com/u2takey/go-utils v0.3.1 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
 	github.com/yosida95/uritemplate/v3 v3.0.2 // indirect
-	golang.org/x/text v0.33.0 // indirect
 )
 
 replace github.com/mark3labs/mcp-go => ./third_party/mcp-go
@@ -82,6 +82,38 @@ func (c *Canvas) Clear() {
 	c.pathHasCurrent = false
 }
 
+// CopyFrom copies the pixels of src into c, ignoring both transforms.
+// Without scale, pixels keep their positions and those outside c are
+// dropped. With scale, src is stretched to fill c using nearest-neighbor
+// sampling.
+func (c *Canvas) CopyFrom(src *Canvas, scale bool) {
+	if c == nil || c.buffer == nil || src == nil || src.buffer == nil {
+		return
+	}
+	dstW, dstH := c.buffer.Size()
+	srcW, srcH := src.buffer.Size()
+	if srcW == 0 || srcH == 0 {
+		return
+	}
+	for y := 0; y < dstH; y++ {
+		sy := y
+		if scale {
+			sy = y * srcH / dstH
+		} else if y >= srcH {
+			break
+		}
+		for x := 0; x < dstW; x++ {
+			sx := x
+			if scale {
+				sx = x * srcW / dstW
+			} else if x >= srcW {
+				break
+			}
+			c.buffer.Set(x, y, src.buffer.Get(sx, sy))
+		}
+	}
+}
+
 // Save stores the current transform on the stack.
 func (c *Canvas) Save() {
 	if c == nil {
@@ -98,3 +98,30 @@ func TestCanvasPathFill(t *testing.T) {
 		t.Fatalf("expected path fill pixel to be set")
 	}
 }
+
+func TestCanvasCopyFrom(t *testing.T) {
+	src := NewCanvasWithBlitter(2, 1, &HalfBlockBlitter{})
+	src.SetPixel(1, 1, backend.ColorRed)
+
+	clipped := NewCanvasWithBlitter(1, 1, &HalfBlockBlitter{})
+	clipped.CopyFrom(src, false)
+	if clipped.GetPixel(0, 1).Set {
+		t.Fatalf("expected the clipped copy to keep pixel positions")
+	}
+	grown := NewCanvasWithBlitter(3, 2, &HalfBlockBlitter{})
+	grown.CopyFrom(src, false)
+	if !grown.GetPixel(1, 1).Set || grown.GetPixel(2, 3).Set {
+		t.Fatalf("expected the pixel copied in place")
+	}
+
+	scaled := NewCanvasWithBlitter(4, 2, &HalfBlockBlitter{})
+	scaled.CopyFrom(src, true)
+	for _, p := range []Point{{X: 2, Y: 2}, {X: 3, Y: 3}} {
+		if got := scaled.GetPixel(p.X, p.Y); !got.Set || got.Color != backend.ColorRed {
+			t.Fatalf("scaled pixel %v = %+v", p, got)
+		}
+	}
+	if scaled.GetPixel(1, 1).Set {
+		t.Fatalf("expected unset pixels to scale too")
+	}
+}
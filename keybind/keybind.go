@@ -105,6 +105,19 @@ func (k *Keymap) Match(seq []KeyPress, ctx Context) keyMatch {
 	return keyMatch{}
 }
 
+// Bind parses sequence and appends a binding to command.
+func (k *Keymap) Bind(sequence, command string) error {
+	if k == nil {
+		return nil
+	}
+	key, err := ParseKeySequence(sequence)
+	if err != nil {
+		return err
+	}
+	k.Bindings = append(k.Bindings, Binding{Key: key, Command: command})
+	return nil
+}
+
 // ParseKeySequence parses a key sequence like "ctrl+s" or "g g".
 func ParseKeySequence(input string) (Key, error) {
 	fields := strings.Fields(strings.TrimSpace(input))
@@ -0,0 +1,243 @@
+package keybind
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
+)
+
+// ModalKeymap holds one keymap per editing mode, Vim style. Only the
+// active mode's bindings match, and Escape in any other mode returns to the
+// default mode when routed through NewModalKeyRouter.
+type ModalKeymap struct {
+	modes       *ModeManager
+	names       []string
+	defaultMode string
+}
+
+// NewModalKeymap creates a keymap with an empty binding set per mode. The
+// first mode is the default and starts active.
+func NewModalKeymap(modes ...string) *ModalKeymap {
+	m := &ModalKeymap{modes: NewModeManager()}
+	for _, mode := range modes {
+		m.AddMode(mode)
+	}
+	return m
+}
+
+// AddMode registers a mode with no bindings. The first mode added becomes
+// the default.
+func (m *ModalKeymap) AddMode(mode string) *Keymap {
+	if m == nil || mode == "" {
+		return nil
+	}
+	if keymap := m.modes.modes[mode]; keymap != nil {
+		return keymap
+	}
+	keymap := &Keymap{Name: mode}
+	m.modes.Register(mode, keymap)
+	m.names = append(m.names, mode)
+	if m.defaultMode == "" {
+		m.defaultMode = mode
+	}
+	return keymap
+}
+
+// Keymap returns the bindings for a mode, or nil if it is not registered.
+func (m *ModalKeymap) Keymap(mode string) *Keymap {
+	if m == nil {
+		return nil
+	}
+	return m.modes.modes[mode]
+}
+
+// Bind appends bindings to a mode, registering the mode if needed.
+func (m *ModalKeymap) Bind(mode string, bindings ...Binding) {
+	keymap := m.AddMode(mode)
+	if keymap == nil {
+		return
+	}
+	keymap.Bindings = append(keymap.Bindings, bindings...)
+}
+
+// Modes returns the registered modes in the order they were added.
+func (m *ModalKeymap) Modes() []string {
+	if m == nil {
+		return nil
+	}
+	return append([]string(nil), m.names...)
+}
+
+// Mode returns the active mode.
+func (m *ModalKeymap) Mode() string {
+	if m == nil {
+		return ""
+	}
+	return m.modes.CurrentName()
+}
+
+// SetMode switches to a registered mode. Unknown modes are ignored.
+func (m *ModalKeymap) SetMode(mode string) {
+	if m == nil || m.modes.modes[mode] == nil {
+		return
+	}
+	m.modes.Set(mode)
+}
+
+// DefaultMode returns the mode Escape returns to.
+func (m *ModalKeymap) DefaultMode() string {
+	if m == nil {
+		return ""
+	}
+	return m.defaultMode
+}
+
+// SetDefaultMode changes the mode Escape returns to. Unknown modes are
+// ignored.
+func (m *ModalKeymap) SetDefaultMode(mode string) {
+	if m == nil || m.modes.modes[mode] == nil {
+		return
+	}
+	m.defaultMode = mode
+}
+
+// Escape returns to the default mode and reports whether the mode changed.
+func (m *ModalKeymap) Escape() bool {
+	if m == nil || m.defaultMode == "" || m.Mode() == m.defaultMode {
+		return false
+	}
+	m.SetMode(m.defaultMode)
+	return true
+}
+
+// OnModeChange sets a callback invoked after the mode changes, whether
+// through SetMode, Escape, or the ModeManager.
+func (m *ModalKeymap) OnModeChange(fn func(from, to string)) {
+	if m == nil {
+		return
+	}
+	m.modes.OnChange(fn)
+}
+
+// ModeManager returns the manager that stores the active mode, for
+// pushing and popping temporary modes.
+func (m *ModalKeymap) ModeManager() *ModeManager {
+	if m == nil {
+		return nil
+	}
+	return m.modes
+}
+
+// RegisterCommands registers a "mode.<name>" command for each mode so
+// bindings can switch modes, e.g. "i" to "mode.insert".
+func (m *ModalKeymap) RegisterCommands(registry *CommandRegistry) {
+	if m == nil || registry == nil {
+		return
+	}
+	for _, name := range m.names {
+		mode := name
+		registry.Register(Command{
+			ID:       "mode." + mode,
+			Title:    "Switch to " + mode + " mode",
+			Category: "Mode",
+			Handler: func(ctx Context) {
+				m.SetMode(mode)
+			},
+		})
+	}
+}
+
+// ModeIndicatorWidget shows the active mode of a ModalKeymap, such as
+// "-- INSERT --", for use in a status bar. The default mode shows nothing.
+type ModeIndicatorWidget struct {
+	accessibility.Base
+	keymap *ModalKeymap
+	format func(mode string) string
+	style  backend.Style
+	bounds runtime.Rect
+}
+
+// NewModeIndicatorWidget creates an indicator for keymap.
+func NewModeIndicatorWidget(keymap *ModalKeymap) *ModeIndicatorWidget {
+	w := &ModeIndicatorWidget{
+		keymap: keymap,
+		style:  backend.DefaultStyle().Bold(true),
+	}
+	w.Base.Role = accessibility.RoleStatus
+	return w
+}
+
+// SetFormat overrides how a mode is displayed. Returning "" hides the
+// indicator.
+func (w *ModeIndicatorWidget) SetFormat(fn func(mode string) string) {
+	if w == nil {
+		return
+	}
+	w.format = fn
+}
+
+// SetStyle sets the indicator style.
+func (w *ModeIndicatorWidget) SetStyle(style backend.Style) {
+	if w == nil {
+		return
+	}
+	w.style = style
+}
+
+// Text returns the text currently displayed.
+func (w *ModeIndicatorWidget) Text() string {
+	if w == nil || w.keymap == nil {
+		return ""
+	}
+	mode := w.keymap.Mode()
+	if w.format != nil {
+		return w.format(mode)
+	}
+	if mode == "" || mode == w.keymap.DefaultMode() {
+		return ""
+	}
+	return "-- " + strings.ToUpper(mode) + " --"
+}
+
+// Measure returns the width of the longest mode label.
+func (w *ModeIndicatorWidget) Measure(constraints runtime.Constraints) runtime.Size {
+	width := text.Width(w.Text())
+	if w != nil && w.keymap != nil && w.format == nil {
+		for _, mode := range w.keymap.names {
+			width = max(width, text.Width("-- "+strings.ToUpper(mode)+" --"))
+		}
+	}
+	return constraints.Constrain(runtime.Size{Width: width, Height: 1})
+}
+
+// Layout stores the assigned bounds.
+func (w *ModeIndicatorWidget) Layout(bounds runtime.Rect) {
+	w.bounds = bounds
+}
+
+// Bounds returns the assigned bounds.
+func (w *ModeIndicatorWidget) Bounds() runtime.Rect {
+	return w.bounds
+}
+
+// Render draws the indicator.
+func (w *ModeIndicatorWidget) Render(ctx runtime.RenderContext) {
+	bounds := w.bounds
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	label := w.Text()
+	w.Base.Label = label
+	ctx.Buffer.Fill(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1}, ' ', w.style)
+	ctx.Buffer.SetString(bounds.X, bounds.Y, text.Truncate(label, bounds.Width, ""), w.style)
+}
+
+// HandleMessage ignores all messages.
+func (w *ModeIndicatorWidget) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	return runtime.Unhandled()
+}
+
+var _ runtime.Widget = (*ModeIndicatorWidget)(nil)
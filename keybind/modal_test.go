@@ -0,0 +1,119 @@
+package keybind
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestModalKeymapRouting(t *testing.T) {
+	modal := NewModalKeymap("normal", "insert", "visual")
+	modal.Bind("normal",
+		Binding{Key: MustParseKeySequence("i"), Command: "mode.insert"},
+		Binding{Key: MustParseKeySequence("v"), Command: "mode.visual"},
+		Binding{Key: MustParseKeySequence("x"), Command: "delete"},
+	)
+	registry := NewRegistry()
+	modal.RegisterCommands(registry)
+	deletes := 0
+	registry.Register(Command{ID: "delete", Handler: func(ctx Context) { deletes++ }})
+	var changes []string
+	modal.OnModeChange(func(from, to string) { changes = append(changes, from+">"+to) })
+	router := NewModalKeyRouter(registry, modal, nil)
+
+	key := func(r rune) runtime.KeyMsg { return runtime.KeyMsg{Key: terminal.KeyRune, Rune: r} }
+	escape := runtime.KeyMsg{Key: terminal.KeyEscape}
+
+	if modal.Mode() != "normal" || modal.DefaultMode() != "normal" {
+		t.Fatalf("expected normal default, got %q/%q", modal.Mode(), modal.DefaultMode())
+	}
+	router.HandleKey(key('x'), Context{})
+	router.HandleKey(key('i'), Context{})
+	if modal.Mode() != "insert" {
+		t.Fatalf("expected insert mode, got %q", modal.Mode())
+	}
+	if router.HandleKey(key('x'), Context{}) {
+		t.Fatalf("expected x to be unbound in insert mode")
+	}
+	if !router.HandleKey(escape, Context{}) || modal.Mode() != "normal" {
+		t.Fatalf("expected escape to return to normal, got %q", modal.Mode())
+	}
+	if router.HandleKey(escape, Context{}) {
+		t.Fatalf("expected escape in the default mode to be unhandled")
+	}
+	router.HandleKey(key('v'), Context{})
+	router.HandleKey(escape, Context{})
+	if deletes != 1 {
+		t.Fatalf("expected one delete, got %d", deletes)
+	}
+	want := []string{"normal>insert", "insert>normal", "normal>visual", "visual>normal"}
+	if len(changes) != len(want) {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("changes = %v, want %v", changes, want)
+		}
+	}
+}
+
+func TestModalKeymapModeManagerNotifies(t *testing.T) {
+	modal := NewModalKeymap("normal", "insert")
+	var changes []string
+	modal.OnModeChange(func(from, to string) { changes = append(changes, from+">"+to) })
+
+	modes := modal.ModeManager()
+	modes.Push("insert")
+	modes.Pop()
+	modes.Set("normal")
+	want := []string{"normal>insert", "insert>normal"}
+	if len(changes) != len(want) || changes[0] != want[0] || changes[1] != want[1] {
+		t.Fatalf("changes = %v, want %v", changes, want)
+	}
+}
+
+func TestModalKeymapDefaultMode(t *testing.T) {
+	modal := NewModalKeymap("normal", "insert")
+	modal.SetDefaultMode("insert")
+	modal.SetDefaultMode("missing")
+	modal.SetMode("missing")
+	if modal.DefaultMode() != "insert" || modal.Mode() != "normal" {
+		t.Fatalf("unexpected modes %q/%q", modal.DefaultMode(), modal.Mode())
+	}
+	if !modal.Escape() || modal.Mode() != "insert" {
+		t.Fatalf("expected escape to return to insert, got %q", modal.Mode())
+	}
+}
+
+func TestModeIndicatorWidget(t *testing.T) {
+	modal := NewModalKeymap("normal", "insert")
+	indicator := NewModeIndicatorWidget(modal)
+	if indicator.Text() != "" {
+		t.Fatalf("expected default mode to be hidden, got %q", indicator.Text())
+	}
+	size := indicator.Measure(runtime.Constraints{MaxWidth: 40, MaxHeight: 1})
+	if size.Width != len("-- INSERT --") {
+		t.Fatalf("expected stable width, got %d", size.Width)
+	}
+
+	modal.SetMode("insert")
+	buf := runtime.NewBuffer(20, 1)
+	indicator.Layout(runtime.Rect{Width: 20, Height: 1})
+	indicator.Render(runtime.RenderContext{Buffer: buf, Bounds: indicator.Bounds()})
+	got := ""
+	for x := 0; x < len("-- INSERT --"); x++ {
+		got += string(buf.Get(x, 0).Rune)
+	}
+	if got != "-- INSERT --" {
+		t.Fatalf("rendered %q", got)
+	}
+	if indicator.AccessibleLabel() != "-- INSERT --" {
+		t.Fatalf("unexpected label %q", indicator.AccessibleLabel())
+	}
+
+	indicator.SetFormat(func(mode string) string { return "[" + mode + "]" })
+	if indicator.Text() != "[insert]" {
+		t.Fatalf("custom format = %q", indicator.Text())
+	}
+}
@@ -2,9 +2,10 @@ package keybind
 
 // ModeManager manages keymap modes.
 type ModeManager struct {
-	modes   map[string]*Keymap
-	current string
-	stack   []string
+	modes    map[string]*Keymap
+	current  string
+	stack    []string
+	onChange func(from, to string)
 }
 
 // NewModeManager creates an empty mode manager.
@@ -50,7 +51,7 @@ func (m *ModeManager) Push(mode string) {
 	if m.current != "" {
 		m.stack = append(m.stack, m.current)
 	}
-	m.current = mode
+	m.switchTo(mode)
 }
 
 // Pop restores the previous mode.
@@ -60,7 +61,7 @@ func (m *ModeManager) Pop() {
 	}
 	last := m.stack[len(m.stack)-1]
 	m.stack = m.stack[:len(m.stack)-1]
-	m.current = last
+	m.switchTo(last)
 }
 
 // Set switches to a mode without modifying the stack.
@@ -68,5 +69,22 @@ func (m *ModeManager) Set(mode string) {
 	if m == nil || mode == "" {
 		return
 	}
+	m.switchTo(mode)
+}
+
+// OnChange sets a callback invoked after Set, Push, or Pop changes the
+// active mode.
+func (m *ModeManager) OnChange(fn func(from, to string)) {
+	if m == nil {
+		return
+	}
+	m.onChange = fn
+}
+
+func (m *ModeManager) switchTo(mode string) {
+	from := m.current
 	m.current = mode
+	if from != mode && m.onChange != nil {
+		m.onChange(from, mode)
+	}
 }
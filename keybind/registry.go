@@ -7,12 +7,19 @@ type Command struct {
 	Description string
 	Category    string
 	Handler     func(ctx Context)
-	Enabled     func(ctx Context) bool
+	// Undoable runs the command like Handler and returns how to revert it.
+	// When set it is used instead of Handler, and a non-nil UndoFunc puts
+	// the command on the registry's undo stack.
+	Undoable func(ctx Context) UndoFunc
+	Enabled  func(ctx Context) bool
 }
 
 // CommandRegistry stores registered commands.
 type CommandRegistry struct {
-	commands map[string]Command
+	commands  map[string]Command
+	undo      []undoEntry
+	redo      []undoEntry
+	undoLimit int
 }
 
 // NewRegistry returns an empty registry.
@@ -53,12 +60,19 @@ func (r *CommandRegistry) Execute(id string, ctx Context) bool {
 		return false
 	}
 	cmd, ok := r.commands[id]
-	if !ok || cmd.Handler == nil {
+	if !ok || (cmd.Handler == nil && cmd.Undoable == nil) {
 		return false
 	}
 	if cmd.Enabled != nil && !cmd.Enabled(ctx) {
 		return false
 	}
+	if cmd.Undoable != nil {
+		if undo := cmd.Undoable(ctx); undo != nil {
+			r.pushUndo(undoEntry{cmd: cmd, ctx: ctx, undo: undo})
+			r.redo = nil
+		}
+		return true
+	}
 	cmd.Handler(ctx)
 	return true
 }
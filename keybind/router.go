@@ -37,6 +37,7 @@ type KeyRouter struct {
 	registry *CommandRegistry
 	modes    *ModeManager
 	keymaps  *KeymapStack
+	modal    *ModalKeymap
 	sequence []KeyPress
 }
 
@@ -49,6 +50,17 @@ func NewKeyRouter(registry *CommandRegistry, modes *ModeManager, keymaps *Keymap
 	}
 }
 
+// NewModalKeyRouter constructs a router whose modes come from a
+// ModalKeymap. Unbound Escape presses return to the default mode.
+func NewModalKeyRouter(registry *CommandRegistry, modal *ModalKeymap, keymaps *KeymapStack) *KeyRouter {
+	r := NewKeyRouter(registry, nil, keymaps)
+	if modal != nil {
+		r.modes = modal.modes
+		r.modal = modal
+	}
+	return r
+}
+
 // Reset clears any pending key sequence.
 func (r *KeyRouter) Reset() {
 	if r == nil {
@@ -83,6 +95,9 @@ func (r *KeyRouter) HandleKey(msg runtime.KeyMsg, ctx Context) bool {
 		return r.execute(match.Binding, ctx)
 	}
 	r.sequence = nil
+	if r.modal != nil && press.Key == terminal.KeyEscape && !press.Ctrl && !press.Alt {
+		return r.modal.Escape()
+	}
 	return false
 }
 
@@ -0,0 +1,144 @@
+package keybind
+
+// UndoFunc reverts the effect of a command.
+type UndoFunc func()
+
+// Command IDs registered by RegisterUndoCommands.
+const (
+	CommandUndo = "undo"
+	CommandRedo = "redo"
+)
+
+// DefaultUndoLimit is the number of commands a registry remembers.
+const DefaultUndoLimit = 100
+
+type undoEntry struct {
+	cmd  Command
+	ctx  Context
+	undo UndoFunc
+}
+
+func (e undoEntry) title() string {
+	if e.cmd.Title != "" {
+		return e.cmd.Title
+	}
+	return e.cmd.ID
+}
+
+// SetUndoLimit sets how many commands are kept for undo. Zero or less
+// restores DefaultUndoLimit.
+func (r *CommandRegistry) SetUndoLimit(n int) {
+	if r == nil {
+		return
+	}
+	r.undoLimit = n
+	r.trimUndo()
+}
+
+func (r *CommandRegistry) pushUndo(entry undoEntry) {
+	r.undo = append(r.undo, entry)
+	r.trimUndo()
+}
+
+func (r *CommandRegistry) trimUndo() {
+	limit := r.undoLimit
+	if limit <= 0 {
+		limit = DefaultUndoLimit
+	}
+	if extra := len(r.undo) - limit; extra > 0 {
+		r.undo = append(r.undo[:0], r.undo[extra:]...)
+	}
+}
+
+// Undo reverts the most recent undoable command and moves it to the redo
+// stack. It reports false when there is nothing to undo.
+func (r *CommandRegistry) Undo() bool {
+	if r == nil || len(r.undo) == 0 {
+		return false
+	}
+	entry := r.undo[len(r.undo)-1]
+	r.undo = r.undo[:len(r.undo)-1]
+	entry.undo()
+	r.redo = append(r.redo, entry)
+	return true
+}
+
+// Redo runs the most recently undone command again and returns it to the
+// undo stack. It reports false when there is nothing to redo.
+func (r *CommandRegistry) Redo() bool {
+	if r == nil || len(r.redo) == 0 {
+		return false
+	}
+	entry := r.redo[len(r.redo)-1]
+	r.redo = r.redo[:len(r.redo)-1]
+	if undo := entry.cmd.Undoable(entry.ctx); undo != nil {
+		entry.undo = undo
+		r.pushUndo(entry)
+	}
+	return true
+}
+
+// CanUndo reports whether Undo has a command to revert.
+func (r *CommandRegistry) CanUndo() bool {
+	return r != nil && len(r.undo) > 0
+}
+
+// CanRedo reports whether Redo has a command to replay.
+func (r *CommandRegistry) CanRedo() bool {
+	return r != nil && len(r.redo) > 0
+}
+
+// UndoHistory returns the titles of undoable commands, oldest first.
+// Commands without a title are listed by ID.
+func (r *CommandRegistry) UndoHistory() []string {
+	if r == nil || len(r.undo) == 0 {
+		return nil
+	}
+	out := make([]string, len(r.undo))
+	for i, entry := range r.undo {
+		out[i] = entry.title()
+	}
+	return out
+}
+
+// ClearHistory drops the undo and redo stacks.
+func (r *CommandRegistry) ClearHistory() {
+	if r == nil {
+		return
+	}
+	r.undo = nil
+	r.redo = nil
+}
+
+// RegisterUndoCommands registers the "undo" and "redo" commands.
+func RegisterUndoCommands(registry *CommandRegistry) {
+	if registry == nil {
+		return
+	}
+	registry.RegisterAll(
+		Command{
+			ID:          CommandUndo,
+			Title:       "Undo",
+			Description: "Revert the last command",
+			Category:    "Edit",
+			Handler: func(ctx Context) {
+				registry.Undo()
+			},
+			Enabled: func(ctx Context) bool {
+				return registry.CanUndo()
+			},
+		},
+		Command{
+			ID:          CommandRedo,
+			Title:       "Redo",
+			Description: "Repeat the last undone command",
+			Category:    "Edit",
+			Handler: func(ctx Context) {
+				registry.Redo()
+			},
+			Enabled: func(ctx Context) bool {
+				return registry.CanRedo()
+			},
+		},
+	)
+}
@@ -0,0 +1,83 @@
+package keybind
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistryUndoRedo(t *testing.T) {
+	registry := NewRegistry()
+	RegisterUndoCommands(registry)
+	value := 0
+	registry.Register(Command{
+		ID:    "inc",
+		Title: "Increment",
+		Undoable: func(ctx Context) UndoFunc {
+			value++
+			return func() { value-- }
+		},
+	})
+	registry.Register(Command{ID: "noop", Handler: func(ctx Context) {}})
+
+	registry.Execute("inc", Context{})
+	registry.Execute("noop", Context{})
+	registry.Execute("inc", Context{})
+	if value != 2 {
+		t.Fatalf("value = %d, want 2", value)
+	}
+	if got := registry.UndoHistory(); !reflect.DeepEqual(got, []string{"Increment", "Increment"}) {
+		t.Fatalf("history = %v", got)
+	}
+
+	if !registry.Execute(CommandUndo, Context{}) || value != 1 {
+		t.Fatalf("undo: value = %d, want 1", value)
+	}
+	if !registry.Execute(CommandRedo, Context{}) || value != 2 {
+		t.Fatalf("redo: value = %d, want 2", value)
+	}
+	if registry.Execute(CommandRedo, Context{}) {
+		t.Fatalf("expected redo to be disabled with an empty redo stack")
+	}
+
+	registry.Undo()
+	registry.Execute("inc", Context{})
+	if registry.CanRedo() {
+		t.Fatalf("a new command should clear the redo stack")
+	}
+	registry.Undo()
+	registry.Undo()
+	if registry.Undo() || value != 0 {
+		t.Fatalf("expected empty undo stack, value = %d", value)
+	}
+}
+
+func TestRegistryUndoLimit(t *testing.T) {
+	registry := NewRegistry()
+	n := 0
+	registry.Register(Command{ID: "step", Undoable: func(ctx Context) UndoFunc {
+		n++
+		return func() { n-- }
+	}})
+	registry.Register(Command{ID: "skip", Undoable: func(ctx Context) UndoFunc { return nil }})
+	registry.SetUndoLimit(2)
+	for i := 0; i < 3; i++ {
+		registry.Execute("step", Context{})
+	}
+	registry.Execute("skip", Context{})
+	if got := registry.UndoHistory(); !reflect.DeepEqual(got, []string{"step", "step"}) {
+		t.Fatalf("history = %v", got)
+	}
+}
+
+func TestKeymapBind(t *testing.T) {
+	keymap := &Keymap{}
+	if err := keymap.Bind("ctrl+z", CommandUndo); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	if err := keymap.Bind("", CommandRedo); err == nil {
+		t.Fatalf("expected empty sequence to fail")
+	}
+	if len(keymap.Bindings) != 1 || keymap.Bindings[0].Command != CommandUndo {
+		t.Fatalf("unexpected bindings %+v", keymap.Bindings)
+	}
+}
@@ -11,10 +11,12 @@ import (
 
 // Highlighter applies syntax highlighting to fenced code blocks.
 type Highlighter struct {
-	palette codePalette
+	palette CodeTheme
 }
 
-type codePalette struct {
+// CodeTheme is the syntax color scheme for fenced code blocks, with one
+// style per kind of token. Default styles any token without its own entry.
+type CodeTheme struct {
 	Default     compositor.Style
 	Keyword     compositor.Style
 	TypeName    compositor.Style
@@ -33,14 +35,21 @@ type codePalette struct {
 
 // NewHighlighter returns a theme-aware highlighter.
 func NewHighlighter(t *theme.Theme) *Highlighter {
+	return NewCodeHighlighter(CodeThemeFromTheme(t))
+}
+
+// NewCodeHighlighter returns a highlighter using the given color scheme.
+func NewCodeHighlighter(scheme CodeTheme) *Highlighter {
+	return &Highlighter{palette: scheme}
+}
+
+// CodeThemeFromTheme derives a code color scheme from a UI theme. A nil
+// theme uses the default theme.
+func CodeThemeFromTheme(t *theme.Theme) CodeTheme {
 	if t == nil {
 		t = theme.DefaultTheme()
 	}
-	return &Highlighter{palette: newCodePalette(t)}
-}
-
-func newCodePalette(t *theme.Theme) codePalette {
-	return codePalette{
+	return CodeTheme{
 		Default:     t.TextPrimary,
 		Keyword:     t.Accent.WithBold(true),
 		TypeName:    t.Info,
@@ -58,6 +67,40 @@ func newCodePalette(t *theme.Theme) codePalette {
 	}
 }
 
+// CodeThemeFromChroma converts a chroma style, such as one from the
+// chroma styles registry, into a code color scheme.
+func CodeThemeFromChroma(style *chroma.Style) CodeTheme {
+	if style == nil {
+		return CodeThemeFromTheme(nil)
+	}
+	get := func(ttype chroma.TokenType) compositor.Style {
+		entry := style.Get(ttype)
+		out := compositor.DefaultStyle()
+		if entry.Colour.IsSet() {
+			out = out.WithFG(compositor.RGB(entry.Colour.Red(), entry.Colour.Green(), entry.Colour.Blue()))
+		}
+		return out.WithBold(entry.Bold == chroma.Yes).
+			WithItalic(entry.Italic == chroma.Yes).
+			WithUnderline(entry.Underline == chroma.Yes)
+	}
+	return CodeTheme{
+		Default:     get(chroma.Text),
+		Keyword:     get(chroma.Keyword),
+		TypeName:    get(chroma.NameClass),
+		Function:    get(chroma.NameFunction),
+		String:      get(chroma.LiteralString),
+		Number:      get(chroma.LiteralNumber),
+		Comment:     get(chroma.Comment),
+		Operator:    get(chroma.Operator),
+		Punctuation: get(chroma.Punctuation),
+		Builtin:     get(chroma.NameBuiltin),
+		Variable:    get(chroma.NameVariable),
+		Attribute:   get(chroma.NameAttribute),
+		Tag:         get(chroma.NameTag),
+		Error:       get(chroma.Error),
+	}
+}
+
 // Highlight tokenizes and styles code into markdown lines.
 func (h *Highlighter) Highlight(code, language string, cfg *StyleConfig) []StyledLine {
 	if cfg == nil {
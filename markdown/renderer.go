@@ -40,6 +40,17 @@ func (r *Renderer) Render(source, content string) []StyledLine {
 	return r.renderASTWithConfig(root, []byte(content), cfg)
 }
 
+// WithCodeTheme returns a copy of the renderer that highlights fenced code
+// blocks with the given color scheme.
+func (r *Renderer) WithCodeTheme(scheme CodeTheme) *Renderer {
+	if r == nil {
+		r = NewRenderer(nil)
+	}
+	out := *r
+	out.highlighter = NewCodeHighlighter(scheme)
+	return &out
+}
+
 // CodeBlockBackground returns the default code block background style.
 func (r *Renderer) CodeBlockBackground() compositor.Style {
 	if r == nil || r.baseConfig == nil {
@@ -241,6 +252,11 @@ func (r *Renderer) renderBlock(node ast.Node, state *renderState, tight bool) {
 			state.addSpacer()
 		}
 
+	case *ast.TextBlock:
+		// Items of tight lists hold their text directly, without a paragraph.
+		r.renderInlineChildren(n, state, state.baseStyle)
+		state.flushLine(false, false, "")
+
 	case *ast.Heading:
 		style := headingStyle(state.cfg, n.Level)
 		r.renderInlineChildren(n, state, style)
@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/odvcencio/fluffyui/compositor"
 	"github.com/odvcencio/fluffyui/theme"
 )
 
@@ -19,6 +21,20 @@ func TestRenderer_RenderParagraph(t *testing.T) {
 	}
 }
 
+func TestRenderer_RenderTightTaskList(t *testing.T) {
+	r := NewRenderer(theme.DefaultTheme())
+	lines := r.Render("assistant", "- [x] done\n- [ ] todo\n")
+	var got []string
+	for _, line := range lines {
+		if !line.BlankLine {
+			got = append(got, spansText(line.Prefix)+spansText(line.Spans))
+		}
+	}
+	if len(got) != 2 || !strings.HasSuffix(got[0], " [x] done") || !strings.HasSuffix(got[1], " [ ] todo") {
+		t.Fatalf("unexpected task list lines: %q", got)
+	}
+}
+
 func TestRenderer_RenderCodeBlock(t *testing.T) {
 	r := NewRenderer(theme.DefaultTheme())
 	md := "```go\nfmt.Println(\"hi\")\n```\n"
@@ -49,6 +65,33 @@ func TestRenderer_RenderCodeBlock(t *testing.T) {
 	}
 }
 
+func TestRenderer_WithCodeTheme(t *testing.T) {
+	base := NewRenderer(theme.DefaultTheme())
+	scheme := CodeThemeFromChroma(styles.Get("monokai"))
+	want := scheme.Keyword
+	if want.FG.Mode != compositor.ColorModeRGB {
+		t.Fatalf("keyword color mode = %v, want RGB", want.FG.Mode)
+	}
+	md := "```go\nreturn 1\n```\n"
+	keywordStyle := func(lines []StyledLine) (compositor.Style, bool) {
+		for _, line := range lines {
+			for _, span := range line.Spans {
+				if span.Text == "return" {
+					return span.Style, true
+				}
+			}
+		}
+		return compositor.Style{}, false
+	}
+	got, ok := keywordStyle(base.WithCodeTheme(scheme).Render("assistant", md))
+	if !ok || got.FG != want.FG {
+		t.Fatalf("keyword style = %+v, want FG %+v", got, want.FG)
+	}
+	if got, _ := keywordStyle(base.Render("assistant", md)); got.FG == want.FG {
+		t.Fatal("expected WithCodeTheme to leave the original renderer unchanged")
+	}
+}
+
 func spansText(spans []StyledSpan) string {
 	var b strings.Builder
 	for _, span := range spans {
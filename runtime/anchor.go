@@ -0,0 +1,186 @@
+package runtime
+
+import "strconv"
+
+// Anchor is the point of an AnchorLayout a child is pinned to.
+type Anchor int
+
+const (
+	TopLeft Anchor = iota
+	TopCenter
+	TopRight
+	CenterLeft
+	Center
+	CenterRight
+	BottomLeft
+	BottomCenter
+	BottomRight
+)
+
+// AnchorChild is a widget pinned to an anchor of an AnchorLayout.
+type AnchorChild struct {
+	Widget  Widget
+	Anchor  Anchor
+	OffsetX int // Cells to shift right (negative shifts left)
+	OffsetY int // Cells to shift down (negative shifts up)
+}
+
+// AnchorLayout draws a base widget that fills its bounds and pins other
+// widgets over it at anchors such as corners or the center, for HUDs and
+// badges. Later children draw above earlier ones and receive mouse hits
+// first.
+type AnchorLayout struct {
+	Base     Widget
+	Children []AnchorChild
+
+	// Cached layout
+	bounds      Rect
+	childBounds []Rect
+}
+
+// NewAnchorLayout creates an empty anchor layout.
+func NewAnchorLayout() *AnchorLayout {
+	return &AnchorLayout{}
+}
+
+// SetBase sets the widget that fills the layout under the anchored children.
+func (a *AnchorLayout) SetBase(base Widget) *AnchorLayout {
+	a.Base = base
+	return a
+}
+
+// Add pins child to anchor, shifted by the offset.
+func (a *AnchorLayout) Add(child Widget, anchor Anchor, offsetX, offsetY int) *AnchorLayout {
+	if child == nil {
+		return a
+	}
+	a.Children = append(a.Children, AnchorChild{Widget: child, Anchor: anchor, OffsetX: offsetX, OffsetY: offsetY})
+	return a
+}
+
+// Measure returns the base size, grown to fit every anchored child.
+func (a *AnchorLayout) Measure(constraints Constraints) Size {
+	size := constraints.MinSize()
+	if a.Base != nil {
+		size = a.Base.Measure(constraints)
+	}
+	loose := Loose(constraints.MaxWidth, constraints.MaxHeight)
+	for _, child := range a.Children {
+		childSize := child.Widget.Measure(loose)
+		size.Width = max(size.Width, childSize.Width)
+		size.Height = max(size.Height, childSize.Height)
+	}
+	return constraints.Constrain(size)
+}
+
+// Layout fills the bounds with the base and places each child at its
+// anchor. Children are measured with loose constraints and keep their size.
+func (a *AnchorLayout) Layout(bounds Rect) {
+	a.bounds = bounds
+	if a.Base != nil {
+		a.Base.Layout(bounds)
+	}
+	a.childBounds = make([]Rect, len(a.Children))
+	loose := Loose(bounds.Width, bounds.Height)
+	for i, child := range a.Children {
+		size := loose.Constrain(child.Widget.Measure(loose))
+		childBounds := Rect{
+			X:      bounds.X + anchorOffset(child.Anchor%3, bounds.Width-size.Width) + child.OffsetX,
+			Y:      bounds.Y + anchorOffset(child.Anchor/3, bounds.Height-size.Height) + child.OffsetY,
+			Width:  size.Width,
+			Height: size.Height,
+		}
+		a.childBounds[i] = childBounds
+		child.Widget.Layout(childBounds)
+	}
+}
+
+// anchorOffset places content in free cells by column or row of the anchor
+// grid: 0 is start, 1 is center, 2 is end.
+func anchorOffset(position Anchor, free int) int {
+	switch position {
+	case 1:
+		return free / 2
+	case 2:
+		return free
+	default:
+		return 0
+	}
+}
+
+// Bounds returns the assigned bounds for the anchor layout.
+func (a *AnchorLayout) Bounds() Rect {
+	return a.bounds
+}
+
+// ChildWidgets returns the base followed by the anchored children, in
+// drawing order. Hit testing lets later widgets cover earlier ones, so a
+// click reaches the topmost child at that cell.
+func (a *AnchorLayout) ChildWidgets() []Widget {
+	children := make([]Widget, 0, len(a.Children)+1)
+	if a.Base != nil {
+		children = append(children, a.Base)
+	}
+	for _, child := range a.Children {
+		children = append(children, child.Widget)
+	}
+	return children
+}
+
+// PathSegment returns a debug path segment for the given child.
+func (a *AnchorLayout) PathSegment(child Widget) string {
+	if a == nil {
+		return "AnchorLayout"
+	}
+	if child != nil && child == a.Base {
+		return "AnchorLayout[base]"
+	}
+	for i, entry := range a.Children {
+		if entry.Widget == child {
+			return "AnchorLayout[" + strconv.Itoa(i) + "]"
+		}
+	}
+	return "AnchorLayout"
+}
+
+// Render draws the base, then the anchored children in order.
+func (a *AnchorLayout) Render(ctx RenderContext) {
+	if a.Base != nil {
+		RenderChild(ctx, a.Base)
+	}
+	for i, child := range a.Children {
+		if i >= len(a.childBounds) {
+			continue
+		}
+		bounds := a.childBounds[i]
+		if bounds.Width <= 0 || bounds.Height <= 0 {
+			continue
+		}
+		if childCtx, ok := ctx.SubVisible(bounds); ok {
+			child.Widget.Render(childCtx)
+		}
+	}
+}
+
+// HandleMessage offers messages to the topmost child first and the base
+// last. Mouse messages only go to widgets under the pointer.
+func (a *AnchorLayout) HandleMessage(msg Message) HandleResult {
+	mouse, isMouse := msg.(MouseMsg)
+	for i := len(a.Children) - 1; i >= 0; i-- {
+		if isMouse && (i >= len(a.childBounds) || !a.childBounds[i].Contains(mouse.X, mouse.Y)) {
+			continue
+		}
+		if result := a.Children[i].Widget.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	if a.Base != nil {
+		return a.Base.HandleMessage(msg)
+	}
+	return Unhandled()
+}
+
+var (
+	_ Widget        = (*AnchorLayout)(nil)
+	_ ChildProvider = (*AnchorLayout)(nil)
+)
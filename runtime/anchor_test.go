@@ -0,0 +1,89 @@
+package runtime
+
+import "testing"
+
+// anchorProbe is a sized widget that records the messages it receives.
+type anchorProbe struct {
+	testWidget
+	hits int
+}
+
+func (p *anchorProbe) Bounds() Rect {
+	return p.bounds
+}
+
+func (p *anchorProbe) HandleMessage(msg Message) HandleResult {
+	if _, ok := msg.(MouseMsg); ok {
+		p.hits++
+		return Handled()
+	}
+	return Unhandled()
+}
+
+func newAnchorProbe(w, h int) *anchorProbe {
+	return &anchorProbe{testWidget: testWidget{preferredSize: Size{Width: w, Height: h}}}
+}
+
+func TestAnchorLayout_PlacesChildren(t *testing.T) {
+	base := newTestWidget(0, 0)
+	corner := newTestWidget(4, 2)
+	center := newTestWidget(6, 3)
+	bottom := newTestWidget(30, 1)
+	layout := NewAnchorLayout().SetBase(base).
+		Add(corner, BottomRight, -1, -1).
+		Add(center, Center, 0, 0).
+		Add(bottom, BottomCenter, 0, 0)
+
+	layout.Layout(Rect{X: 2, Y: 1, Width: 20, Height: 10})
+	if base.bounds != (Rect{X: 2, Y: 1, Width: 20, Height: 10}) {
+		t.Errorf("base bounds = %+v, want the full area", base.bounds)
+	}
+	if corner.bounds != (Rect{X: 17, Y: 8, Width: 4, Height: 2}) {
+		t.Errorf("corner bounds = %+v", corner.bounds)
+	}
+	if center.bounds != (Rect{X: 9, Y: 4, Width: 6, Height: 3}) {
+		t.Errorf("center bounds = %+v", center.bounds)
+	}
+	// Children are measured loosely against the layout, so oversized ones
+	// are clipped to its width.
+	if bottom.bounds != (Rect{X: 2, Y: 10, Width: 20, Height: 1}) {
+		t.Errorf("bottom bounds = %+v", bottom.bounds)
+	}
+}
+
+func TestAnchorLayout_MeasureFitsChildren(t *testing.T) {
+	layout := NewAnchorLayout().SetBase(newTestWidget(10, 2)).Add(newTestWidget(4, 5), TopLeft, 0, 0)
+	if size := layout.Measure(Loose(50, 50)); size != (Size{Width: 10, Height: 5}) {
+		t.Fatalf("Measure = %+v, want 10x5", size)
+	}
+}
+
+func TestAnchorLayout_HitTestingReachesTopmostChild(t *testing.T) {
+	base := newAnchorProbe(0, 0)
+	lower := newAnchorProbe(6, 3)
+	upper := newAnchorProbe(2, 1)
+	layout := NewAnchorLayout().SetBase(base).
+		Add(lower, TopLeft, 0, 0).
+		Add(upper, TopLeft, 1, 1)
+	screen := NewScreen(20, 10)
+	screen.SetRoot(layout)
+
+	screen.HandleMessage(MouseMsg{X: 1, Y: 1, Button: MouseLeft, Action: MousePress})
+	if upper.hits != 1 || lower.hits != 0 {
+		t.Fatalf("overlapping cell: upper=%d lower=%d, want the upper child", upper.hits, lower.hits)
+	}
+	screen.HandleMessage(MouseMsg{X: 5, Y: 2, Button: MouseLeft, Action: MousePress})
+	if lower.hits != 1 {
+		t.Fatalf("expected lower child to get clicks outside the upper child")
+	}
+	screen.HandleMessage(MouseMsg{X: 15, Y: 8, Button: MouseLeft, Action: MousePress})
+	if base.hits != 1 {
+		t.Fatalf("expected base to get clicks outside the children")
+	}
+
+	// Direct dispatch follows the same order.
+	layout.HandleMessage(MouseMsg{X: 2, Y: 1, Button: MouseLeft, Action: MousePress})
+	if upper.hits != 2 || lower.hits != 1 {
+		t.Fatalf("direct dispatch: upper=%d lower=%d", upper.hits, lower.hits)
+	}
+}
@@ -53,6 +53,25 @@ type AppConfig struct {
 	FrameBudget       time.Duration
 	Localizer         i18n.Localizer
 	ErrorReporter     *ErrorReporter
+	// TooltipDelay is how long the pointer or focus must rest on a widget
+	// before its tooltip shows. Zero uses DefaultTooltipDelay and a
+	// negative delay disables tooltips.
+	TooltipDelay time.Duration
+	// TooltipRenderer builds the widget drawn for a tooltip. Nil draws the
+	// text plainly; widgets.TooltipRenderer renders fur markup.
+	TooltipRenderer TooltipRenderer
+	// QuietAnnouncements stops focus changes and standard widgets from
+	// announcing on their own. Explicit Announcer calls still go through.
+	QuietAnnouncements bool
+	// Scroll configures mouse wheel scrolling for every scrolling widget.
+	Scroll ScrollConfig
+	// DoubleClickInterval is the longest gap between presses counted as
+	// one double or triple click. Zero uses DefaultDoubleClickInterval.
+	DoubleClickInterval time.Duration
+	// Clock times frame ticks, animations and After and Every effects, and
+	// becomes the Animator's time source. Nil uses SystemClock; tests pass
+	// a ManualClock and Advance it.
+	Clock Clock
 }
 
 // App runs a widget tree against a terminal backend.
@@ -65,6 +84,7 @@ type App struct {
 	keyHandler        KeyHandler
 	messages          chan Message
 	tickRate          time.Duration
+	clock             Clock
 	stateQueue        *state.Queue
 	queueScheduler    *QueueScheduler
 	flushPolicy       QueueFlushPolicy
@@ -84,11 +104,18 @@ type App struct {
 	lastFrameDuration time.Duration
 	localizer         i18n.Localizer
 	errorReporter     *ErrorReporter
+	tooltipDelay      time.Duration
+	tooltipRenderer   TooltipRenderer
+	quietAnnounce     bool
+	scrollConfig      ScrollConfig
+	clicks            clickCounter
 	taskCtx           context.Context
 	taskCancel        context.CancelFunc
 	pendingMu         sync.Mutex
 	pendingEffects    []Effect
 	mcpCloser         io.Closer
+	values            sync.Map
+	restoreCh         chan []byte
 
 	running     atomic.Bool
 	dirty       bool
@@ -122,10 +149,15 @@ func NewApp(cfg AppConfig) *App {
 		commandHandler:    cfg.CommandHandler,
 		keyHandler:        cfg.KeyHandler,
 		messages:          make(chan Message, bufferSize),
+		restoreCh:         make(chan []byte, 1),
 		tickRate:          cfg.TickRate,
+		clock:             cfg.Clock,
 		stateQueue:        queue,
 		flushPolicy:       policy,
 		announcer:         cfg.Announcer,
+		quietAnnounce:     cfg.QuietAnnouncements,
+		scrollConfig:      cfg.Scroll,
+		clicks:            clickCounter{interval: cfg.DoubleClickInterval},
 		clipboard:         cfg.Clipboard,
 		focusStyle:        cfg.FocusStyle,
 		recorder:          cfg.Recorder,
@@ -139,10 +171,15 @@ func NewApp(cfg AppConfig) *App {
 		frameBudget:       cfg.FrameBudget,
 		localizer:         cfg.Localizer,
 		errorReporter:     cfg.ErrorReporter,
+		tooltipDelay:      cfg.TooltipDelay,
+		tooltipRenderer:   cfg.TooltipRenderer,
 	}
 	if app.flushPolicy == 0 {
 		app.flushPolicy = FlushOnMessageAndTick
 	}
+	if cfg.Clock != nil && cfg.Animator != nil {
+		cfg.Animator.SetTimeSource(cfg.Clock.Now)
+	}
 	app.queueScheduler = NewQueueScheduler(queue, app.tryPost)
 	app.invalidator = NewInvalidator(app.tryPost)
 	return app
@@ -185,6 +222,14 @@ func (a *App) Localizer() i18n.Localizer {
 	return a.localizer
 }
 
+// Clock returns the clock the app schedules ticks and effects against.
+func (a *App) Clock() Clock {
+	if a == nil || a.clock == nil {
+		return SystemClock
+	}
+	return a.clock
+}
+
 // Animator returns the app animator.
 func (a *App) Animator() *animation.Animator {
 	if a == nil {
@@ -227,6 +272,16 @@ func (a *App) SetTheme(th *theme.Theme) {
 	a.SetStylesheet(theme.Stylesheet(th))
 }
 
+// onThemeChanged follows theme.Set. Apps configured with a theme adopt the
+// new one; all apps repaint so theme-aware widgets pick up new colors.
+func (a *App) onThemeChanged() {
+	if a.theme != nil {
+		a.SetTheme(theme.Current())
+		return
+	}
+	a.Invalidate()
+}
+
 // Relayout recomputes layout and invalidates the render pass.
 func (a *App) Relayout() {
 	if a == nil || a.screen == nil {
@@ -356,6 +411,7 @@ func (a *App) Run(ctx context.Context) error {
 	a.screen.SetServices(a.Services())
 	a.screen.SetErrorReporter(a.errorReporter)
 	a.screen.SetAutoRegisterFocus(a.focusRegistration == FocusRegistrationAuto)
+	a.screen.SetTooltipDelay(a.tooltipDelay)
 	if a.root != nil {
 		a.screen.SetRoot(a.root)
 	}
@@ -377,14 +433,25 @@ func (a *App) Run(ctx context.Context) error {
 
 	a.startPendingEffects()
 
+	unsubTheme := theme.Signal().SubscribeWithScheduler(a.StateScheduler(), a.onThemeChanged)
+	defer unsubTheme()
+
 	go a.pollEvents()
 
-	var ticker *time.Ticker
 	var ticks <-chan time.Time
 	if a.tickRate > 0 {
-		ticker = time.NewTicker(a.tickRate)
-		defer ticker.Stop()
-		ticks = ticker.C
+		if a.clock == nil {
+			ticker := time.NewTicker(a.tickRate)
+			defer ticker.Stop()
+			ticks = ticker.C
+		} else {
+			// An injected clock queues a tick per interval crossed, so
+			// advancing a ManualClock steps the app frame by frame.
+			stop := clockEvery(taskCtx, a.clock, a.tickRate, func(now time.Time) {
+				a.tryPost(clockTickMsg{now: now})
+			})
+			defer stop()
+		}
 	}
 
 	for a.running.Load() {
@@ -402,22 +469,13 @@ func (a *App) Run(ctx context.Context) error {
 				}
 				continue
 			}
-			if a.update(a, msg) {
+			if tick, ok := msg.(clockTickMsg); ok {
+				msg = a.tick(tick.now)
+			} else if a.update(a, msg) {
 				a.dirty = true
 			}
 		case now := <-ticks:
-			msg = TickMsg{Time: now}
-			if a.animator != nil {
-				dt := a.tickRate.Seconds()
-				if a.frameBudget > 0 && a.lastFrameDuration > a.frameBudget {
-					// Skip animation update when we're over budget.
-				} else if a.animator.Update(dt) {
-					a.dirty = true
-				}
-			}
-			if a.update(a, msg) {
-				a.dirty = true
-			}
+			msg = a.tick(now)
 		}
 
 		if !a.running.Load() {
@@ -516,11 +574,21 @@ func (a *App) handleCommand(cmd Command) bool {
 	}
 }
 
-// ExecuteCommand runs a command through the app handler.
+// ExecuteCommand runs a command through the app handler. Focus and overlay
+// commands are applied to the screen first, as they are when returned by a
+// widget.
 func (a *App) ExecuteCommand(cmd Command) bool {
 	if a == nil {
 		return false
 	}
+	switch cmd.(type) {
+	case FocusNext, FocusPrev, PushOverlay, PopOverlay, RemoveOverlay:
+		if a.screen != nil {
+			a.screen.handleCommand(cmd)
+			a.handleCommand(cmd)
+			return true
+		}
+	}
 	return a.handleCommand(cmd)
 }
 
@@ -543,7 +611,7 @@ func (a *App) pollEvents() {
 		case terminal.ResizeEvent:
 			a.Post(ResizeMsg{Width: e.Width, Height: e.Height})
 		case terminal.MouseEvent:
-			a.Post(MouseMsg{
+			a.Post(a.clicks.apply(MouseMsg{
 				X:      e.X,
 				Y:      e.Y,
 				Button: MouseButton(e.Button),
@@ -551,7 +619,7 @@ func (a *App) pollEvents() {
 				Alt:    e.Alt,
 				Ctrl:   e.Ctrl,
 				Shift:  e.Shift,
-			})
+			}, time.Now()))
 		case terminal.PasteEvent:
 			a.Post(PasteMsg{Text: e.Text})
 		}
@@ -685,6 +753,23 @@ func (a *App) render() {
 	a.lastFrameDuration = time.Since(frameStart)
 }
 
+// tick steps animations and delivers a TickMsg for now.
+func (a *App) tick(now time.Time) Message {
+	msg := TickMsg{Time: now}
+	if a.animator != nil {
+		dt := a.tickRate.Seconds()
+		if a.frameBudget > 0 && a.lastFrameDuration > a.frameBudget {
+			// Skip animation update when we're over budget.
+		} else if a.animator.UpdateAt(now, dt) {
+			a.dirty = true
+		}
+	}
+	if a.update(a, msg) {
+		a.dirty = true
+	}
+	return msg
+}
+
 func (a *App) taskContext() context.Context {
 	if a != nil && a.taskCtx != nil {
 		return a.taskCtx
@@ -703,7 +788,7 @@ func (a *App) runEffect(effect Effect) {
 	if a == nil || effect.Run == nil {
 		return
 	}
-	ctx := a.taskContext()
+	ctx := WithClock(a.taskContext(), a.clock)
 	post := a.tryPost
 	go effect.Run(ctx, post)
 }
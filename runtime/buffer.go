@@ -162,7 +162,10 @@ func (b *Buffer) ClearImageOps() {
 }
 
 // SetString writes a string starting at (x, y).
-// Clips to buffer bounds. Marks changed cells as dirty.
+// Text is segmented into grapheme clusters: each cluster advances by its
+// display width, and wide clusters are followed by continuation cells
+// (Rune 0) so later columns stay aligned. Clips to buffer bounds; a wide
+// cluster cut by an edge is replaced with spaces. Marks changed cells as dirty.
 func (b *Buffer) SetString(x, y int, s string, style backend.Style) {
 	if y < 0 || y >= b.height {
 		return
@@ -170,61 +173,53 @@ func (b *Buffer) SetString(x, y int, s string, style backend.Style) {
 	if x >= b.width {
 		return
 	}
+	px := x
+	i := 0
 	if x >= 0 {
-		px := x
-		i := 0
+		// ASCII fast path: each byte is one cell unless a combining
+		// sequence follows it.
 		for i < len(s) && px < b.width {
 			ch := s[i]
-			if ch >= 0x80 {
+			if ch >= 0x80 || (i+1 < len(s) && s[i+1] >= 0x80) {
 				break
 			}
-			idx := y*b.width + px
-			old := b.cells[idx]
-			r := rune(ch)
-			if old.Rune != r || old.Style != style {
-				b.cells[idx] = Cell{Rune: r, Style: style}
-				b.markCellDirty(px, y, idx)
-			}
+			b.setCell(px, y, rune(ch), style)
 			i++
 			px++
 		}
 		if i >= len(s) {
 			return
 		}
-		for _, r := range s[i:] {
-			if px < 0 {
-				px++
-				continue
-			}
-			if px >= b.width {
-				break
-			}
-			idx := y*b.width + px
-			old := b.cells[idx]
-			if old.Rune != r || old.Style != style {
-				b.cells[idx] = Cell{Rune: r, Style: style}
-				b.markCellDirty(px, y, idx)
-			}
-			px++
-		}
-		return
 	}
-	px := x
-	for _, r := range s {
-		if px < 0 {
-			px++
-			continue
-		}
+	backend.ForEachCluster(s[i:], func(cluster string, width int) bool {
 		if px >= b.width {
-			break
+			return false
+		}
+		if width == 0 {
+			return true
 		}
-		idx := y*b.width + px
-		old := b.cells[idx]
-		if old.Rune != r || old.Style != style {
-			b.cells[idx] = Cell{Rune: r, Style: style}
-			b.markCellDirty(px, y, idx)
+		r := backend.ClusterRune(cluster)
+		if px < 0 || px+width > b.width {
+			for k := max(px, 0); k < min(px+width, b.width); k++ {
+				b.setCell(k, y, ' ', style)
+			}
+		} else {
+			b.setCell(px, y, r, style)
+			for k := 1; k < width; k++ {
+				b.setCell(px+k, y, 0, style)
+			}
 		}
-		px++
+		px += width
+		return true
+	})
+}
+
+func (b *Buffer) setCell(x, y int, r rune, style backend.Style) {
+	idx := y*b.width + x
+	old := b.cells[idx]
+	if old.Rune != r || old.Style != style {
+		b.cells[idx] = Cell{Rune: r, Style: style}
+		b.markCellDirty(x, y, idx)
 	}
 }
 
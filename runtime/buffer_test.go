@@ -77,6 +77,36 @@ func TestBuffer_SetStringClips(t *testing.T) {
 	}
 }
 
+func TestBuffer_SetStringGraphemes(t *testing.T) {
+	b := NewBuffer(10, 1)
+	style := backend.DefaultStyle()
+
+	b.SetString(0, 0, "📁 ae\u0301x", style)
+	want := []rune{'📁', 0, ' ', 'a', 'é', 'x'}
+	for i, r := range want {
+		if got := b.Get(i, 0).Rune; got != r {
+			t.Errorf("Get(%d, 0) = %q, want %q", i, got, r)
+		}
+	}
+
+	// A wide cluster cut by the right edge becomes a space.
+	b = NewBuffer(3, 1)
+	b.SetString(0, 0, "ab📁", style)
+	if got := b.Get(2, 0).Rune; got != ' ' {
+		t.Errorf("expected clipped wide cluster to be blank, got %q", got)
+	}
+
+	// Negative x clips whole clusters, not bytes.
+	b = NewBuffer(3, 1)
+	b.SetString(-1, 0, "📁bc", style)
+	if got := b.Get(0, 0).Rune; got != ' ' {
+		t.Errorf("expected clipped continuation to be blank, got %q", got)
+	}
+	if got := b.Get(1, 0).Rune; got != 'b' {
+		t.Errorf("expected b after wide cluster, got %q", got)
+	}
+}
+
 func TestBuffer_Fill(t *testing.T) {
 	b := NewBuffer(10, 10)
 	style := backend.DefaultStyle()
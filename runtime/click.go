@@ -0,0 +1,72 @@
+package runtime
+
+import "time"
+
+// DefaultDoubleClickInterval is the longest gap between presses that still
+// counts as a repeated click.
+const DefaultDoubleClickInterval = 500 * time.Millisecond
+
+// clickJitter is how many cells the pointer may drift between presses of a
+// repeated click.
+const clickJitter = 1
+
+// clickCounter numbers repeated presses of the same button at the same
+// spot, setting MouseMsg.Count. The zero value uses
+// DefaultDoubleClickInterval.
+type clickCounter struct {
+	interval time.Duration
+	last     time.Time
+	button   MouseButton
+	x, y     int
+	count    int
+}
+
+// apply sets msg.Count for a press or release received at now. A press
+// within the interval of the previous one, with the same button and within
+// clickJitter cells, continues the count; a release carries its press's
+// count. Moving the pointer further away resets the count.
+func (c *clickCounter) apply(msg MouseMsg, now time.Time) MouseMsg {
+	near := c.count > 0 && abs(msg.X-c.x) <= clickJitter && abs(msg.Y-c.y) <= clickJitter
+	if msg.Action == MouseMove {
+		if !near {
+			c.count = 0
+		}
+		return msg
+	}
+	switch msg.Button {
+	case MouseLeft, MouseMiddle, MouseRight:
+	default:
+		return msg
+	}
+	near = near && msg.Button == c.button
+	switch msg.Action {
+	case MousePress:
+		interval := c.interval
+		if interval <= 0 {
+			interval = DefaultDoubleClickInterval
+		}
+		if near && now.Sub(c.last) <= interval {
+			c.count++
+		} else {
+			c.count = 1
+			c.x, c.y = msg.X, msg.Y
+		}
+		c.button = msg.Button
+		c.last = now
+		msg.Count = c.count
+	case MouseRelease:
+		if near {
+			msg.Count = c.count
+		} else {
+			msg.Count = 1
+		}
+	}
+	return msg
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClickCounterCountsRepeatedClicks(t *testing.T) {
+	var clicks clickCounter
+	start := time.Unix(100, 0)
+	press := MouseMsg{X: 4, Y: 2, Button: MouseLeft, Action: MousePress}
+	release := MouseMsg{X: 4, Y: 2, Button: MouseLeft, Action: MouseRelease}
+
+	var got []int
+	for i := 0; i < 3; i++ {
+		at := start.Add(time.Duration(i) * 200 * time.Millisecond)
+		got = append(got, clicks.apply(press, at).Count, clicks.apply(release, at).Count)
+	}
+	want := []int{1, 1, 2, 2, 3, 3}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("counts = %v, want %v", got, want)
+		}
+	}
+
+	late := clicks.apply(press, start.Add(2*time.Second))
+	if late.Count != 1 {
+		t.Fatalf("count after pause = %d, want 1", late.Count)
+	}
+	right := clicks.apply(MouseMsg{X: 4, Y: 2, Button: MouseRight, Action: MousePress}, start.Add(2100*time.Millisecond))
+	if right.Count != 1 {
+		t.Fatalf("count after button change = %d, want 1", right.Count)
+	}
+	if wheel := clicks.apply(MouseMsg{Button: MouseWheelDown, Action: MousePress}, start); wheel.Count != 0 {
+		t.Fatalf("wheel count = %d, want 0", wheel.Count)
+	}
+}
+
+func TestClickCounterJitter(t *testing.T) {
+	clicks := clickCounter{interval: time.Second}
+	now := time.Unix(100, 0)
+	clicks.apply(MouseMsg{X: 10, Y: 5, Button: MouseLeft, Action: MousePress}, now)
+	clicks.apply(MouseMsg{X: 11, Y: 5, Action: MouseMove}, now)
+	if msg := clicks.apply(MouseMsg{X: 11, Y: 6, Button: MouseLeft, Action: MousePress}, now); msg.Count != 2 {
+		t.Fatalf("count within jitter = %d, want 2", msg.Count)
+	}
+	clicks.apply(MouseMsg{X: 14, Y: 5, Action: MouseMove}, now)
+	if msg := clicks.apply(MouseMsg{X: 10, Y: 5, Button: MouseLeft, Action: MousePress}, now); msg.Count != 1 {
+		t.Fatalf("count after moving away = %d, want 1", msg.Count)
+	}
+}
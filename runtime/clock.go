@@ -0,0 +1,100 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffyui/state"
+)
+
+// Clock supplies the time an App schedules frame ticks and After and Every
+// effects against. It is the clock interface of the state package, so the
+// same clock can also drive debounced and throttled signals.
+type Clock = state.Clock
+
+// Timer is a pending callback returned by Clock.AfterFunc.
+type Timer = state.Timer
+
+// ManualClock is a Clock that only moves when a test calls Advance.
+type ManualClock = state.ManualClock
+
+// SystemClock follows the real wall clock. Apps use it by default.
+var SystemClock = state.SystemClock
+
+// NewManualClock creates a manual clock for driving an App's ticks,
+// animations and effects deterministically in tests.
+func NewManualClock() *ManualClock {
+	return state.NewManualClock()
+}
+
+type clockKey struct{}
+
+// WithClock returns a context whose effects schedule against clock.
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if clock == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// ClockFromContext returns the clock effects started with ctx should use,
+// or SystemClock if none was set. Apps set their configured clock on the
+// context of every effect they run.
+func ClockFromContext(ctx context.Context) Clock {
+	if ctx != nil {
+		if clock, ok := ctx.Value(clockKey{}).(Clock); ok {
+			return clock
+		}
+	}
+	return SystemClock
+}
+
+// clockTickMsg carries a frame tick from a non-system clock into the event
+// loop, where it becomes a TickMsg.
+type clockTickMsg struct {
+	now time.Time
+}
+
+func (clockTickMsg) isMessage() {}
+
+// clockEvery calls fn every interval on clock until ctx is done or the
+// returned stop is called. Like time.Ticker it skips ticks a slow callback
+// missed. On a ManualClock, fn runs inside Advance once per interval
+// crossed.
+func clockEvery(ctx context.Context, clock Clock, interval time.Duration, fn func(now time.Time)) (stop func()) {
+	var mu sync.Mutex
+	var timer Timer
+	stopped := false
+	var schedule func(next time.Time)
+	schedule = func(next time.Time) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped || ctx.Err() != nil {
+			return
+		}
+		now := clock.Now()
+		for !next.After(now) {
+			next = next.Add(interval)
+		}
+		timer = clock.AfterFunc(next.Sub(now), func() {
+			if ctx.Err() != nil {
+				return
+			}
+			fn(clock.Now())
+			schedule(next.Add(interval))
+		})
+	}
+	schedule(clock.Now().Add(interval))
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/animation"
+	"github.com/odvcencio/fluffyui/backend/sim"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestManualClockStepsAppTicks(t *testing.T) {
+	clock := NewManualClock()
+	ticks := make(chan time.Time, 8)
+	app := NewApp(AppConfig{
+		Backend:  sim.New(5, 3),
+		Root:     &appTestWidget{keyCommands: map[rune]Command{'q': Quit{}}, renderChar: 'X'},
+		TickRate: 10 * time.Millisecond,
+		Clock:    clock,
+		Update: func(app *App, msg Message) bool {
+			if tick, ok := msg.(TickMsg); ok {
+				ticks <- tick.Time
+			}
+			return DefaultUpdate(app, msg)
+		},
+	})
+	if app.Clock() != Clock(clock) || app.Services().Clock() != Clock(clock) {
+		t.Fatal("expected the app to expose the configured clock")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	waitForTimers(t, clock, 1)
+
+	start := clock.Now()
+	clock.Advance(30 * time.Millisecond)
+	for i := 1; i <= 3; i++ {
+		select {
+		case got := <-ticks:
+			if want := start.Add(time.Duration(i) * 10 * time.Millisecond); !got.Equal(want) {
+				t.Fatalf("tick %d at %v, want %v", i, got, want)
+			}
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("tick %d was not delivered", i)
+		}
+	}
+	select {
+	case got := <-ticks:
+		t.Fatalf("unexpected extra tick at %v", got)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	app.Post(KeyMsg{Key: terminal.KeyRune, Rune: 'q'})
+	if err := <-done; err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+}
+
+func TestManualClockFinishesTweenOnExactFrame(t *testing.T) {
+	clock := NewManualClock()
+	animator := animation.NewAnimator()
+	value := animation.Float64(0)
+	finished := false
+	doneAt := make(chan time.Time, 1)
+	app := NewApp(AppConfig{
+		Backend:  sim.New(5, 3),
+		Root:     &appTestWidget{keyCommands: map[rune]Command{'q': Quit{}}, renderChar: 'X'},
+		TickRate: 10 * time.Millisecond,
+		Clock:    clock,
+		Animator: animator,
+		Update: func(app *App, msg Message) bool {
+			if tick, ok := msg.(TickMsg); ok && finished {
+				select {
+				case doneAt <- tick.Time:
+				default:
+				}
+			}
+			return DefaultUpdate(app, msg)
+		},
+	})
+	start := clock.Now()
+	animator.Animate(&value, "value",
+		func() animation.Animatable { return value },
+		func(v animation.Animatable) { value = v.(animation.Float64) },
+		animation.Float64(100),
+		animation.TweenConfig{Duration: 50 * time.Millisecond, Easing: animation.Linear, OnComplete: func() { finished = true }},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- app.Run(ctx)
+	}()
+	waitForTimers(t, clock, 1)
+
+	clock.Advance(100 * time.Millisecond)
+	select {
+	case got := <-doneAt:
+		if want := start.Add(50 * time.Millisecond); !got.Equal(want) {
+			t.Fatalf("tween finished on the frame at %v, want %v", got.Sub(start), want.Sub(start))
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("tween did not finish")
+	}
+
+	app.Post(KeyMsg{Key: terminal.KeyRune, Rune: 'q'})
+	if err := <-done; err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if value != 100 {
+		t.Fatalf("value = %v, want 100", value)
+	}
+}
+
+func TestEffectsUseContextClock(t *testing.T) {
+	clock := NewManualClock()
+	ctx, cancel := context.WithCancel(WithClock(context.Background(), clock))
+	defer cancel()
+	posted := make(chan Message, 8)
+	post := func(msg Message) bool {
+		posted <- msg
+		return true
+	}
+
+	go After(50*time.Millisecond, ResizeMsg{Width: 1, Height: 1}).Run(ctx, post)
+	go Every(20*time.Millisecond, func(now time.Time) Message { return TickMsg{Time: now} }).Run(ctx, post)
+	waitForTimers(t, clock, 2)
+
+	clock.Advance(45 * time.Millisecond)
+	if len(posted) != 2 {
+		t.Fatalf("posted %d messages, want two Every ticks", len(posted))
+	}
+	for _, want := range []time.Duration{20, 40} {
+		if tick := (<-posted).(TickMsg); tick.Time.Sub(time.Unix(0, 0)) != want*time.Millisecond {
+			t.Fatalf("tick at %v, want %v", tick.Time, want*time.Millisecond)
+		}
+	}
+	clock.Advance(5 * time.Millisecond)
+	if msg := <-posted; msg != (ResizeMsg{Width: 1, Height: 1}) {
+		t.Fatalf("After posted %#v", msg)
+	}
+
+	if ClockFromContext(context.Background()) != SystemClock {
+		t.Fatal("expected SystemClock without a context clock")
+	}
+}
+
+func waitForTimers(t *testing.T, clock *ManualClock, n int) {
+	t.Helper()
+
+	deadline := time.After(500 * time.Millisecond)
+	for clock.Pending() < n {
+		select {
+		case <-deadline:
+			t.Fatalf("expected %d pending timers, got %d", n, clock.Pending())
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
@@ -72,9 +72,15 @@ type FocusPrev struct{}
 func (FocusPrev) Command() {}
 
 // PushOverlay requests a modal overlay be pushed.
+// Modal overlays always trap focus: Tab and Shift+Tab cycle within the
+// overlay, and focus returns to the previously focused widget when it is
+// popped. TrapFocus does the same for a non-modal overlay. Scrim draws a
+// backdrop (e.g. ScrimDim) over the layers below before the overlay renders.
 type PushOverlay struct {
-	Widget Widget
-	Modal  bool
+	Widget    Widget
+	Modal     bool
+	TrapFocus bool
+	Scrim     ScrimStyle
 }
 
 func (PushOverlay) Command() {}
@@ -84,6 +90,14 @@ type PopOverlay struct{}
 
 func (PopOverlay) Command() {}
 
+// RemoveOverlay requests the overlay whose root is Widget be dismissed,
+// wherever it sits in the layer stack.
+type RemoveOverlay struct {
+	Widget Widget
+}
+
+func (RemoveOverlay) Command() {}
+
 // PaletteSelected indicates an item was chosen from a palette.
 type PaletteSelected struct {
 	ID   string // Item identifier
@@ -0,0 +1,122 @@
+package runtime
+
+import "github.com/odvcencio/fluffyui/terminal"
+
+// ShowContextMenu opens menu as a modal overlay with its top-left corner at
+// at, typically where the right mouse button was pressed. The menu is moved
+// left or up as needed to stay on screen. A press outside the menu or an
+// unhandled Esc closes it; the menu closes itself with PopOverlay when an
+// item is chosen.
+//
+// A widget opts in by returning the result from its HandleMessage:
+//
+//	if mouse.Button == runtime.MouseRight && mouse.Action == runtime.MousePress {
+//		return runtime.ShowContextMenu(runtime.Point{X: mouse.X, Y: mouse.Y}, menu)
+//	}
+func ShowContextMenu(at Point, menu Widget) HandleResult {
+	if menu == nil {
+		return Handled()
+	}
+	return WithCommand(PushOverlay{Widget: &contextMenuLayer{anchor: Rect{X: at.X, Y: at.Y}, menu: menu}, Modal: true})
+}
+
+// ShowContextMenuNear opens menu like ShowContextMenu, but below anchor,
+// typically the bounds of the widget the menu belongs to. The menu opens
+// above anchor instead when it only fits there.
+func ShowContextMenuNear(anchor Rect, menu Widget) HandleResult {
+	if menu == nil {
+		return Handled()
+	}
+	return WithCommand(PushOverlay{Widget: &contextMenuLayer{anchor: anchor, menu: menu}, Modal: true})
+}
+
+// contextMenuLayer fills the screen and places a menu at a point, or
+// beside an anchor rectangle.
+type contextMenuLayer struct {
+	anchor     Rect
+	menu       Widget
+	bounds     Rect
+	menuBounds Rect
+}
+
+// Measure fills the available space so presses outside the menu are seen.
+func (l *contextMenuLayer) Measure(constraints Constraints) Size {
+	return constraints.MaxSize()
+}
+
+// Layout places the menu below the anchor, clamped within bounds. A menu
+// that does not fit below a non-empty anchor flips above it if it fits
+// there.
+func (l *contextMenuLayer) Layout(bounds Rect) {
+	l.bounds = bounds
+	loose := Loose(bounds.Width, bounds.Height)
+	size := loose.Constrain(l.menu.Measure(loose))
+	y := l.anchor.Y + l.anchor.Height
+	if l.anchor.Height > 0 && y+size.Height > bounds.Y+bounds.Height && l.anchor.Y-size.Height >= bounds.Y {
+		y = l.anchor.Y - size.Height
+	}
+	l.menuBounds = Rect{
+		X:      contextMenuOffset(l.anchor.X, size.Width, bounds.X, bounds.Width),
+		Y:      contextMenuOffset(y, size.Height, bounds.Y, bounds.Height),
+		Width:  size.Width,
+		Height: size.Height,
+	}
+	l.menu.Layout(l.menuBounds)
+}
+
+// contextMenuOffset returns where content of length size starts so that it
+// begins at pos when it fits before the end of the span, and otherwise ends
+// at the edge of the span.
+func contextMenuOffset(pos, size, start, length int) int {
+	end := start + length
+	if pos+size > end {
+		pos = end - size
+	}
+	return max(start, pos)
+}
+
+// Render draws the menu.
+func (l *contextMenuLayer) Render(ctx RenderContext) {
+	RenderChild(ctx, l.menu)
+}
+
+// HandleMessage offers messages to the menu and closes the layer on an
+// outside press or Esc.
+func (l *contextMenuLayer) HandleMessage(msg Message) HandleResult {
+	if mouse, ok := msg.(MouseMsg); ok {
+		if !l.menuBounds.Contains(mouse.X, mouse.Y) {
+			if mouse.Action == MousePress {
+				return WithCommand(PopOverlay{})
+			}
+			return Handled()
+		}
+	}
+	if result := l.menu.HandleMessage(msg); result.Handled {
+		return result
+	}
+	if key, ok := msg.(KeyMsg); ok && key.Key == terminal.KeyEscape {
+		return WithCommand(PopOverlay{})
+	}
+	return Unhandled()
+}
+
+// Bounds returns the layer bounds.
+func (l *contextMenuLayer) Bounds() Rect {
+	return l.bounds
+}
+
+// ChildWidgets returns the menu.
+func (l *contextMenuLayer) ChildWidgets() []Widget {
+	return []Widget{l.menu}
+}
+
+// HitSelf routes presses outside the menu to the layer.
+func (l *contextMenuLayer) HitSelf() bool {
+	return true
+}
+
+var (
+	_ Widget          = (*contextMenuLayer)(nil)
+	_ ChildProvider   = (*contextMenuLayer)(nil)
+	_ HitSelfProvider = (*contextMenuLayer)(nil)
+)
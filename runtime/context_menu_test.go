@@ -0,0 +1,87 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func showContextMenu(t *testing.T, screen *Screen, at Point, menu Widget) {
+	t.Helper()
+	result := ShowContextMenu(at, menu)
+	if len(result.Commands) != 1 {
+		t.Fatalf("expected one command, got %+v", result.Commands)
+	}
+	screen.handleCommand(result.Commands[0])
+}
+
+func TestShowContextMenu_PlacesMenuAtPoint(t *testing.T) {
+	screen := NewScreen(40, 10)
+	screen.SetRoot(newTestWidget(40, 10))
+	menu := newTestWidget(12, 4)
+	showContextMenu(t, screen, Point{X: 5, Y: 3}, menu)
+
+	if screen.LayerCount() != 2 || !screen.TopLayer().Modal {
+		t.Fatalf("expected a modal overlay, layers = %d", screen.LayerCount())
+	}
+	if want := (Rect{X: 5, Y: 3, Width: 12, Height: 4}); menu.bounds != want {
+		t.Fatalf("menu bounds = %+v, want %+v", menu.bounds, want)
+	}
+}
+
+func TestShowContextMenu_ClampsToScreen(t *testing.T) {
+	screen := NewScreen(40, 10)
+	screen.SetRoot(newTestWidget(40, 10))
+	menu := newTestWidget(12, 4)
+	showContextMenu(t, screen, Point{X: 35, Y: 8}, menu)
+
+	if want := (Rect{X: 28, Y: 6, Width: 12, Height: 4}); menu.bounds != want {
+		t.Fatalf("menu bounds = %+v, want %+v", menu.bounds, want)
+	}
+
+	tall := newTestWidget(12, 20)
+	screen.PopLayer()
+	showContextMenu(t, screen, Point{X: 0, Y: 5}, tall)
+	if want := (Rect{X: 0, Y: 0, Width: 12, Height: 10}); tall.bounds != want {
+		t.Fatalf("tall menu bounds = %+v, want %+v", tall.bounds, want)
+	}
+}
+
+func TestShowContextMenu_DismissesOnOutsidePressAndEscape(t *testing.T) {
+	screen := NewScreen(40, 10)
+	screen.SetRoot(newTestWidget(40, 10))
+	menu := newTestWidget(12, 4)
+	showContextMenu(t, screen, Point{X: 5, Y: 3}, menu)
+
+	screen.HandleMessage(MouseMsg{X: 6, Y: 4, Button: MouseLeft, Action: MousePress})
+	if screen.LayerCount() != 2 {
+		t.Fatalf("press inside the menu closed it")
+	}
+	screen.HandleMessage(MouseMsg{X: 30, Y: 1, Button: MouseLeft, Action: MousePress})
+	if screen.LayerCount() != 1 {
+		t.Fatalf("expected an outside press to close the menu")
+	}
+
+	showContextMenu(t, screen, Point{X: 5, Y: 3}, menu)
+	screen.HandleMessage(KeyMsg{Key: terminal.KeyEscape})
+	if screen.LayerCount() != 1 {
+		t.Fatalf("expected Esc to close the menu")
+	}
+}
+
+func TestShowContextMenuNear_PlacesBelowOrAboveAnchor(t *testing.T) {
+	screen := NewScreen(40, 10)
+	screen.SetRoot(newTestWidget(40, 10))
+	menu := newTestWidget(12, 4)
+	push := ShowContextMenuNear(Rect{X: 3, Y: 2, Width: 8, Height: 1}, menu).Commands[0]
+	screen.handleCommand(push)
+	if want := (Rect{X: 3, Y: 3, Width: 12, Height: 4}); menu.bounds != want {
+		t.Fatalf("menu bounds = %+v, want %+v", menu.bounds, want)
+	}
+
+	screen.PopLayer()
+	screen.handleCommand(ShowContextMenuNear(Rect{X: 3, Y: 7, Width: 8, Height: 1}, menu).Commands[0])
+	if want := (Rect{X: 3, Y: 3, Width: 12, Height: 4}); menu.bounds != want {
+		t.Fatalf("flipped menu bounds = %+v, want %+v", menu.bounds, want)
+	}
+}
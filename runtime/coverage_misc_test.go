@@ -162,6 +162,7 @@ func TestMessageMarkers(t *testing.T) {
 	QueueFlushMsg{}.isMessage()
 	InvalidateMsg{}.isMessage()
 	CustomMsg{}.isMessage()
+	RouterMsg{}.isMessage()
 	callMsg{}.isMessage()
 }
 
@@ -268,3 +269,53 @@ var _ Focusable = (*boundsWidget)(nil)
 var _ Persistable = (*persistWidget)(nil)
 var _ ChildProvider = (*persistWidget)(nil)
 var _ Keyed = (*persistWidget)(nil)
+
+type ariaWidget struct {
+	boundsWidget
+	accessibility.Base
+	id string
+}
+
+func (a *ariaWidget) ID() string { return a.id }
+
+func TestScreenAnnounceFocusDescribedBy(t *testing.T) {
+	help := &ariaWidget{id: "pw-help"}
+	help.Base.Label = "At least 12 characters"
+	field := &ariaWidget{boundsWidget: boundsWidget{focusable: true}, id: "pw"}
+	field.Base.Role = accessibility.RoleTextbox
+	field.Base.Label = "Password"
+	field.SetDescribedBy("pw-help")
+	root := &boundsWidget{children: []Widget{field, help}}
+
+	announcer := &accessibility.SimpleAnnouncer{}
+	app := NewApp(AppConfig{Announcer: announcer})
+	screen := NewScreen(10, 5)
+	screen.SetServices(app.Services())
+	screen.SetRoot(root)
+	screen.announceFocus(field)
+
+	history := announcer.History()
+	if len(history) != 1 || history[0].Message != "Password, text box, At least 12 characters" {
+		t.Fatalf("unexpected focus announcement: %v", history)
+	}
+}
+
+func TestQuietAnnouncementsSkipsAutomaticAnnouncements(t *testing.T) {
+	field := &ariaWidget{boundsWidget: boundsWidget{focusable: true}}
+	field.Base.Label = "Name"
+	announcer := &accessibility.SimpleAnnouncer{}
+	app := NewApp(AppConfig{Announcer: announcer, QuietAnnouncements: true})
+	screen := NewScreen(10, 5)
+	screen.SetServices(app.Services())
+	screen.SetRoot(field)
+	screen.announceFocus(field)
+	app.Services().AnnounceChange(field)
+	if history := announcer.History(); len(history) != 0 {
+		t.Fatalf("expected no announcements, got %v", history)
+	}
+
+	app.Services().Announcer().Announce("Saved", accessibility.PriorityPolite)
+	if history := announcer.History(); len(history) != 1 {
+		t.Fatalf("explicit announcements should still go through, got %v", history)
+	}
+}
@@ -5,7 +5,8 @@ import (
 	"time"
 )
 
-// After posts a message after a delay.
+// After posts a message after a delay, measured on the clock from
+// ClockFromContext.
 func After(delay time.Duration, msg Message) Effect {
 	return Effect{
 		Run: func(ctx context.Context, post PostFunc) {
@@ -15,38 +16,37 @@ func After(delay time.Duration, msg Message) Effect {
 				}
 				return
 			}
-			timer := time.NewTimer(delay)
-			defer timer.Stop()
+			fired := make(chan struct{})
+			timer := ClockFromContext(ctx).AfterFunc(delay, func() {
+				if ctx.Err() == nil {
+					post(msg)
+				}
+				close(fired)
+			})
 			select {
 			case <-ctx.Done():
-				return
-			case <-timer.C:
-				post(msg)
+				timer.Stop()
+			case <-fired:
 			}
 		},
 	}
 }
 
-// Every posts messages on a fixed interval.
-// Returning nil from fn skips posting.
+// Every posts messages on a fixed interval of the clock from
+// ClockFromContext. Returning nil from fn skips posting.
 func Every(interval time.Duration, fn func(time.Time) Message) Effect {
 	return Effect{
 		Run: func(ctx context.Context, post PostFunc) {
 			if interval <= 0 || fn == nil || post == nil {
 				return
 			}
-			ticker := time.NewTicker(interval)
-			defer ticker.Stop()
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case now := <-ticker.C:
-					if msg := fn(now); msg != nil {
-						post(msg)
-					}
+			stop := clockEvery(ctx, ClockFromContext(ctx), interval, func(now time.Time) {
+				if msg := fn(now); msg != nil {
+					post(msg)
 				}
-			}
+			})
+			<-ctx.Done()
+			stop()
 		},
 	}
 }
@@ -19,26 +19,59 @@ type FlexChild struct {
 	Grow   float64 // How much to grow (0 = fixed, 1+ = proportional)
 	Shrink float64 // How much to shrink (0 = fixed, 1+ = proportional)
 	Basis  int     // Base size (-1 = use measured size)
-}
-
-// Fixed creates a child that doesn't grow or shrink.
-func Fixed(w Widget) FlexChild {
-	return FlexChild{Widget: w, Grow: 0, Shrink: 0, Basis: -1}
+	Min    int     // Smallest main-axis size (0 = no minimum)
+	Max    int     // Largest main-axis size (0 = no maximum)
 }
 
 // Flexible creates a child that grows with the given factor.
 func Flexible(w Widget, grow float64) FlexChild {
-	return FlexChild{Widget: w, Grow: grow, Shrink: 1, Basis: -1}
+	return FlexibleShrink(w, grow, 1)
+}
+
+// FlexibleShrink creates a child that takes a grow share of leftover space
+// and gives up a shrink share, weighted by its size, when space is tight.
+func FlexibleShrink(w Widget, grow, shrink float64) FlexChild {
+	return FlexChild{Widget: w, Grow: grow, Shrink: shrink, Basis: -1}
+}
+
+// Constrained creates a child that fills available space while staying
+// between min and max cells on the main axis. A max of 0 means no maximum.
+func Constrained(w Widget, min, max int) FlexChild {
+	return Expanded(w).Constrain(min, max)
+}
+
+// Constrain returns a copy of c limited to min..max cells on the main axis.
+// A max of 0 means no maximum.
+func (c FlexChild) Constrain(min, max int) FlexChild {
+	c.Min = min
+	c.Max = max
+	return c
+}
+
+// Fixed creates a child that doesn't grow or shrink.
+func Fixed(w Widget) FlexChild {
+	return FlexibleShrink(w, 0, 0)
 }
 
 // Expanded creates a child that grows to fill available space (Grow=1).
 func Expanded(w Widget) FlexChild {
-	return FlexChild{Widget: w, Grow: 1, Shrink: 1, Basis: -1}
+	return Flexible(w, 1)
 }
 
 // Sized creates a child with a fixed basis size.
 func Sized(w Widget, basis int) FlexChild {
-	return FlexChild{Widget: w, Grow: 0, Shrink: 0, Basis: basis}
+	c := Fixed(w)
+	c.Basis = basis
+	return c
+}
+
+// clamp limits size to the child's min and max. Min wins when they
+// conflict.
+func (c FlexChild) clamp(size int) int {
+	if c.Max > 0 && size > c.Max {
+		size = c.Max
+	}
+	return max(size, c.Min, 0)
 }
 
 // Flex is a container that lays out children along an axis.
@@ -111,6 +144,11 @@ func (f *Flex) Measure(constraints Constraints) Size {
 		} else {
 			childSizes[i] = child.Widget.Measure(childConstraints)
 		}
+		if f.Direction == Column {
+			childSizes[i].Height = child.clamp(childSizes[i].Height)
+		} else {
+			childSizes[i].Width = child.clamp(childSizes[i].Width)
+		}
 
 		if f.Direction == Column {
 			totalMain += childSizes[i].Height
@@ -149,6 +187,7 @@ func (f *Flex) Layout(bounds Rect) {
 	growWeights := make([]float64, len(f.Children))
 	shrinkWeights := make([]float64, len(f.Children))
 	totalBase := 0
+	shrinkRoom := make([]int, len(f.Children))
 	totalGrow := 0.0
 	totalShrink := 0.0
 
@@ -166,7 +205,7 @@ func (f *Flex) Layout(bounds Rect) {
 			childSizes[i] = child.Widget.Measure(childConstraints)
 		}
 
-		mainSize := f.mainSize(childSizes[i])
+		mainSize := child.clamp(f.mainSize(childSizes[i]))
 		baseSizes[i] = mainSize
 		totalBase += mainSize
 		if child.Grow > 0 {
@@ -177,6 +216,7 @@ func (f *Flex) Layout(bounds Rect) {
 			shrinkWeights[i] = child.Shrink * float64(mainSize)
 			totalShrink += shrinkWeights[i]
 		}
+		shrinkRoom[i] = mainSize - child.Min
 	}
 
 	// Add gaps to fixed space
@@ -190,12 +230,11 @@ func (f *Flex) Layout(bounds Rect) {
 	sizes := make([]int, len(f.Children))
 	copy(sizes, baseSizes)
 	if available > 0 && totalGrow > 0 {
-		extras := distributeFlexSpace(available, growWeights)
-		for i := range sizes {
-			sizes[i] += extras[i]
-		}
+		f.grow(sizes, growWeights, available)
 	} else if available < 0 && totalShrink > 0 {
-		shrinks := distributeFlexShrink(-available, shrinkWeights, sizes)
+		// Children never shrink below Min; if the minimums don't fit, the
+		// layout overflows the container.
+		shrinks := distributeFlexShrink(-available, shrinkWeights, shrinkRoom)
 		for i := range sizes {
 			sizes[i] -= shrinks[i]
 			if sizes[i] < 0 {
@@ -235,6 +274,34 @@ func (f *Flex) Layout(bounds Rect) {
 	}
 }
 
+// grow hands available cells to children by weight. A child that reaches
+// its Max is frozen and its surplus goes to the others; space nobody can
+// take is left empty.
+func (f *Flex) grow(sizes []int, weights []float64, available int) {
+	weights = append([]float64(nil), weights...)
+	for available > 0 {
+		extras := distributeFlexSpace(available, weights)
+		used := 0
+		frozen := false
+		for i, extra := range extras {
+			if extra <= 0 {
+				continue
+			}
+			if limit := f.Children[i].Max; limit > 0 && sizes[i]+extra >= limit {
+				extra = max(limit-sizes[i], 0)
+				weights[i] = 0
+				frozen = true
+			}
+			sizes[i] += extra
+			used += extra
+		}
+		available -= used
+		if !frozen {
+			return
+		}
+	}
+}
+
 func distributeFlexSpace(available int, weights []float64) []int {
 	out := make([]int, len(weights))
 	if available <= 0 {
@@ -448,3 +448,68 @@ func TestFlex_MeasureVBox(t *testing.T) {
 		t.Errorf("VBox Measure height = %d, want 50", size.Height)
 	}
 }
+
+func TestHBox_FlexibleShrink(t *testing.T) {
+	a := newTestWidget(10, 1)
+	b := newTestWidget(10, 1)
+	hbox := HBox(Flexible(a, 1), FlexibleShrink(b, 3, 0))
+
+	hbox.Layout(Rect{0, 0, 40, 1}) // 20 extra: a +5, b +15
+	if a.bounds.Width != 15 || b.bounds.Width != 25 {
+		t.Errorf("grow widths = %d, %d, want 15, 25", a.bounds.Width, b.bounds.Width)
+	}
+
+	hbox.Layout(Rect{0, 0, 14, 1}) // 6 short: only a shrinks
+	if a.bounds.Width != 4 || b.bounds.Width != 10 {
+		t.Errorf("shrink widths = %d, %d, want 4, 10", a.bounds.Width, b.bounds.Width)
+	}
+}
+
+func TestHBox_ConstrainedMaxRedistributes(t *testing.T) {
+	capped := newTestWidget(0, 1)
+	free := newTestWidget(0, 1)
+	hbox := HBox(Constrained(capped, 0, 10), Expanded(free))
+	hbox.Layout(Rect{0, 0, 50, 1})
+
+	if capped.bounds.Width != 10 {
+		t.Errorf("capped width = %d, want 10", capped.bounds.Width)
+	}
+	if free.bounds.Width != 40 || free.bounds.X != 10 {
+		t.Errorf("free bounds = %+v, want X=10 Width=40", free.bounds)
+	}
+}
+
+func TestHBox_UnderConstrainedLeavesSpace(t *testing.T) {
+	a := newTestWidget(2, 1)
+	b := newTestWidget(2, 1)
+	hbox := HBox(Constrained(a, 0, 8), Flexible(b, 2).Constrain(0, 12))
+	hbox.Layout(Rect{0, 0, 100, 1})
+
+	if a.bounds.Width != 8 || b.bounds.Width != 12 {
+		t.Errorf("widths = %d, %d, want both at max (8, 12)", a.bounds.Width, b.bounds.Width)
+	}
+}
+
+func TestVBox_OverConstrainedKeepsMinimums(t *testing.T) {
+	a := newTestWidget(10, 20)
+	b := newTestWidget(10, 20)
+	vbox := VBox(Constrained(a, 15, 0), Constrained(b, 5, 0))
+
+	vbox.Layout(Rect{0, 0, 10, 24}) // 16 short; a can give 5, b 15
+	if a.bounds.Height+b.bounds.Height != 24 || a.bounds.Height < 15 || b.bounds.Height < 5 {
+		t.Errorf("heights = %d, %d, want 24 total within minimums", a.bounds.Height, b.bounds.Height)
+	}
+
+	vbox.Layout(Rect{0, 0, 10, 12}) // minimums need 20: overflow
+	if a.bounds.Height != 15 || b.bounds.Height != 5 || b.bounds.Y != 15 {
+		t.Errorf("a=%+v b=%+v, want heights at minimums and overflow past 12", a.bounds, b.bounds)
+	}
+}
+
+func TestFlex_MeasureAppliesMinMax(t *testing.T) {
+	hbox := HBox(Constrained(newTestWidget(30, 1), 0, 10), Constrained(newTestWidget(2, 1), 6, 0))
+	size := hbox.Measure(Loose(100, 10))
+	if size.Width != 16 {
+		t.Errorf("measured width = %d, want 16", size.Width)
+	}
+}
@@ -0,0 +1,212 @@
+package runtime
+
+import "strconv"
+
+// FlowAlign positions children within a flow line.
+type FlowAlign int
+
+const (
+	FlowStart  FlowAlign = iota // Left (or top, across a line)
+	FlowCenter                  // Centered
+	FlowEnd                     // Right (or bottom, across a line)
+)
+
+// Flow lays children out left to right, wrapping to a new line when the
+// next child doesn't fit. Children keep their measured size; a child wider
+// than the container gets a line of its own and is clipped.
+type Flow struct {
+	Children   []Widget
+	HGap       int       // Space between children on a line
+	VGap       int       // Space between lines
+	Align      FlowAlign // Placement of each line within the width
+	CrossAlign FlowAlign // Placement of short children within a line
+
+	// Cached layout
+	bounds      Rect
+	childBounds []Rect
+}
+
+// NewFlow creates a flow container.
+func NewFlow(children ...Widget) *Flow {
+	return &Flow{Children: children}
+}
+
+// Add appends a child to the flow.
+func (f *Flow) Add(child Widget) {
+	if child == nil {
+		return
+	}
+	f.Children = append(f.Children, child)
+}
+
+// SetGap sets the space between children (h) and between lines (v).
+func (f *Flow) SetGap(h, v int) *Flow {
+	f.HGap = max(h, 0)
+	f.VGap = max(v, 0)
+	return f
+}
+
+// SetAlign sets how each line is placed within the container width.
+func (f *Flow) SetAlign(align FlowAlign) *Flow {
+	f.Align = align
+	return f
+}
+
+// SetCrossAlign sets how children shorter than their line are placed.
+func (f *Flow) SetCrossAlign(align FlowAlign) *Flow {
+	f.CrossAlign = align
+	return f
+}
+
+// flowLine is a run of children that share a row.
+type flowLine struct {
+	start, end int // Children[start:end]
+	width      int
+	height     int
+}
+
+// wrap measures the children against width and breaks them into lines.
+func (f *Flow) wrap(width int) ([]Size, []flowLine) {
+	sizes := make([]Size, len(f.Children))
+	var lines []flowLine
+	line := flowLine{}
+	for i, child := range f.Children {
+		size := child.Measure(Loose(width, maxInt))
+		size.Width = min(size.Width, width)
+		sizes[i] = size
+		if i > line.start && line.width+f.HGap+size.Width > width {
+			lines = append(lines, line)
+			line = flowLine{start: i}
+		}
+		if i > line.start {
+			line.width += f.HGap
+		}
+		line.width += size.Width
+		line.height = max(line.height, size.Height)
+		line.end = i + 1
+	}
+	if line.end > line.start {
+		lines = append(lines, line)
+	}
+	return sizes, lines
+}
+
+// Measure wraps the children against the available width and reports the
+// width of the widest line and the total height of all lines.
+func (f *Flow) Measure(constraints Constraints) Size {
+	if len(f.Children) == 0 {
+		return constraints.MinSize()
+	}
+	_, lines := f.wrap(constraints.MaxWidth)
+	width, height := 0, 0
+	for i, line := range lines {
+		width = max(width, line.width)
+		if i > 0 {
+			height += f.VGap
+		}
+		height += line.height
+	}
+	return constraints.Constrain(Size{Width: width, Height: height})
+}
+
+// Layout wraps the children against the bounds width and positions them.
+func (f *Flow) Layout(bounds Rect) {
+	f.bounds = bounds
+	f.childBounds = make([]Rect, len(f.Children))
+	if len(f.Children) == 0 {
+		return
+	}
+	sizes, lines := f.wrap(bounds.Width)
+	y := bounds.Y
+	for _, line := range lines {
+		x := bounds.X + alignOffset(f.Align, bounds.Width-line.width)
+		for i := line.start; i < line.end; i++ {
+			size := sizes[i]
+			childBounds := Rect{
+				X:      x,
+				Y:      y + alignOffset(f.CrossAlign, line.height-size.Height),
+				Width:  size.Width,
+				Height: size.Height,
+			}
+			f.childBounds[i] = childBounds
+			f.Children[i].Layout(childBounds)
+			x += size.Width + f.HGap
+		}
+		y += line.height + f.VGap
+	}
+}
+
+// alignOffset returns the offset that places content within free cells.
+func alignOffset(align FlowAlign, free int) int {
+	if free <= 0 {
+		return 0
+	}
+	switch align {
+	case FlowCenter:
+		return free / 2
+	case FlowEnd:
+		return free
+	default:
+		return 0
+	}
+}
+
+// Bounds returns the assigned bounds for the flow container.
+func (f *Flow) Bounds() Rect {
+	return f.bounds
+}
+
+// ChildWidgets returns the children in visual order, so focus moves left to
+// right and then down.
+func (f *Flow) ChildWidgets() []Widget {
+	if len(f.Children) == 0 {
+		return nil
+	}
+	return append([]Widget(nil), f.Children...)
+}
+
+// PathSegment returns a debug path segment for the given child.
+func (f *Flow) PathSegment(child Widget) string {
+	if f == nil {
+		return "Flow"
+	}
+	for i, entry := range f.Children {
+		if entry == child {
+			return "Flow[" + strconv.Itoa(i) + "]"
+		}
+	}
+	return "Flow"
+}
+
+// Render draws all children.
+func (f *Flow) Render(ctx RenderContext) {
+	for i, child := range f.Children {
+		if i >= len(f.childBounds) {
+			continue
+		}
+		bounds := f.childBounds[i]
+		if bounds.Width <= 0 || bounds.Height <= 0 {
+			continue
+		}
+		if childCtx, ok := ctx.SubVisible(bounds); ok {
+			child.Render(childCtx)
+		}
+	}
+}
+
+// HandleMessage dispatches to children.
+// Messages go to all children; first handler wins.
+func (f *Flow) HandleMessage(msg Message) HandleResult {
+	for _, child := range f.Children {
+		result := child.HandleMessage(msg)
+		if result.Handled {
+			return result
+		}
+	}
+	return Unhandled()
+}
+
+var (
+	_ Widget        = (*Flow)(nil)
+	_ ChildProvider = (*Flow)(nil)
+)
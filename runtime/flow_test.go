@@ -0,0 +1,78 @@
+package runtime
+
+import "testing"
+
+func TestFlow_WrapsToNextLine(t *testing.T) {
+	a := newTestWidget(4, 1)
+	b := newTestWidget(4, 2)
+	c := newTestWidget(4, 1)
+	flow := NewFlow(a, b)
+	flow.Add(c)
+	flow.SetGap(1, 1)
+
+	size := flow.Measure(Loose(10, 100))
+	if size != (Size{Width: 9, Height: 4}) {
+		t.Fatalf("Measure = %+v, want 9x4", size)
+	}
+
+	flow.Layout(Rect{X: 2, Y: 3, Width: 10, Height: 10})
+	if a.bounds != (Rect{X: 2, Y: 3, Width: 4, Height: 1}) {
+		t.Errorf("a bounds = %+v", a.bounds)
+	}
+	if b.bounds != (Rect{X: 7, Y: 3, Width: 4, Height: 2}) {
+		t.Errorf("b bounds = %+v", b.bounds)
+	}
+	// The first line is as tall as b, plus the vertical gap.
+	if c.bounds != (Rect{X: 2, Y: 6, Width: 4, Height: 1}) {
+		t.Errorf("c bounds = %+v", c.bounds)
+	}
+}
+
+func TestFlow_Alignment(t *testing.T) {
+	a := newTestWidget(4, 1)
+	b := newTestWidget(2, 3)
+	flow := NewFlow(a, b).SetAlign(FlowEnd).SetCrossAlign(FlowCenter)
+
+	flow.Layout(Rect{Width: 10, Height: 3})
+	if a.bounds != (Rect{X: 4, Y: 1, Width: 4, Height: 1}) {
+		t.Errorf("a bounds = %+v", a.bounds)
+	}
+	if b.bounds != (Rect{X: 8, Y: 0, Width: 2, Height: 3}) {
+		t.Errorf("b bounds = %+v", b.bounds)
+	}
+
+	flow.SetAlign(FlowCenter).SetCrossAlign(FlowStart)
+	flow.Layout(Rect{Width: 10, Height: 3})
+	if a.bounds.X != 2 || a.bounds.Y != 0 {
+		t.Errorf("centered a bounds = %+v", a.bounds)
+	}
+}
+
+func TestFlow_OversizedChildGetsOwnLine(t *testing.T) {
+	a := newTestWidget(3, 1)
+	wide := newTestWidget(20, 1)
+	flow := NewFlow(a, wide)
+
+	flow.Layout(Rect{Width: 8, Height: 4})
+	if wide.bounds != (Rect{X: 0, Y: 1, Width: 8, Height: 1}) {
+		t.Fatalf("wide bounds = %+v", wide.bounds)
+	}
+}
+
+func TestFlow_FocusOrderAndMessages(t *testing.T) {
+	first := &handlingWidget{}
+	second := &handlingWidget{shouldHandle: true}
+	third := &handlingWidget{}
+	flow := NewFlow(first, second, third)
+
+	children := flow.ChildWidgets()
+	if len(children) != 3 || children[0] != first || children[2] != third {
+		t.Fatalf("ChildWidgets = %v, want visual order", children)
+	}
+	if result := flow.HandleMessage(KeyMsg{}); !result.Handled {
+		t.Fatal("expected second child to handle the message")
+	}
+	if third.received {
+		t.Error("third child should not receive a handled message")
+	}
+}
@@ -1,11 +1,15 @@
 package runtime
 
+import "time"
+
 // FocusScope manages focus within a layer/context.
 // Each modal layer has its own FocusScope, so overlays trap focus.
 type FocusScope struct {
-	widgets  []Focusable
-	current  int // Index of focused widget, -1 if none
-	onChange func(prev Focusable, next Focusable)
+	widgets   []Focusable
+	current   int // Index of focused widget, -1 if none
+	focusedAt time.Time
+	clock     Clock
+	onChange  func(prev Focusable, next Focusable)
 }
 
 // NewFocusScope creates a new empty focus scope.
@@ -21,6 +25,22 @@ func (f *FocusScope) SetOnChange(fn func(prev Focusable, next Focusable)) {
 	f.onChange = fn
 }
 
+// SetClock sets the clock focus times are read from. A nil clock uses
+// SystemClock.
+func (f *FocusScope) SetClock(clock Clock) {
+	if f == nil {
+		return
+	}
+	f.clock = clock
+}
+
+func (f *FocusScope) now() time.Time {
+	if f.clock == nil {
+		return SystemClock.Now()
+	}
+	return f.clock.Now()
+}
+
 // Register adds a focusable widget to the scope.
 // The first registered widget receives focus if nothing is focused.
 func (f *FocusScope) Register(w Focusable) {
@@ -35,6 +55,7 @@ func (f *FocusScope) Register(w Focusable) {
 	// Auto-focus first widget
 	if f.current == -1 && w.CanFocus() {
 		f.current = len(f.widgets) - 1
+		f.focusedAt = f.now()
 		w.Focus()
 	}
 }
@@ -48,6 +69,7 @@ func (f *FocusScope) Unregister(w Focusable) {
 			if f.current == i {
 				w.Blur()
 				f.current = -1
+				f.focusedAt = time.Time{}
 			} else if f.current > i {
 				f.current--
 			}
@@ -157,6 +179,7 @@ func (f *FocusScope) ClearFocus() {
 		prev.Blur()
 	}
 	f.current = -1
+	f.focusedAt = time.Time{}
 	if f.onChange != nil {
 		f.onChange(prev, nil)
 	}
@@ -172,11 +195,39 @@ func (f *FocusScope) Reset() {
 	f.current = -1
 }
 
+func (f *FocusScope) contains(w Focusable) bool {
+	for _, existing := range f.widgets {
+		if existing == w {
+			return true
+		}
+	}
+	return false
+}
+
+// FocusedAt returns when the current widget received focus, or the zero
+// time when nothing is focused.
+func (f *FocusScope) FocusedAt() time.Time {
+	if f == nil {
+		return time.Time{}
+	}
+	return f.focusedAt
+}
+
 // Count returns the number of registered widgets.
 func (f *FocusScope) Count() int {
 	return len(f.widgets)
 }
 
+// canFocus reports whether any registered widget can take focus.
+func (f *FocusScope) canFocus() bool {
+	for _, w := range f.widgets {
+		if w != nil && w.CanFocus() {
+			return true
+		}
+	}
+	return false
+}
+
 // focusIndex changes focus to the widget at index i.
 func (f *FocusScope) focusIndex(i int) bool {
 	if i == f.current {
@@ -192,10 +243,12 @@ func (f *FocusScope) focusIndex(i int) bool {
 
 	// Focus new
 	f.current = i
+	f.focusedAt = time.Time{}
 	var next Focusable
 	if i >= 0 && i < len(f.widgets) {
 		next = f.widgets[i]
 		next.Focus()
+		f.focusedAt = f.now()
 	}
 	if f.onChange != nil {
 		f.onChange(prev, next)
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"testing"
+	"time"
 )
 
 // focusableWidget is a test widget that can receive focus.
@@ -196,6 +197,28 @@ func TestFocusScope_SetFocus(t *testing.T) {
 	}
 }
 
+func TestFocusScope_FocusedAt(t *testing.T) {
+	fs := NewFocusScope()
+	if !fs.FocusedAt().IsZero() {
+		t.Fatal("expected no focus time before anything is focused")
+	}
+
+	clock := NewManualClock()
+	fs.SetClock(clock)
+	fs.Register(newFocusable("w1"))
+	w2 := newFocusable("w2")
+	fs.Register(w2)
+	clock.Advance(time.Second)
+	fs.SetFocus(w2)
+	if at := fs.FocusedAt(); !at.Equal(clock.Now()) {
+		t.Fatalf("FocusedAt = %v, want the time focus moved (%v)", at, clock.Now())
+	}
+	fs.ClearFocus()
+	if !fs.FocusedAt().IsZero() {
+		t.Fatal("expected clearing focus to reset the focus time")
+	}
+}
+
 func TestFocusScope_SetFocusSameWidget(t *testing.T) {
 	fs := NewFocusScope()
 	w := newFocusable("w")
@@ -39,6 +39,11 @@ type MouseMsg struct {
 	Alt    bool
 	Ctrl   bool
 	Shift  bool
+	// Count numbers repeated clicks on presses and releases: 1 for a
+	// single click, 2 for a double click, 3 for a triple click. The app
+	// sets it from AppConfig.DoubleClickInterval; it is zero for moves and
+	// the wheel.
+	Count int
 }
 
 func (MouseMsg) isMessage() {}
@@ -0,0 +1,143 @@
+package runtime
+
+import "sort"
+
+// Breakpoint builds the widget tree a Responsive shows at MinWidth columns
+// and wider.
+type Breakpoint struct {
+	Name     string
+	MinWidth int
+	Build    func() Widget
+}
+
+// Responsive switches between widget trees as its width crosses
+// breakpoints, e.g. side-by-side panes on wide terminals and a stack on
+// narrow ones. The tree is rebuilt only when the breakpoint changes.
+//
+// Builders may return widget instances they have built before. Widgets that
+// appear in both the old and new tree stay bound and mounted, and keep
+// focus, so their state (input text, scroll position, selection) survives
+// the switch.
+type Responsive struct {
+	viewHost
+	breakpoints []Breakpoint
+	active      int
+	child       Widget
+}
+
+// NewResponsive creates a responsive container. Below the smallest MinWidth
+// the smallest breakpoint is used.
+func NewResponsive(breakpoints []Breakpoint) *Responsive {
+	sorted := append([]Breakpoint(nil), breakpoints...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MinWidth < sorted[j].MinWidth
+	})
+	return &Responsive{breakpoints: sorted, active: -1}
+}
+
+// BreakpointName returns the name of the active breakpoint, or "" before
+// the first layout.
+func (r *Responsive) BreakpointName() string {
+	if r == nil || r.active < 0 {
+		return ""
+	}
+	return r.breakpoints[r.active].Name
+}
+
+// Child returns the widget tree built for the active breakpoint.
+func (r *Responsive) Child() Widget {
+	if r == nil {
+		return nil
+	}
+	return r.child
+}
+
+// breakpointFor returns the index of the breakpoint for width.
+func (r *Responsive) breakpointFor(width int) int {
+	if len(r.breakpoints) == 0 {
+		return -1
+	}
+	index := 0
+	for i, bp := range r.breakpoints {
+		if width >= bp.MinWidth {
+			index = i
+		}
+	}
+	return index
+}
+
+// Measure fills the available space.
+func (r *Responsive) Measure(constraints Constraints) Size {
+	return constraints.MaxSize()
+}
+
+// Layout rebuilds the tree if bounds moved across a breakpoint and lays it
+// out.
+func (r *Responsive) Layout(bounds Rect) {
+	r.bounds = bounds
+	if index := r.breakpointFor(bounds.Width); index != r.active {
+		r.active = index
+		var next Widget
+		if build := r.breakpoints[index].Build; build != nil {
+			next = build()
+		}
+		prev := r.child
+		r.child = next
+		r.replace(prev, next)
+		if next != nil {
+			next.Layout(bounds)
+		}
+		// Layout is already under way; just pick up new focusables.
+		r.services.childrenChanged(false)
+		return
+	}
+	if r.child != nil {
+		r.child.Layout(bounds)
+	}
+}
+
+// Render draws the active tree.
+func (r *Responsive) Render(ctx RenderContext) {
+	if r == nil {
+		return
+	}
+	RenderChild(ctx, r.child)
+}
+
+// HandleMessage forwards messages to the active tree.
+func (r *Responsive) HandleMessage(msg Message) HandleResult {
+	if r == nil || r.child == nil {
+		return Unhandled()
+	}
+	return r.child.HandleMessage(msg)
+}
+
+// ChildWidgets returns the active tree.
+func (r *Responsive) ChildWidgets() []Widget {
+	if r == nil || r.child == nil {
+		return nil
+	}
+	return []Widget{r.child}
+}
+
+// StyleType returns the selector type name.
+func (r *Responsive) StyleType() string {
+	return "Responsive"
+}
+
+// StyleClasses returns the active breakpoint name, so stylesheets can
+// target e.g. `Responsive.narrow Button`.
+func (r *Responsive) StyleClasses() []string {
+	if name := r.BreakpointName(); name != "" {
+		return []string{name}
+	}
+	return nil
+}
+
+var (
+	_ Widget             = (*Responsive)(nil)
+	_ ChildProvider      = (*Responsive)(nil)
+	_ Lifecycle          = (*Responsive)(nil)
+	_ Bindable           = (*Responsive)(nil)
+	_ StyleClassProvider = (*Responsive)(nil)
+)
@@ -0,0 +1,77 @@
+package runtime
+
+import "testing"
+
+// lifecycleFocusWidget is a focusable widget that counts mounts.
+type lifecycleFocusWidget struct {
+	mockWidget
+	mounted   int
+	unmounted int
+}
+
+func (w *lifecycleFocusWidget) Mount()   { w.mounted++ }
+func (w *lifecycleFocusWidget) Unmount() { w.unmounted++ }
+
+func TestResponsive_RebuildsOnlyAcrossBreakpoints(t *testing.T) {
+	builds := map[string]int{}
+	build := func(name string) func() Widget {
+		return func() Widget {
+			builds[name]++
+			return newTestWidget(1, 1)
+		}
+	}
+	responsive := NewResponsive([]Breakpoint{
+		{Name: "wide", MinWidth: 80, Build: build("wide")},
+		{Name: "narrow", MinWidth: 0, Build: build("narrow")},
+	})
+	if responsive.BreakpointName() != "" {
+		t.Fatalf("expected no breakpoint before layout")
+	}
+
+	responsive.Layout(Rect{Width: 100, Height: 10})
+	responsive.Layout(Rect{Width: 90, Height: 10})
+	if responsive.BreakpointName() != "wide" || builds["wide"] != 1 {
+		t.Fatalf("breakpoint = %q wide builds = %d", responsive.BreakpointName(), builds["wide"])
+	}
+	responsive.Layout(Rect{Width: 40, Height: 10})
+	responsive.Layout(Rect{Width: 30, Height: 10})
+	if responsive.BreakpointName() != "narrow" || builds["narrow"] != 1 {
+		t.Fatalf("breakpoint = %q narrow builds = %d", responsive.BreakpointName(), builds["narrow"])
+	}
+	if got := responsive.Child().(*testWidget).bounds; got != (Rect{Width: 30, Height: 10}) {
+		t.Fatalf("child bounds = %+v", got)
+	}
+	if classes := responsive.StyleClasses(); len(classes) != 1 || classes[0] != "narrow" {
+		t.Fatalf("StyleClasses = %v, want [narrow]", classes)
+	}
+}
+
+func TestResponsive_ReusedWidgetsKeepState(t *testing.T) {
+	list := &lifecycleFocusWidget{}
+	detail := &lifecycleFocusWidget{}
+	responsive := NewResponsive([]Breakpoint{
+		{Name: "narrow", Build: func() Widget { return VBox(Fixed(list), Fixed(detail)) }},
+		{Name: "wide", MinWidth: 60, Build: func() Widget { return HBox(Expanded(list), Expanded(detail)) }},
+	})
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(80, 24)
+	app.screen.SetServices(app.Services())
+	app.screen.SetRoot(responsive)
+	app.screen.SetAutoRegisterFocus(true)
+	app.screen.BaseFocusScope().SetFocus(detail)
+
+	app.screen.Resize(40, 24)
+	if responsive.BreakpointName() != "narrow" {
+		t.Fatalf("breakpoint = %q, want narrow", responsive.BreakpointName())
+	}
+	if list.unmounted != 0 || detail.unmounted != 0 || detail.mounted != 1 {
+		t.Fatalf("reused widgets were remounted: list unmounted=%d detail mounted=%d unmounted=%d",
+			list.unmounted, detail.mounted, detail.unmounted)
+	}
+	if !detail.focused || list.focused {
+		t.Fatalf("expected focus to stay on detail")
+	}
+	if detail.bounds.Y != 5 {
+		t.Fatalf("detail bounds = %+v, want stacked below list", detail.bounds)
+	}
+}
@@ -0,0 +1,128 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// RestoreEnv is set by `fluffy dev` on a restarted child when a snapshot of
+// the previous process is waiting to be delivered over the agent socket.
+const RestoreEnv = "FLUFFYUI_DEV_RESTORE"
+
+// DefaultRestoreTimeout bounds how long RestoreFromAgent waits for a snapshot.
+const DefaultRestoreTimeout = 3 * time.Second
+
+// ErrRestoreTimeout is returned when no snapshot arrives in time.
+var ErrRestoreTimeout = errors.New("restore: timed out waiting for snapshot")
+
+// Snapshot serializes the Persistable state of the widget tree. When the app
+// is running the state is captured on the event loop.
+func (a *App) Snapshot() ([]byte, error) {
+	if a == nil {
+		return nil, errors.New("app is nil")
+	}
+	var data []byte
+	capture := func(app *App) error {
+		snapshot, err := CaptureState(app.root)
+		if err != nil {
+			return err
+		}
+		data, err = json.Marshal(snapshot)
+		if err != nil {
+			return fmt.Errorf("encode snapshot: %w", err)
+		}
+		return nil
+	}
+	if a.running.Load() {
+		if err := a.Call(context.Background(), capture); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+	if err := capture(a); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Restore applies a snapshot produced by Snapshot to the widget tree. When
+// the app is running the state is applied on the event loop.
+func (a *App) Restore(data []byte) error {
+	if a == nil {
+		return errors.New("app is nil")
+	}
+	var snapshot PersistSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return fmt.Errorf("decode snapshot: %w", err)
+	}
+	apply := func(app *App) error {
+		err := ApplyState(app.root, snapshot)
+		app.Invalidate()
+		return err
+	}
+	if a.running.Load() {
+		return a.Call(context.Background(), apply)
+	}
+	return apply(a)
+}
+
+// DeliverRestore hands a snapshot to a pending RestoreFromAgent call, or
+// applies it directly when nothing is waiting. It is used by the agent
+// server's "restore" action.
+func (a *App) DeliverRestore(data []byte) error {
+	if a == nil {
+		return errors.New("app is nil")
+	}
+	if !a.running.Load() && a.restoreCh != nil {
+		select {
+		case a.restoreCh <- data:
+			return nil
+		default:
+		}
+	}
+	return a.Restore(data)
+}
+
+// RestoreFromAgent waits for a snapshot delivered through the agent's
+// "restore" action and applies it. It returns immediately unless RestoreEnv
+// is set, so apps can call it unconditionally before Run. The agent server
+// must already be listening.
+func (a *App) RestoreFromAgent() error {
+	return a.RestoreFromAgentTimeout(DefaultRestoreTimeout)
+}
+
+// RestoreFromAgentTimeout is RestoreFromAgent with an explicit timeout.
+func (a *App) RestoreFromAgentTimeout(timeout time.Duration) error {
+	if a == nil {
+		return errors.New("app is nil")
+	}
+	if !restoreRequested() || a.restoreCh == nil {
+		return nil
+	}
+	if timeout <= 0 {
+		timeout = DefaultRestoreTimeout
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case data := <-a.restoreCh:
+		return a.Restore(data)
+	case <-timer.C:
+		return ErrRestoreTimeout
+	}
+}
+
+func restoreRequested() bool {
+	value := strings.TrimSpace(os.Getenv(RestoreEnv))
+	switch strings.ToLower(value) {
+	case "", "0", "false", "off":
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,57 @@
+package runtime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAppSnapshotRestore(t *testing.T) {
+	child := &persistWidget{key: "child", state: "draft"}
+	app := NewApp(AppConfig{Root: &persistWidget{key: "root", state: "a", children: []Widget{child}}})
+
+	data, err := app.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	child.state = ""
+	if err := app.Restore(data); err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if child.state != "draft" {
+		t.Fatalf("child state = %q, want draft", child.state)
+	}
+	if err := app.Restore([]byte("{")); err == nil {
+		t.Fatal("expected error for malformed snapshot")
+	}
+}
+
+func TestRestoreFromAgent(t *testing.T) {
+	source := NewApp(AppConfig{Root: &persistWidget{key: "field", state: "hello"}})
+	data, err := source.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+
+	target := &persistWidget{key: "field"}
+	app := NewApp(AppConfig{Root: target})
+	if err := app.RestoreFromAgent(); err != nil {
+		t.Fatalf("restore without %s should be a no-op: %v", RestoreEnv, err)
+	}
+
+	t.Setenv(RestoreEnv, "1")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_ = app.DeliverRestore(data)
+	}()
+	if err := app.RestoreFromAgentTimeout(time.Second); err != nil {
+		t.Fatalf("restore from agent: %v", err)
+	}
+	if target.state != "hello" {
+		t.Fatalf("state = %q, want hello", target.state)
+	}
+
+	if err := app.RestoreFromAgentTimeout(time.Millisecond); !errors.Is(err, ErrRestoreTimeout) {
+		t.Fatalf("err = %v, want ErrRestoreTimeout", err)
+	}
+}
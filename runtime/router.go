@@ -0,0 +1,351 @@
+package runtime
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/backend"
+)
+
+// RouterMsg asks the nearest router to navigate. Post it with
+// Services.Post, or return it with Send, to navigate without holding a
+// router reference. Routers offer the message to their active view first, so
+// the innermost router that understands the action handles it.
+//
+// StackRouter actions:
+//
+//	"pop" or "back"     pop the top view
+//	"push:<route>"      push the view registered as route
+//	"replace:<route>"   replace the top view with route
+//
+// TabRouter actions:
+//
+//	"tab:<title|index>" activate a tab
+//	"next", "prev"      cycle through tabs
+type RouterMsg struct {
+	Action string
+}
+
+func (RouterMsg) isMessage() {}
+
+// StackRouter shows the top of a stack of views.
+type StackRouter struct {
+	viewHost
+	stack  []Widget
+	routes map[string]func() Widget
+}
+
+// NewStackRouter creates an empty stack router.
+func NewStackRouter() *StackRouter {
+	return &StackRouter{}
+}
+
+// SetRoutes registers view constructors for "push:<route>" and
+// "replace:<route>" actions.
+func (r *StackRouter) SetRoutes(routes map[string]func() Widget) {
+	if r == nil {
+		return
+	}
+	r.routes = routes
+}
+
+// Push shows w on top of the current view.
+func (r *StackRouter) Push(w Widget) {
+	if r == nil || w == nil {
+		return
+	}
+	from := r.Top()
+	r.stack = append(r.stack, w)
+	r.swap(from, w, r.bounds)
+}
+
+// Pop returns to the previous view. It returns false when there is no view
+// to go back to; the root view is never popped.
+func (r *StackRouter) Pop() bool {
+	if r == nil || len(r.stack) < 2 {
+		return false
+	}
+	from := r.Top()
+	r.stack[len(r.stack)-1] = nil
+	r.stack = r.stack[:len(r.stack)-1]
+	r.swap(from, r.Top(), r.bounds)
+	return true
+}
+
+// Replace swaps the top view for w, or pushes w onto an empty stack.
+func (r *StackRouter) Replace(w Widget) {
+	if r == nil || w == nil {
+		return
+	}
+	from := r.Top()
+	if from == nil {
+		r.stack = append(r.stack, w)
+	} else {
+		r.stack[len(r.stack)-1] = w
+	}
+	r.swap(from, w, r.bounds)
+}
+
+// Top returns the visible view, or nil when the stack is empty.
+func (r *StackRouter) Top() Widget {
+	if r == nil || len(r.stack) == 0 {
+		return nil
+	}
+	return r.stack[len(r.stack)-1]
+}
+
+// Depth returns the number of views on the stack.
+func (r *StackRouter) Depth() int {
+	if r == nil {
+		return 0
+	}
+	return len(r.stack)
+}
+
+func (r *StackRouter) route(name string) Widget {
+	if build := r.routes[name]; build != nil {
+		return build()
+	}
+	return nil
+}
+
+// Measure fills the available space.
+func (r *StackRouter) Measure(constraints Constraints) Size {
+	return constraints.MaxSize()
+}
+
+// Layout gives the top view the router's bounds.
+func (r *StackRouter) Layout(bounds Rect) {
+	r.bounds = bounds
+	if top := r.Top(); top != nil {
+		top.Layout(bounds)
+	}
+}
+
+// Render draws the top view.
+func (r *StackRouter) Render(ctx RenderContext) {
+	RenderChild(ctx, r.Top())
+}
+
+// HandleMessage sends input to the top view and handles RouterMsg actions
+// the view leaves unhandled.
+func (r *StackRouter) HandleMessage(msg Message) HandleResult {
+	if r == nil {
+		return Unhandled()
+	}
+	if top := r.Top(); top != nil {
+		if result := top.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	nav, ok := msg.(RouterMsg)
+	if !ok {
+		return Unhandled()
+	}
+	verb, arg, _ := strings.Cut(nav.Action, ":")
+	switch verb {
+	case "pop", "back":
+		if r.Pop() {
+			return Handled()
+		}
+	case "push":
+		if w := r.route(arg); w != nil {
+			r.Push(w)
+			return Handled()
+		}
+	case "replace":
+		if w := r.route(arg); w != nil {
+			r.Replace(w)
+			return Handled()
+		}
+	}
+	return Unhandled()
+}
+
+// ChildWidgets returns the top view.
+func (r *StackRouter) ChildWidgets() []Widget {
+	if top := r.Top(); top != nil {
+		return []Widget{top}
+	}
+	return nil
+}
+
+// TabDef is a titled view shown by a TabRouter.
+type TabDef struct {
+	Title  string
+	Widget Widget
+}
+
+// TabRouter shows one of several views, with a one-line tab bar above it.
+type TabRouter struct {
+	viewHost
+	tabs     []TabDef
+	active   int
+	showBar  bool
+	tabSpans [][2]int
+}
+
+// NewTabRouter creates a router over tabs with the first tab active.
+func NewTabRouter(tabs ...TabDef) *TabRouter {
+	return &TabRouter{tabs: tabs, showBar: true}
+}
+
+// SetActive shows the tab at index. Out-of-range indexes are ignored.
+func (r *TabRouter) SetActive(index int) {
+	if r == nil || index < 0 || index >= len(r.tabs) || index == r.active {
+		return
+	}
+	from := r.activeWidget()
+	r.active = index
+	r.swap(from, r.activeWidget(), r.contentBounds())
+}
+
+// Active returns the index of the visible tab.
+func (r *TabRouter) Active() int {
+	if r == nil {
+		return 0
+	}
+	return r.active
+}
+
+// Tabs returns the router's tabs.
+func (r *TabRouter) Tabs() []TabDef {
+	if r == nil {
+		return nil
+	}
+	return r.tabs
+}
+
+// SetTabBar shows or hides the tab bar.
+func (r *TabRouter) SetTabBar(show bool) {
+	if r == nil {
+		return
+	}
+	r.showBar = show
+	r.Layout(r.bounds)
+}
+
+func (r *TabRouter) activeWidget() Widget {
+	if r == nil || r.active >= len(r.tabs) {
+		return nil
+	}
+	return r.tabs[r.active].Widget
+}
+
+func (r *TabRouter) contentBounds() Rect {
+	bounds := r.bounds
+	if r.showBar && bounds.Height > 0 {
+		bounds.Y++
+		bounds.Height--
+	}
+	return bounds
+}
+
+// tabIndex resolves a "tab:" argument by title, then by index.
+func (r *TabRouter) tabIndex(arg string) int {
+	for i, tab := range r.tabs {
+		if strings.EqualFold(tab.Title, arg) {
+			return i
+		}
+	}
+	if i, err := strconv.Atoi(arg); err == nil && i >= 0 && i < len(r.tabs) {
+		return i
+	}
+	return -1
+}
+
+// Measure fills the available space.
+func (r *TabRouter) Measure(constraints Constraints) Size {
+	return constraints.MaxSize()
+}
+
+// Layout places the tab bar on the first row and the active view below.
+func (r *TabRouter) Layout(bounds Rect) {
+	r.bounds = bounds
+	if w := r.activeWidget(); w != nil {
+		w.Layout(r.contentBounds())
+	}
+}
+
+// Render draws the tab bar and the active view.
+func (r *TabRouter) Render(ctx RenderContext) {
+	if r == nil {
+		return
+	}
+	r.tabSpans = r.tabSpans[:0]
+	if r.showBar && r.bounds.Height > 0 {
+		x := r.bounds.X
+		for i, tab := range r.tabs {
+			label := " " + tab.Title + " "
+			style := backend.DefaultStyle()
+			if i == r.active {
+				style = style.Reverse(true).Bold(true)
+			}
+			ctx.Buffer.SetString(x, r.bounds.Y, label, style)
+			width := backend.StringWidth(label)
+			r.tabSpans = append(r.tabSpans, [2]int{x, x + width})
+			x += width
+		}
+	}
+	RenderChild(ctx, r.activeWidget())
+}
+
+// HandleMessage switches tabs on bar clicks and RouterMsg actions, and
+// sends other input to the active view.
+func (r *TabRouter) HandleMessage(msg Message) HandleResult {
+	if r == nil {
+		return Unhandled()
+	}
+	if mouse, ok := msg.(MouseMsg); ok && r.showBar && mouse.Y == r.bounds.Y &&
+		mouse.Button == MouseLeft && mouse.Action == MousePress {
+		for i, span := range r.tabSpans {
+			if mouse.X >= span[0] && mouse.X < span[1] {
+				r.SetActive(i)
+				return Handled()
+			}
+		}
+	}
+	if w := r.activeWidget(); w != nil {
+		if result := w.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	nav, ok := msg.(RouterMsg)
+	if !ok || len(r.tabs) == 0 {
+		return Unhandled()
+	}
+	verb, arg, _ := strings.Cut(nav.Action, ":")
+	switch verb {
+	case "tab":
+		if i := r.tabIndex(arg); i >= 0 {
+			r.SetActive(i)
+			return Handled()
+		}
+	case "next":
+		r.SetActive((r.active + 1) % len(r.tabs))
+		return Handled()
+	case "prev":
+		r.SetActive((r.active + len(r.tabs) - 1) % len(r.tabs))
+		return Handled()
+	}
+	return Unhandled()
+}
+
+// ChildWidgets returns the active view.
+func (r *TabRouter) ChildWidgets() []Widget {
+	if w := r.activeWidget(); w != nil {
+		return []Widget{w}
+	}
+	return nil
+}
+
+var (
+	_ Widget        = (*StackRouter)(nil)
+	_ ChildProvider = (*StackRouter)(nil)
+	_ Lifecycle     = (*StackRouter)(nil)
+	_ Bindable      = (*StackRouter)(nil)
+	_ Widget        = (*TabRouter)(nil)
+	_ ChildProvider = (*TabRouter)(nil)
+	_ Lifecycle     = (*TabRouter)(nil)
+	_ Bindable      = (*TabRouter)(nil)
+)
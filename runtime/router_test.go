@@ -0,0 +1,167 @@
+package runtime
+
+import "testing"
+
+func TestStackRouter_PushPopReplace(t *testing.T) {
+	root := newTestWidget(1, 1)
+	detail := newTestWidget(1, 1)
+	edit := newTestWidget(1, 1)
+	router := NewStackRouter()
+	router.Layout(Rect{X: 1, Y: 2, Width: 20, Height: 10})
+
+	router.Push(root)
+	router.Push(detail)
+	if router.Top() != detail || router.Depth() != 2 {
+		t.Fatalf("top = %v depth = %d, want detail at depth 2", router.Top(), router.Depth())
+	}
+	if detail.bounds != (Rect{X: 1, Y: 2, Width: 20, Height: 10}) {
+		t.Fatalf("pushed view bounds = %+v", detail.bounds)
+	}
+
+	router.Replace(edit)
+	if router.Top() != edit || router.Depth() != 2 {
+		t.Fatalf("replace: top = %v depth = %d", router.Top(), router.Depth())
+	}
+	if !router.Pop() || router.Top() != root {
+		t.Fatalf("expected pop to return to root")
+	}
+	if router.Pop() {
+		t.Fatalf("expected root view not to pop")
+	}
+	if got := router.ChildWidgets(); len(got) != 1 || got[0] != root {
+		t.Fatalf("children = %v, want [root]", got)
+	}
+}
+
+func TestStackRouter_MountsOnlyTop(t *testing.T) {
+	first := &lifecycleWidget{}
+	second := &lifecycleWidget{}
+	router := NewStackRouter()
+	router.Push(first)
+	screen := NewScreen(10, 5)
+	screen.SetRoot(router)
+
+	router.Push(second)
+	if first.unmounted != 1 || second.mounted != 1 {
+		t.Fatalf("push: first unmounted=%d second mounted=%d", first.unmounted, second.mounted)
+	}
+	router.Pop()
+	if second.unmounted != 1 || first.mounted != 2 {
+		t.Fatalf("pop: second unmounted=%d first mounted=%d", second.unmounted, first.mounted)
+	}
+}
+
+func TestStackRouter_RouterMsg(t *testing.T) {
+	home := newTestWidget(1, 1)
+	settings := newTestWidget(1, 1)
+	router := NewStackRouter()
+	router.SetRoutes(map[string]func() Widget{
+		"settings": func() Widget { return settings },
+	})
+	router.Push(home)
+
+	if result := router.HandleMessage(RouterMsg{Action: "push:settings"}); !result.Handled {
+		t.Fatalf("expected push to be handled")
+	}
+	if router.Top() != settings {
+		t.Fatalf("expected settings on top")
+	}
+	if result := router.HandleMessage(RouterMsg{Action: "push:missing"}); result.Handled {
+		t.Fatalf("expected unknown route to be unhandled")
+	}
+	if result := router.HandleMessage(RouterMsg{Action: "back"}); !result.Handled || router.Top() != home {
+		t.Fatalf("expected back to return home")
+	}
+	if result := router.HandleMessage(RouterMsg{Action: "pop"}); result.Handled {
+		t.Fatalf("expected pop at root to be unhandled")
+	}
+}
+
+func TestTabRouter_SetActive(t *testing.T) {
+	inbox := newTestWidget(1, 1)
+	sent := newTestWidget(1, 1)
+	router := NewTabRouter(TabDef{Title: "Inbox", Widget: inbox}, TabDef{Title: "Sent", Widget: sent})
+	router.Layout(Rect{Width: 20, Height: 10})
+
+	if inbox.bounds != (Rect{Y: 1, Width: 20, Height: 9}) {
+		t.Fatalf("content bounds = %+v, want below the tab bar", inbox.bounds)
+	}
+	router.SetActive(1)
+	if router.Active() != 1 || sent.bounds != (Rect{Y: 1, Width: 20, Height: 9}) {
+		t.Fatalf("active = %d sent bounds = %+v", router.Active(), sent.bounds)
+	}
+	router.SetActive(5)
+	if router.Active() != 1 {
+		t.Fatalf("expected out-of-range index to be ignored")
+	}
+
+	router.SetTabBar(false)
+	if sent.bounds != (Rect{Width: 20, Height: 10}) {
+		t.Fatalf("bounds without tab bar = %+v", sent.bounds)
+	}
+}
+
+func TestTabRouter_RouterMsg(t *testing.T) {
+	router := NewTabRouter(
+		TabDef{Title: "One", Widget: newTestWidget(1, 1)},
+		TabDef{Title: "Two", Widget: newTestWidget(1, 1)},
+		TabDef{Title: "Three", Widget: newTestWidget(1, 1)},
+	)
+
+	router.HandleMessage(RouterMsg{Action: "prev"})
+	if router.Active() != 2 {
+		t.Fatalf("prev from first tab = %d, want 2", router.Active())
+	}
+	router.HandleMessage(RouterMsg{Action: "next"})
+	if router.Active() != 0 {
+		t.Fatalf("next from last tab = %d, want 0", router.Active())
+	}
+	router.HandleMessage(RouterMsg{Action: "tab:two"})
+	if router.Active() != 1 {
+		t.Fatalf("tab by title = %d, want 1", router.Active())
+	}
+	router.HandleMessage(RouterMsg{Action: "tab:2"})
+	if router.Active() != 2 {
+		t.Fatalf("tab by index = %d, want 2", router.Active())
+	}
+	if result := router.HandleMessage(RouterMsg{Action: "tab:missing"}); result.Handled {
+		t.Fatalf("expected unknown tab to be unhandled")
+	}
+}
+
+func TestTabRouter_BarClick(t *testing.T) {
+	router := NewTabRouter(
+		TabDef{Title: "One", Widget: newTestWidget(1, 1)},
+		TabDef{Title: "Two", Widget: newTestWidget(1, 1)},
+	)
+	router.Layout(Rect{Width: 20, Height: 5})
+	buf := NewBuffer(20, 5)
+	router.Render(RenderContext{Buffer: buf, Bounds: router.Bounds()})
+
+	if got := buf.Get(1, 0).Rune; got != 'O' {
+		t.Fatalf("tab bar cell = %q, want 'O'", got)
+	}
+	// " One " spans columns 0-4, " Two " columns 5-9.
+	result := router.HandleMessage(MouseMsg{X: 6, Y: 0, Button: MouseLeft, Action: MousePress})
+	if !result.Handled || router.Active() != 1 {
+		t.Fatalf("click on second tab: handled=%v active=%d", result.Handled, router.Active())
+	}
+}
+
+func TestRouter_NestedRouterHandlesFirst(t *testing.T) {
+	inner := NewStackRouter()
+	inner.Push(newTestWidget(1, 1))
+	inner.Push(newTestWidget(1, 1))
+	outer := NewStackRouter()
+	outer.Push(newTestWidget(1, 1))
+	outer.Push(inner)
+
+	outer.HandleMessage(RouterMsg{Action: "pop"})
+	if inner.Depth() != 1 || outer.Depth() != 2 {
+		t.Fatalf("inner depth = %d outer depth = %d, want inner to pop first", inner.Depth(), outer.Depth())
+	}
+	outer.HandleMessage(RouterMsg{Action: "pop"})
+	if outer.Depth() != 1 {
+		t.Fatalf("expected outer to pop once inner is at its root")
+	}
+}
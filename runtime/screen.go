@@ -1,6 +1,8 @@
 package runtime
 
 import (
+	"strings"
+
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/style"
@@ -11,7 +13,13 @@ import (
 type Layer struct {
 	Root       Widget
 	FocusScope *FocusScope
-	Modal      bool // If true, blocks input to layers below
+	Modal      bool       // If true, blocks input to layers below
+	TrapFocus  bool       // If true, focus stays within this layer until it is popped
+	Scrim      ScrimStyle // Backdrop drawn over the layers below
+
+	// restoreFocus is the widget focused below when a trapping layer was
+	// pushed; PopLayer focuses it again.
+	restoreFocus Focusable
 }
 
 // Screen manages the widget tree, modal stack, and rendering.
@@ -31,6 +39,7 @@ type Screen struct {
 	styleResolverRoots []Widget
 	styleResolverMedia style.MediaContext
 	styleResolverDirty bool
+	tooltip            tooltipState
 }
 
 // NewScreen creates a new screen with the given dimensions.
@@ -103,6 +112,24 @@ func (s *Screen) RefreshFocusables() {
 	}
 }
 
+// refreshFocusablesKeepingFocus rescans all layers like RefreshFocusables,
+// then returns focus to each layer's focused widget if it is still there.
+func (s *Screen) refreshFocusablesKeepingFocus() {
+	if s == nil {
+		return
+	}
+	for i, layer := range s.layers {
+		if layer == nil || layer.FocusScope == nil {
+			continue
+		}
+		prev := layer.FocusScope.Current()
+		s.refreshLayerFocusables(layer)
+		if prev != nil && !s.focusTrappedAbove(i) && layer.FocusScope.contains(prev) {
+			layer.FocusScope.SetFocus(prev)
+		}
+	}
+}
+
 // Size returns the screen dimensions.
 func (s *Screen) Size() (w, h int) {
 	return s.width, s.height
@@ -147,6 +174,7 @@ func (s *Screen) SetRoot(root Widget) {
 		UnmountTree(oldRoot)
 		UnbindTree(oldRoot)
 	}
+	s.resetTooltip()
 	s.hitGridDirty = true
 	s.invalidateStyleResolver()
 
@@ -170,15 +198,27 @@ func (s *Screen) Root() Widget {
 }
 
 // PushLayer adds a new layer on top of the stack.
-// If modal is true, input won't pass to layers below.
+// If modal is true, input won't pass to layers below and focus is trapped
+// in the new layer: the widget focused below is blurred until PopLayer
+// restores it.
 func (s *Screen) PushLayer(root Widget, modal bool) {
-	layer := &Layer{
-		Root:       root,
-		FocusScope: NewFocusScope(),
-		Modal:      modal,
+	s.pushLayer(&Layer{Root: root, Modal: modal, TrapFocus: modal})
+}
+
+// pushLayer pushes layer, filling in its focus scope.
+func (s *Screen) pushLayer(layer *Layer) {
+	root := layer.Root
+	if layer.TrapFocus {
+		if below := s.FocusScope(); below != nil {
+			if layer.restoreFocus = below.Current(); layer.restoreFocus != nil {
+				layer.restoreFocus.Blur()
+			}
+		}
 	}
+	layer.FocusScope = NewFocusScope()
 	s.configureFocusScope(layer.FocusScope)
 	s.layers = append(s.layers, layer)
+	s.resetTooltip()
 	s.hitGridDirty = true
 	s.invalidateStyleResolver()
 
@@ -199,20 +239,84 @@ func (s *Screen) PopLayer() bool {
 	if len(s.layers) <= 1 {
 		return false
 	}
+	s.removeLayer(len(s.layers) - 1)
+	return true
+}
 
-	// Clear focus on the layer being removed
-	top := s.layers[len(s.layers)-1]
-	top.FocusScope.ClearFocus()
-	if top.Root != nil {
-		UnmountTree(top.Root)
-		UnbindTree(top.Root)
+// RemoveLayer removes the overlay layer whose root is root, wherever it
+// sits in the stack. Returns false if no overlay has that root.
+func (s *Screen) RemoveLayer(root Widget) bool {
+	if root == nil {
+		return false
 	}
+	for i := len(s.layers) - 1; i > 0; i-- {
+		if s.layers[i] != nil && s.layers[i].Root == root {
+			s.removeLayer(i)
+			return true
+		}
+	}
+	return false
+}
 
-	s.layers = s.layers[:len(s.layers)-1]
+// removeLayer removes the overlay layer at index.
+func (s *Screen) removeLayer(index int) {
+	layer := s.layers[index]
+	top := index == len(s.layers)-1
+
+	// Clear focus on the layer being removed
+	layer.FocusScope.ClearFocus()
+	if layer.Root != nil {
+		UnmountTree(layer.Root)
+		UnbindTree(layer.Root)
+	}
+
+	s.layers = append(s.layers[:index], s.layers[index+1:]...)
+	s.resetTooltip()
+	if layer.TrapFocus {
+		if top {
+			s.restoreFocus(layer.restoreFocus)
+		} else if above := s.layers[index]; above.TrapFocus && above.restoreFocus == nil {
+			// The layer above now returns focus to where this one would.
+			above.restoreFocus = layer.restoreFocus
+		}
+	}
+	if !layer.Scrim.IsNone() && s.buffer != nil {
+		// Widgets below may not repaint every cell the scrim touched.
+		s.buffer.Clear()
+	}
 	s.hitGridDirty = true
 	s.invalidateStyleResolver()
 	s.relayout()
-	return true
+}
+
+// restoreFocus refocuses target in the top scope, falling back to the
+// scope's current widget when target is gone.
+func (s *Screen) restoreFocus(target Focusable) {
+	scope := s.FocusScope()
+	if scope == nil {
+		return
+	}
+	if target != nil && scope.contains(target) && target.CanFocus() {
+		if scope.Current() == target {
+			target.Focus()
+		} else {
+			scope.SetFocus(target)
+		}
+		return
+	}
+	if current := scope.Current(); current != nil && current.CanFocus() {
+		current.Focus()
+	}
+}
+
+// focusTrappedAbove reports whether a layer above index traps focus.
+func (s *Screen) focusTrappedAbove(index int) bool {
+	for i := index + 1; i < len(s.layers); i++ {
+		if s.layers[i] != nil && s.layers[i].TrapFocus {
+			return true
+		}
+	}
+	return false
 }
 
 // TopLayer returns the topmost layer.
@@ -292,10 +396,16 @@ func (s *Screen) WidgetAt(x, y int) Widget {
 	return s.hitGrid.WidgetAt(x, y)
 }
 
-// FocusScope returns the focus scope of the top layer.
+// FocusScope returns the focus scope of the top layer. With automatic
+// focus registration, a non-modal overlay with nothing to focus, such as a
+// notification, leaves focus with the layer below it.
 func (s *Screen) FocusScope() *FocusScope {
-	if top := s.TopLayer(); top != nil {
-		return top.FocusScope
+	for i := len(s.layers) - 1; i >= 0; i-- {
+		layer := s.layers[i]
+		if i > 0 && s.autoRegisterFocus && !layer.TrapFocus && layer.FocusScope != nil && !layer.FocusScope.canFocus() {
+			continue
+		}
+		return layer.FocusScope
 	}
 	return nil
 }
@@ -347,10 +457,13 @@ func (s *Screen) Render() {
 		isTopLayer := i == len(s.layers)-1
 		ctx.Focused = isTopLayer
 
+		layer.Scrim.apply(s.buffer, ctx.Bounds)
+
 		s.safeRender(layer.Root, ctx)
 	}
 
 	s.drawFocusIndicator()
+	s.drawTooltip()
 }
 
 func (s *Screen) styleResolverFor(roots []Widget, media style.MediaContext) *StyleResolver {
@@ -400,6 +513,7 @@ func (s *Screen) configureFocusScope(scope *FocusScope) {
 	if scope == nil {
 		return
 	}
+	scope.SetClock(s.services.Clock())
 	scope.SetOnChange(func(prev Focusable, next Focusable) {
 		s.announceFocus(next)
 		if s.shouldRelayoutOnFocus(prev, next) {
@@ -438,13 +552,23 @@ func (s *Screen) refreshLayerFocusables(layer *Layer) {
 	if layer.Root != nil {
 		RegisterFocusables(layer.FocusScope, layer.Root)
 	}
+	// Registration focuses the first widget; a layer under a focus trap
+	// must not hold focus.
+	for i, l := range s.layers {
+		if l == layer && s.focusTrappedAbove(i) {
+			if current := layer.FocusScope.Current(); current != nil {
+				current.Blur()
+			}
+			break
+		}
+	}
 }
 
 func (s *Screen) announceFocus(next Focusable) {
 	if s == nil {
 		return
 	}
-	announcer := s.services.Announcer()
+	announcer := s.services.autoAnnouncer()
 	if announcer == nil {
 		return
 	}
@@ -452,9 +576,45 @@ func (s *Screen) announceFocus(next Focusable) {
 	if !ok || accessible == nil {
 		return
 	}
+	if focusAnnouncer, ok := announcer.(accessibility.FocusAnnouncer); ok {
+		focusAnnouncer.AnnounceFocus(accessible, s.describedByText(accessible))
+		return
+	}
 	announcer.AnnounceChange(accessible)
 }
 
+// describedByText returns the text of the widget named by the
+// accessible's AriaDescribedBy ID.
+func (s *Screen) describedByText(accessible accessibility.Accessible) string {
+	aria, ok := accessible.(accessibility.ARIA)
+	if !ok {
+		return ""
+	}
+	id := strings.TrimSpace(aria.AriaDescribedBy())
+	if id == "" {
+		return ""
+	}
+	text := ""
+	for i := len(s.layers) - 1; i >= 0 && text == ""; i-- {
+		walkWidgets(s.layers[i].Root, func(widget Widget) {
+			if text != "" {
+				return
+			}
+			ider, ok := widget.(interface{ ID() string })
+			if !ok || ider.ID() != id {
+				return
+			}
+			if described, ok := widget.(accessibility.Accessible); ok {
+				text = strings.TrimSpace(described.AccessibleDescription())
+				if text == "" {
+					text = strings.TrimSpace(described.AccessibleLabel())
+				}
+			}
+		})
+	}
+	return text
+}
+
 func (s *Screen) drawFocusIndicator() {
 	if s == nil || s.buffer == nil {
 		return
@@ -489,8 +649,17 @@ func (s *Screen) drawFocusIndicator() {
 
 // HandleMessage dispatches a message to the appropriate layer.
 // Messages go to the top layer. If not handled and not modal,
-// they bubble down to lower layers.
+// they bubble down to lower layers. A tooltip appearing or disappearing
+// invalidates the screen.
 func (s *Screen) HandleMessage(msg Message) HandleResult {
+	if s.trackTooltip(msg) {
+		s.services.Invalidate()
+	}
+	return s.dispatch(msg)
+}
+
+// dispatch routes a message to the hit widget or down the layers.
+func (s *Screen) dispatch(msg Message) HandleResult {
 	if mouse, ok := msg.(MouseMsg); ok {
 		if s.hitGrid == nil || s.hitGridDirty {
 			s.buildHitGrid()
@@ -550,8 +719,15 @@ func (s *Screen) handleCommand(cmd Command) {
 		}
 	case PopOverlay:
 		s.PopLayer()
+	case RemoveOverlay:
+		s.RemoveLayer(c.Widget)
 	case PushOverlay:
-		s.PushLayer(c.Widget, c.Modal)
+		s.pushLayer(&Layer{
+			Root:      c.Widget,
+			Modal:     c.Modal,
+			TrapFocus: c.Modal || c.TrapFocus,
+			Scrim:     c.Scrim,
+		})
 	}
 	// Other commands bubble up to App
 }
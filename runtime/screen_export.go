@@ -0,0 +1,70 @@
+package runtime
+
+import "github.com/odvcencio/fluffyui/accessibility"
+
+// ExportScreen describes the widget tree under root for accessibility
+// audits and tests: each accessible widget's role, label, description,
+// state, value, and bounds, plus the screen's text in reading order. It
+// reads the tree as last laid out. Widgets that are not accessible are
+// skipped and their children take their place.
+func ExportScreen(root Widget) accessibility.ScreenDescription {
+	return accessibility.NewScreenDescription(exportWidget(root, map[Widget]struct{}{}))
+}
+
+// ExportScreen describes every layer of the screen, bottom to top.
+func (s *Screen) ExportScreen() accessibility.ScreenDescription {
+	if s == nil {
+		return accessibility.ScreenDescription{}
+	}
+	visited := map[Widget]struct{}{}
+	var nodes []accessibility.ScreenNode
+	for _, layer := range s.layers {
+		if layer != nil {
+			nodes = append(nodes, exportWidget(layer.Root, visited)...)
+		}
+	}
+	return accessibility.NewScreenDescription(nodes)
+}
+
+// exportWidget returns the node for w, or its children's nodes when w is
+// not accessible.
+func exportWidget(w Widget, visited map[Widget]struct{}) []accessibility.ScreenNode {
+	if w == nil {
+		return nil
+	}
+	if _, ok := visited[w]; ok {
+		return nil
+	}
+	visited[w] = struct{}{}
+	var children []accessibility.ScreenNode
+	if container, ok := w.(ChildProvider); ok {
+		for _, child := range container.ChildWidgets() {
+			children = append(children, exportWidget(child, visited)...)
+		}
+	}
+	acc, ok := w.(accessibility.Accessible)
+	if !ok || acc == nil {
+		return children
+	}
+	node := accessibility.ScreenNode{Children: children}
+	node.Role = acc.AccessibleRole()
+	node.Label = acc.AccessibleLabel()
+	node.Description = acc.AccessibleDescription()
+	node.State = acc.AccessibleState()
+	node.Value = acc.AccessibleValue()
+	if aria, ok := w.(accessibility.ARIA); ok {
+		if label := aria.AriaLabel(); label != "" {
+			node.Label = label
+		}
+		node.DescribedBy = aria.AriaDescribedBy()
+		node.Live = aria.AriaLive()
+	}
+	if bounds, ok := w.(BoundsProvider); ok {
+		r := bounds.Bounds()
+		node.Bounds = accessibility.Bounds{X: r.X, Y: r.Y, Width: r.Width, Height: r.Height}
+	}
+	if focusable, ok := w.(Focusable); ok {
+		node.Focused = focusable.IsFocused()
+	}
+	return []accessibility.ScreenNode{node}
+}
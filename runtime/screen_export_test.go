@@ -0,0 +1,39 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+)
+
+func exportNode(role accessibility.Role, label string, bounds Rect) *ariaWidget {
+	w := &ariaWidget{boundsWidget: boundsWidget{bounds: bounds, focusable: true}}
+	w.Base.Role = role
+	w.Base.Label = label
+	return w
+}
+
+func TestExportScreenReadingOrder(t *testing.T) {
+	save := exportNode(accessibility.RoleButton, "Save", Rect{X: 20, Y: 0, Width: 6, Height: 1})
+	title := exportNode(accessibility.RoleHeading, "Settings", Rect{X: 0, Y: 0, Width: 10, Height: 1})
+	wrap := exportNode(accessibility.RoleCheckbox, "Wrap lines", Rect{X: 0, Y: 2, Width: 14, Height: 1})
+	wrap.Base.State.Checked = accessibility.BoolPtr(true)
+	wrap.focused = true
+	hidden := exportNode(accessibility.RoleText, "Off screen", Rect{})
+	group := exportNode(accessibility.RoleGroup, "", Rect{Width: 30, Height: 3})
+	group.children = []Widget{wrap, hidden}
+	root := &boundsWidget{children: []Widget{save, title, group}}
+
+	desc := ExportScreen(root)
+	if len(desc.Nodes) != 3 {
+		t.Fatalf("nodes = %d, want the plain root skipped", len(desc.Nodes))
+	}
+	node := desc.Nodes[2].Children[0]
+	if node.Role != accessibility.RoleCheckbox || !node.Focused || node.Bounds != (accessibility.Bounds{Y: 2, Width: 14, Height: 1}) {
+		t.Fatalf("checkbox node = %+v", node)
+	}
+	want := "Settings, heading\nSave, button\nWrap lines, check box, checked"
+	if desc.Text != want {
+		t.Fatalf("text = %q, want %q", desc.Text, want)
+	}
+}
@@ -81,6 +81,135 @@ func TestScreen_PushPopLayer(t *testing.T) {
 	}
 }
 
+func TestScreen_ModalLayerRestoresFocus(t *testing.T) {
+	s := NewScreen(80, 24)
+	root := &mockWidget{}
+	s.SetRoot(root)
+	s.BaseFocusScope().Register(root)
+	if !root.focused {
+		t.Fatal("expected base widget to take focus")
+	}
+
+	s.PushLayer(&mockWidget{}, true)
+	if root.focused {
+		t.Error("modal layer should blur the widget below")
+	}
+	s.PopLayer()
+	if !root.focused {
+		t.Error("popping the modal should restore focus")
+	}
+
+	s.PushLayer(&mockWidget{}, false)
+	if !root.focused {
+		t.Error("non-modal layer should leave focus alone")
+	}
+}
+
+// focusGroup is a container of focusable mock widgets.
+type focusGroup struct {
+	mockWidget
+	children []Widget
+}
+
+func (g *focusGroup) CanFocus() bool         { return false }
+func (g *focusGroup) ChildWidgets() []Widget { return g.children }
+
+func TestScreen_TrapFocusCyclesWithinOverlay(t *testing.T) {
+	s := NewScreen(80, 24)
+	a, b := &mockWidget{}, &mockWidget{}
+	s.SetRoot(&focusGroup{children: []Widget{a, b}})
+	s.SetAutoRegisterFocus(true)
+	s.BaseFocusScope().SetFocus(b)
+
+	c, d := &mockWidget{}, &mockWidget{}
+	s.handleCommand(PushOverlay{Widget: &focusGroup{children: []Widget{c, d}}, TrapFocus: true})
+	if b.focused {
+		t.Fatal("trapping overlay should blur the widget below")
+	}
+	if !c.focused {
+		t.Fatal("expected first overlay widget to take focus")
+	}
+
+	// Refreshing must not hand focus back to the layer below.
+	s.RefreshFocusables()
+	if a.focused || b.focused {
+		t.Fatal("background widget focused while overlay traps focus")
+	}
+
+	for i, want := range []*mockWidget{d, c, d} {
+		s.handleCommand(FocusNext{})
+		if !want.focused || a.focused || b.focused {
+			t.Fatalf("step %d: focus escaped the overlay", i)
+		}
+	}
+	s.handleCommand(FocusPrev{})
+	if !c.focused {
+		t.Fatal("FocusPrev should stay within the overlay")
+	}
+
+	s.handleCommand(PopOverlay{})
+	if !b.focused || a.focused {
+		t.Fatalf("focus not restored to original widget: a=%v b=%v", a.focused, b.focused)
+	}
+	if s.FocusScope().Current() != b {
+		t.Fatal("scope current should be the restored widget")
+	}
+}
+
+func TestApp_ExecuteCommandMovesScreenFocus(t *testing.T) {
+	app := NewApp(AppConfig{})
+	app.screen = NewScreen(80, 24)
+	a, b := &mockWidget{}, &mockWidget{}
+	app.screen.SetRoot(&focusGroup{children: []Widget{a, b}})
+	app.screen.SetAutoRegisterFocus(true)
+
+	if !app.ExecuteCommand(FocusNext{}) {
+		t.Fatal("ExecuteCommand(FocusNext) should report handled")
+	}
+	if !b.focused || a.focused {
+		t.Fatal("ExecuteCommand(FocusNext) should move focus on the screen")
+	}
+
+	app.ExecuteCommand(PushOverlay{Widget: &mockWidget{}, Modal: true})
+	if app.screen.LayerCount() != 2 || b.focused {
+		t.Fatal("ExecuteCommand(PushOverlay) should push a focus-trapping layer")
+	}
+	app.ExecuteCommand(PopOverlay{})
+	if app.screen.LayerCount() != 1 || !b.focused {
+		t.Fatal("ExecuteCommand(PopOverlay) should restore focus")
+	}
+}
+
+func TestScreen_OverlayScrim(t *testing.T) {
+	s := NewScreen(20, 6)
+	s.SetRoot(&mockWidget{})
+	s.handleCommand(PushOverlay{Widget: &mockWidget{}, Modal: true, Scrim: ScrimDim})
+	top := s.TopLayer()
+	top.Root.Layout(Rect{X: 12, Y: 0, Width: 4, Height: 2})
+	s.Render()
+
+	buf := s.Buffer()
+	below := buf.Get(0, 0)
+	if below.Rune != 'X' || below.Style.Attributes()&backend.AttrDim == 0 {
+		t.Fatalf("base cell = %+v, want dimmed X", below)
+	}
+	if over := buf.Get(12, 0); over.Style.Attributes()&backend.AttrDim != 0 {
+		t.Fatalf("overlay cell = %+v, should not be dimmed", over)
+	}
+
+	top.Scrim = ScrimStyle{Tint: true, Foreground: backend.ColorWhite, Background: backend.ColorBlue, Rune: '░'}
+	s.Render()
+	if cell := buf.Get(0, 0); cell.Rune != '░' || cell.Style.BG() != backend.ColorBlue {
+		t.Fatalf("custom scrim cell = %+v", cell)
+	}
+
+	s.PopLayer()
+	s.Render()
+	if cell := buf.Get(0, 0); cell.Rune != 'X' || cell.Style.Attributes()&backend.AttrDim != 0 {
+		t.Fatalf("base cell after pop = %+v, want plain X", cell)
+	}
+}
+
 func TestScreen_ModalLayerBlocksInput(t *testing.T) {
 	s := NewScreen(80, 24)
 
@@ -154,6 +283,39 @@ func TestScreen_PopOverlayCommand(t *testing.T) {
 	}
 }
 
+func TestScreen_RemoveOverlayCommand(t *testing.T) {
+	s := NewScreen(80, 24)
+	s.SetRoot(&mockWidget{})
+	lower, upper := &mockWidget{}, &mockWidget{}
+	s.PushLayer(lower, false)
+	s.PushLayer(upper, false)
+
+	s.handleCommand(RemoveOverlay{Widget: lower})
+	if s.LayerCount() != 2 || s.TopLayer().Root != upper {
+		t.Fatalf("expected only the named overlay removed, %d layers", s.LayerCount())
+	}
+	if s.RemoveLayer(lower) || s.RemoveLayer(s.Root()) {
+		t.Fatal("RemoveLayer should ignore missing overlays and the base layer")
+	}
+}
+
+func TestScreen_UnfocusableOverlayKeepsFocusBelow(t *testing.T) {
+	s := NewScreen(80, 24)
+	a, b := &mockWidget{}, &mockWidget{}
+	s.SetRoot(&focusGroup{children: []Widget{a, b}})
+	s.SetAutoRegisterFocus(true)
+	s.BaseFocusScope().SetFocus(b)
+
+	s.handleCommand(PushOverlay{Widget: &focusGroup{}})
+	if s.FocusScope() != s.BaseFocusScope() || !b.focused {
+		t.Fatal("an overlay with nothing to focus should leave focus below")
+	}
+	s.handleCommand(FocusNext{})
+	if !a.focused {
+		t.Fatal("expected Tab to keep moving focus below the overlay")
+	}
+}
+
 func TestScreen_PushOverlayCommand(t *testing.T) {
 	s := NewScreen(80, 24)
 
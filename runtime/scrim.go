@@ -0,0 +1,59 @@
+package runtime
+
+import "github.com/odvcencio/fluffyui/backend"
+
+// ScrimStyle describes the backdrop drawn over lower layers before an
+// overlay renders. It is applied to the screen buffer, so it looks the same
+// on every backend. The zero value is ScrimNone.
+type ScrimStyle struct {
+	// Dim adds the dim attribute to covered cells.
+	Dim bool
+
+	// Tint replaces the colors of covered cells with Foreground and
+	// Background.
+	Tint       bool
+	Foreground backend.Color
+	Background backend.Color
+
+	// Rune, if non-zero, replaces the content of covered cells (e.g. '░').
+	Rune rune
+}
+
+var (
+	// ScrimNone leaves the layers below untouched.
+	ScrimNone = ScrimStyle{}
+	// ScrimDim dims everything below the overlay.
+	ScrimDim = ScrimStyle{Dim: true}
+)
+
+// IsNone reports whether the scrim draws nothing.
+func (s ScrimStyle) IsNone() bool {
+	return s == ScrimNone
+}
+
+// apply draws the scrim over r.
+func (s ScrimStyle) apply(buf *Buffer, r Rect) {
+	if buf == nil || s.IsNone() {
+		return
+	}
+	width, height := buf.Size()
+	x0, y0 := max(0, r.X), max(0, r.Y)
+	x1, y1 := min(width, r.X+r.Width), min(height, r.Y+r.Height)
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			cell := buf.Get(x, y)
+			style := cell.Style
+			if s.Dim {
+				style = style.Dim(true)
+			}
+			if s.Tint {
+				style = style.Foreground(s.Foreground).Background(s.Background)
+			}
+			ch := cell.Rune
+			if s.Rune != 0 {
+				ch = s.Rune
+			}
+			buf.Set(x, y, ch, style)
+		}
+	}
+}
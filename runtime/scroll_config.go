@@ -0,0 +1,97 @@
+package runtime
+
+import (
+	"math"
+	"time"
+)
+
+// Defaults used for zero ScrollConfig fields.
+const (
+	DefaultWheelLines          = 3
+	DefaultScrollMaxMultiplier = 4.0
+	DefaultScrollAccelWindow   = 150 * time.Millisecond
+)
+
+// ScrollConfig controls how scrolling widgets turn mouse wheel notches
+// into lines. Set it once in AppConfig.Scroll; widgets read it through
+// Services.ScrollConfig. The zero value keeps each widget's own step
+// with no acceleration.
+type ScrollConfig struct {
+	// LinesPerNotch is how many lines one wheel notch scrolls. Zero keeps
+	// the widget's own default.
+	LinesPerNotch int
+	// Acceleration is how much each quick consecutive notch in the same
+	// direction adds to the line multiplier. Zero disables acceleration.
+	Acceleration float64
+	// MaxMultiplier caps the accelerated multiplier. Zero uses
+	// DefaultScrollMaxMultiplier.
+	MaxMultiplier float64
+	// AccelerationWindow is the longest gap between notches that still
+	// counts as consecutive; a longer pause resets the ramp. Zero uses
+	// DefaultScrollAccelWindow.
+	AccelerationWindow time.Duration
+	// Natural reverses the wheel direction, matching touchpads set to
+	// natural scrolling.
+	Natural bool
+}
+
+// WheelScroller converts wheel messages into scroll deltas under a
+// ScrollConfig, timing consecutive notches for acceleration. Each
+// scrolling widget keeps its own; the zero value is ready to use.
+type WheelScroller struct {
+	last   time.Time
+	streak int
+	dir    int
+	now    func() time.Time
+}
+
+// Delta returns the lines to scroll for msg, negative for up, and false
+// when msg is not a wheel notch. defaultLines is used when
+// cfg.LinesPerNotch is zero.
+func (w *WheelScroller) Delta(cfg ScrollConfig, msg MouseMsg, defaultLines int) (int, bool) {
+	dir := 0
+	switch msg.Button {
+	case MouseWheelUp:
+		dir = -1
+	case MouseWheelDown:
+		dir = 1
+	default:
+		return 0, false
+	}
+	if cfg.Natural {
+		dir = -dir
+	}
+	lines := cfg.LinesPerNotch
+	if lines <= 0 {
+		lines = defaultLines
+	}
+	if lines <= 0 {
+		lines = DefaultWheelLines
+	}
+	if w == nil || cfg.Acceleration <= 0 {
+		return dir * lines, true
+	}
+
+	now := time.Now()
+	if w.now != nil {
+		now = w.now()
+	}
+	window := cfg.AccelerationWindow
+	if window <= 0 {
+		window = DefaultScrollAccelWindow
+	}
+	if dir == w.dir && !w.last.IsZero() && now.Sub(w.last) <= window {
+		w.streak++
+	} else {
+		w.streak = 0
+	}
+	w.dir = dir
+	w.last = now
+
+	maxMultiplier := cfg.MaxMultiplier
+	if maxMultiplier <= 0 {
+		maxMultiplier = DefaultScrollMaxMultiplier
+	}
+	multiplier := math.Min(1+cfg.Acceleration*float64(w.streak), math.Max(maxMultiplier, 1))
+	return dir * int(math.Round(float64(lines)*multiplier)), true
+}
@@ -0,0 +1,45 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWheelScrollerDefaults(t *testing.T) {
+	var wheel WheelScroller
+	if delta, ok := wheel.Delta(ScrollConfig{}, MouseMsg{Button: MouseWheelDown}, 5); !ok || delta != 5 {
+		t.Fatalf("delta = %d, want the widget default", delta)
+	}
+	if delta, _ := wheel.Delta(ScrollConfig{LinesPerNotch: 2, Natural: true}, MouseMsg{Button: MouseWheelDown}, 5); delta != -2 {
+		t.Fatalf("delta = %d, want natural scrolling to reverse the configured step", delta)
+	}
+	if _, ok := wheel.Delta(ScrollConfig{}, MouseMsg{Button: MouseLeft}, 5); ok {
+		t.Fatal("expected clicks to be ignored")
+	}
+}
+
+func TestWheelScrollerAcceleration(t *testing.T) {
+	clock := time.Unix(100, 0)
+	wheel := WheelScroller{now: func() time.Time { return clock }}
+	cfg := ScrollConfig{LinesPerNotch: 2, Acceleration: 1, MaxMultiplier: 3}
+	down := MouseMsg{Button: MouseWheelDown}
+
+	var got []int
+	for i := 0; i < 4; i++ {
+		delta, _ := wheel.Delta(cfg, down, 0)
+		got = append(got, delta)
+		clock = clock.Add(50 * time.Millisecond)
+	}
+	if got[0] != 2 || got[1] != 4 || got[2] != 6 || got[3] != 6 {
+		t.Fatalf("deltas = %v, want a ramp capped at 3x", got)
+	}
+
+	clock = clock.Add(time.Second)
+	if delta, _ := wheel.Delta(cfg, down, 0); delta != 2 {
+		t.Fatalf("delta = %d, want a pause to reset the ramp", delta)
+	}
+	clock = clock.Add(50 * time.Millisecond)
+	if delta, _ := wheel.Delta(cfg, MouseMsg{Button: MouseWheelUp}, 0); delta != -2 {
+		t.Fatalf("delta = %d, want a direction change to reset the ramp", delta)
+	}
+}
@@ -35,6 +35,26 @@ func (s Services) Announcer() accessibility.Announcer {
 	return s.app.announcer
 }
 
+// AnnounceChange announces a widget's new state or value through the app
+// announcer, honoring the widget's live region. It does nothing when the
+// app was configured with QuietAnnouncements.
+func (s Services) AnnounceChange(widget accessibility.Accessible) {
+	announcer := s.autoAnnouncer()
+	if announcer == nil || widget == nil {
+		return
+	}
+	announcer.AnnounceChange(widget)
+}
+
+// autoAnnouncer returns the announcer for automatic announcements, or nil
+// when they are turned off.
+func (s Services) autoAnnouncer() accessibility.Announcer {
+	if s.app == nil || s.app.quietAnnounce {
+		return nil
+	}
+	return s.app.announcer
+}
+
 // FocusStyle returns the global focus style.
 func (s Services) FocusStyle() *accessibility.FocusStyle {
 	if s.app == nil {
@@ -91,6 +111,38 @@ func (s Services) Animator() *animation.Animator {
 	return s.app.animator
 }
 
+// TooltipRenderer returns the renderer tooltips are drawn with, or nil to
+// draw them as plain text.
+func (s Services) TooltipRenderer() TooltipRenderer {
+	if s.app == nil {
+		return nil
+	}
+	return s.app.tooltipRenderer
+}
+
+// AppValue returns the value stored under key for this app, calling init
+// to create it on first use. Widgets keep state shared by all their
+// instances in one app here, so it goes away with the app. Without an app
+// it returns nil.
+func (s Services) AppValue(key any, init func() any) any {
+	if s.app == nil {
+		return nil
+	}
+	if v, ok := s.app.values.Load(key); ok {
+		return v
+	}
+	v, _ := s.app.values.LoadOrStore(key, init())
+	return v
+}
+
+// Clock returns the app clock, or SystemClock without an app.
+func (s Services) Clock() Clock {
+	if s.app == nil {
+		return SystemClock
+	}
+	return s.app.Clock()
+}
+
 // ReducedMotion reports whether motion should be minimized.
 func (s Services) ReducedMotion() bool {
 	if s.app == nil {
@@ -99,6 +151,14 @@ func (s Services) ReducedMotion() bool {
 	return s.app.reducedMotion
 }
 
+// ScrollConfig returns the app's mouse wheel scrolling settings.
+func (s Services) ScrollConfig() ScrollConfig {
+	if s.app == nil {
+		return ScrollConfig{}
+	}
+	return s.app.scrollConfig
+}
+
 // Scheduler returns the app state scheduler.
 func (s Services) Scheduler() state.Scheduler {
 	if s.app == nil {
@@ -0,0 +1,279 @@
+package runtime
+
+import (
+	"strings"
+	"time"
+
+	"github.com/odvcencio/fluffyui/backend"
+)
+
+// DefaultTooltipDelay is how long the pointer must rest on a widget, or
+// focus stay on it, before its tooltip shows.
+const DefaultTooltipDelay = 500 * time.Millisecond
+
+// TooltipProvider is implemented by widgets with a hint to show when they
+// are hovered or focused for the tooltip delay. A widget without one, or
+// with empty text, uses the nearest ancestor's tooltip. The app's
+// TooltipRenderer decides how the text is drawn, including any markup.
+type TooltipProvider interface {
+	TooltipText() string
+}
+
+// TooltipRenderer builds the widget the screen draws for a tooltip's text.
+// The widget is measured against the screen size and placed next to the
+// tooltip's target. Without a renderer the screen draws the text plainly
+// in reverse video; widgets.TooltipRenderer renders fur markup.
+type TooltipRenderer interface {
+	TooltipWidget(text string) Widget
+}
+
+// tooltipState tracks the widget under the pointer and the focused widget,
+// and times how long the tooltip target has been resting.
+type tooltipState struct {
+	delay     time.Duration
+	hit       Widget // widget under the pointer
+	hover     Widget // tooltip target under the pointer
+	focused   Focusable
+	focus     Widget // tooltip target of the focused widget
+	target    Widget // hover, or focus when nothing is hovered
+	since     time.Time
+	dismissed bool // a key or click dismissed the target's tooltip
+	shown     bool
+	text      string
+	view      Widget
+	anchor    Rect
+}
+
+// SetTooltipDelay sets how long the pointer or focus must rest on a widget
+// before its tooltip shows. Zero uses DefaultTooltipDelay and a negative
+// delay disables tooltips.
+func (s *Screen) SetTooltipDelay(d time.Duration) {
+	if s == nil {
+		return
+	}
+	s.tooltip.delay = d
+	if d < 0 {
+		s.hideTooltip()
+	}
+}
+
+// TooltipText returns the text of the tooltip on screen, if any, with its
+// markup.
+func (s *Screen) TooltipText() string {
+	if s == nil || !s.tooltip.shown {
+		return ""
+	}
+	return s.tooltip.text
+}
+
+// trackTooltip follows pointer moves, focus, keys, and ticks, and reports
+// whether the tooltip appeared or disappeared.
+func (s *Screen) trackTooltip(msg Message) bool {
+	tip := &s.tooltip
+	if tip.delay < 0 {
+		return false
+	}
+	wasShown := tip.shown
+	switch m := msg.(type) {
+	case MouseMsg:
+		if m.Action != MouseMove || m.Button != MouseNone {
+			tip.dismissed = true
+			s.hideTooltip()
+			break
+		}
+		if hit := s.WidgetAt(m.X, m.Y); hit != tip.hit {
+			tip.hit = hit
+			tip.hover = s.tooltipTarget(hit)
+		}
+		s.retargetTooltip(time.Time{})
+	case KeyMsg, PasteMsg:
+		tip.dismissed = true
+		s.hideTooltip()
+	case TickMsg:
+		// Focus dwell counts from when focus moved, not from this tick.
+		since := m.Time
+		var focused Focusable
+		if scope := s.FocusScope(); scope != nil {
+			focused = scope.Current()
+			if focused != tip.focused && !scope.FocusedAt().IsZero() {
+				since = scope.FocusedAt()
+			}
+		}
+		if focused != tip.focused {
+			tip.focused = focused
+			tip.focus = nil
+			if focused != nil {
+				tip.focus = s.tooltipTarget(focused)
+			}
+		}
+		s.retargetTooltip(since)
+		if tip.target == nil || tip.dismissed || tip.shown {
+			break
+		}
+		if tip.since.IsZero() {
+			tip.since = m.Time
+		}
+		delay := tip.delay
+		if delay == 0 {
+			delay = DefaultTooltipDelay
+		}
+		if m.Time.Sub(tip.since) >= delay {
+			s.showTooltip()
+		}
+	}
+	return tip.shown != wasShown
+}
+
+// retargetTooltip restarts the dwell from since when the tooltip target
+// changes. A zero since starts it on the next tick.
+func (s *Screen) retargetTooltip(since time.Time) {
+	tip := &s.tooltip
+	target := tip.hover
+	if target == nil {
+		target = tip.focus
+	}
+	if target == tip.target {
+		return
+	}
+	s.hideTooltip()
+	tip.target = target
+	tip.since = since
+	tip.dismissed = false
+}
+
+// resetTooltip forgets the tracked widgets when layers change, keeping
+// the delay.
+func (s *Screen) resetTooltip() {
+	s.hideTooltip()
+	s.tooltip = tooltipState{delay: s.tooltip.delay}
+}
+
+// tooltipTarget returns w or its nearest ancestor with tooltip text.
+func (s *Screen) tooltipTarget(w Widget) Widget {
+	if w == nil {
+		return nil
+	}
+	for i := len(s.layers) - 1; i >= 0; i-- {
+		path := widgetPath(s.layers[i].Root, w)
+		for j := len(path) - 1; j >= 0; j-- {
+			if provider, ok := path[j].(TooltipProvider); ok && strings.TrimSpace(provider.TooltipText()) != "" {
+				return path[j]
+			}
+		}
+		if path != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// widgetPath returns the widgets from root down to target, or nil.
+func widgetPath(root, target Widget) []Widget {
+	if root == nil {
+		return nil
+	}
+	if root == target {
+		return []Widget{root}
+	}
+	if container, ok := root.(ChildProvider); ok {
+		for _, child := range container.ChildWidgets() {
+			if path := widgetPath(child, target); path != nil {
+				return append([]Widget{root}, path...)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Screen) showTooltip() {
+	tip := &s.tooltip
+	provider, ok := tip.target.(TooltipProvider)
+	if !ok {
+		return
+	}
+	tip.text = strings.TrimSpace(provider.TooltipText())
+	if tip.text == "" {
+		return
+	}
+	tip.view = nil
+	if renderer := s.services.TooltipRenderer(); renderer != nil {
+		tip.view = renderer.TooltipWidget(tip.text)
+	}
+	if tip.view == nil {
+		tip.view = plainTooltip(strings.Split(tip.text, "\n"))
+	}
+	tip.anchor = Rect{}
+	if bounds, ok := tip.target.(BoundsProvider); ok {
+		tip.anchor = bounds.Bounds()
+	}
+	tip.shown = true
+}
+
+func (s *Screen) hideTooltip() {
+	tip := &s.tooltip
+	if !tip.shown {
+		return
+	}
+	tip.shown = false
+	if s.buffer != nil {
+		// Widgets below may not repaint every cell the tooltip covered.
+		s.buffer.Clear()
+	}
+}
+
+// tooltipRect places the tooltip just below its anchor, or above it when
+// there is no room below, clamped to the screen.
+func (s *Screen) tooltipRect(size Size) Rect {
+	width := min(size.Width, s.width)
+	height := min(size.Height, s.height)
+	anchor := s.tooltip.anchor
+	y := anchor.Y + anchor.Height
+	if y+height > s.height {
+		y = anchor.Y - height
+	}
+	return Rect{
+		X:      clamp(anchor.X, 0, max(s.width-width, 0)),
+		Y:      clamp(y, 0, max(s.height-height, 0)),
+		Width:  width,
+		Height: height,
+	}
+}
+
+func (s *Screen) drawTooltip() {
+	if s == nil || s.buffer == nil || !s.tooltip.shown || s.tooltip.view == nil {
+		return
+	}
+	view := s.tooltip.view
+	rect := s.tooltipRect(view.Measure(Loose(s.width, s.height)))
+	view.Layout(rect)
+	s.safeRender(view, RenderContext{Buffer: s.buffer, Bounds: rect})
+}
+
+// plainTooltip draws tooltip lines as-is in reverse video, with a column
+// of padding on each side.
+type plainTooltip []string
+
+func (p plainTooltip) Measure(constraints Constraints) Size {
+	width := 0
+	for _, line := range p {
+		width = max(width, backend.StringWidth(line))
+	}
+	return constraints.Constrain(Size{Width: width + 2, Height: len(p)})
+}
+
+func (p plainTooltip) Layout(Rect) {}
+
+func (p plainTooltip) Render(ctx RenderContext) {
+	style := backend.DefaultStyle().Reverse(true)
+	ctx.Buffer.Fill(ctx.Bounds, ' ', style)
+	for i, line := range p {
+		if i >= ctx.Bounds.Height {
+			break
+		}
+		ctx.Buffer.SetString(ctx.Bounds.X+1, ctx.Bounds.Y+i, line, style)
+	}
+}
+
+func (p plainTooltip) HandleMessage(Message) HandleResult {
+	return Unhandled()
+}
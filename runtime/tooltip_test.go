@@ -0,0 +1,148 @@
+package runtime
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// tipWidget is a sized, focusable widget with a tooltip.
+type tipWidget struct {
+	testWidget
+	tip     string
+	focused bool
+}
+
+func newTipWidget(tip string) *tipWidget {
+	return &tipWidget{testWidget: testWidget{preferredSize: Size{Width: 10, Height: 2}}, tip: tip}
+}
+
+func (w *tipWidget) Bounds() Rect        { return w.bounds }
+func (w *tipWidget) TooltipText() string { return w.tip }
+func (w *tipWidget) CanFocus() bool      { return true }
+func (w *tipWidget) Focus()              { w.focused = true }
+func (w *tipWidget) Blur()               { w.focused = false }
+func (w *tipWidget) IsFocused() bool     { return w.focused }
+
+func tick(s *Screen, at time.Time) HandleResult {
+	return s.HandleMessage(TickMsg{Time: at})
+}
+
+func TestScreen_TooltipAfterHoverDwell(t *testing.T) {
+	s := NewScreen(30, 10)
+	save, quiet := newTipWidget("Write to disk"), newTipWidget("")
+	s.SetRoot(VBox(Fixed(save), Fixed(quiet)))
+	s.SetTooltipDelay(time.Second)
+
+	start := time.Unix(100, 0)
+	s.HandleMessage(MouseMsg{X: 2, Y: 1, Action: MouseMove})
+	tick(s, start)
+	if s.TooltipText() != "" {
+		t.Fatal("tooltip shown before the delay")
+	}
+	if result := tick(s, start.Add(time.Second)); result.Handled || s.TooltipText() != "Write to disk" {
+		t.Fatalf("tooltip = %q, want it shown without consuming the tick", s.TooltipText())
+	}
+
+	s.Render()
+	if got := s.Buffer().Get(1, 2).Rune; got != 'W' {
+		t.Fatalf("tooltip cell = %q, want the hint just below the widget", got)
+	}
+
+	s.HandleMessage(MouseMsg{X: 2, Y: 3, Action: MouseMove})
+	if s.TooltipText() != "" {
+		t.Fatal("moving away should hide the tooltip")
+	}
+	tick(s, start.Add(time.Hour))
+	if s.TooltipText() != "" {
+		t.Fatal("a widget without tooltip text should show nothing")
+	}
+}
+
+func TestScreen_TooltipOnFocusDismissedByKey(t *testing.T) {
+	s := NewScreen(30, 3)
+	field := newTipWidget("Press Enter to search")
+	s.SetRoot(VBox(FixedSpace(1), Fixed(field)))
+	clock := NewManualClock()
+	s.FocusScope().SetClock(clock)
+	s.SetAutoRegisterFocus(true)
+	s.RefreshFocusables()
+
+	// The dwell counts from the focus time, not from the first tick.
+	start := clock.Now()
+	tick(s, start.Add(DefaultTooltipDelay-time.Millisecond))
+	if s.TooltipText() != "" {
+		t.Fatal("tooltip shown before the delay")
+	}
+	tick(s, start.Add(DefaultTooltipDelay))
+	if s.TooltipText() != "Press Enter to search" {
+		t.Fatalf("tooltip = %q, want the focused widget's hint", s.TooltipText())
+	}
+	s.Render()
+	if got := s.Buffer().Get(1, 0).Rune; got != 'P' {
+		t.Fatalf("tooltip cell = %q, want the hint above when there is no room below", got)
+	}
+
+	s.HandleMessage(KeyMsg{Key: terminal.KeyRune, Rune: 'a'})
+	tick(s, start.Add(time.Hour))
+	if s.TooltipText() != "" {
+		t.Fatal("a key press should dismiss the tooltip until focus moves")
+	}
+}
+
+func TestScreen_TooltipDisabled(t *testing.T) {
+	s := NewScreen(30, 10)
+	s.SetRoot(newTipWidget("hint"))
+	s.SetTooltipDelay(-1)
+	s.HandleMessage(MouseMsg{X: 1, Y: 1, Action: MouseMove})
+	tick(s, time.Unix(100, 0))
+	tick(s, time.Unix(200, 0))
+	if s.TooltipText() != "" {
+		t.Fatal("a negative delay should disable tooltips")
+	}
+}
+
+func TestScreen_TooltipPlainWithoutRenderer(t *testing.T) {
+	s := NewScreen(30, 10)
+	s.SetRoot(VBox(Fixed(newTipWidget("[bold]Ctrl+S[/] saves"))))
+	s.HandleMessage(MouseMsg{X: 1, Y: 1, Action: MouseMove})
+	tick(s, time.Unix(100, 0))
+	tick(s, time.Unix(101, 0))
+	s.Render()
+
+	buf := s.Buffer()
+	var row []rune
+	for x := 0; x < 23; x++ {
+		row = append(row, buf.Get(x, 2).Rune)
+	}
+	if got := string(row); got != " [bold]Ctrl+S[/] saves " {
+		t.Fatalf("tooltip row = %q, want the text drawn as-is", got)
+	}
+	if buf.Get(1, 2).Style.Attributes()&backend.AttrReverse == 0 {
+		t.Fatal("expected a reverse-video tooltip")
+	}
+}
+
+type upperTooltips struct{}
+
+func (upperTooltips) TooltipWidget(text string) Widget {
+	return plainTooltip{strings.ToUpper(text)}
+}
+
+func TestScreen_TooltipRendererFromServices(t *testing.T) {
+	app := NewApp(AppConfig{TooltipRenderer: upperTooltips{}})
+	s := NewScreen(30, 10)
+	s.SetServices(app.Services())
+	s.SetRoot(VBox(Fixed(newTipWidget("save"))))
+	s.HandleMessage(MouseMsg{X: 1, Y: 1, Action: MouseMove})
+	tick(s, time.Unix(100, 0))
+	tick(s, time.Unix(101, 0))
+	s.Render()
+
+	if got := s.Buffer().Get(1, 2).Rune; got != 'S' {
+		t.Fatalf("tooltip cell = %q, want the renderer's widget", got)
+	}
+}
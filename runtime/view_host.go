@@ -0,0 +1,130 @@
+package runtime
+
+// viewHost tracks the lifecycle state containers pass on to the single view
+// they show, such as routers and responsive layouts. Only the shown view is
+// bound, mounted, and reported as a child.
+type viewHost struct {
+	bounds   Rect
+	services Services
+	bound    bool
+	mounted  bool
+}
+
+// Bind stores the services handed to views as they are shown.
+func (h *viewHost) Bind(services Services) {
+	h.services = services
+	h.bound = true
+}
+
+// Unbind forgets the app services.
+func (h *viewHost) Unbind() {
+	h.services = Services{}
+	h.bound = false
+}
+
+// Mount marks the container as on screen.
+func (h *viewHost) Mount() {
+	h.mounted = true
+}
+
+// Unmount marks the container as off screen.
+func (h *viewHost) Unmount() {
+	h.mounted = false
+}
+
+// Bounds returns the container's assigned bounds.
+func (h *viewHost) Bounds() Rect {
+	return h.bounds
+}
+
+// swap hides from and shows to, then refreshes layout and focus.
+func (h *viewHost) swap(from, to Widget, contentBounds Rect) {
+	if from != to {
+		h.replace(from, to)
+		if to != nil {
+			to.Layout(contentBounds)
+		}
+	}
+	h.services.childrenChanged(true)
+}
+
+// replace unmounts and unbinds the widgets of from, then binds and mounts
+// the widgets of to. Widgets found in both trees are left alone, so they
+// keep their state across the switch.
+func (h *viewHost) replace(from, to Widget) {
+	if from == to {
+		return
+	}
+	oldTree := treeWidgets(from)
+	newTree := treeWidgets(to)
+	inOld := make(map[Widget]bool, len(oldTree))
+	for _, w := range oldTree {
+		inOld[w] = true
+	}
+	inNew := make(map[Widget]bool, len(newTree))
+	for _, w := range newTree {
+		inNew[w] = true
+	}
+	// Reverse pre-order visits children before their parents, as
+	// UnmountTree and UnbindTree do.
+	if h.mounted {
+		for i := len(oldTree) - 1; i >= 0; i-- {
+			if m, ok := oldTree[i].(Lifecycle); ok && !inNew[oldTree[i]] {
+				m.Unmount()
+			}
+		}
+	}
+	if h.bound {
+		for i := len(oldTree) - 1; i >= 0; i-- {
+			if u, ok := oldTree[i].(Unbindable); ok && !inNew[oldTree[i]] {
+				u.Unbind()
+			}
+		}
+	}
+	if h.bound && !h.services.isZero() {
+		for _, w := range newTree {
+			if b, ok := w.(Bindable); ok && !inOld[w] {
+				b.Bind(h.services)
+			}
+		}
+	}
+	if h.mounted {
+		for _, w := range newTree {
+			if m, ok := w.(Lifecycle); ok && !inOld[w] {
+				m.Mount()
+			}
+		}
+	}
+}
+
+// treeWidgets lists root and its descendants in pre-order.
+func treeWidgets(root Widget) []Widget {
+	if root == nil {
+		return nil
+	}
+	out := []Widget{root}
+	if children, ok := root.(ChildProvider); ok {
+		for _, child := range children.ChildWidgets() {
+			out = append(out, treeWidgets(child)...)
+		}
+	}
+	return out
+}
+
+// childrenChanged rescans focusables after a container swaps its children,
+// keeping focus on widgets that are still in the tree, and redraws.
+// Containers that swap outside of Layout also ask for a relayout.
+func (s Services) childrenChanged(relayout bool) {
+	if s.app == nil {
+		return
+	}
+	if screen := s.app.screen; screen != nil {
+		if relayout {
+			screen.relayout()
+		}
+		if screen.autoRegisterFocus {
+			screen.refreshFocusablesKeepingFocus()
+		}
+	}
+	s.app.Invalidate()
+}
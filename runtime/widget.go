@@ -92,6 +92,11 @@ func (s Size) Zero() bool {
 	return s.Width == 0 && s.Height == 0
 }
 
+// Point is a cell position on screen.
+type Point struct {
+	X, Y int
+}
+
 // Rect is a positioned rectangle.
 type Rect struct {
 	X, Y, Width, Height int
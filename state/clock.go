@@ -0,0 +1,150 @@
+package state
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time for time-based signals.
+type Clock interface {
+	Now() time.Time
+	AfterFunc(d time.Duration, fn func()) Timer
+}
+
+// Timer is a pending callback returned by Clock.AfterFunc.
+type Timer interface {
+	// Stop cancels the timer and reports whether it was still pending.
+	Stop() bool
+}
+
+// SystemClock uses the real wall clock.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) AfterFunc(d time.Duration, fn func()) Timer {
+	return time.AfterFunc(d, fn)
+}
+
+// ManualClock is a deterministic Clock that only moves when advanced.
+// Timers fire synchronously inside Advance, in deadline order.
+type ManualClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*manualTimer
+	seq    int
+}
+
+type manualTimer struct {
+	clock    *ManualClock
+	deadline time.Time
+	seq      int
+	fn       func()
+	stopped  bool
+}
+
+// NewManualClock creates a manual clock starting at a fixed instant.
+func NewManualClock() *ManualClock {
+	return &ManualClock{now: time.Unix(0, 0).UTC()}
+}
+
+// Now returns the current manual time.
+func (c *ManualClock) Now() time.Time {
+	if c == nil {
+		return time.Time{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc schedules fn to run once the clock has advanced by d.
+func (c *ManualClock) AfterFunc(d time.Duration, fn func()) Timer {
+	if c == nil {
+		return &manualTimer{stopped: true}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.seq++
+	t := &manualTimer{clock: c, deadline: c.now.Add(d), seq: c.seq, fn: fn}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward by d, firing due timers in order.
+func (c *ManualClock) Advance(d time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	target := c.now.Add(d)
+	c.mu.Unlock()
+	for {
+		c.mu.Lock()
+		next := c.popDueLocked(target)
+		if next == nil {
+			c.now = target
+			c.mu.Unlock()
+			return
+		}
+		if next.deadline.After(c.now) {
+			c.now = next.deadline
+		}
+		c.mu.Unlock()
+		if next.fn != nil {
+			next.fn()
+		}
+	}
+}
+
+// Pending reports the number of timers that have not fired or been stopped.
+func (c *ManualClock) Pending() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+func (c *ManualClock) popDueLocked(target time.Time) *manualTimer {
+	if len(c.timers) == 0 {
+		return nil
+	}
+	sort.Slice(c.timers, func(i, j int) bool {
+		if c.timers[i].deadline.Equal(c.timers[j].deadline) {
+			return c.timers[i].seq < c.timers[j].seq
+		}
+		return c.timers[i].deadline.Before(c.timers[j].deadline)
+	})
+	first := c.timers[0]
+	if first.deadline.After(target) {
+		return nil
+	}
+	c.timers = c.timers[1:]
+	first.stopped = true
+	return first
+}
+
+func (t *manualTimer) Stop() bool {
+	if t == nil || t.clock == nil {
+		return false
+	}
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t.stopped {
+		return false
+	}
+	t.stopped = true
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			break
+		}
+	}
+	return true
+}
@@ -0,0 +1,287 @@
+package state
+
+import "sync"
+
+// ListChangeKind identifies the kind of change applied to a ListSignal.
+type ListChangeKind int
+
+const (
+	// ListAdded means Count items were inserted starting at Index.
+	ListAdded ListChangeKind = iota
+	// ListRemoved means Count items were removed starting at Index.
+	ListRemoved
+	// ListMoved means the item at From now lives at To.
+	ListMoved
+	// ListUpdated means the item at Index was replaced.
+	ListUpdated
+	// ListReset means the whole list was replaced.
+	ListReset
+)
+
+// String returns the change kind name.
+func (k ListChangeKind) String() string {
+	switch k {
+	case ListAdded:
+		return "added"
+	case ListRemoved:
+		return "removed"
+	case ListMoved:
+		return "moved"
+	case ListUpdated:
+		return "updated"
+	case ListReset:
+		return "reset"
+	default:
+		return "unknown"
+	}
+}
+
+// ListChange describes a single granular change to a ListSignal.
+type ListChange struct {
+	Kind  ListChangeKind
+	Index int
+	Count int
+	From  int
+	To    int
+}
+
+// ListSignal holds a slice and emits granular change events.
+// Subscribe listeners fire on every change; ObserveChanges listeners
+// also receive the ListChange describing which indices were affected.
+type ListSignal[T any] struct {
+	mu         sync.Mutex
+	items      []T
+	subs       map[int]subscriber
+	changeSubs map[int]listChangeSubscriber
+	next       int
+}
+
+type listChangeSubscriber struct {
+	fn        func(ListChange)
+	scheduler Scheduler
+}
+
+// NewListSignal creates a list signal with optional initial items.
+func NewListSignal[T any](initial ...T) *ListSignal[T] {
+	return &ListSignal[T]{items: append([]T(nil), initial...)}
+}
+
+// Get returns a copy of the current items.
+func (l *ListSignal[T]) Get() []T {
+	return l.Snapshot()
+}
+
+// Snapshot returns a copy of the current items.
+func (l *ListSignal[T]) Snapshot() []T {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	out := append([]T(nil), l.items...)
+	l.mu.Unlock()
+	recordDependency(l)
+	return out
+}
+
+// Len returns the number of items.
+func (l *ListSignal[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	l.mu.Lock()
+	n := len(l.items)
+	l.mu.Unlock()
+	recordDependency(l)
+	return n
+}
+
+// At returns the item at index.
+func (l *ListSignal[T]) At(index int) (T, bool) {
+	var zero T
+	if l == nil {
+		return zero, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if index < 0 || index >= len(l.items) {
+		return zero, false
+	}
+	return l.items[index], true
+}
+
+// Append adds items to the end of the list.
+func (l *ListSignal[T]) Append(items ...T) {
+	if l == nil || len(items) == 0 {
+		return
+	}
+	l.mu.Lock()
+	index := len(l.items)
+	l.items = append(l.items, items...)
+	l.emitLocked(ListChange{Kind: ListAdded, Index: index, Count: len(items)})
+}
+
+// InsertAt inserts items before index. Index is clamped to the list bounds.
+func (l *ListSignal[T]) InsertAt(index int, items ...T) {
+	if l == nil || len(items) == 0 {
+		return
+	}
+	l.mu.Lock()
+	index = max(0, min(index, len(l.items)))
+	grown := make([]T, 0, len(l.items)+len(items))
+	grown = append(grown, l.items[:index]...)
+	grown = append(grown, items...)
+	grown = append(grown, l.items[index:]...)
+	l.items = grown
+	l.emitLocked(ListChange{Kind: ListAdded, Index: index, Count: len(items)})
+}
+
+// RemoveAt removes the item at index and returns it.
+func (l *ListSignal[T]) RemoveAt(index int) (T, bool) {
+	var zero T
+	if l == nil {
+		return zero, false
+	}
+	l.mu.Lock()
+	if index < 0 || index >= len(l.items) {
+		l.mu.Unlock()
+		return zero, false
+	}
+	removed := l.items[index]
+	l.items = append(l.items[:index:index], l.items[index+1:]...)
+	l.emitLocked(ListChange{Kind: ListRemoved, Index: index, Count: 1})
+	return removed, true
+}
+
+// Move relocates the item at from so that it ends up at index to.
+func (l *ListSignal[T]) Move(from, to int) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	n := len(l.items)
+	if from < 0 || from >= n || to < 0 || to >= n {
+		l.mu.Unlock()
+		return false
+	}
+	if from == to {
+		l.mu.Unlock()
+		return true
+	}
+	item := l.items[from]
+	if from < to {
+		copy(l.items[from:to], l.items[from+1:to+1])
+	} else {
+		copy(l.items[to+1:from+1], l.items[to:from])
+	}
+	l.items[to] = item
+	l.emitLocked(ListChange{Kind: ListMoved, Index: to, Count: 1, From: from, To: to})
+	return true
+}
+
+// Set replaces the item at index.
+func (l *ListSignal[T]) Set(index int, value T) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	if index < 0 || index >= len(l.items) {
+		l.mu.Unlock()
+		return false
+	}
+	l.items[index] = value
+	l.emitLocked(ListChange{Kind: ListUpdated, Index: index, Count: 1})
+	return true
+}
+
+// Reset replaces the entire list.
+func (l *ListSignal[T]) Reset(items []T) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	l.items = append([]T(nil), items...)
+	l.emitLocked(ListChange{Kind: ListReset, Count: len(l.items)})
+}
+
+// Subscribe registers a listener for any change.
+func (l *ListSignal[T]) Subscribe(fn func()) func() {
+	return l.SubscribeWithScheduler(nil, fn)
+}
+
+// SubscribeWithScheduler registers a listener using a scheduler.
+// If scheduler is nil, callbacks run synchronously.
+func (l *ListSignal[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func() {
+	if l == nil || fn == nil {
+		return func() {}
+	}
+	l.mu.Lock()
+	if l.subs == nil {
+		l.subs = make(map[int]subscriber)
+	}
+	id := l.next
+	l.next++
+	l.subs[id] = subscriber{fn: fn, scheduler: scheduler}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			delete(l.subs, id)
+			l.mu.Unlock()
+		})
+	}
+}
+
+// ObserveChanges registers a listener that receives each granular change.
+// If scheduler is nil, callbacks run synchronously.
+func (l *ListSignal[T]) ObserveChanges(scheduler Scheduler, fn func(ListChange)) func() {
+	if l == nil || fn == nil {
+		return func() {}
+	}
+	l.mu.Lock()
+	if l.changeSubs == nil {
+		l.changeSubs = make(map[int]listChangeSubscriber)
+	}
+	id := l.next
+	l.next++
+	l.changeSubs[id] = listChangeSubscriber{fn: fn, scheduler: scheduler}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			delete(l.changeSubs, id)
+			l.mu.Unlock()
+		})
+	}
+}
+
+// emitLocked releases the lock and notifies listeners of change.
+func (l *ListSignal[T]) emitLocked(change ListChange) {
+	var subs []subscriber
+	if len(l.subs) > 0 {
+		subs = acquireSubscribers(len(l.subs))
+		for _, sub := range l.subs {
+			subs = append(subs, sub)
+		}
+	}
+	changeSubs := make([]listChangeSubscriber, 0, len(l.changeSubs))
+	for _, sub := range l.changeSubs {
+		changeSubs = append(changeSubs, sub)
+	}
+	l.mu.Unlock()
+
+	for _, sub := range changeSubs {
+		fn := sub.fn
+		if sub.scheduler == nil {
+			fn(change)
+			continue
+		}
+		sub.scheduler.Schedule(func() { fn(change) })
+	}
+	notifySubscribers(subs)
+}
+
+var _ Readable[[]int] = (*ListSignal[int])(nil)
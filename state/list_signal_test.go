@@ -0,0 +1,91 @@
+package state
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListSignalOperations(t *testing.T) {
+	list := NewListSignal("a", "b")
+	var changes []ListChange
+	list.ObserveChanges(nil, func(change ListChange) {
+		changes = append(changes, change)
+	})
+	notified := 0
+	list.Subscribe(func() { notified++ })
+
+	list.Append("c", "d")
+	list.InsertAt(1, "x")
+	if removed, ok := list.RemoveAt(0); !ok || removed != "a" {
+		t.Fatalf("RemoveAt(0) = %q, %v", removed, ok)
+	}
+	if !list.Move(0, 3) {
+		t.Fatalf("expected move to succeed")
+	}
+	if !list.Set(0, "B") {
+		t.Fatalf("expected set to succeed")
+	}
+
+	if got, want := list.Snapshot(), []string{"B", "c", "d", "x"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Snapshot() = %v, want %v", got, want)
+	}
+	want := []ListChange{
+		{Kind: ListAdded, Index: 2, Count: 2},
+		{Kind: ListAdded, Index: 1, Count: 1},
+		{Kind: ListRemoved, Index: 0, Count: 1},
+		{Kind: ListMoved, Index: 3, Count: 1, From: 0, To: 3},
+		{Kind: ListUpdated, Index: 0, Count: 1},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Fatalf("changes = %+v, want %+v", changes, want)
+	}
+	if notified != len(want) {
+		t.Fatalf("expected %d notifications, got %d", len(want), notified)
+	}
+}
+
+func TestListSignalMoveBackward(t *testing.T) {
+	list := NewListSignal(1, 2, 3, 4)
+	list.Move(3, 1)
+	if got, want := list.Snapshot(), []int{1, 4, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Snapshot() = %v, want %v", got, want)
+	}
+}
+
+func TestListSignalBounds(t *testing.T) {
+	list := NewListSignal[int]()
+	if _, ok := list.RemoveAt(0); ok {
+		t.Fatalf("expected RemoveAt on empty list to fail")
+	}
+	if list.Move(0, 1) || list.Set(2, 1) {
+		t.Fatalf("expected out-of-range operations to fail")
+	}
+	list.InsertAt(10, 7)
+	if got := list.Snapshot(); !reflect.DeepEqual(got, []int{7}) {
+		t.Fatalf("expected clamped insert, got %v", got)
+	}
+}
+
+func TestListSignalSnapshotIsCopy(t *testing.T) {
+	list := NewListSignal(1, 2)
+	snap := list.Snapshot()
+	snap[0] = 99
+	if v, _ := list.At(0); v != 1 {
+		t.Fatalf("expected snapshot mutation not to leak, got %d", v)
+	}
+}
+
+func TestListSignalTrackedByComputed(t *testing.T) {
+	list := NewListSignal(1, 2)
+	total := NewComputed(func() int {
+		sum := 0
+		for _, v := range list.Snapshot() {
+			sum += v
+		}
+		return sum
+	})
+	list.Append(3)
+	if total.Get() != 6 {
+		t.Fatalf("expected computed to follow list, got %d", total.Get())
+	}
+}
@@ -158,6 +158,11 @@ func (s *Signal[T]) copySubscribersLocked() []subscriber {
 }
 
 func (s *Signal[T]) notify(subs []subscriber) {
+	notifySubscribers(subs)
+}
+
+// notifySubscribers dispatches and releases a pooled subscriber slice.
+func notifySubscribers(subs []subscriber) {
 	if len(subs) == 0 {
 		return
 	}
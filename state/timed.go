@@ -0,0 +1,178 @@
+package state
+
+import (
+	"sync"
+	"time"
+)
+
+// Timed is a signal derived from a source that emits on a time schedule.
+// Emissions happen on the clock's timer goroutine for SystemClock, so
+// UI consumers should subscribe with the app scheduler.
+type Timed[T any] struct {
+	signal   *Signal[T]
+	src      *Signal[T]
+	clock    Clock
+	interval time.Duration
+	throttle bool
+
+	mu       sync.Mutex
+	unsub    func()
+	timer    Timer
+	pending  bool
+	latest   T
+	lastEmit time.Time
+	emitted  bool
+}
+
+// Debounced emits the source value once it has been quiet for d.
+// The trailing value is always delivered after the quiet period.
+func Debounced[T any](src *Signal[T], d time.Duration) *Timed[T] {
+	return DebouncedWithClock(SystemClock, src, d)
+}
+
+// DebouncedWithClock is Debounced with an explicit clock.
+func DebouncedWithClock[T any](clock Clock, src *Signal[T], d time.Duration) *Timed[T] {
+	return newTimed(clock, src, d, false)
+}
+
+// Throttled emits the source value at most once per d. The first change
+// is delivered immediately and the latest value is delivered at the end
+// of each window so the final state is never lost.
+func Throttled[T any](src *Signal[T], d time.Duration) *Timed[T] {
+	return ThrottledWithClock(SystemClock, src, d)
+}
+
+// ThrottledWithClock is Throttled with an explicit clock.
+func ThrottledWithClock[T any](clock Clock, src *Signal[T], d time.Duration) *Timed[T] {
+	return newTimed(clock, src, d, true)
+}
+
+func newTimed[T any](clock Clock, src *Signal[T], d time.Duration, throttle bool) *Timed[T] {
+	if clock == nil {
+		clock = SystemClock
+	}
+	var initial T
+	if src != nil {
+		initial = src.Get()
+	}
+	t := &Timed[T]{
+		signal:   NewSignal(initial),
+		src:      src,
+		clock:    clock,
+		interval: d,
+		throttle: throttle,
+	}
+	if src != nil {
+		t.unsub = src.Subscribe(t.onChange)
+	}
+	return t
+}
+
+// Get returns the last emitted value.
+func (t *Timed[T]) Get() T {
+	if t == nil {
+		var zero T
+		return zero
+	}
+	return t.signal.Get()
+}
+
+// Subscribe registers a listener for emissions.
+func (t *Timed[T]) Subscribe(fn func()) func() {
+	if t == nil {
+		return func() {}
+	}
+	return t.signal.Subscribe(fn)
+}
+
+// SubscribeWithScheduler registers a listener using a scheduler.
+// If scheduler is nil, callbacks run synchronously.
+func (t *Timed[T]) SubscribeWithScheduler(scheduler Scheduler, fn func()) func() {
+	if t == nil {
+		return func() {}
+	}
+	return t.signal.SubscribeWithScheduler(scheduler, fn)
+}
+
+// Flush emits any pending value immediately.
+func (t *Timed[T]) Flush() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.mu.Unlock()
+	t.fire()
+}
+
+// Stop unsubscribes from the source and cancels pending emissions.
+func (t *Timed[T]) Stop() {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	unsub := t.unsub
+	t.unsub = nil
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+	t.pending = false
+	t.mu.Unlock()
+	if unsub != nil {
+		unsub()
+	}
+}
+
+func (t *Timed[T]) onChange() {
+	value := t.src.Get()
+	t.mu.Lock()
+	t.latest = value
+	t.pending = true
+	if !t.throttle || t.interval <= 0 {
+		if t.timer != nil {
+			t.timer.Stop()
+		}
+		if t.interval <= 0 {
+			t.timer = nil
+			t.mu.Unlock()
+			t.fire()
+			return
+		}
+		t.timer = t.clock.AfterFunc(t.interval, t.fire)
+		t.mu.Unlock()
+		return
+	}
+	if t.timer != nil {
+		// A trailing emission is already scheduled for this window.
+		t.mu.Unlock()
+		return
+	}
+	now := t.clock.Now()
+	elapsed := now.Sub(t.lastEmit)
+	if !t.emitted || elapsed >= t.interval {
+		t.mu.Unlock()
+		t.fire()
+		return
+	}
+	t.timer = t.clock.AfterFunc(t.interval-elapsed, t.fire)
+	t.mu.Unlock()
+}
+
+func (t *Timed[T]) fire() {
+	t.mu.Lock()
+	t.timer = nil
+	if !t.pending {
+		t.mu.Unlock()
+		return
+	}
+	value := t.latest
+	t.pending = false
+	t.emitted = true
+	t.lastEmit = t.clock.Now()
+	t.mu.Unlock()
+	t.signal.Set(value)
+}
@@ -0,0 +1,104 @@
+package state
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDebouncedDeliversTrailingValue(t *testing.T) {
+	clock := NewManualClock()
+	src := NewSignal(0)
+	deb := DebouncedWithClock(clock, src, 100*time.Millisecond)
+	defer deb.Stop()
+
+	emits := 0
+	deb.Subscribe(func() { emits++ })
+
+	src.Set(1)
+	clock.Advance(50 * time.Millisecond)
+	src.Set(2)
+	clock.Advance(50 * time.Millisecond)
+	src.Set(3)
+	clock.Advance(99 * time.Millisecond)
+	if emits != 0 || deb.Get() != 0 {
+		t.Fatalf("expected no emission during burst, got %d emits value %d", emits, deb.Get())
+	}
+
+	clock.Advance(time.Millisecond)
+	if emits != 1 || deb.Get() != 3 {
+		t.Fatalf("expected trailing value 3 once, got %d emits value %d", emits, deb.Get())
+	}
+	if clock.Pending() != 0 {
+		t.Fatalf("expected no pending timers, got %d", clock.Pending())
+	}
+}
+
+func TestThrottledLeadingAndTrailing(t *testing.T) {
+	clock := NewManualClock()
+	src := NewSignal(0)
+	thr := ThrottledWithClock(clock, src, 100*time.Millisecond)
+	defer thr.Stop()
+
+	var seen []int
+	thr.Subscribe(func() { seen = append(seen, thr.Get()) })
+
+	src.Set(1)
+	if len(seen) != 1 || seen[0] != 1 {
+		t.Fatalf("expected leading emission of 1, got %v", seen)
+	}
+	src.Set(2)
+	clock.Advance(30 * time.Millisecond)
+	src.Set(3)
+	if len(seen) != 1 {
+		t.Fatalf("expected throttled window to suppress emissions, got %v", seen)
+	}
+	clock.Advance(70 * time.Millisecond)
+	if len(seen) != 2 || seen[1] != 3 {
+		t.Fatalf("expected trailing emission of 3, got %v", seen)
+	}
+
+	clock.Advance(200 * time.Millisecond)
+	src.Set(4)
+	if len(seen) != 3 || seen[2] != 4 {
+		t.Fatalf("expected immediate emission after quiet window, got %v", seen)
+	}
+}
+
+func TestTimedStopCancelsPending(t *testing.T) {
+	clock := NewManualClock()
+	src := NewSignal("a")
+	deb := DebouncedWithClock(clock, src, 10*time.Millisecond)
+
+	src.Set("b")
+	deb.Stop()
+	clock.Advance(time.Second)
+	if got := deb.Get(); got != "a" {
+		t.Fatalf("expected stopped debounce to keep %q, got %q", "a", got)
+	}
+	src.Set("c")
+	if clock.Pending() != 0 {
+		t.Fatalf("expected no timers after stop, got %d", clock.Pending())
+	}
+}
+
+func TestTimedFlush(t *testing.T) {
+	clock := NewManualClock()
+	src := NewSignal(1)
+	deb := DebouncedWithClock(clock, src, time.Minute)
+	defer deb.Stop()
+
+	src.Set(5)
+	deb.Flush()
+	if deb.Get() != 5 {
+		t.Fatalf("expected flush to emit 5, got %d", deb.Get())
+	}
+}
+
+func TestTimedNilSource(t *testing.T) {
+	deb := DebouncedWithClock[int](NewManualClock(), nil, time.Second)
+	if deb.Get() != 0 {
+		t.Fatalf("expected zero value, got %d", deb.Get())
+	}
+	deb.Flush()
+	deb.Stop()
+}
@@ -0,0 +1,260 @@
+//go:build !js
+
+package testing
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/odvcencio/fluffyui/recording"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+// maxGoldenChanges caps the cell changes listed under a golden diff.
+const maxGoldenChanges = 20
+
+var (
+	recordingsMu sync.Mutex
+	recordings   = map[string][]byte{}
+)
+
+// RecordWidget renders w at width x height and records the frame as an
+// asciicast. CompareGolden with the same path checks the recording against
+// the committed cast.
+func RecordWidget(t *testing.T, w runtime.Widget, width, height int, path string) {
+	t.Helper()
+	if path == "" {
+		t.Fatalf("golden path is empty")
+	}
+	buf := LayoutAndRender(w, width, height)
+	var out bytes.Buffer
+	// A fixed clock and empty env keep the cast byte-stable across runs.
+	recorder := recording.NewAsciicastRecorderWriter(&out, recording.AsciicastOptions{Env: map[string]string{}})
+	epoch := time.Unix(0, 0)
+	if err := recorder.Start(width, height, epoch); err != nil {
+		t.Fatalf("record %s: %v", path, err)
+	}
+	if err := recorder.Frame(buf, epoch); err != nil {
+		t.Fatalf("record %s: %v", path, err)
+	}
+	key := filepath.Clean(path)
+	recordingsMu.Lock()
+	recordings[key] = out.Bytes()
+	recordingsMu.Unlock()
+	t.Cleanup(func() {
+		recordingsMu.Lock()
+		delete(recordings, key)
+		recordingsMu.Unlock()
+	})
+}
+
+// CompareGolden diffs the cast recorded by RecordWidget against the golden
+// cast at path, reporting changed cells side by side. When
+// FLUFFYUI_UPDATE_SNAPSHOTS is set (fluffy test --update-golden), it writes
+// the recording to path instead.
+func CompareGolden(t *testing.T, path string) {
+	t.Helper()
+	recordingsMu.Lock()
+	actual, ok := recordings[filepath.Clean(path)]
+	recordingsMu.Unlock()
+	if !ok {
+		t.Fatalf("no recording for %s; call RecordWidget first", path)
+	}
+	if UpdateSnapshots() {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create golden dir: %v", err)
+		}
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatalf("failed to write golden file: %v", err)
+		}
+		return
+	}
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v (run fluffy test --update-golden to create it)", err)
+	}
+	if bytes.Equal(expected, actual) {
+		return
+	}
+	want, err := decodeCast(expected)
+	if err != nil {
+		t.Fatalf("decode golden %s: %v", path, err)
+	}
+	got, err := decodeCast(actual)
+	if err != nil {
+		t.Fatalf("decode recording for %s: %v", path, err)
+	}
+	if diff := diffCasts(want, got, os.Getenv("NO_COLOR") == ""); diff != "" {
+		t.Fatalf("golden mismatch for %s:\n%s", path, diff)
+	}
+}
+
+// castCell is a decoded screen cell. SGR holds the parameters of the last
+// style sequence, which the recorder always emits in full.
+type castCell struct {
+	r   rune
+	sgr string
+}
+
+type castScreen struct {
+	width, height int
+	cells         [][]castCell
+}
+
+// decodeCast replays an asciicast v2 recording made by the recording
+// package and returns the final screen. It understands the subset of ANSI
+// the recorder writes: absolute and forward cursor moves, SGR, and clears.
+func decodeCast(data []byte) (*castScreen, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	if !scanner.Scan() {
+		return nil, fmt.Errorf("missing asciicast header")
+	}
+	var header struct {
+		Width  int `json:"width"`
+		Height int `json:"height"`
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, fmt.Errorf("header: %w", err)
+	}
+	screen := &castScreen{width: header.Width, height: header.Height}
+	screen.clear()
+	x, y, sgr := 0, 0, ""
+	for scanner.Scan() {
+		var event []any
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("event: %w", err)
+		}
+		if len(event) < 3 || event[1] != "o" {
+			continue
+		}
+		out, _ := event[2].(string)
+		for i := 0; i < len(out); {
+			if out[i] != 0x1b || i+1 >= len(out) || out[i+1] != '[' {
+				r, size := utf8.DecodeRuneInString(out[i:])
+				screen.set(x, y, castCell{r: r, sgr: sgr})
+				x++
+				i += size
+				continue
+			}
+			end := i + 2
+			for end < len(out) && (out[end] < 0x40 || out[end] > 0x7e) {
+				end++
+			}
+			if end >= len(out) {
+				break
+			}
+			params := out[i+2 : end]
+			switch out[end] {
+			case 'H':
+				row, col := 1, 1
+				if before, after, ok := strings.Cut(params, ";"); ok {
+					row, _ = strconv.Atoi(before)
+					col, _ = strconv.Atoi(after)
+				}
+				x, y = col-1, row-1
+			case 'C':
+				n, err := strconv.Atoi(params)
+				if err != nil {
+					n = 1
+				}
+				x += n
+			case 'm':
+				sgr = params
+				if sgr == "0" {
+					sgr = ""
+				}
+			case 'J':
+				if params == "2" {
+					screen.clear()
+				}
+			}
+			i = end + 1
+		}
+	}
+	return screen, scanner.Err()
+}
+
+func (s *castScreen) clear() {
+	s.cells = make([][]castCell, s.height)
+	for y := range s.cells {
+		row := make([]castCell, s.width)
+		for x := range row {
+			row[x] = castCell{r: ' '}
+		}
+		s.cells[y] = row
+	}
+}
+
+func (s *castScreen) set(x, y int, cell castCell) {
+	if y < 0 || y >= len(s.cells) || x < 0 || x >= len(s.cells[y]) {
+		return
+	}
+	s.cells[y][x] = cell
+}
+
+func (s *castScreen) at(x, y int) castCell {
+	if y < 0 || y >= len(s.cells) || x < 0 || x >= len(s.cells[y]) {
+		return castCell{r: ' '}
+	}
+	return s.cells[y][x]
+}
+
+// diffCasts renders the rows that differ side by side, golden on the left
+// and current on the right, followed by a list of changed cells. It returns
+// "" when the screens match.
+func diffCasts(want, got *castScreen, color bool) string {
+	var b strings.Builder
+	if want.width != got.width || want.height != got.height {
+		fmt.Fprintf(&b, "size changed: golden %dx%d, current %dx%d\n", want.width, want.height, got.width, got.height)
+	}
+	width := max(want.width, got.width)
+	height := max(want.height, got.height)
+	mark := func(r rune, changed bool, sgr string) string {
+		if !changed || !color {
+			return string(r)
+		}
+		return "\x1b[" + sgr + "m" + string(r) + "\x1b[0m"
+	}
+	fmt.Fprintf(&b, "row | %-*s | current\n", width, "golden")
+	var changes []string
+	total := 0
+	for y := 0; y < height; y++ {
+		var left, right strings.Builder
+		rowChanged := false
+		for x := 0; x < width; x++ {
+			w, g := want.at(x, y), got.at(x, y)
+			changed := w != g
+			if changed {
+				rowChanged = true
+				total++
+				if len(changes) < maxGoldenChanges {
+					changes = append(changes, fmt.Sprintf("  (%d,%d) %q [%s] -> %q [%s]", x, y, w.r, w.sgr, g.r, g.sgr))
+				}
+			}
+			left.WriteString(mark(w.r, changed, "41"))
+			right.WriteString(mark(g.r, changed, "42"))
+		}
+		if rowChanged {
+			fmt.Fprintf(&b, "%3d | %s | %s\n", y, left.String(), right.String())
+		}
+	}
+	if total == 0 && want.width == got.width && want.height == got.height {
+		return ""
+	}
+	fmt.Fprintf(&b, "%d cells changed (golden -> current):\n%s", total, strings.Join(changes, "\n"))
+	if total > len(changes) {
+		fmt.Fprintf(&b, "\n  ... and %d more", total-len(changes))
+	}
+	return b.String()
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/widgets"
+)
+
+func TestRecordWidgetCompareGolden(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "golden", "label.cast")
+
+	t.Setenv("FLUFFYUI_UPDATE_SNAPSHOTS", "1")
+	RecordWidget(t, widgets.NewLabel("Hello"), 10, 2, path)
+	CompareGolden(t, path)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden not written: %v", err)
+	}
+	if !strings.HasPrefix(string(data), `{"height":2,"timestamp":0,"version":2,"width":10}`) {
+		t.Fatalf("unexpected cast header: %q", strings.SplitN(string(data), "\n", 2)[0])
+	}
+
+	t.Setenv("FLUFFYUI_UPDATE_SNAPSHOTS", "")
+	RecordWidget(t, widgets.NewLabel("Hello"), 10, 2, path)
+	CompareGolden(t, path)
+}
+
+func TestDiffCastsReportsChangedCells(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "label.cast")
+	t.Setenv("FLUFFYUI_UPDATE_SNAPSHOTS", "1")
+	RecordWidget(t, widgets.NewLabel("Hello"), 8, 1, path)
+	CompareGolden(t, path)
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed := widgets.NewLabel("Help", widgets.WithLabelStyle(backend.DefaultStyle().Bold(true)))
+	RecordWidget(t, changed, 8, 1, path)
+	want, err := decodeCast(golden)
+	if err != nil {
+		t.Fatalf("decode golden: %v", err)
+	}
+	if got := string(want.cells[0][0].r) + string(want.cells[0][4].r); got != "Ho" {
+		t.Fatalf("decoded cells = %q, want Ho", got)
+	}
+	got, err := decodeCast(recordings[filepath.Clean(path)])
+	if err != nil {
+		t.Fatalf("decode recording: %v", err)
+	}
+
+	if diff := diffCasts(want, want, false); diff != "" {
+		t.Fatalf("identical screens should not diff:\n%s", diff)
+	}
+	diff := diffCasts(want, got, false)
+	if !strings.Contains(diff, "  0 | Hello    | Help    \n") {
+		t.Fatalf("diff missing side-by-side row:\n%s", diff)
+	}
+	if !strings.Contains(diff, `(3,0) 'l' [0;39;49] -> 'p' [0;1;39;49]`) {
+		t.Fatalf("diff missing cell change:\n%s", diff)
+	}
+	if colored := diffCasts(want, got, true); !strings.Contains(colored, "\x1b[41mH\x1b[0m") {
+		t.Fatalf("colored diff should highlight changed cells:\n%q", colored)
+	}
+}
@@ -0,0 +1,130 @@
+//go:build !js
+
+// Package simtest renders widgets through the simulation backend and
+// inspects the resulting frame.
+//
+// It packages the setup that backend tests otherwise repeat: create a sim
+// backend, measure, lay out and render the widget, flush the buffer the way
+// the app does, and read the screen back.
+package simtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/backend/sim"
+	"github.com/odvcencio/fluffyui/runtime"
+	flufftest "github.com/odvcencio/fluffyui/testing"
+)
+
+// Cell is one screen cell of a Frame.
+type Cell struct {
+	// Text is the grapheme drawn in the cell, including combining marks.
+	// It is empty for the trailing cells covered by a wide grapheme.
+	Text string
+	// Width is the number of columns the grapheme spans: 1 or 2 for a
+	// drawn cell, 0 for a continuation cell.
+	Width int
+	Style backend.Style
+}
+
+// Frame is a snapshot of the simulation screen after a render.
+type Frame struct {
+	width, height int
+	cells         []Cell
+}
+
+// Render lays out and renders w at width x height on a fresh simulation
+// backend and returns the captured screen.
+func Render(w runtime.Widget, width, height int) *Frame {
+	width = max(width, 0)
+	height = max(height, 0)
+	be := sim.New(width, height)
+	if err := be.Init(); err != nil {
+		panic("simtest: init sim backend: " + err.Error())
+	}
+	defer be.Fini()
+
+	if w != nil && width > 0 && height > 0 {
+		buf := flufftest.LayoutAndRender(w, width, height)
+		be.SetRect(0, 0, width, height, buf.Cells())
+	}
+	be.Show()
+	return capture(be, width, height)
+}
+
+// capture reads the screen back, marking the columns covered by wide
+// graphemes as continuation cells.
+func capture(be *sim.Backend, width, height int) *Frame {
+	frame := &Frame{width: width, height: height, cells: make([]Cell, width*height)}
+	for y := 0; y < height; y++ {
+		row := frame.cells[y*width : (y+1)*width]
+		for x := 0; x < width; {
+			mainc, comb, style := be.CaptureCell(x, y)
+			text := string(mainc) + string(comb)
+			cellWidth := min(max(backend.StringWidth(text), 1), width-x)
+			row[x] = Cell{Text: text, Width: cellWidth, Style: style}
+			for k := 1; k < cellWidth; k++ {
+				row[x+k] = Cell{Style: style}
+			}
+			x += cellWidth
+		}
+	}
+	return frame
+}
+
+// Size returns the frame dimensions.
+func (f *Frame) Size() (width, height int) {
+	if f == nil {
+		return 0, 0
+	}
+	return f.width, f.height
+}
+
+// CellAt returns the cell at x, y. Cells outside the frame are blank.
+func (f *Frame) CellAt(x, y int) Cell {
+	if f == nil || x < 0 || y < 0 || x >= f.width || y >= f.height {
+		return Cell{Text: " ", Width: 1, Style: backend.DefaultStyle()}
+	}
+	return f.cells[y*f.width+x]
+}
+
+// Lines returns each screen row as text with trailing spaces trimmed. A
+// wide grapheme appears once, without a placeholder for the column it
+// covers.
+func (f *Frame) Lines() []string {
+	if f == nil {
+		return nil
+	}
+	lines := make([]string, f.height)
+	for y := range lines {
+		var line strings.Builder
+		for _, cell := range f.cells[y*f.width : (y+1)*f.width] {
+			line.WriteString(cell.Text)
+		}
+		lines[y] = strings.TrimRight(line.String(), " ")
+	}
+	return lines
+}
+
+// Text returns the screen as newline-separated Lines.
+func (f *Frame) Text() string {
+	return strings.Join(f.Lines(), "\n")
+}
+
+// AssertContains fails the test if substr does not appear in the frame
+// text.
+func (f *Frame) AssertContains(t *testing.T, substr string) {
+	t.Helper()
+	if text := f.Text(); !strings.Contains(text, substr) {
+		t.Errorf("expected frame to contain %q, got:\n%s", substr, text)
+	}
+}
+
+// AssertGolden compares the frame text with the golden file at path. When
+// FLUFFYUI_UPDATE_SNAPSHOTS is set, it writes the golden file instead.
+func (f *Frame) AssertGolden(t *testing.T, path string) {
+	t.Helper()
+	flufftest.AssertGolden(t, path, f.Text()+"\n")
+}
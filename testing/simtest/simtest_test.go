@@ -0,0 +1,41 @@
+//go:build !js
+
+package simtest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/widgets"
+)
+
+func TestRenderExtractsWideRunes(t *testing.T) {
+	frame := Render(widgets.NewLabel("世界 ok"), 12, 2)
+
+	if got := frame.Text(); got != "世界 ok\n" {
+		t.Fatalf("text = %q", got)
+	}
+	if cell := frame.CellAt(0, 0); cell.Text != "世" || cell.Width != 2 {
+		t.Fatalf("cell (0,0) = %+v, want a wide grapheme", cell)
+	}
+	if cell := frame.CellAt(1, 0); cell.Text != "" || cell.Width != 0 {
+		t.Fatalf("cell (1,0) = %+v, want a continuation cell", cell)
+	}
+	if cell := frame.CellAt(5, 0); cell.Text != "o" {
+		t.Fatalf("cell (5,0) = %+v, want columns after wide runes aligned", cell)
+	}
+	frame.AssertContains(t, "界 o")
+}
+
+func TestFrameAssertGolden(t *testing.T) {
+	Render(widgets.NewLabel("Hello"), 10, 1).AssertGolden(t, "testdata/label.golden")
+
+	path := filepath.Join(t.TempDir(), "label.golden")
+	t.Setenv("FLUFFYUI_UPDATE_SNAPSHOTS", "1")
+	Render(widgets.NewLabel("Updated"), 10, 1).AssertGolden(t, path)
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "Updated\n" {
+		t.Fatalf("golden = %q, %v", data, err)
+	}
+}
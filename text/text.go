@@ -0,0 +1,94 @@
+// Package text provides grapheme- and width-aware string helpers for
+// terminal layout. Widths are display columns: combining marks take none
+// and wide characters such as CJK and emoji take two.
+package text
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/backend"
+)
+
+// Width returns the number of display columns s occupies.
+func Width(s string) int {
+	return backend.StringWidth(s)
+}
+
+// Truncate shortens s to at most width columns, appending ellipsis when
+// text is cut. If the ellipsis does not fit, s is clipped without it. The
+// result never exceeds width columns and never splits a grapheme cluster.
+func Truncate(s string, width int, ellipsis string) string {
+	if width <= 0 {
+		return ""
+	}
+	if Width(s) <= width {
+		return s
+	}
+	ew := Width(ellipsis)
+	if ew == 0 || ew >= width {
+		return backend.TruncateWidth(s, width)
+	}
+	return backend.TruncateWidth(s, width-ew) + ellipsis
+}
+
+// TruncateLeft is like Truncate but keeps the end of s, placing the
+// ellipsis at the start.
+func TruncateLeft(s string, width int, ellipsis string) string {
+	if width <= 0 {
+		return ""
+	}
+	if Width(s) <= width {
+		return s
+	}
+	ew := Width(ellipsis)
+	if ew == 0 || ew >= width {
+		return suffixWidth(s, width)
+	}
+	return ellipsis + suffixWidth(s, width-ew)
+}
+
+// Pad returns s padded with spaces to exactly width columns. Longer
+// strings are clipped first.
+func Pad(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	s = Truncate(s, width, "")
+	if pad := width - Width(s); pad > 0 {
+		s += strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// PadLeft is like Pad but adds the spaces before s.
+func PadLeft(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	s = Truncate(s, width, "")
+	if pad := width - Width(s); pad > 0 {
+		s = strings.Repeat(" ", pad) + s
+	}
+	return s
+}
+
+// suffixWidth returns the longest suffix of s that fits in width columns.
+func suffixWidth(s string, width int) string {
+	var starts, widths []int
+	offset := 0
+	backend.ForEachCluster(s, func(cluster string, w int) bool {
+		starts = append(starts, offset)
+		widths = append(widths, w)
+		offset += len(cluster)
+		return true
+	})
+	used, start := 0, len(s)
+	for i := len(starts) - 1; i >= 0; i-- {
+		if used+widths[i] > width {
+			break
+		}
+		used += widths[i]
+		start = starts[i]
+	}
+	return s[start:]
+}
@@ -0,0 +1,65 @@
+package text
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		in       string
+		width    int
+		ellipsis string
+		want     string
+	}{
+		{"hello", 10, "...", "hello"},
+		{"hello world", 8, "...", "hello..."},
+		{"hello", 3, "...", "hel"},
+		{"hello", 0, "...", ""},
+		{"日本語テキスト", 7, "…", "日本語…"},
+		{"日本語", 5, "", "日本"},
+		{"📁 documents", 6, "…", "📁 do…"},
+		{"café au lait", 5, "", "café "},
+		{"héllo", 4, ".", "hél."},
+	}
+	for _, tc := range cases {
+		got := Truncate(tc.in, tc.width, tc.ellipsis)
+		if got != tc.want {
+			t.Errorf("Truncate(%q, %d, %q) = %q, want %q", tc.in, tc.width, tc.ellipsis, got, tc.want)
+		}
+		if w := Width(got); w > tc.width {
+			t.Errorf("Truncate(%q, %d) is %d columns wide", tc.in, tc.width, w)
+		}
+	}
+}
+
+func TestTruncateLeft(t *testing.T) {
+	if got := TruncateLeft("/home/user/docs", 8, "…"); got != "…er/docs" {
+		t.Fatalf("TruncateLeft = %q", got)
+	}
+	if got := TruncateLeft("日本語", 3, ""); got != "語" {
+		t.Fatalf("TruncateLeft wide = %q", got)
+	}
+}
+
+func TestPad(t *testing.T) {
+	cases := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"ab", 4, "ab  "},
+		{"📁", 4, "📁  "},
+		{"日本語", 5, "日本 "},
+		{"abcdef", 3, "abc"},
+		{"x", 0, ""},
+	}
+	for _, tc := range cases {
+		if got := Pad(tc.in, tc.width); got != tc.want {
+			t.Errorf("Pad(%q, %d) = %q, want %q", tc.in, tc.width, got, tc.want)
+		}
+		if got := Pad(tc.in, tc.width); Width(got) != tc.width {
+			t.Errorf("Pad(%q, %d) is %d columns wide", tc.in, tc.width, Width(got))
+		}
+	}
+	if got := PadLeft("7", 3); got != "  7" {
+		t.Fatalf("PadLeft = %q", got)
+	}
+}
@@ -0,0 +1,156 @@
+package theme
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// Background describes the terminal's background brightness.
+type Background int
+
+const (
+	// BackgroundUnknown means the background could not be determined.
+	BackgroundUnknown Background = iota
+	// BackgroundDark is a dark terminal background.
+	BackgroundDark
+	// BackgroundLight is a light terminal background.
+	BackgroundLight
+)
+
+// String returns the background name.
+func (b Background) String() string {
+	switch b {
+	case BackgroundDark:
+		return "dark"
+	case BackgroundLight:
+		return "light"
+	default:
+		return "unknown"
+	}
+}
+
+// ForBackground returns the bundled theme suited to b. Unknown
+// backgrounds get the default dark theme.
+func ForBackground(b Background) *Theme {
+	if b == BackgroundLight {
+		return LightTheme()
+	}
+	return DefaultTheme()
+}
+
+// backgroundQueryTimeout bounds how long DetectTerminalBackground waits
+// for the terminal to answer.
+const backgroundQueryTimeout = 150 * time.Millisecond
+
+// DetectTerminalBackground reports whether the terminal background is
+// light or dark. It honors COLORFGBG when set and otherwise asks the
+// terminal for its background color (OSC 11). Call it before the app
+// starts reading input; the query briefly puts the terminal in raw mode.
+func DetectTerminalBackground() Background {
+	if bg := backgroundFromColorFGBG(os.Getenv("COLORFGBG")); bg != BackgroundUnknown {
+		return bg
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return BackgroundUnknown
+	}
+	defer tty.Close()
+	fd := int(tty.Fd())
+	if !term.IsTerminal(fd) {
+		return BackgroundUnknown
+	}
+	saved, err := term.MakeRaw(fd)
+	if err != nil {
+		return BackgroundUnknown
+	}
+	defer func() { _ = term.Restore(fd, saved) }()
+	if err := tty.SetReadDeadline(time.Now().Add(backgroundQueryTimeout)); err != nil {
+		return BackgroundUnknown
+	}
+	return queryBackground(tty)
+}
+
+// queryBackground writes an OSC 11 query and parses the reply.
+func queryBackground(rw io.ReadWriter) Background {
+	if _, err := io.WriteString(rw, "\x1b]11;?\x07"); err != nil {
+		return BackgroundUnknown
+	}
+	var reply []byte
+	buf := make([]byte, 64)
+	for len(reply) < 256 {
+		n, err := rw.Read(buf)
+		reply = append(reply, buf[:n]...)
+		if bg, done := parseBackgroundReply(string(reply)); done {
+			return bg
+		}
+		if err != nil {
+			break
+		}
+	}
+	return BackgroundUnknown
+}
+
+// parseBackgroundReply parses "ESC ] 11 ; rgb:RRRR/GGGG/BBBB" terminated by
+// BEL or ST. done is false while the reply is incomplete.
+func parseBackgroundReply(reply string) (Background, bool) {
+	start := strings.Index(reply, "\x1b]11;")
+	if start < 0 {
+		return BackgroundUnknown, false
+	}
+	body := reply[start+len("\x1b]11;"):]
+	end := strings.IndexAny(body, "\x07\x1b")
+	if end < 0 {
+		return BackgroundUnknown, false
+	}
+	body = body[:end]
+	if !strings.HasPrefix(body, "rgb:") {
+		return BackgroundUnknown, true
+	}
+	parts := strings.Split(strings.TrimPrefix(body, "rgb:"), "/")
+	if len(parts) != 3 {
+		return BackgroundUnknown, true
+	}
+	var channels [3]float64
+	for i, part := range parts {
+		if part == "" || len(part) > 4 {
+			return BackgroundUnknown, true
+		}
+		v, err := strconv.ParseUint(part, 16, 16)
+		if err != nil {
+			return BackgroundUnknown, true
+		}
+		channels[i] = float64(v) / float64(uint64(1)<<(4*len(part))-1)
+	}
+	return backgroundFromLuminance(channels[0], channels[1], channels[2]), true
+}
+
+// backgroundFromColorFGBG reads the "fg;bg" convention used by rxvt and
+// others. Palette entries 7 and 9-15 are light; the rest are dark.
+func backgroundFromColorFGBG(value string) Background {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return BackgroundUnknown
+	}
+	fields := strings.Split(value, ";")
+	bg, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil || bg < 0 || bg > 15 {
+		return BackgroundUnknown
+	}
+	if bg == 7 || bg >= 9 {
+		return BackgroundLight
+	}
+	return BackgroundDark
+}
+
+// backgroundFromLuminance classifies channels in [0,1] by perceived brightness.
+func backgroundFromLuminance(r, g, b float64) Background {
+	if 0.2126*r+0.7152*g+0.0722*b >= 0.5 {
+		return BackgroundLight
+	}
+	return BackgroundDark
+}
@@ -0,0 +1,72 @@
+package theme
+
+import (
+	"sync"
+
+	"github.com/odvcencio/fluffyui/state"
+)
+
+var (
+	currentMu    sync.Mutex
+	currentBase  = DefaultTheme()
+	currentDepth = ColorDepthTrueColor
+	current      = newCurrentSignal(currentBase)
+)
+
+func newCurrentSignal(initial *Theme) *state.Signal[*Theme] {
+	sig := state.NewSignal(initial)
+	sig.SetEqualFunc(func(a, b *Theme) bool { return a == b })
+	return sig
+}
+
+// Current returns the process-wide theme, degraded to the color depth
+// configured with SetColorDepth.
+func Current() *Theme {
+	if th := current.Get(); th != nil {
+		return th
+	}
+	return DefaultTheme()
+}
+
+// Set replaces the process-wide theme and notifies subscribers.
+// Passing nil restores DefaultTheme.
+func Set(t *Theme) {
+	if t == nil {
+		t = DefaultTheme()
+	}
+	currentMu.Lock()
+	currentBase = t
+	effective := effectiveTheme(t, currentDepth)
+	currentMu.Unlock()
+	current.Set(effective)
+}
+
+// SetColorDepth limits the colors used by Current. RGB tokens map to the
+// nearest palette entry so styles degrade the same way on every run.
+func SetColorDepth(depth ColorDepth) {
+	currentMu.Lock()
+	currentDepth = depth
+	effective := effectiveTheme(currentBase, depth)
+	currentMu.Unlock()
+	current.Set(effective)
+}
+
+// CurrentColorDepth returns the depth configured with SetColorDepth.
+func CurrentColorDepth() ColorDepth {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	return currentDepth
+}
+
+// Signal exposes the current theme for subscription. Running apps
+// subscribe to it and repaint when Set or SetColorDepth is called.
+func Signal() state.Readable[*Theme] {
+	return current
+}
+
+func effectiveTheme(t *Theme, depth ColorDepth) *Theme {
+	if depth == ColorDepthTrueColor {
+		return t
+	}
+	return t.WithColorDepth(depth)
+}
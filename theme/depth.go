@@ -0,0 +1,105 @@
+package theme
+
+import (
+	"os"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/compositor"
+)
+
+// ColorDepth describes how many colors the terminal can display.
+type ColorDepth int
+
+const (
+	// ColorDepthTrueColor renders 24-bit colors unchanged.
+	ColorDepthTrueColor ColorDepth = iota
+	// ColorDepthAnsi256 maps colors to the xterm 256-color palette.
+	ColorDepthAnsi256
+	// ColorDepthAnsi16 maps colors to the 16 basic ANSI colors.
+	ColorDepthAnsi16
+	// ColorDepthMono drops colors and keeps only text attributes.
+	ColorDepthMono
+)
+
+// String returns the depth name.
+func (d ColorDepth) String() string {
+	switch d {
+	case ColorDepthTrueColor:
+		return "truecolor"
+	case ColorDepthAnsi256:
+		return "ansi256"
+	case ColorDepthAnsi16:
+		return "ansi16"
+	case ColorDepthMono:
+		return "mono"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectColorDepth infers the color depth from NO_COLOR, COLORTERM, and TERM.
+func DetectColorDepth() ColorDepth {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return ColorDepthMono
+	}
+	colorterm := strings.ToLower(os.Getenv("COLORTERM"))
+	if strings.Contains(colorterm, "truecolor") || strings.Contains(colorterm, "24bit") {
+		return ColorDepthTrueColor
+	}
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case term == "dumb":
+		return ColorDepthMono
+	case strings.Contains(term, "256color"):
+		return ColorDepthAnsi256
+	default:
+		return ColorDepthAnsi16
+	}
+}
+
+// WithColorDepth returns a copy of t with every token degraded to depth.
+func (t *Theme) WithColorDepth(depth ColorDepth) *Theme {
+	if t == nil {
+		return nil
+	}
+	out := *t
+	for _, fields := range [][]tokenField{tokenFields, typographyFields} {
+		for _, field := range fields {
+			style := field.field(&out)
+			style.FG = DegradeColor(style.FG, depth)
+			style.BG = DegradeColor(style.BG, depth)
+		}
+	}
+	return &out
+}
+
+// DegradeColor maps c to the closest color available at depth. The mapping
+// is deterministic so the same input always yields the same palette entry,
+// and matches backend.Color.To256 and To16.
+func DegradeColor(c compositor.Color, depth ColorDepth) compositor.Color {
+	switch depth {
+	case ColorDepthMono:
+		if c.Mode == compositor.ColorModeNone {
+			return c
+		}
+		return compositor.ColorDefault
+	case ColorDepthAnsi256:
+		if c.Mode != compositor.ColorModeRGB {
+			return c
+		}
+		return compositor.Color256(uint8(rgbOf(c).To256()))
+	case ColorDepthAnsi16:
+		switch c.Mode {
+		case compositor.ColorModeRGB:
+			return compositor.Color{Mode: compositor.ColorMode16, Value: uint32(rgbOf(c).To16())}
+		case compositor.ColorMode256:
+			return compositor.Color{Mode: compositor.ColorMode16, Value: uint32(backend.Color(c.Value & 0xFF).To16())}
+		}
+	}
+	return c
+}
+
+func rgbOf(c compositor.Color) backend.Color {
+	return backend.ColorRGB(uint8(c.Value>>16), uint8(c.Value>>8), uint8(c.Value))
+}
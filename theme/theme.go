@@ -8,6 +8,9 @@ import (
 
 // Theme defines the complete visual language for the TUI.
 type Theme struct {
+	// Name identifies the theme, e.g. in theme files.
+	Name string
+
 	// Core palette
 	Background    compositor.Style // Primary canvas
 	Surface       compositor.Style // Elevated surfaces (cards, panels)
@@ -63,11 +66,60 @@ type Theme struct {
 	// Special
 	Logo    compositor.Style
 	Spinner compositor.Style
+
+	// Spacing is the spacing scale in cells.
+	Spacing Spacing
+
+	// Typography holds the text treatments layered over the palette.
+	Typography Typography
+}
+
+// Spacing is a theme's spacing scale, in cells.
+type Spacing struct {
+	XS int
+	SM int
+	MD int
+	LG int
+	XL int
+}
+
+// Typography holds text treatments. A terminal has a single font, so each
+// level is expressed through attributes (bold, dim, italic) and optional
+// colors.
+type Typography struct {
+	Heading  compositor.Style
+	Body     compositor.Style
+	Caption  compositor.Style
+	Emphasis compositor.Style
+}
+
+// DefaultSpacing returns the spacing scale shared by the built-in themes.
+func DefaultSpacing() Spacing {
+	return Spacing{
+		XS: Layout.PaddingXS,
+		SM: Layout.PaddingSM,
+		MD: Layout.PaddingMD,
+		LG: Layout.PaddingLG,
+		XL: Layout.PaddingXL,
+	}
+}
+
+// DefaultTypography returns the attribute-only typography shared by the
+// built-in themes.
+func DefaultTypography() Typography {
+	return Typography{
+		Heading:  compositor.DefaultStyle().WithBold(true),
+		Body:     compositor.DefaultStyle(),
+		Caption:  compositor.DefaultStyle().WithDim(true),
+		Emphasis: compositor.DefaultStyle().WithItalic(true),
+	}
 }
 
 // DefaultTheme returns the Dark Elegance theme.
 func DefaultTheme() *Theme {
 	return &Theme{
+		Name: "dark",
+
 		// Core palette - deep blacks with subtle blue undertone
 		Background:    compositor.DefaultStyle().WithBG(compositor.RGB(12, 12, 16)),
 		Surface:       compositor.DefaultStyle().WithBG(compositor.RGB(22, 22, 28)),
@@ -123,12 +175,17 @@ func DefaultTheme() *Theme {
 		// Special
 		Logo:    compositor.DefaultStyle().WithFG(compositor.RGB(255, 183, 77)).WithBold(true),
 		Spinner: compositor.DefaultStyle().WithFG(compositor.RGB(255, 183, 77)),
+
+		Spacing:    DefaultSpacing(),
+		Typography: DefaultTypography(),
 	}
 }
 
 // LightTheme returns a light variant of the default palette.
 func LightTheme() *Theme {
 	return &Theme{
+		Name: "light",
+
 		// Core palette - warm off-whites with gentle depth
 		Background:    compositor.DefaultStyle().WithBG(compositor.RGB(248, 247, 244)),
 		Surface:       compositor.DefaultStyle().WithBG(compositor.RGB(240, 239, 236)),
@@ -184,6 +241,83 @@ func LightTheme() *Theme {
 		// Special
 		Logo:    compositor.DefaultStyle().WithFG(compositor.RGB(184, 110, 36)).WithBold(true),
 		Spinner: compositor.DefaultStyle().WithFG(compositor.RGB(184, 110, 36)),
+
+		Spacing:    DefaultSpacing(),
+		Typography: DefaultTypography(),
+	}
+}
+
+// HighContrast returns a palette with maximal contrast for low-vision use:
+// pure black surfaces, white text, and saturated accents.
+func HighContrast() *Theme {
+	black := compositor.RGB(0, 0, 0)
+	white := compositor.RGB(255, 255, 255)
+	yellow := compositor.RGB(255, 255, 0)
+	cyan := compositor.RGB(0, 255, 255)
+	green := compositor.RGB(0, 255, 0)
+	red := compositor.RGB(255, 64, 64)
+	magenta := compositor.RGB(255, 0, 255)
+	return &Theme{
+		Name: "high-contrast",
+
+		// Core palette - no tonal steps between surfaces
+		Background:    compositor.DefaultStyle().WithBG(black),
+		Surface:       compositor.DefaultStyle().WithBG(black),
+		SurfaceRaised: compositor.DefaultStyle().WithBG(black),
+		SurfaceDim:    compositor.DefaultStyle().WithBG(black),
+
+		// Text hierarchy - white throughout, muted text stays legible
+		TextPrimary:   compositor.DefaultStyle().WithFG(white),
+		TextSecondary: compositor.DefaultStyle().WithFG(white),
+		TextMuted:     compositor.DefaultStyle().WithFG(compositor.RGB(200, 200, 200)),
+		TextInverse:   compositor.DefaultStyle().WithFG(black),
+
+		// Accent - bright yellow
+		Accent:       compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+		AccentDim:    compositor.DefaultStyle().WithFG(yellow),
+		AccentGlow:   compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+		ElectricBlue: compositor.DefaultStyle().WithFG(cyan),
+		Coral:        compositor.DefaultStyle().WithFG(red),
+		Teal:         compositor.DefaultStyle().WithFG(cyan),
+
+		// Glow variants - no dimming
+		BlueGlow:   compositor.DefaultStyle().WithFG(cyan),
+		PurpleGlow: compositor.DefaultStyle().WithFG(magenta),
+		CoralGlow:  compositor.DefaultStyle().WithFG(red),
+
+		// Semantic colors
+		Success: compositor.DefaultStyle().WithFG(green).WithBold(true),
+		Warning: compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+		Error:   compositor.DefaultStyle().WithFG(red).WithBold(true),
+		Info:    compositor.DefaultStyle().WithFG(cyan).WithBold(true),
+
+		// Message sources
+		User:      compositor.DefaultStyle().WithFG(green),
+		Assistant: compositor.DefaultStyle().WithFG(yellow),
+		System:    compositor.DefaultStyle().WithFG(white).WithItalic(true),
+		Tool:      compositor.DefaultStyle().WithFG(magenta),
+		Thinking:  compositor.DefaultStyle().WithFG(white).WithItalic(true),
+
+		// UI elements
+		Border:      compositor.DefaultStyle().WithFG(white),
+		BorderFocus: compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+		Selection:   compositor.DefaultStyle().WithBG(yellow).WithFG(black),
+		SearchMatch: compositor.DefaultStyle().WithBG(cyan).WithFG(black),
+		Scrollbar:   compositor.DefaultStyle().WithFG(white),
+		ScrollThumb: compositor.DefaultStyle().WithFG(yellow),
+
+		// Mode indicators
+		ModeNormal: compositor.DefaultStyle().WithFG(white),
+		ModeShell:  compositor.DefaultStyle().WithFG(green).WithBold(true),
+		ModeEnv:    compositor.DefaultStyle().WithFG(cyan).WithBold(true),
+		ModeSearch: compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+
+		// Special
+		Logo:    compositor.DefaultStyle().WithFG(yellow).WithBold(true),
+		Spinner: compositor.DefaultStyle().WithFG(yellow),
+
+		Spacing:    DefaultSpacing(),
+		Typography: DefaultTypography(),
 	}
 }
 
@@ -1,8 +1,10 @@
 package theme
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/compositor"
 )
 
@@ -278,3 +280,236 @@ func BenchmarkDefaultTheme(b *testing.B) {
 		_ = DefaultTheme()
 	}
 }
+
+func TestThemeTokens(t *testing.T) {
+	th := DefaultTheme()
+	primary, ok := th.Token("primary")
+	if !ok || primary != th.Accent {
+		t.Fatalf("primary token = %#v, %v; want Accent", primary, ok)
+	}
+	if danger, ok := th.Token("Danger"); !ok || danger != th.Error {
+		t.Fatalf("danger token should alias Error")
+	}
+	if _, ok := th.Token("missing"); ok {
+		t.Fatal("expected unknown token lookup to fail")
+	}
+	custom := compositor.DefaultStyle().WithFG(compositor.RGB(1, 2, 3))
+	if !th.SetToken("border-focus", custom) || th.BorderFocus != custom {
+		t.Fatal("SetToken did not update BorderFocus")
+	}
+	for _, name := range TokenNames() {
+		if _, ok := th.Token(name); !ok {
+			t.Fatalf("TokenNames entry %q does not resolve", name)
+		}
+	}
+}
+
+func TestLoadYAML(t *testing.T) {
+	doc := `name: "Ocean"
+colors:
+  background: "#001122"
+  text: "#eeeeee"
+  sea: "#0088ff"
+  primary: sea
+styles:
+  panel:
+    foreground: text
+    background: "#112233"
+    bold: "true"
+  button.primary:
+    foreground: background
+`
+	th, err := LoadYAML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("LoadYAML error: %v", err)
+	}
+	if th.Name != "Ocean" {
+		t.Fatalf("Name = %q", th.Name)
+	}
+	if th.Background.BG != compositor.Hex(0x001122) {
+		t.Fatalf("background BG = %#v", th.Background.BG)
+	}
+	if th.TextPrimary.FG != compositor.Hex(0xeeeeee) {
+		t.Fatalf("text FG = %#v", th.TextPrimary.FG)
+	}
+	if th.Accent.FG != compositor.Hex(0x0088ff) {
+		t.Fatalf("primary FG = %#v", th.Accent.FG)
+	}
+	if th.Surface.FG != compositor.Hex(0xeeeeee) || th.Surface.BG != compositor.Hex(0x112233) || !th.Surface.Bold {
+		t.Fatalf("panel style = %#v", th.Surface)
+	}
+	if th.Error != DefaultTheme().Error {
+		t.Fatal("untouched tokens should keep defaults")
+	}
+
+	light, err := LoadYAML(strings.NewReader("base: light\n"))
+	if err != nil || light.Background != LightTheme().Background {
+		t.Fatalf("expected light base, got %v", err)
+	}
+}
+
+func TestLoadYAMLSpacingTypography(t *testing.T) {
+	doc := `colors:
+  muted: "#808080"
+spacing:
+  xs: 0
+  lg: 5
+typography:
+  heading:
+    bold: "true"
+    underline: "true"
+  caption:
+    foreground: muted
+`
+	th, err := LoadYAML(strings.NewReader(doc))
+	if err != nil {
+		t.Fatalf("LoadYAML error: %v", err)
+	}
+	want := DefaultSpacing()
+	want.XS, want.LG = 0, 5
+	if th.Spacing != want {
+		t.Fatalf("Spacing = %+v, want %+v", th.Spacing, want)
+	}
+	if !th.Typography.Heading.Bold || !th.Typography.Heading.Underline {
+		t.Fatalf("heading = %#v", th.Typography.Heading)
+	}
+	if th.Typography.Caption.FG != compositor.Hex(0x808080) {
+		t.Fatalf("caption FG = %#v", th.Typography.Caption.FG)
+	}
+	if th.Typography.Body != DefaultTypography().Body {
+		t.Fatal("untouched typography should keep defaults")
+	}
+
+	mono := th.WithColorDepth(ColorDepthMono)
+	if mono.Typography.Caption.FG == th.Typography.Caption.FG {
+		t.Fatal("WithColorDepth should degrade typography colors")
+	}
+}
+
+func TestLoadYAMLErrors(t *testing.T) {
+	for _, doc := range []string{
+		"colors:\n  accent: nope\n",
+		"colors:\n  a: b\n  b: a\n  accent: a\n",
+		"colors:\n  accent: \"#12345\"\n",
+		"base: neon\n",
+		"spacing:\n  huge: 9\n",
+		"spacing:\n  sm: -1\n",
+		"typography:\n  title:\n    bold: \"true\"\n",
+		"styles:\n  panel:\n    bold: maybe\n",
+	} {
+		if _, err := LoadYAML(strings.NewReader(doc)); err == nil {
+			t.Fatalf("expected error for %q", doc)
+		}
+	}
+}
+
+func TestCurrentThemeSignal(t *testing.T) {
+	defer Set(nil)
+
+	calls := 0
+	unsub := Signal().Subscribe(func() { calls++ })
+	defer unsub()
+
+	light := LightTheme()
+	Set(light)
+	if Current() != light || calls != 1 {
+		t.Fatalf("expected Set to switch theme and notify once, calls=%d", calls)
+	}
+	Set(light)
+	if calls != 1 {
+		t.Fatalf("expected setting the same theme to be a no-op, calls=%d", calls)
+	}
+	Set(nil)
+	if Current() == nil || Current().Name != "dark" || calls != 2 {
+		t.Fatalf("expected nil to restore the default theme, calls=%d", calls)
+	}
+}
+
+func TestDegradeColor(t *testing.T) {
+	red := compositor.RGB(255, 0, 0)
+	if got := DegradeColor(red, ColorDepthTrueColor); got != red {
+		t.Fatalf("truecolor should keep RGB, got %+v", got)
+	}
+	if got := DegradeColor(red, ColorDepthAnsi256); got != compositor.Color256(196) {
+		t.Fatalf("expected 256-color 196, got %+v", got)
+	}
+	if got := DegradeColor(compositor.RGB(128, 128, 128), ColorDepthAnsi256); got != compositor.Color256(244) {
+		t.Fatalf("expected grayscale 244, got %+v", got)
+	}
+	if got := DegradeColor(red, ColorDepthAnsi16); got.Mode != compositor.ColorMode16 || got.Value != 9 {
+		t.Fatalf("expected bright red, got %+v", got)
+	}
+	if got := DegradeColor(compositor.Color256(196), ColorDepthAnsi16); got.Mode != compositor.ColorMode16 || got.Value != 9 {
+		t.Fatalf("expected 256-color to map to bright red, got %+v", got)
+	}
+	if got := DegradeColor(red, ColorDepthMono); got != compositor.ColorDefault {
+		t.Fatalf("expected mono to drop color, got %+v", got)
+	}
+	navy := compositor.RGB(0, 34, 68)
+	if got, want := DegradeColor(navy, ColorDepthAnsi256), backend.ColorRGB(0, 34, 68).To256(); got.Value != uint32(want) {
+		t.Fatalf("theme and backend disagree on 256-color: %d vs %d", got.Value, want)
+	}
+	if got, want := DegradeColor(navy, ColorDepthAnsi16), backend.ColorRGB(0, 34, 68).To16(); got.Value != uint32(want) {
+		t.Fatalf("theme and backend disagree on 16-color: %d vs %d", got.Value, want)
+	}
+}
+
+func TestSetColorDepth(t *testing.T) {
+	defer Set(nil)
+	defer SetColorDepth(ColorDepthTrueColor)
+
+	calls := 0
+	unsub := Signal().Subscribe(func() { calls++ })
+	defer unsub()
+
+	SetColorDepth(ColorDepthAnsi256)
+	if calls != 1 || CurrentColorDepth() != ColorDepthAnsi256 {
+		t.Fatalf("expected depth change to notify, calls=%d", calls)
+	}
+	if mode := Current().Accent.FG.Mode; mode != compositor.ColorMode256 {
+		t.Fatalf("expected accent degraded to 256 colors, got mode %v", mode)
+	}
+
+	Set(HighContrast())
+	if Current().Name != "high-contrast" || Current().Background.BG.Mode != compositor.ColorMode256 {
+		t.Fatalf("expected new theme to keep the configured depth")
+	}
+
+	SetColorDepth(ColorDepthTrueColor)
+	if Current().Accent.FG.Mode != compositor.ColorModeRGB {
+		t.Fatalf("expected truecolor to restore RGB tokens")
+	}
+}
+
+func TestBackgroundDetection(t *testing.T) {
+	cases := map[string]Background{
+		"15;0":        BackgroundDark,
+		"0;15":        BackgroundLight,
+		"0;default;7": BackgroundLight,
+		"":            BackgroundUnknown,
+		"0;x":         BackgroundUnknown,
+	}
+	for value, want := range cases {
+		if got := backgroundFromColorFGBG(value); got != want {
+			t.Fatalf("COLORFGBG %q: expected %v, got %v", value, want, got)
+		}
+	}
+
+	if bg, done := parseBackgroundReply("\x1b]11;rgb:ffff/ffff/f0f0\x1b\\"); !done || bg != BackgroundLight {
+		t.Fatalf("expected light reply, got %v", bg)
+	}
+	if bg, done := parseBackgroundReply("\x1b]11;rgb:1e/1e/2e\x07"); !done || bg != BackgroundDark {
+		t.Fatalf("expected dark reply, got %v", bg)
+	}
+	if _, done := parseBackgroundReply("\x1b]11;rgb:ff"); done {
+		t.Fatalf("expected partial reply to be incomplete")
+	}
+
+	t.Setenv("COLORFGBG", "0;15")
+	if got := DetectTerminalBackground(); got != BackgroundLight {
+		t.Fatalf("expected COLORFGBG to win, got %v", got)
+	}
+	if ForBackground(BackgroundLight).Name != "light" || ForBackground(BackgroundUnknown).Name != "dark" {
+		t.Fatalf("unexpected ForBackground mapping")
+	}
+}
@@ -0,0 +1,129 @@
+package theme
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/compositor"
+)
+
+// tokenField binds a token name to a theme field. Background tokens carry
+// their color in BG; the rest carry it in FG.
+type tokenField struct {
+	name  string
+	field func(t *Theme) *compositor.Style
+	bg    bool
+}
+
+var tokenFields = []tokenField{
+	{"background", func(t *Theme) *compositor.Style { return &t.Background }, true},
+	{"surface", func(t *Theme) *compositor.Style { return &t.Surface }, true},
+	{"surface_raised", func(t *Theme) *compositor.Style { return &t.SurfaceRaised }, true},
+	{"surface_dim", func(t *Theme) *compositor.Style { return &t.SurfaceDim }, true},
+	{"text_primary", func(t *Theme) *compositor.Style { return &t.TextPrimary }, false},
+	{"text_secondary", func(t *Theme) *compositor.Style { return &t.TextSecondary }, false},
+	{"text_muted", func(t *Theme) *compositor.Style { return &t.TextMuted }, false},
+	{"text_inverse", func(t *Theme) *compositor.Style { return &t.TextInverse }, false},
+	{"accent", func(t *Theme) *compositor.Style { return &t.Accent }, false},
+	{"accent_dim", func(t *Theme) *compositor.Style { return &t.AccentDim }, false},
+	{"accent_glow", func(t *Theme) *compositor.Style { return &t.AccentGlow }, false},
+	{"electric_blue", func(t *Theme) *compositor.Style { return &t.ElectricBlue }, false},
+	{"coral", func(t *Theme) *compositor.Style { return &t.Coral }, false},
+	{"teal", func(t *Theme) *compositor.Style { return &t.Teal }, false},
+	{"blue_glow", func(t *Theme) *compositor.Style { return &t.BlueGlow }, false},
+	{"purple_glow", func(t *Theme) *compositor.Style { return &t.PurpleGlow }, false},
+	{"coral_glow", func(t *Theme) *compositor.Style { return &t.CoralGlow }, false},
+	{"success", func(t *Theme) *compositor.Style { return &t.Success }, false},
+	{"warning", func(t *Theme) *compositor.Style { return &t.Warning }, false},
+	{"error", func(t *Theme) *compositor.Style { return &t.Error }, false},
+	{"info", func(t *Theme) *compositor.Style { return &t.Info }, false},
+	{"user", func(t *Theme) *compositor.Style { return &t.User }, false},
+	{"assistant", func(t *Theme) *compositor.Style { return &t.Assistant }, false},
+	{"system", func(t *Theme) *compositor.Style { return &t.System }, false},
+	{"tool", func(t *Theme) *compositor.Style { return &t.Tool }, false},
+	{"thinking", func(t *Theme) *compositor.Style { return &t.Thinking }, false},
+	{"border", func(t *Theme) *compositor.Style { return &t.Border }, false},
+	{"border_focus", func(t *Theme) *compositor.Style { return &t.BorderFocus }, false},
+	{"selection", func(t *Theme) *compositor.Style { return &t.Selection }, true},
+	{"search_match", func(t *Theme) *compositor.Style { return &t.SearchMatch }, true},
+	{"scrollbar", func(t *Theme) *compositor.Style { return &t.Scrollbar }, false},
+	{"scroll_thumb", func(t *Theme) *compositor.Style { return &t.ScrollThumb }, false},
+	{"mode_normal", func(t *Theme) *compositor.Style { return &t.ModeNormal }, false},
+	{"mode_shell", func(t *Theme) *compositor.Style { return &t.ModeShell }, false},
+	{"mode_env", func(t *Theme) *compositor.Style { return &t.ModeEnv }, false},
+	{"mode_search", func(t *Theme) *compositor.Style { return &t.ModeSearch }, false},
+	{"logo", func(t *Theme) *compositor.Style { return &t.Logo }, false},
+	{"spinner", func(t *Theme) *compositor.Style { return &t.Spinner }, false},
+}
+
+// typographyFields binds the names used under typography in theme.yaml to
+// Typography fields.
+var typographyFields = []tokenField{
+	{"heading", func(t *Theme) *compositor.Style { return &t.Typography.Heading }, false},
+	{"body", func(t *Theme) *compositor.Style { return &t.Typography.Body }, false},
+	{"caption", func(t *Theme) *compositor.Style { return &t.Typography.Caption }, false},
+	{"emphasis", func(t *Theme) *compositor.Style { return &t.Typography.Emphasis }, false},
+}
+
+// tokenAliases maps friendly names onto theme fields.
+var tokenAliases = map[string]string{
+	"primary": "accent",
+	"danger":  "error",
+	"text":    "text_primary",
+	"app":     "background",
+	"panel":   "surface",
+	"focus":   "border_focus",
+}
+
+// TokenNames returns every token name in declaration order.
+func TokenNames() []string {
+	names := make([]string, len(tokenFields))
+	for i, field := range tokenFields {
+		names[i] = field.name
+	}
+	return names
+}
+
+// Token returns the style registered under name. Names are case-insensitive,
+// accept "-" or "_" separators, and include aliases such as "primary"
+// (Accent) and "danger" (Error).
+func (t *Theme) Token(name string) (compositor.Style, bool) {
+	field, ok := lookupToken(name)
+	if !ok || t == nil {
+		return compositor.Style{}, false
+	}
+	return *field.field(t), true
+}
+
+// SetToken replaces the style registered under name.
+func (t *Theme) SetToken(name string, style compositor.Style) bool {
+	field, ok := lookupToken(name)
+	if !ok || t == nil {
+		return false
+	}
+	*field.field(t) = style
+	return true
+}
+
+func lookupToken(name string) (tokenField, bool) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.ReplaceAll(key, "-", "_")
+	if alias, ok := tokenAliases[key]; ok {
+		key = alias
+	}
+	for _, field := range tokenFields {
+		if field.name == key {
+			return field, true
+		}
+	}
+	return tokenField{}, false
+}
+
+func lookupTypography(name string) (tokenField, bool) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	for _, field := range typographyFields {
+		if field.name == key {
+			return field, true
+		}
+	}
+	return tokenField{}, false
+}
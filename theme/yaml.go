@@ -0,0 +1,203 @@
+package theme
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/compositor"
+	"gopkg.in/yaml.v3"
+)
+
+// themeFile mirrors the theme.yaml format written by `fluffy theme init`.
+type themeFile struct {
+	Name       string                       `yaml:"name"`
+	Base       string                       `yaml:"base"`
+	Colors     map[string]string            `yaml:"colors"`
+	Spacing    map[string]int               `yaml:"spacing"`
+	Typography map[string]map[string]string `yaml:"typography"`
+	Styles     map[string]map[string]string `yaml:"styles"`
+}
+
+// LoadYAML reads a theme.yaml document and returns the resulting theme.
+//
+// The document starts from DefaultTheme, LightTheme when base is
+// "light", or HighContrast when base is "high-contrast". Entries under
+// colors whose name matches a token (see TokenNames) recolor that token;
+// other colors may be referenced by name. Entries under styles whose
+// selector matches a token replace that token's foreground, background,
+// and attributes. Other selectors are ignored so the same file can drive
+// `fluffy theme export`.
+//
+// Entries under spacing (xs, sm, md, lg, xl) set the spacing scale in
+// cells. Entries under typography (heading, body, caption, emphasis) take
+// the same properties as styles. Unknown names in either section are errors.
+func LoadYAML(r io.Reader) (*Theme, error) {
+	var tf themeFile
+	if err := yaml.NewDecoder(r).Decode(&tf); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("parse theme: %w", err)
+	}
+
+	var th *Theme
+	switch strings.ToLower(strings.TrimSpace(tf.Base)) {
+	case "", "dark", "default":
+		th = DefaultTheme()
+	case "light":
+		th = LightTheme()
+	case "high-contrast":
+		th = HighContrast()
+	default:
+		return nil, fmt.Errorf("unknown base theme %q", tf.Base)
+	}
+	if name := strings.TrimSpace(tf.Name); name != "" {
+		th.Name = name
+	}
+
+	for _, name := range sortedKeys(tf.Colors) {
+		color, err := resolveColor(tf.Colors[name], tf.Colors)
+		if err != nil {
+			return nil, fmt.Errorf("color %q: %w", name, err)
+		}
+		field, ok := lookupToken(name)
+		if !ok {
+			continue
+		}
+		style := field.field(th)
+		if field.bg {
+			style.BG = color
+		} else {
+			style.FG = color
+		}
+	}
+
+	for _, selector := range sortedKeys(tf.Styles) {
+		field, ok := lookupToken(selector)
+		if !ok {
+			continue
+		}
+		style, err := parseStyleProps(tf.Styles[selector], tf.Colors)
+		if err != nil {
+			return nil, fmt.Errorf("style %q: %w", selector, err)
+		}
+		*field.field(th) = style
+	}
+
+	for _, name := range sortedKeys(tf.Spacing) {
+		value := tf.Spacing[name]
+		if value < 0 {
+			return nil, fmt.Errorf("spacing %q: must not be negative", name)
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "xs":
+			th.Spacing.XS = value
+		case "sm":
+			th.Spacing.SM = value
+		case "md":
+			th.Spacing.MD = value
+		case "lg":
+			th.Spacing.LG = value
+		case "xl":
+			th.Spacing.XL = value
+		default:
+			return nil, fmt.Errorf("unknown spacing %q", name)
+		}
+	}
+
+	for _, name := range sortedKeys(tf.Typography) {
+		field, ok := lookupTypography(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown typography %q", name)
+		}
+		style, err := parseStyleProps(tf.Typography[name], tf.Colors)
+		if err != nil {
+			return nil, fmt.Errorf("typography %q: %w", name, err)
+		}
+		*field.field(th) = style
+	}
+	return th, nil
+}
+
+func parseStyleProps(props map[string]string, colors map[string]string) (compositor.Style, error) {
+	style := compositor.DefaultStyle()
+	for _, key := range sortedKeys(props) {
+		value := strings.TrimSpace(props[key])
+		switch strings.ToLower(key) {
+		case "foreground", "fg", "color":
+			color, err := resolveColor(value, colors)
+			if err != nil {
+				return style, fmt.Errorf("foreground: %w", err)
+			}
+			style.FG = color
+		case "background", "bg":
+			color, err := resolveColor(value, colors)
+			if err != nil {
+				return style, fmt.Errorf("background: %w", err)
+			}
+			style.BG = color
+		case "bold", "dim", "italic", "underline", "reverse":
+			on, err := strconv.ParseBool(value)
+			if err != nil {
+				return style, fmt.Errorf("%s: %w", key, err)
+			}
+			switch strings.ToLower(key) {
+			case "bold":
+				style.Bold = on
+			case "dim":
+				style.Dim = on
+			case "italic":
+				style.Italic = on
+			case "underline":
+				style.Underline = on
+			case "reverse":
+				style.Reverse = on
+			}
+		}
+	}
+	return style, nil
+}
+
+// resolveColor resolves a "#rrggbb" value or a reference to another entry
+// in colors.
+func resolveColor(value string, colors map[string]string) (compositor.Color, error) {
+	visited := map[string]bool{}
+	for {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return compositor.Color{}, fmt.Errorf("empty color value")
+		}
+		if strings.HasPrefix(value, "#") {
+			return parseHexColor(value)
+		}
+		if visited[value] {
+			return compositor.Color{}, fmt.Errorf("cyclic color reference: %s", value)
+		}
+		visited[value] = true
+		next, ok := colors[value]
+		if !ok {
+			return compositor.Color{}, fmt.Errorf("unknown color %q", value)
+		}
+		value = next
+	}
+}
+
+func parseHexColor(value string) (compositor.Color, error) {
+	if len(value) != 7 || value[0] != '#' {
+		return compositor.Color{}, fmt.Errorf("invalid hex color %q", value)
+	}
+	hex, err := strconv.ParseUint(value[1:], 16, 32)
+	if err != nil {
+		return compositor.Color{}, fmt.Errorf("invalid hex color %q", value)
+	}
+	return compositor.Hex(uint32(hex)), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,6 +1,7 @@
 package toast
 
 import (
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -21,6 +22,8 @@ const (
 const (
 	DefaultToastDuration = 4 * time.Second
 	DefaultMaxToasts     = 5
+	// Persistent keeps a toast until it is dismissed or its action runs.
+	Persistent time.Duration = -1
 )
 
 // Toast represents a toast notification.
@@ -32,12 +35,30 @@ type Toast struct {
 	Duration  time.Duration
 	CreatedAt time.Time
 	Action    *ToastAction
+	// Count is how many times the toast was shown within the dedupe
+	// window. It is 1 for a toast shown once.
+	Count int
+}
+
+// DisplayMessage returns the message with a count badge, e.g. "Saved (3)",
+// when the toast was shown more than once.
+func (t *Toast) DisplayMessage() string {
+	if t == nil {
+		return ""
+	}
+	if t.Count <= 1 {
+		return t.Message
+	}
+	return strings.TrimSpace(t.Message + " (" + strconv.Itoa(t.Count) + ")")
 }
 
 // ToastAction represents an optional action for a toast.
 type ToastAction struct {
 	Label   string
 	Command string
+	// OnClick runs when the action button is clicked. The toast is
+	// dismissed afterwards.
+	OnClick func()
 }
 
 // ToastManager manages active toast notifications.
@@ -46,6 +67,7 @@ type ToastManager struct {
 	toasts   []*Toast
 	timers   map[string]*time.Timer
 	maxCount int
+	dedupe   time.Duration
 	onChange func([]*Toast)
 }
 
@@ -71,14 +93,35 @@ func (tm *ToastManager) SetOnChange(fn func([]*Toast)) {
 	}
 }
 
-// Show creates a new toast and returns its ID.
+// SetDedupeWindow groups repeats: showing a toast with the same level,
+// title, and message as one shown less than d ago bumps that toast's Count
+// and restarts its timer instead of stacking a copy. Zero disables grouping.
+func (tm *ToastManager) SetDedupeWindow(d time.Duration) {
+	if tm == nil {
+		return
+	}
+	tm.mu.Lock()
+	tm.dedupe = max(d, 0)
+	tm.mu.Unlock()
+}
+
+// Show creates a new toast and returns its ID. A zero duration uses
+// DefaultToastDuration; Persistent keeps the toast until it is dismissed.
 func (tm *ToastManager) Show(level ToastLevel, title, message string, duration time.Duration) string {
+	return tm.ShowWithAction(level, title, message, duration, nil)
+}
+
+// ShowWithAction creates a toast with an action button and returns its ID.
+func (tm *ToastManager) ShowWithAction(level ToastLevel, title, message string, duration time.Duration, action *ToastAction) string {
 	if tm == nil {
 		return ""
 	}
-	if duration <= 0 {
+	if duration == 0 {
 		duration = DefaultToastDuration
 	}
+	if duration < 0 {
+		duration = Persistent
+	}
 	toast := &Toast{
 		ID:        ulid.Make().String(),
 		Level:     level,
@@ -86,6 +129,8 @@ func (tm *ToastManager) Show(level ToastLevel, title, message string, duration t
 		Message:   strings.TrimSpace(message),
 		Duration:  duration,
 		CreatedAt: time.Now(),
+		Action:    action,
+		Count:     1,
 	}
 
 	tm.mu.Lock()
@@ -95,10 +140,26 @@ func (tm *ToastManager) Show(level ToastLevel, title, message string, duration t
 	if tm.maxCount <= 0 {
 		tm.maxCount = DefaultMaxToasts
 	}
-	tm.toasts = append(tm.toasts, toast)
+	if i := tm.duplicateLocked(toast); i >= 0 {
+		// Replace rather than mutate: snapshots handed out earlier may
+		// still be read.
+		repeat := *tm.toasts[i]
+		repeat.Count++
+		repeat.CreatedAt = toast.CreatedAt
+		repeat.Duration = toast.Duration
+		if action != nil {
+			repeat.Action = action
+		}
+		toast = &repeat
+		tm.toasts[i] = toast
+		tm.stopTimerLocked(toast.ID)
+	} else {
+		tm.toasts = append(tm.toasts, toast)
+	}
 	if duration > 0 {
-		tm.timers[toast.ID] = time.AfterFunc(duration, func() {
-			tm.Dismiss(toast.ID)
+		id := toast.ID
+		tm.timers[id] = time.AfterFunc(duration, func() {
+			tm.Dismiss(id)
 		})
 	}
 
@@ -139,6 +200,20 @@ func (tm *ToastManager) Error(title, msg string) {
 	tm.Show(ToastError, title, msg, DefaultToastDuration)
 }
 
+// InvokeAction runs the action of a toast and dismisses it. It reports
+// whether the toast had an action.
+func (tm *ToastManager) InvokeAction(id string) bool {
+	toast := tm.Get(id)
+	if toast == nil || toast.Action == nil {
+		return false
+	}
+	if toast.Action.OnClick != nil {
+		toast.Action.OnClick()
+	}
+	tm.Dismiss(id)
+	return true
+}
+
 // Dismiss removes a toast by ID.
 func (tm *ToastManager) Dismiss(id string) {
 	if tm == nil || strings.TrimSpace(id) == "" {
@@ -166,6 +241,22 @@ func (tm *ToastManager) Dismiss(id string) {
 	}
 }
 
+// duplicateLocked returns the index of an active toast that t repeats
+// within the dedupe window, or -1.
+func (tm *ToastManager) duplicateLocked(t *Toast) int {
+	if tm.dedupe <= 0 {
+		return -1
+	}
+	for i := len(tm.toasts) - 1; i >= 0; i-- {
+		existing := tm.toasts[i]
+		if existing.Level == t.Level && existing.Title == t.Title && existing.Message == t.Message &&
+			t.CreatedAt.Sub(existing.CreatedAt) < tm.dedupe {
+			return i
+		}
+	}
+	return -1
+}
+
 func (tm *ToastManager) stopTimerLocked(id string) {
 	if tm.timers == nil {
 		return
@@ -220,6 +311,12 @@ func (tm *ToastManager) Get(id string) *Toast {
 	return nil
 }
 
+// SetMaxStack limits the number of visible toasts. When a new toast
+// overflows the stack, the oldest are discarded.
+func (tm *ToastManager) SetMaxStack(n int) {
+	tm.SetMaxCount(n)
+}
+
 // SetMaxCount configures the maximum number of active toasts.
 func (tm *ToastManager) SetMaxCount(max int) {
 	if tm == nil || max <= 0 {
@@ -243,7 +340,7 @@ func (tm *ToastManager) SetMaxCount(max int) {
 	}
 }
 
-// Clear removes all active toasts.
+// Clear removes all active toasts immediately and stops their timers.
 func (tm *ToastManager) Clear() {
 	if tm == nil {
 		return
@@ -52,3 +52,105 @@ func TestToastManagerMaxCount(t *testing.T) {
 		t.Fatalf("expected latest toast retained, got %s", manager.toasts[0].ID)
 	}
 }
+
+func TestToastManagerInvokeAction(t *testing.T) {
+	manager := NewToastManager()
+	var clicked bool
+	id := manager.ShowWithAction(ToastInfo, "Deleted", "1 file", 0, &ToastAction{
+		Label:   "Undo",
+		OnClick: func() { clicked = true },
+	})
+	if got := manager.Get(id); got == nil || got.Duration != DefaultToastDuration {
+		t.Fatalf("expected default duration, got %#v", got)
+	}
+	if !manager.InvokeAction(id) {
+		t.Fatal("expected action to run")
+	}
+	if !clicked {
+		t.Fatal("expected OnClick to be called")
+	}
+	if manager.Count() != 0 {
+		t.Fatalf("expected toast dismissed after action, got %d", manager.Count())
+	}
+
+	plain := manager.Show(ToastInfo, "Plain", "", time.Hour)
+	if manager.InvokeAction(plain) {
+		t.Fatal("expected no action for a plain toast")
+	}
+}
+
+func TestToastManagerPerToastDuration(t *testing.T) {
+	manager := NewToastManager()
+	short := manager.Show(ToastInfo, "Short", "", 10*time.Millisecond)
+	sticky := manager.Show(ToastInfo, "Sticky", "", Persistent)
+
+	deadline := time.Now().Add(time.Second)
+	for manager.Get(short) != nil && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if manager.Get(short) != nil {
+		t.Fatal("expected short toast to expire")
+	}
+	if manager.Get(sticky) == nil {
+		t.Fatal("expected persistent toast to remain")
+	}
+}
+
+func TestToastManagerDedupeWindow(t *testing.T) {
+	manager := NewToastManager()
+	first := manager.Show(ToastInfo, "Sync", "Saved", time.Hour)
+	second := manager.Show(ToastInfo, "Sync", "Saved", time.Hour)
+	if first == second || manager.Count() != 2 {
+		t.Fatalf("expected no grouping without a dedupe window, got %d toasts", manager.Count())
+	}
+
+	manager.Clear()
+	if manager.Count() != 0 {
+		t.Fatalf("expected Clear to remove all toasts, got %d", manager.Count())
+	}
+
+	manager.SetDedupeWindow(time.Minute)
+	first = manager.Show(ToastInfo, "Sync", "Saved", time.Hour)
+	second = manager.Show(ToastInfo, "Sync", "Saved", time.Hour)
+	third := manager.Show(ToastInfo, "Sync", "Saved", time.Hour)
+	other := manager.Show(ToastError, "Sync", "Saved", time.Hour)
+	if second != first || third != first || other == first {
+		t.Fatalf("expected repeats to reuse the first toast id")
+	}
+	if manager.Count() != 2 {
+		t.Fatalf("expected 2 toasts, got %d", manager.Count())
+	}
+	grouped := manager.Get(first)
+	if grouped.Count != 3 {
+		t.Fatalf("Count = %d, want 3", grouped.Count)
+	}
+	if got := grouped.DisplayMessage(); got != "Saved (3)" {
+		t.Fatalf("DisplayMessage = %q, want %q", got, "Saved (3)")
+	}
+	if got := manager.Get(other).DisplayMessage(); got != "Saved" {
+		t.Fatalf("DisplayMessage without repeats = %q", got)
+	}
+}
+
+func TestToastManagerDedupeWindowExpires(t *testing.T) {
+	manager := NewToastManager()
+	manager.SetDedupeWindow(time.Minute)
+	first := manager.Show(ToastInfo, "", "Saved", time.Hour)
+	manager.mu.Lock()
+	manager.toasts[0].CreatedAt = manager.toasts[0].CreatedAt.Add(-2 * time.Minute)
+	manager.mu.Unlock()
+	if second := manager.Show(ToastInfo, "", "Saved", time.Hour); second == first {
+		t.Fatal("expected a new toast once the dedupe window has passed")
+	}
+}
+
+func TestToastManagerSetMaxStack(t *testing.T) {
+	manager := NewToastManager()
+	manager.Show(ToastInfo, "One", "", time.Hour)
+	manager.Show(ToastInfo, "Two", "", time.Hour)
+	third := manager.Show(ToastInfo, "Three", "", time.Hour)
+	manager.SetMaxStack(1)
+	if list := manager.List(); len(list) != 1 || list[0].ID != third {
+		t.Fatalf("expected only the newest toast to remain, got %d", len(list))
+	}
+}
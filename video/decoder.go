@@ -105,6 +105,13 @@ func (d *Decoder) ExtractFrames(fps float64) (<-chan image.Image, error) {
 
 // ExtractFramesContext streams frames with cancellation support.
 func (d *Decoder) ExtractFramesContext(ctx context.Context, fps float64) (<-chan image.Image, error) {
+	return d.ExtractFramesFrom(ctx, 0, fps)
+}
+
+// ExtractFramesFrom streams frames starting at the given position. ffmpeg
+// seeks to the nearest keyframe and decodes forward, so the first frame is
+// the one at start. The stream stops when ctx is cancelled.
+func (d *Decoder) ExtractFramesFrom(ctx context.Context, start time.Duration, fps float64) (<-chan image.Image, error) {
 	if d == nil {
 		return nil, errors.New("decoder is nil")
 	}
@@ -153,7 +160,11 @@ func (d *Decoder) ExtractFramesContext(ctx context.Context, fps float64) (<-chan
 
 	go func() {
 		var stderr bytes.Buffer
-		err := ffmpeg.Input(d.path).
+		input := ffmpeg.KwArgs{}
+		if start > 0 {
+			input["ss"] = fmt.Sprintf("%.3f", start.Seconds())
+		}
+		err := ffmpeg.Input(d.path, input).
 			Output("pipe:", ffmpeg.KwArgs{
 				"f":       "rawvideo",
 				"pix_fmt": "rgb24",
@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -21,6 +22,9 @@ type Accordion struct {
 	selected      int
 	label         string
 
+	animated          bool
+	animationDuration time.Duration
+
 	style         backend.Style
 	headerStyle   backend.Style
 	selectedStyle backend.Style
@@ -40,8 +44,9 @@ type AccordionSection struct {
 	animationDuration time.Duration
 	easing            animation.EasingFunc
 
-	visible float64
-	target  float64
+	visible   float64
+	target    float64
+	animating bool
 
 	headerBounds  runtime.Rect
 	contentBounds runtime.Rect
@@ -219,6 +224,34 @@ func (a *Accordion) SetAllowMultiple(allow bool) {
 	a.invalidate()
 }
 
+// SetAnimated toggles animated expand and collapse. When enabled and the
+// app has an animator, sections grow to their natural height and shrink
+// back over the animation duration instead of toggling instantly.
+func (a *Accordion) SetAnimated(animated bool) {
+	if a == nil {
+		return
+	}
+	a.animated = animated
+	if !animated {
+		for _, section := range a.sections {
+			if section != nil {
+				section.visible = section.target
+				section.animating = false
+			}
+		}
+		a.services.Relayout()
+	}
+}
+
+// SetAnimationDuration overrides the duration of every section's animation.
+// A zero duration uses each section's own WithSectionAnimation setting.
+func (a *Accordion) SetAnimationDuration(d time.Duration) {
+	if a == nil || d < 0 {
+		return
+	}
+	a.animationDuration = d
+}
+
 // SetLabel updates the accessibility label.
 func (a *Accordion) SetLabel(label string) {
 	if a == nil {
@@ -283,14 +316,15 @@ func (a *Accordion) Measure(constraints runtime.Constraints) runtime.Size {
 			if section == nil || section.content == nil {
 				continue
 			}
-			if section.expanded != nil && section.expanded.Get() {
+			expanded := section.expanded != nil && section.expanded.Get()
+			if expanded || section.visible > 0 {
 				size := section.content.Measure(runtime.Constraints{
 					MinWidth:  width,
 					MaxWidth:  width,
 					MinHeight: 0,
 					MaxHeight: contentConstraints.MaxHeight,
 				})
-				height += size.Height
+				height += a.sectionHeight(section, expanded, size.Height)
 			}
 		}
 		return contentConstraints.Constrain(runtime.Size{Width: width, Height: height})
@@ -325,13 +359,7 @@ func (a *Accordion) Layout(bounds runtime.Rect) {
 			target = contentHeight
 		}
 		a.syncSectionTarget(section, float64(target))
-		visible := int(section.visible)
-		if visible < 0 {
-			visible = 0
-		}
-		if visible > contentHeight {
-			visible = contentHeight
-		}
+		visible := clampInt(int(math.Ceil(section.visible)), 0, contentHeight)
 		section.contentBounds = runtime.Rect{X: content.X, Y: y, Width: content.Width, Height: visible}
 		if section.content != nil {
 			section.content.Layout(section.contentBounds)
@@ -599,31 +627,63 @@ func (a *Accordion) syncSectionTarget(section *AccordionSection, target float64)
 	}
 }
 
+// sectionHeight returns the rows a section's content takes up while
+// measuring. While a section is animating, or has been toggled but not yet
+// laid out, it reports the revealed height so containers grow with it.
+func (a *Accordion) sectionHeight(section *AccordionSection, expanded bool, natural int) int {
+	target := 0
+	if expanded {
+		target = natural
+	}
+	if !a.animationEnabled() {
+		return target
+	}
+	if section.animating || float64(target) != section.target {
+		return clampInt(int(math.Ceil(section.visible)), 0, natural)
+	}
+	return target
+}
+
+func (a *Accordion) animationEnabled() bool {
+	return a.animated && !a.services.ReducedMotion() && a.services.Animator() != nil
+}
+
 func (a *Accordion) animateSection(section *AccordionSection, target float64) {
 	if a == nil || section == nil {
 		return
 	}
-	if a.services.ReducedMotion() || section.animationDuration <= 0 {
-		section.visible = target
-		return
+	duration := section.animationDuration
+	if a.animationDuration > 0 {
+		duration = a.animationDuration
 	}
-	animator := a.services.Animator()
-	if animator == nil {
+	if !a.animationEnabled() || duration <= 0 {
 		section.visible = target
+		section.animating = false
 		return
 	}
 	easing := section.easing
 	if easing == nil {
 		easing = animation.OutCubic
 	}
-	animator.Animate(section, "height", func() animation.Animatable {
+	// Each section animates under its own key, so several can move at once
+	// and retoggling a section retargets its tween from the current height.
+	section.animating = true
+	a.services.Animator().Animate(section, "height", func() animation.Animatable {
 		return animation.Float64(section.visible)
 	}, func(value animation.Animatable) {
+		if !section.animating {
+			// SetAnimated(false) settled the section mid-flight.
+			return
+		}
 		section.visible = float64(value.(animation.Float64))
 		a.services.Relayout()
 	}, animation.Float64(target), animation.TweenConfig{
-		Duration: section.animationDuration,
+		Duration: duration,
 		Easing:   easing,
+		OnComplete: func() {
+			section.animating = false
+			a.services.Relayout()
+		},
 	})
 }
 
@@ -3,7 +3,9 @@ package widgets
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/odvcencio/fluffyui/animation"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/terminal"
 	fluffytest "github.com/odvcencio/fluffyui/testing"
@@ -79,3 +81,70 @@ func TestAccordionDisabledSelectionSkips(t *testing.T) {
 		t.Fatalf("disabled section should not expand")
 	}
 }
+
+func threeLines() runtime.Widget {
+	return runtime.VBox(runtime.Fixed(NewLabel("a")), runtime.Fixed(NewLabel("b")), runtime.Fixed(NewLabel("c")))
+}
+
+func TestAccordionInstantWithoutAnimation(t *testing.T) {
+	section := NewAccordionSection("First", threeLines())
+	acc := NewAccordion(section)
+	acc.Bind(runtime.NewApp(runtime.AppConfig{Animator: animation.NewAnimator()}).Services())
+	defer acc.Unbind()
+
+	section.SetExpanded(true)
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+	if section.contentBounds.Height != 3 {
+		t.Fatalf("content height = %d, want 3 without animation", section.contentBounds.Height)
+	}
+}
+
+func TestAccordionAnimatedSectionsMoveTogether(t *testing.T) {
+	first := NewAccordionSection("First", threeLines(), WithSectionAnimation(0, animation.Linear))
+	second := NewAccordionSection("Second", threeLines(), WithSectionExpanded(true))
+	acc := NewAccordion(first, second)
+	acc.SetAllowMultiple(true)
+	animator := animation.NewAnimator()
+	acc.Bind(runtime.NewApp(runtime.AppConfig{Animator: animator}).Services())
+	defer acc.Unbind()
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+
+	acc.SetAnimated(true)
+	acc.SetAnimationDuration(time.Hour)
+	first.SetExpanded(true)
+	second.SetExpanded(false)
+	constraints := runtime.Constraints{MaxWidth: 20, MaxHeight: 10}
+	if got := acc.Measure(constraints).Height; got != 5 {
+		t.Fatalf("height before layout = %d, want 5 until the animation advances", got)
+	}
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+	animator.Update(0.016)
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+	if !first.animating || !second.animating {
+		t.Fatalf("expected both sections to animate")
+	}
+	if h := first.contentBounds.Height; h < 0 || h >= 3 {
+		t.Fatalf("expanding height = %d, want partway", h)
+	}
+	if h := second.contentBounds.Height; h != 3 {
+		t.Fatalf("collapsing height = %d, want 3 at the start", h)
+	}
+
+	acc.SetAnimationDuration(time.Nanosecond)
+	first.SetExpanded(false)
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+	time.Sleep(time.Millisecond)
+	animator.Update(0.016)
+	if first.animating || first.visible != 0 {
+		t.Fatalf("expected retargeted section to finish collapsed, visible = %v", first.visible)
+	}
+	if got := acc.Measure(constraints).Height; got != 2+int(second.visible+0.999) {
+		t.Fatalf("height = %d, want headers plus the collapsing section", got)
+	}
+
+	acc.SetAnimated(false)
+	acc.Layout(runtime.Rect{Width: 20, Height: 10})
+	if second.animating || second.contentBounds.Height != 0 {
+		t.Fatalf("disabling animation should settle sections, height = %d", second.contentBounds.Height)
+	}
+}
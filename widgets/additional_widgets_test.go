@@ -63,19 +63,21 @@ func TestTreeToggle(t *testing.T) {
 }
 
 func TestMenuToggle(t *testing.T) {
-	menu := NewMenu(
-		&MenuItem{Title: "File", Expanded: true, Children: []*MenuItem{{Title: "Open"}}},
-		&MenuItem{Title: "Help"},
-	)
+	file := &MenuItem{Title: "File", Children: []*MenuItem{{Title: "Open"}}}
+	menu := NewMenu(file, &MenuItem{Title: "Help"})
 	out := flufftest.RenderToString(menu, 20, 3)
-	if !strings.Contains(out, "- File") {
-		t.Fatalf("expected expanded menu prefix, got:\n%s", out)
+	if !strings.Contains(out, "File") || !strings.Contains(out, menuSubmenuIndicator) {
+		t.Fatalf("expected submenu indicator, got:\n%s", out)
 	}
 	menu.Focus()
-	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
-	out = flufftest.RenderToString(menu, 20, 3)
-	if !strings.Contains(out, "+ File") {
-		t.Fatalf("expected collapsed menu prefix, got:\n%s", out)
+	result := menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if len(result.Commands) != 1 || !file.Expanded {
+		t.Fatalf("expected enter to open the submenu")
+	}
+	popover := result.Commands[0].(runtime.PushOverlay).Widget.(*Popover)
+	popover.Unmount()
+	if file.Expanded {
+		t.Fatalf("expected closing the submenu to collapse the item")
 	}
 }
 
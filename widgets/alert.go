@@ -7,6 +7,7 @@ import (
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
 	uistyle "github.com/odvcencio/fluffyui/style"
+	"github.com/odvcencio/fluffyui/terminal"
 )
 
 // AlertVariant describes alert styling.
@@ -19,21 +20,55 @@ const (
 	AlertError   AlertVariant = "error"
 )
 
-// Alert renders an inline message.
+const alertCloseLabel = "×"
+
+type alertHit struct {
+	rect  runtime.Rect
+	index int
+}
+
+// AlertAction is a button shown on an alert.
+type AlertAction struct {
+	Label   string
+	OnClick func()
+}
+
+// Alert renders an inline message. With a title, Text becomes the body on
+// a second line. Actions and a close button turn it into an inline
+// notification, and Details adds a button that expands the alert to show
+// them below the text.
 type Alert struct {
 	Base
 	Variant AlertVariant
+	Title   string
 	Text    string
-	style   backend.Style
+	Details string
+
+	showIcon      bool
+	dismissable   bool
+	onDismiss     func()
+	hideOnDismiss bool
+	hidden        bool
+	expanded      bool
+	actions       []AlertAction
+	focusable     bool
+	selected      int
+	services      runtime.Services
+
+	// Button hit areas from the last render.
+	buttonHits []alertHit
+
+	style    backend.Style
 	styleSet bool
 }
 
 // NewAlert creates an alert.
 func NewAlert(text string, variant AlertVariant) *Alert {
 	alert := &Alert{
-		Text:    text,
-		Variant: variant,
-		style:   backend.DefaultStyle(),
+		Text:     text,
+		Variant:  variant,
+		showIcon: true,
+		style:    backend.DefaultStyle(),
 	}
 	alert.Base.Role = accessibility.RoleAlert
 	alert.Base.Label = text
@@ -49,6 +84,134 @@ func (a *Alert) SetStyle(style backend.Style) {
 	a.styleSet = true
 }
 
+// SetTitle sets a bold title shown above the text.
+func (a *Alert) SetTitle(title string) {
+	if a == nil {
+		return
+	}
+	a.Title = title
+	a.Invalidate()
+}
+
+// SetShowIcon toggles the variant icon (default true).
+func (a *Alert) SetShowIcon(show bool) {
+	if a == nil {
+		return
+	}
+	a.showIcon = show
+	a.Invalidate()
+}
+
+// SetDismissable shows a close button that fires the dismiss callback.
+// Escape dismisses a focused alert too.
+func (a *Alert) SetDismissable(dismissable bool) {
+	if a == nil {
+		return
+	}
+	a.dismissable = dismissable
+	a.setSelected(a.selected)
+	a.Invalidate()
+}
+
+// SetOnDismiss sets the callback for the close button.
+func (a *Alert) SetOnDismiss(fn func()) {
+	if a == nil {
+		return
+	}
+	a.onDismiss = fn
+}
+
+// SetHideOnDismiss hides the alert, taking no space, once it is dismissed.
+// Show brings it back.
+func (a *Alert) SetHideOnDismiss(hide bool) {
+	if a == nil {
+		return
+	}
+	a.hideOnDismiss = hide
+}
+
+// Hidden reports whether a dismissal hid the alert.
+func (a *Alert) Hidden() bool {
+	return a != nil && a.hidden
+}
+
+// Show reveals an alert hidden by a dismissal.
+func (a *Alert) Show() {
+	if a == nil || !a.hidden {
+		return
+	}
+	a.hidden = false
+	a.Invalidate()
+	a.services.Relayout()
+}
+
+// SetDetails sets longer text, possibly several lines, shown below the
+// alert text while it is expanded. A details button toggles it.
+func (a *Alert) SetDetails(details string) {
+	if a == nil {
+		return
+	}
+	a.Details = details
+	a.setSelected(a.selected)
+	a.Invalidate()
+	a.services.Relayout()
+}
+
+// SetExpanded shows or hides the details.
+func (a *Alert) SetExpanded(expanded bool) {
+	if a == nil || a.expanded == expanded {
+		return
+	}
+	a.expanded = expanded
+	a.Invalidate()
+	a.services.Relayout()
+}
+
+// Expanded reports whether the details are shown.
+func (a *Alert) Expanded() bool {
+	return a != nil && a.expanded
+}
+
+// Bind attaches app services.
+func (a *Alert) Bind(services runtime.Services) {
+	if a == nil {
+		return
+	}
+	a.services = services
+}
+
+// Unbind releases app services.
+func (a *Alert) Unbind() {
+	if a == nil {
+		return
+	}
+	a.services = runtime.Services{}
+}
+
+// SetActions sets the action buttons. One or two fit comfortably.
+func (a *Alert) SetActions(actions []AlertAction) {
+	if a == nil {
+		return
+	}
+	a.actions = append([]AlertAction(nil), actions...)
+	a.setSelected(a.selected)
+	a.Invalidate()
+}
+
+// SetFocusable lets keyboard focus reach the alert's buttons.
+func (a *Alert) SetFocusable(focusable bool) {
+	if a == nil {
+		return
+	}
+	a.focusable = focusable
+}
+
+// CanFocus reports whether the alert takes focus: it must be focusable,
+// shown, and have a button.
+func (a *Alert) CanFocus() bool {
+	return a != nil && a.focusable && !a.hidden && a.buttonCount() > 0
+}
+
 // StyleType returns the selector type name.
 func (a *Alert) StyleType() string {
 	return "Alert"
@@ -75,11 +238,36 @@ func (a *Alert) StyleClasses() []string {
 // Measure returns desired size.
 func (a *Alert) Measure(constraints runtime.Constraints) runtime.Size {
 	return a.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		width := textWidth(a.Text)
-		if width < 1 {
-			width = 1
+		withButtons := func(width, buttons int) int {
+			if buttons > 0 {
+				return width + 1 + buttons
+			}
+			return width
+		}
+		if a.hidden {
+			return contentConstraints.Constrain(runtime.Size{})
+		}
+		icon := textWidth(a.icon())
+		details := a.detailLines()
+		detailsWidth := 0
+		for _, line := range details {
+			detailsWidth = max(detailsWidth, textWidth(line))
+		}
+		if a.Title == "" {
+			width := max(withButtons(icon+textWidth(a.Text), a.buttonsWidth(false)), detailsWidth)
+			return contentConstraints.Constrain(runtime.Size{Width: max(width, 1), Height: 1 + len(details)})
 		}
-		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
+		closeWidth := 0
+		if a.dismissable {
+			closeWidth = textWidth(alertCloseLabel)
+		}
+		width := max(
+			withButtons(icon+textWidth(a.Title), closeWidth),
+			withButtons(textWidth(a.Text), a.buttonsWidth(true)),
+			detailsWidth,
+		)
+		height := 2 + len(details)
+		return contentConstraints.Constrain(runtime.Size{Width: max(width, 1), Height: height})
 	})
 }
 
@@ -91,7 +279,8 @@ func (a *Alert) Render(ctx runtime.RenderContext) {
 	a.syncA11y()
 	outer := a.bounds
 	content := a.ContentBounds()
-	if outer.Width <= 0 || outer.Height <= 0 {
+	a.buttonHits = a.buttonHits[:0]
+	if outer.Width <= 0 || outer.Height <= 0 || a.hidden {
 		return
 	}
 	resolved := ctx.ResolveStyle(a)
@@ -103,6 +292,9 @@ func (a *Alert) Render(ctx runtime.RenderContext) {
 		}
 		style = final.ToBackend()
 	} else {
+		if !a.styleSet && a.Variant != "" {
+			style = themeStyle(string(a.Variant))
+		}
 		switch a.Variant {
 		case AlertSuccess:
 			style = style.Bold(true)
@@ -116,15 +308,260 @@ func (a *Alert) Render(ctx runtime.RenderContext) {
 	if content.Width <= 0 || content.Height <= 0 {
 		return
 	}
-	text := truncateString(a.Text, content.Width)
-	writePadded(ctx.Buffer, content.X, content.Y, content.Width, text, style)
+
+	// The close button sits at the top right; actions end the last line.
+	right := content.X + content.Width
+	closeRect := runtime.Rect{}
+	if a.dismissable {
+		closeWidth := textWidth(alertCloseLabel)
+		closeRect = runtime.Rect{X: right - closeWidth, Y: content.Y, Width: closeWidth, Height: 1}
+	}
+	textRow := content.Y
+	if a.Title != "" && content.Height > 1 {
+		textRow = content.Y + 1
+	}
+	actionsRight := right
+	if textRow == content.Y && a.dismissable {
+		actionsRight = closeRect.X - 1
+	}
+	labels := a.rowButtonLabels()
+	actionRects := make([]runtime.Rect, len(labels))
+	x := actionsRight
+	for i := len(labels) - 1; i >= 0; i-- {
+		width := textWidth(labels[i])
+		x -= width
+		actionRects[i] = runtime.Rect{X: x, Y: textRow, Width: width, Height: 1}
+		x--
+	}
+	textRight := right
+	if len(labels) > 0 {
+		textRight = x
+	} else if textRow == content.Y && a.dismissable {
+		textRight = closeRect.X - 1
+	}
+
+	icon := a.icon()
+	titleRight := right
+	if a.dismissable {
+		titleRight = closeRect.X - 1
+	}
+	if a.Title != "" && content.Height > 1 {
+		a.renderLine(ctx, content.X, content.Y, titleRight, icon, a.Title, style, style.Bold(true))
+		a.renderLine(ctx, content.X, textRow, textRight, "", a.Text, style, style)
+	} else {
+		line := a.Text
+		if a.Title != "" {
+			line = a.Title
+		}
+		a.renderLine(ctx, content.X, content.Y, textRight, icon, line, style, style)
+	}
+
+	for i, label := range labels {
+		rect := actionRects[i]
+		if rect.X < content.X {
+			continue
+		}
+		ctx.Buffer.SetString(rect.X, rect.Y, label, a.buttonStyle(i, style))
+		a.buttonHits = append(a.buttonHits, alertHit{rect: rect, index: i})
+	}
+	if a.dismissable && closeRect.X >= content.X {
+		ctx.Buffer.SetString(closeRect.X, closeRect.Y, alertCloseLabel, a.buttonStyle(a.closeIndex(), style))
+		a.buttonHits = append(a.buttonHits, alertHit{rect: closeRect, index: a.closeIndex()})
+	}
+	for i, line := range a.detailLines() {
+		y := textRow + 1 + i
+		if y >= content.Y+content.Height {
+			break
+		}
+		ctx.Buffer.SetString(content.X, y, truncateString(line, content.Width), style)
+	}
+}
+
+func (a *Alert) renderLine(ctx runtime.RenderContext, x, y, right int, icon, line string, iconStyle, textStyle backend.Style) {
+	width := right - x
+	if width <= 0 {
+		return
+	}
+	if icon != "" {
+		icon = clipString(icon, width)
+		ctx.Buffer.SetString(x, y, icon, iconStyle)
+		x += textWidth(icon)
+		width -= textWidth(icon)
+	}
+	if width > 0 {
+		ctx.Buffer.SetString(x, y, truncateString(line, width), textStyle)
+	}
 }
 
-// HandleMessage returns unhandled.
+func (a *Alert) buttonStyle(index int, style backend.Style) backend.Style {
+	if a.focused && index == a.selected {
+		return style.Reverse(true)
+	}
+	return style.Bold(true)
+}
+
+// HandleMessage activates actions and the close button from the mouse, and
+// from the keyboard when focused.
 func (a *Alert) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if a == nil {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		if m.Action != runtime.MouseRelease || m.Button != runtime.MouseLeft {
+			return runtime.Unhandled()
+		}
+		for _, hit := range a.buttonHits {
+			if hit.rect.Contains(m.X, m.Y) {
+				a.activate(hit.index)
+				return runtime.Handled()
+			}
+		}
+	case runtime.KeyMsg:
+		if !a.focused || a.buttonCount() == 0 {
+			return runtime.Unhandled()
+		}
+		switch m.Key {
+		case terminal.KeyLeft:
+			a.setSelected(a.selected - 1)
+		case terminal.KeyRight:
+			a.setSelected(a.selected + 1)
+		case terminal.KeyEnter:
+			a.activate(a.selected)
+		case terminal.KeyEscape:
+			if !a.dismissable {
+				return runtime.Unhandled()
+			}
+			a.activate(a.closeIndex())
+		default:
+			if m.Key == terminal.KeyRune && m.Rune == ' ' {
+				a.activate(a.selected)
+				return runtime.Handled()
+			}
+			return runtime.Unhandled()
+		}
+		a.Invalidate()
+		return runtime.Handled()
+	}
 	return runtime.Unhandled()
 }
 
+// activate runs the button at index: the actions, then the details
+// toggle, then the close button.
+func (a *Alert) activate(index int) {
+	switch {
+	case index >= 0 && index < len(a.actions):
+		if a.actions[index].OnClick != nil {
+			a.actions[index].OnClick()
+		}
+	case index == a.detailsIndex():
+		a.SetExpanded(!a.expanded)
+	case index == a.closeIndex() && a.dismissable:
+		a.dismiss()
+	}
+}
+
+// dismiss runs the dismiss callback and hides the alert if asked to.
+func (a *Alert) dismiss() {
+	if a.onDismiss != nil {
+		a.onDismiss()
+	}
+	if a.hideOnDismiss && !a.hidden {
+		a.hidden = true
+		a.Invalidate()
+		a.services.Relayout()
+	}
+}
+
+func (a *Alert) buttonCount() int {
+	return len(a.actions) + boolInt(a.Details != "") + boolInt(a.dismissable)
+}
+
+// detailsIndex returns the button index of the details toggle, or -1.
+func (a *Alert) detailsIndex() int {
+	if a.Details == "" {
+		return -1
+	}
+	return len(a.actions)
+}
+
+// closeIndex returns the button index of the close button.
+func (a *Alert) closeIndex() int {
+	return len(a.actions) + boolInt(a.Details != "")
+}
+
+// rowButtonLabels returns the labels of the buttons ending the text line.
+func (a *Alert) rowButtonLabels() []string {
+	labels := make([]string, 0, len(a.actions)+1)
+	for _, action := range a.actions {
+		labels = append(labels, alertActionLabel(action))
+	}
+	if a.Details != "" {
+		labels = append(labels, a.detailsLabel())
+	}
+	return labels
+}
+
+func (a *Alert) detailsLabel() string {
+	if a.expanded {
+		return "[Less]"
+	}
+	return "[More]"
+}
+
+// detailLines returns the details shown below the text, none while
+// collapsed.
+func (a *Alert) detailLines() []string {
+	if !a.expanded || a.Details == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(a.Details, "\n"), "\n")
+}
+
+func (a *Alert) setSelected(index int) {
+	a.selected = min(max(index, 0), max(a.buttonCount()-1, 0))
+}
+
+func (a *Alert) buttonsWidth(titled bool) int {
+	width := 0
+	for _, label := range a.rowButtonLabels() {
+		width += textWidth(label) + 1
+	}
+	if a.dismissable && !titled {
+		width += textWidth(alertCloseLabel) + 1
+	}
+	return max(width-1, 0)
+}
+
+func (a *Alert) icon() string {
+	if !a.showIcon {
+		return ""
+	}
+	switch a.Variant {
+	case AlertSuccess:
+		return "✓ "
+	case AlertWarning:
+		return "⚠ "
+	case AlertError:
+		return "✗ "
+	case AlertInfo:
+		return "ℹ "
+	default:
+		return ""
+	}
+}
+
+func alertActionLabel(action AlertAction) string {
+	return "[" + action.Label + "]"
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 func (a *Alert) syncA11y() {
 	if a == nil {
 		return
@@ -132,14 +569,44 @@ func (a *Alert) syncA11y() {
 	if a.Base.Role == "" {
 		a.Base.Role = accessibility.RoleAlert
 	}
-	label := strings.TrimSpace(a.Text)
+	label := strings.TrimSpace(a.Title)
+	body := strings.TrimSpace(a.Text)
+	if label == "" {
+		label, body = body, ""
+	}
 	if label == "" {
 		label = "Alert"
 	}
 	a.Base.Label = label
-	if a.Variant != "" {
-		a.Base.Description = string(a.Variant)
+	description := string(a.Variant)
+	if body != "" {
+		if description != "" {
+			description += ": "
+		}
+		description += body
+	}
+	if description != "" {
+		a.Base.Description = description
+	}
+	a.Base.State.Expanded = nil
+	if a.Details != "" {
+		a.Base.State.Expanded = accessibility.BoolPtr(a.expanded)
+	}
+	a.Base.Value = nil
+	if a.focused && a.buttonCount() > 0 {
+		name := "Dismiss"
+		switch {
+		case a.selected < len(a.actions):
+			name = a.actions[a.selected].Label
+		case a.selected == a.detailsIndex() && a.expanded:
+			name = "Hide details"
+		case a.selected == a.detailsIndex():
+			name = "Show details"
+		}
+		a.Base.Value = &accessibility.ValueInfo{Text: name}
 	}
 }
 
 var _ runtime.Widget = (*Alert)(nil)
+var _ runtime.Bindable = (*Alert)(nil)
+var _ runtime.Unbindable = (*Alert)(nil)
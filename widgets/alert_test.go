@@ -0,0 +1,155 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestAlertIconAndTitle(t *testing.T) {
+	alert := NewAlert("Disk almost full", AlertWarning)
+	alert.SetTitle("Storage")
+
+	size := alert.Measure(runtime.Constraints{MaxWidth: 40, MaxHeight: 5})
+	if size.Height != 2 {
+		t.Fatalf("titled alert height = %d, want 2", size.Height)
+	}
+	_, rows := renderRows(t, alert, 30, 2)
+	if !strings.HasPrefix(rows[0], "⚠ Storage") {
+		t.Fatalf("title row = %q, want icon and title", rows[0])
+	}
+	if !strings.HasPrefix(rows[1], "Disk almost full") {
+		t.Fatalf("body row = %q", rows[1])
+	}
+
+	alert.SetShowIcon(false)
+	_, rows = renderRows(t, alert, 30, 2)
+	if !strings.HasPrefix(rows[0], "Storage") {
+		t.Fatalf("title row without icon = %q", rows[0])
+	}
+}
+
+func TestAlertDismissButton(t *testing.T) {
+	alert := NewAlert("Saved", AlertSuccess)
+	dismissed := 0
+	alert.SetDismissable(true)
+	alert.SetOnDismiss(func() { dismissed++ })
+
+	_, rows := renderRows(t, alert, 20, 1)
+	if !strings.HasPrefix(rows[0], "✓ Saved") || !strings.HasSuffix(rows[0], "×") {
+		t.Fatalf("row = %q, want icon, text, and close button", rows[0])
+	}
+	alert.HandleMessage(runtime.MouseMsg{X: 19, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if dismissed != 1 {
+		t.Fatalf("dismissed = %d after clicking close, want 1", dismissed)
+	}
+
+	alert.SetFocusable(true)
+	alert.Focus()
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if dismissed != 2 {
+		t.Fatalf("dismissed = %d after Escape, want 2", dismissed)
+	}
+}
+
+func TestAlertActionsKeyboard(t *testing.T) {
+	alert := NewAlert("Upload failed", AlertError)
+	var ran []string
+	alert.SetActions([]AlertAction{
+		{Label: "Retry", OnClick: func() { ran = append(ran, "retry") }},
+		{Label: "Details", OnClick: func() { ran = append(ran, "details") }},
+	})
+	alert.SetDismissable(true)
+	dismissed := false
+	alert.SetOnDismiss(func() { dismissed = true })
+
+	if alert.CanFocus() {
+		t.Fatal("alert should not take focus until SetFocusable")
+	}
+	alert.SetFocusable(true)
+	if !alert.CanFocus() {
+		t.Fatal("focusable alert with actions should take focus")
+	}
+
+	_, rows := renderRows(t, alert, 40, 1)
+	if !strings.Contains(rows[0], "[Retry] [Details] ×") {
+		t.Fatalf("row = %q, want actions before the close button", rows[0])
+	}
+
+	alert.Focus()
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '})
+	if strings.Join(ran, ",") != "retry,details" {
+		t.Fatalf("ran = %v, want retry then details", ran)
+	}
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if !dismissed {
+		t.Fatal("expected Enter on the close button to dismiss")
+	}
+}
+
+func TestAlertActionClick(t *testing.T) {
+	alert := NewAlert("New version", AlertInfo)
+	alert.SetTitle("Update")
+	clicked := false
+	alert.SetActions([]AlertAction{{Label: "Install", OnClick: func() { clicked = true }}})
+
+	_, rows := renderRows(t, alert, 30, 2)
+	x := strings.Index(rows[1], "[Install]")
+	if x < 0 {
+		t.Fatalf("body row = %q, want action on the body line", rows[1])
+	}
+	alert.HandleMessage(runtime.MouseMsg{X: len([]rune(rows[1][:x])) + 1, Y: 1, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if !clicked {
+		t.Fatal("expected click on the action to run it")
+	}
+}
+
+func TestAlertDetailsAndHideOnDismiss(t *testing.T) {
+	alert := NewAlert("Build failed", AlertError)
+	alert.SetDetails("step 3: go vet\nexit status 1")
+	alert.SetDismissable(true)
+	alert.SetHideOnDismiss(true)
+	dismissed := false
+	alert.SetOnDismiss(func() { dismissed = true })
+
+	if size := alert.Measure(runtime.Constraints{MaxWidth: 40, MaxHeight: 5}); size.Height != 1 {
+		t.Fatalf("collapsed height = %d, want 1", size.Height)
+	}
+	_, rows := renderRows(t, alert, 30, 1)
+	if !strings.HasSuffix(rows[0], "[More] ×") {
+		t.Fatalf("row = %q, want the details toggle before the close button", rows[0])
+	}
+
+	alert.SetFocusable(true)
+	alert.Focus()
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if !alert.Expanded() {
+		t.Fatal("expected Enter on the toggle to expand the details")
+	}
+	if size := alert.Measure(runtime.Constraints{MaxWidth: 40, MaxHeight: 5}); size.Height != 3 {
+		t.Fatalf("expanded height = %d, want 3", size.Height)
+	}
+	_, rows = renderRows(t, alert, 30, 3)
+	if !strings.HasSuffix(rows[0], "[Less] ×") || rows[1] != "step 3: go vet" || rows[2] != "exit status 1" {
+		t.Fatalf("rows = %q", rows)
+	}
+
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	alert.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '})
+	if !dismissed || !alert.Hidden() || alert.CanFocus() {
+		t.Fatal("expected Space on the close button to dismiss and hide the alert")
+	}
+	if size := alert.Measure(runtime.Constraints{MaxWidth: 40, MaxHeight: 5}); size.Width != 0 || size.Height != 0 {
+		t.Fatalf("hidden size = %+v, want none", size)
+	}
+	alert.Show()
+	if alert.Hidden() {
+		t.Fatal("expected Show to reveal the alert")
+	}
+}
@@ -2,12 +2,15 @@ package widgets
 
 import (
 	"math"
+	"strconv"
+	"time"
 
 	"github.com/odvcencio/fluffyui/animation"
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/effects"
 	"github.com/odvcencio/fluffyui/graphics"
 	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
 )
 
 // GaugeColors defines the colors used for the animated gauge.
@@ -15,17 +18,56 @@ type GaugeColors struct {
 	Background backend.Color
 	Fill       backend.Color
 	Glow       backend.Color
+	Needle     backend.Color
 }
 
-// AnimatedGauge renders a radial gauge with spring animation.
+// GaugeShape selects how an AnimatedGauge is drawn.
+type GaugeShape int
+
+const (
+	// GaugeDial draws a semicircular dial with a needle on a canvas.
+	GaugeDial GaugeShape = iota
+	// GaugeBar draws a horizontal bar with the scale underneath.
+	GaugeBar
+)
+
+// GaugeZone colors the part of the scale between From and To, in value
+// units, such as a red band near the maximum.
+type GaugeZone struct {
+	From  float64
+	To    float64
+	Color backend.Color
+}
+
+// GaugeTicks configures the gauge scale.
+type GaugeTicks struct {
+	// Major is the number of major ticks including both ends. Zero or one
+	// hides the scale.
+	Major int
+	// Minor is the number of minor ticks between adjacent major ticks.
+	Minor int
+	// Labels draws the value at each major tick.
+	Labels bool
+}
+
+// AnimatedGauge renders a semicircular dial or a bar that animates to its
+// value, with a spring by default or a tween when an easing is set.
 type AnimatedGauge struct {
 	CanvasWidget
 
-	value    float64
-	min, max float64
-	spring   *animation.Spring
-	colors   GaugeColors
-	services runtime.Services
+	value     float64
+	min, max  float64
+	spring    *animation.Spring
+	colors    GaugeColors
+	services  runtime.Services
+	shape     GaugeShape
+	zones     []GaugeZone
+	ticks     GaugeTicks
+	easing    animation.EasingFunc
+	duration  time.Duration
+	eased     float64
+	format    func(value float64) string
+	hideValue bool
 }
 
 // NewAnimatedGauge creates a new animated gauge.
@@ -37,7 +79,9 @@ func NewAnimatedGauge(minValue, maxValue float64) *AnimatedGauge {
 			Background: backend.ColorRGB(40, 40, 40),
 			Fill:       backend.ColorRGB(0, 200, 100),
 			Glow:       backend.ColorRGB(0, 255, 150),
+			Needle:     backend.ColorRGB(230, 230, 230),
 		},
+		duration: 300 * time.Millisecond,
 	}
 	cfg := animation.SpringDefault
 	cfg.OnUpdate = func(value float64) {
@@ -58,7 +102,7 @@ func (g *AnimatedGauge) Bind(services runtime.Services) {
 	}
 	g.services = services
 	g.CanvasWidget.Bind(services)
-	if animator := services.Animator(); animator != nil {
+	if animator := services.Animator(); animator != nil && g.easing == nil {
 		animator.AnimateSpring(g, "value", g.spring, g.spring.Target)
 	}
 }
@@ -72,31 +116,309 @@ func (g *AnimatedGauge) Unbind() {
 	g.CanvasWidget.Unbind()
 }
 
+// SetColors sets the gauge colors.
+func (g *AnimatedGauge) SetColors(colors GaugeColors) {
+	if g == nil {
+		return
+	}
+	g.colors = colors
+	g.Invalidate()
+}
+
+// SetShape switches between the dial and the bar.
+func (g *AnimatedGauge) SetShape(shape GaugeShape) {
+	if g == nil {
+		return
+	}
+	g.shape = shape
+	g.Invalidate()
+}
+
+// SetZones colors bands of the scale. Where zones overlap, the later zone
+// wins. The filled part of the gauge takes the color of the zone it is in.
+func (g *AnimatedGauge) SetZones(zones []GaugeZone) {
+	if g == nil {
+		return
+	}
+	g.zones = append([]GaugeZone(nil), zones...)
+	g.Invalidate()
+}
+
+// SetTicks configures tick marks and scale labels.
+func (g *AnimatedGauge) SetTicks(ticks GaugeTicks) {
+	if g == nil {
+		return
+	}
+	g.ticks = ticks
+	g.Invalidate()
+}
+
+// SetEasing animates value changes with a tween using fn instead of the
+// default spring. Nil restores the spring.
+func (g *AnimatedGauge) SetEasing(fn func(float64) float64) {
+	if g == nil {
+		return
+	}
+	if fn == nil {
+		if g.easing != nil {
+			g.spring.Value = g.eased
+		}
+		g.easing = nil
+		return
+	}
+	if g.easing == nil {
+		g.eased = g.spring.Value
+	}
+	g.easing = fn
+}
+
+// SetDuration sets how long eased transitions take. It has no effect on
+// the spring.
+func (g *AnimatedGauge) SetDuration(d time.Duration) {
+	if g == nil || d <= 0 {
+		return
+	}
+	g.duration = d
+}
+
+// SetFormatter formats the value readout. Nil restores the default.
+func (g *AnimatedGauge) SetFormatter(fn func(value float64) string) {
+	if g == nil {
+		return
+	}
+	g.format = fn
+	g.Invalidate()
+}
+
+// SetShowValue toggles the value readout.
+func (g *AnimatedGauge) SetShowValue(show bool) {
+	if g == nil {
+		return
+	}
+	g.hideValue = !show
+	g.Invalidate()
+}
+
+// Value returns the target value.
+func (g *AnimatedGauge) Value() float64 {
+	if g == nil {
+		return 0
+	}
+	return g.value
+}
+
 // SetValue updates the gauge target value.
 func (g *AnimatedGauge) SetValue(value float64) {
 	if g == nil || g.spring == nil {
 		return
 	}
 	g.value = value
-	rangeSpan := g.max - g.min
-	if rangeSpan == 0 {
+	if g.max-g.min == 0 {
 		return
 	}
-	ratio := (value - g.min) / rangeSpan
-	if ratio < 0 {
-		ratio = 0
-	}
-	if ratio > 1 {
-		ratio = 1
-	}
-	if animator := g.services.Animator(); animator != nil {
+	ratio := g.ratio(value)
+	animator := g.services.Animator()
+	switch {
+	case g.easing != nil && animator != nil:
+		animator.Animate(g, "value",
+			func() animation.Animatable { return animation.Float64(g.eased) },
+			func(v animation.Animatable) { g.eased = float64(v.(animation.Float64)) },
+			animation.Float64(ratio),
+			animation.TweenConfig{
+				Duration: g.duration,
+				Easing:   g.easing,
+				OnUpdate: func(animation.Animatable) { g.Invalidate() },
+			})
+	case g.easing != nil:
+		g.eased = ratio
+	case animator != nil:
 		animator.AnimateSpring(g, "value", g.spring, ratio)
-	} else {
+	default:
 		g.spring.SetTarget(ratio)
 	}
 	g.Invalidate()
 }
 
+// ratio maps a value onto [0, 1] across the gauge range.
+func (g *AnimatedGauge) ratio(value float64) float64 {
+	span := g.max - g.min
+	if span == 0 {
+		return 0
+	}
+	return math.Max(0, math.Min(1, (value-g.min)/span))
+}
+
+// progress returns the animated fill ratio.
+func (g *AnimatedGauge) progress() float64 {
+	if g.easing != nil {
+		return g.eased
+	}
+	return g.spring.Value
+}
+
+// zoneColor returns the color of the last zone containing value.
+func (g *AnimatedGauge) zoneColor(value float64) (backend.Color, bool) {
+	for i := len(g.zones) - 1; i >= 0; i-- {
+		zone := g.zones[i]
+		if value >= zone.From && value <= zone.To {
+			return zone.Color, true
+		}
+	}
+	return 0, false
+}
+
+func (g *AnimatedGauge) fillColor(value float64) backend.Color {
+	if color, ok := g.zoneColor(value); ok {
+		return color
+	}
+	return g.colors.Fill
+}
+
+// tickRatios returns the positions of major and minor ticks.
+func (g *AnimatedGauge) tickRatios() (major, minor []float64) {
+	if g.ticks.Major < 2 {
+		return nil, nil
+	}
+	intervals := g.ticks.Major - 1
+	for i := 0; i <= intervals; i++ {
+		major = append(major, float64(i)/float64(intervals))
+		if i == intervals {
+			break
+		}
+		for j := 1; j <= g.ticks.Minor; j++ {
+			minor = append(minor, (float64(i)+float64(j)/float64(g.ticks.Minor+1))/float64(intervals))
+		}
+	}
+	return major, minor
+}
+
+func (g *AnimatedGauge) formatValue(value float64) string {
+	if g.format != nil {
+		return g.format(value)
+	}
+	decimals := 0
+	if span := math.Abs(g.max - g.min); span > 0 && span < 10 {
+		decimals = 1
+		if span < 1 {
+			decimals = 2
+		}
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
+// Measure gives the bar its natural height and leaves the dial flexible.
+func (g *AnimatedGauge) Measure(constraints runtime.Constraints) runtime.Size {
+	if g == nil {
+		return runtime.Size{}
+	}
+	if g.shape != GaugeBar {
+		return g.CanvasWidget.Measure(constraints)
+	}
+	return g.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		height := 1
+		if g.ticks.Major >= 2 {
+			height++
+			if g.ticks.Labels {
+				height++
+			}
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: contentConstraints.MaxWidth, Height: height})
+	})
+}
+
+// Render draws the gauge, its scale labels, and the value readout.
+func (g *AnimatedGauge) Render(ctx runtime.RenderContext) {
+	if g == nil {
+		return
+	}
+	content := g.ContentBounds()
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	style := resolveBaseStyle(ctx, g, backend.DefaultStyle(), false)
+	if g.shape == GaugeBar {
+		g.renderBar(ctx, content, style)
+		return
+	}
+	g.CanvasWidget.Render(ctx)
+	g.renderDialText(ctx, content, style)
+}
+
+func (g *AnimatedGauge) renderBar(ctx runtime.RenderContext, content runtime.Rect, style backend.Style) {
+	readout := ""
+	if !g.hideValue {
+		readout = g.formatValue(g.min + g.progress()*(g.max-g.min))
+	}
+	width := content.Width
+	if readout != "" && text.Width(readout)+1 < width {
+		width -= text.Width(readout) + 1
+		ctx.Buffer.SetString(content.X+width, content.Y, " "+readout, style)
+	}
+	fill := int(math.Round(g.progress() * float64(width)))
+	for i := 0; i < width; i++ {
+		cellValue := g.min + (float64(i)+0.5)/float64(width)*(g.max-g.min)
+		if i < fill {
+			ctx.Buffer.Set(content.X+i, content.Y, '█', style.Foreground(g.fillColor(cellValue)))
+			continue
+		}
+		empty := style.Foreground(g.colors.Background)
+		if color, ok := g.zoneColor(cellValue); ok {
+			empty = style.Foreground(color).Dim(true)
+		}
+		ctx.Buffer.Set(content.X+i, content.Y, '░', empty)
+	}
+
+	major, minor := g.tickRatios()
+	if len(major) == 0 || content.Height < 2 {
+		return
+	}
+	column := func(ratio float64) int {
+		return content.X + int(math.Round(ratio*float64(width-1)))
+	}
+	tickY := content.Y + 1
+	for _, ratio := range minor {
+		ctx.Buffer.Set(column(ratio), tickY, '╵', style)
+	}
+	for _, ratio := range major {
+		ctx.Buffer.Set(column(ratio), tickY, '│', style)
+	}
+	if !g.ticks.Labels || content.Height < 3 {
+		return
+	}
+	nextFree := content.X
+	for _, ratio := range major {
+		label := g.formatValue(g.min + ratio*(g.max-g.min))
+		labelWidth := text.Width(label)
+		x := min(max(column(ratio)-labelWidth/2, content.X), content.X+content.Width-labelWidth)
+		if x < nextFree {
+			continue
+		}
+		ctx.Buffer.SetString(x, tickY+1, label, style)
+		nextFree = x + labelWidth + 1
+	}
+}
+
+// dialGeometry returns the dial center and radius in canvas pixels,
+// leaving the bottom cell row free for the readout and room around the arc
+// for ticks.
+func dialGeometry(canvas *graphics.Canvas) (cx, cy, radius int) {
+	w, h := canvas.Size()
+	cellW, cellH := canvas.CellSize()
+	if cellW <= 0 || cellH <= 0 {
+		return 0, 0, 0
+	}
+	rowPixels := h / cellH
+	cx = w / 2
+	cy = h - rowPixels - 1
+	radius = min(w/2, cy) - 5
+	return cx, cy, radius
+}
+
+// dialAngle maps a ratio onto the upper semicircle, left to right.
+func dialAngle(ratio float64) float64 {
+	return math.Pi + ratio*math.Pi
+}
+
 func (g *AnimatedGauge) drawGauge(canvas *graphics.Canvas) {
 	if g == nil || canvas == nil || g.spring == nil {
 		return
@@ -105,28 +427,97 @@ func (g *AnimatedGauge) drawGauge(canvas *graphics.Canvas) {
 	if w <= 0 || h <= 0 {
 		return
 	}
-	cx, cy := w/2, h/2
-	radius := min(w, h)/2 - 3
+	cx, cy, radius := dialGeometry(canvas)
 	if radius <= 0 {
 		return
 	}
-	start := math.Pi * 0.75
-	end := math.Pi * 2.25
+	start, end := dialAngle(0), dialAngle(1)
 
 	canvas.SetStrokeColor(g.colors.Background)
 	canvas.DrawArc(cx, cy, radius, start, end)
+	for _, zone := range g.zones {
+		from, to := g.ratio(zone.From), g.ratio(zone.To)
+		if to <= from {
+			continue
+		}
+		canvas.SetStrokeColor(zone.Color)
+		canvas.DrawArc(cx, cy, radius, dialAngle(from), dialAngle(to))
+	}
 
-	progress := g.spring.Value
-	if progress <= 0 {
-		return
+	major, minor := g.tickRatios()
+	point := func(ratio float64, r int) (int, int) {
+		angle := dialAngle(ratio)
+		return cx + int(math.Round(float64(r)*math.Cos(angle))), cy + int(math.Round(float64(r)*math.Sin(angle)))
 	}
-	angle := start + progress*(end-start)
-	canvas.SetStrokeColor(g.colors.Fill)
-	canvas.DrawArc(cx, cy, radius, start, angle)
+	canvas.SetStrokeColor(g.colors.Background)
+	for _, ratio := range minor {
+		x1, y1 := point(ratio, radius+1)
+		x2, y2 := point(ratio, radius+2)
+		canvas.DrawLine(x1, y1, x2, y2)
+	}
+	for _, ratio := range major {
+		x1, y1 := point(ratio, radius+1)
+		x2, y2 := point(ratio, radius+4)
+		canvas.DrawLine(x1, y1, x2, y2)
+	}
+
+	progress := g.progress()
+	value := g.min + progress*(g.max-g.min)
+	if progress > 0 && radius > 2 {
+		canvas.SetStrokeColor(g.fillColor(value))
+		canvas.DrawArc(cx, cy, radius-2, start, dialAngle(progress))
+	}
+
+	needle := g.colors.Needle
+	tipX, tipY := point(progress, max(radius-3, 1))
+	canvas.SetStrokeColor(needle)
+	canvas.DrawLineAA(cx, cy, tipX, tipY)
+	canvas.SetFillColor(needle)
+	canvas.FillCircle(cx, cy, 1)
+	effects.Glow(canvas, tipX, tipY, 3, g.colors.Glow, 0.5)
+}
 
-	endX := cx + int(math.Round(float64(radius)*math.Cos(angle)))
-	endY := cy + int(math.Round(float64(radius)*math.Sin(angle)))
-	effects.Glow(canvas, endX, endY, 3, g.colors.Glow, 0.5)
+// renderDialText draws scale labels beside the major ticks and the value
+// readout under the needle pivot.
+func (g *AnimatedGauge) renderDialText(ctx runtime.RenderContext, content runtime.Rect, style backend.Style) {
+	canvas := g.canvas
+	if canvas == nil {
+		return
+	}
+	w, h := canvas.Size()
+	cellW, cellH := canvas.CellSize()
+	cx, cy, radius := dialGeometry(canvas)
+	if radius <= 0 || w <= 0 || h <= 0 {
+		return
+	}
+	toCell := func(px, py int) (int, int) {
+		return content.X + px*cellW/w, content.Y + py*cellH/h
+	}
+	place := func(label string, px, py int) {
+		x, y := toCell(px, py)
+		width := text.Width(label)
+		x = min(max(x-width/2, content.X), content.X+content.Width-width)
+		if y >= content.Y && y < content.Y+content.Height {
+			ctx.Buffer.SetString(x, y, label, style)
+		}
+	}
+	if g.ticks.Labels {
+		major, _ := g.tickRatios()
+		for _, ratio := range major {
+			angle := dialAngle(ratio)
+			r := float64(radius + 8)
+			px := cx + int(math.Round(r*math.Cos(angle)))
+			py := cy + int(math.Round(r*math.Sin(angle)))
+			place(g.formatValue(g.min+ratio*(g.max-g.min)), px, min(py, cy))
+		}
+	}
+	if !g.hideValue {
+		_, row := toCell(cx, cy)
+		readout := g.formatValue(g.min + g.progress()*(g.max-g.min))
+		width := text.Width(readout)
+		x := min(max(content.X+(content.Width-width)/2, content.X), content.X+content.Width-width)
+		ctx.Buffer.SetString(x, min(row+1, content.Y+content.Height-1), readout, style)
+	}
 }
 
 var _ runtime.Widget = (*AnimatedGauge)(nil)
@@ -0,0 +1,92 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/animation"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestAnimatedGaugeBarZonesAndTicks(t *testing.T) {
+	green := backend.ColorRGB(0, 200, 0)
+	red := backend.ColorRGB(200, 0, 0)
+	gauge := NewAnimatedGauge(0, 100)
+	gauge.SetShape(GaugeBar)
+	gauge.SetEasing(animation.Linear)
+	gauge.SetZones([]GaugeZone{{From: 0, To: 70, Color: green}, {From: 70, To: 100, Color: red}})
+	gauge.SetTicks(GaugeTicks{Major: 3, Minor: 1, Labels: true})
+	gauge.SetFormatter(func(v float64) string { return fmt.Sprintf("%.0f%%", v) })
+	gauge.SetValue(80)
+
+	if size := gauge.Measure(runtime.Constraints{MaxWidth: 20, MaxHeight: 10}); size.Height != 3 {
+		t.Fatalf("bar height = %d, want 3", size.Height)
+	}
+	buf, rows := renderRows(t, gauge, 15, 3)
+	if !strings.HasSuffix(rows[0], " 80%") {
+		t.Fatalf("readout row = %q", rows[0])
+	}
+	if fg := buf.Get(0, 0).Style.FG(); fg != green {
+		t.Fatalf("low cell fg = %v, want green", fg)
+	}
+	if fg := buf.Get(8, 0).Style.FG(); fg != red {
+		t.Fatalf("high filled cell fg = %v, want red", fg)
+	}
+	if r := buf.Get(10, 0).Rune; r != '░' {
+		t.Fatalf("cell past the value = %q, want empty", r)
+	}
+	for x, want := range map[int]rune{0: '│', 3: '╵', 5: '│', 8: '╵', 10: '│'} {
+		if got := buf.Get(x, 1).Rune; got != want {
+			t.Fatalf("tick at column %d = %q, want %q (row %q)", x, got, want, rows[1])
+		}
+	}
+	if !strings.HasPrefix(rows[2], "0%") || !strings.Contains(rows[2], "50%") || !strings.HasSuffix(rows[2], "100%") {
+		t.Fatalf("label row = %q", rows[2])
+	}
+}
+
+func TestAnimatedGaugeEasingTween(t *testing.T) {
+	gauge := NewAnimatedGauge(0, 10)
+	animator := animation.NewAnimator()
+	app := runtime.NewApp(runtime.AppConfig{Animator: animator})
+	gauge.Bind(app.Services())
+	defer gauge.Unbind()
+
+	var calls int
+	gauge.SetEasing(func(t float64) float64 {
+		calls++
+		return t
+	})
+	gauge.SetDuration(time.Nanosecond)
+	gauge.SetValue(5)
+	animator.Update(0.016)
+	if calls == 0 {
+		t.Fatal("custom easing was not used")
+	}
+	if got := gauge.progress(); got != 0.5 {
+		t.Fatalf("progress = %v, want 0.5", got)
+	}
+}
+
+func TestAnimatedGaugeDial(t *testing.T) {
+	gauge := NewAnimatedGauge(0, 100)
+	gauge.SetBlitter(&graphics.BrailleBlitter{})
+	gauge.SetEasing(animation.Linear)
+	gauge.SetTicks(GaugeTicks{Major: 3, Labels: true})
+	gauge.SetValue(50)
+
+	_, rows := renderRows(t, gauge, 30, 10)
+	out := strings.Join(rows, "\n")
+	for _, want := range []string{"0", "100", "50"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("dial output missing %q:\n%s", want, out)
+		}
+	}
+	if !strings.Contains(rows[len(rows)-1], "50") && !strings.Contains(rows[len(rows)-2], "50") {
+		t.Fatalf("readout should sit under the pivot:\n%s", out)
+	}
+}
@@ -4,12 +4,11 @@ package widgets
 import (
 	"strings"
 
-	"github.com/mattn/go-runewidth"
-
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/style"
+	"github.com/odvcencio/fluffyui/text"
 )
 
 // Base provides common functionality for widgets.
@@ -24,6 +23,7 @@ type Base struct {
 	needsRender   bool
 	id            string
 	classes       []string
+	tooltip       string
 }
 
 // Layout stores the assigned bounds.
@@ -159,6 +159,24 @@ func (b *Base) StyleClasses() []string {
 	return b.classes
 }
 
+// SetTooltip sets the hint shown when the widget is hovered or focused for
+// the app's tooltip delay. The text may use fur markup. Empty text shows
+// no tooltip.
+func (b *Base) SetTooltip(text string) {
+	if b == nil {
+		return
+	}
+	b.tooltip = text
+}
+
+// TooltipText returns the widget's tooltip.
+func (b *Base) TooltipText() string {
+	if b == nil {
+		return ""
+	}
+	return b.tooltip
+}
+
 // StyleState returns the default widget style state.
 func (b *Base) StyleState() style.WidgetState {
 	if b == nil {
@@ -266,83 +284,43 @@ func (f *FocusableBase) CanFocus() bool {
 }
 
 func textWidth(s string) int {
-	return runewidth.StringWidth(s)
+	return text.Width(s)
 }
 
 // truncateString truncates a string to fit within maxWidth.
 // Adds "..." if truncated.
 func truncateString(s string, maxWidth int) string {
-	if maxWidth <= 0 {
-		return ""
-	}
-	if textWidth(s) <= maxWidth {
-		return s
-	}
-	if maxWidth <= 3 {
-		return runewidth.Truncate(s, maxWidth, "")
-	}
-	return runewidth.Truncate(s, maxWidth, "...")
+	return text.Truncate(s, maxWidth, "...")
 }
 
 // clipString truncates a string to fit within maxWidth without ellipsis.
 func clipString(s string, maxWidth int) string {
-	if maxWidth <= 0 {
-		return ""
-	}
-	return runewidth.Truncate(s, maxWidth, "")
+	return text.Truncate(s, maxWidth, "")
 }
 
 // clipStringRight keeps the rightmost portion of the string within maxWidth.
 func clipStringRight(s string, maxWidth int) string {
-	if maxWidth <= 0 {
-		return ""
-	}
-	if textWidth(s) <= maxWidth {
-		return s
-	}
-	runes := []rune(s)
-	width := 0
-	start := len(runes)
-	for start > 0 {
-		w := runewidth.RuneWidth(runes[start-1])
-		if w < 0 {
-			w = 0
-		}
-		if width+w > maxWidth {
-			break
-		}
-		width += w
-		start--
-	}
-	return string(runes[start:])
+	return text.TruncateLeft(s, maxWidth, "")
 }
 
 // padRight pads a string with spaces to reach the given width.
 func padRight(s string, width int) string {
-	if width <= 0 {
-		return ""
-	}
-	textW := textWidth(s)
-	if textW >= width {
-		return runewidth.Truncate(s, width, "")
-	}
-	padding := width - textW
-	return s + strings.Repeat(" ", padding)
+	return text.Pad(s, width)
 }
 
-func writePadded(buf *runtime.Buffer, x, y, width int, text string, style backend.Style) {
+func writePadded(buf *runtime.Buffer, x, y, width int, label string, style backend.Style) {
 	if buf == nil || width <= 0 {
 		return
 	}
 	if x < 0 {
-		buf.SetString(x, y, padRight(text, width), style)
+		buf.SetString(x, y, padRight(label, width), style)
 		return
 	}
-	text = runewidth.Truncate(text, width, "")
-	textW := textWidth(text)
-	buf.SetString(x, y, text, style)
-	if pad := width - textW; pad > 0 {
-		buf.Fill(runtime.Rect{X: x + textW, Y: y, Width: pad, Height: 1}, ' ', style)
+	label = clipString(label, width)
+	labelW := textWidth(label)
+	buf.SetString(x, y, label, style)
+	if pad := width - labelW; pad > 0 {
+		buf.Fill(runtime.Rect{X: x + labelW, Y: y, Width: pad, Height: 1}, ' ', style)
 	}
 }
 
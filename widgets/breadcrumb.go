@@ -15,13 +15,27 @@ type BreadcrumbItem struct {
 	OnClick func()
 }
 
-// Breadcrumb renders a path of items.
+// Breadcrumb renders a path of items. When the path is wider than the
+// widget, or longer than the SetMaxVisible cap, the middle items collapse
+// into an ellipsis; the first and last items always show. Clicking the
+// ellipsis lists every item in a popup.
 type Breadcrumb struct {
 	FocusableBase
 	Items      []BreadcrumbItem
 	selected   int // Currently selected/focused item index
 	onNavigate func(index int)
 	separator  string
+	maxVisible int
+}
+
+// breadcrumbEllipsis stands in for collapsed items.
+const breadcrumbEllipsis = "…"
+
+// crumbSegment is a drawn item, or the ellipsis when index is -1.
+type crumbSegment struct {
+	index int
+	x     int
+	width int
 }
 
 // NewBreadcrumb creates a breadcrumb.
@@ -42,6 +56,25 @@ func (b *Breadcrumb) SetSeparator(sep string) {
 	}
 }
 
+// SetMaxVisible caps how many items are shown before the middle ones
+// collapse into an ellipsis. Zero or less removes the cap; the first and
+// last items always show.
+func (b *Breadcrumb) SetMaxVisible(n int) {
+	if b == nil {
+		return
+	}
+	b.maxVisible = n
+	b.Invalidate()
+}
+
+// MaxVisible returns the item cap, or 0 when there is none.
+func (b *Breadcrumb) MaxVisible() int {
+	if b == nil {
+		return 0
+	}
+	return b.maxVisible
+}
+
 // OnNavigate sets the callback for navigation to a breadcrumb item.
 func (b *Breadcrumb) OnNavigate(fn func(index int)) {
 	if b != nil {
@@ -61,15 +94,9 @@ func (b *Breadcrumb) Selected() int {
 func (b *Breadcrumb) Measure(constraints runtime.Constraints) runtime.Size {
 	return b.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
 		width := 0
-		sep := b.separator
-		if sep == "" {
-			sep = " > "
-		}
-		for i, item := range b.Items {
-			width += textWidth(item.Label)
-			if i < len(b.Items)-1 {
-				width += textWidth(sep)
-			}
+		if segments := b.segments(0, contentConstraints.MaxWidth); len(segments) > 0 {
+			last := segments[len(segments)-1]
+			width = last.x + last.width
 		}
 		if width < 1 {
 			width = 1
@@ -89,42 +116,35 @@ func (b *Breadcrumb) Render(ctx runtime.RenderContext) {
 		return
 	}
 
-	sep := b.separator
-	if sep == "" {
-		sep = " > "
-	}
-	sepWidth := textWidth(sep)
-
+	sep := b.sep()
 	x := bounds.X
 	normalStyle := backend.DefaultStyle()
 	selectedStyle := normalStyle.Reverse(true)
 	sepStyle := normalStyle.Dim(true)
+	from, to := b.hiddenRange(bounds.Width)
 
-	for i, item := range b.Items {
+	for i, segment := range b.segments(bounds.X, bounds.Width) {
 		if i > 0 {
-			// Draw separator
-			if x+sepWidth <= bounds.X+bounds.Width {
-				ctx.Buffer.SetString(x, bounds.Y, sep, sepStyle)
-				x += sepWidth
+			if room := bounds.X + bounds.Width - x; room > 0 {
+				ctx.Buffer.SetString(x, bounds.Y, clipString(sep, room), sepStyle)
 			}
 		}
-
-		// Draw item
+		x = segment.x
+		label := breadcrumbEllipsis
+		selected := b.selected >= from && b.selected < to
+		if segment.index >= 0 {
+			label = b.Items[segment.index].Label
+			selected = segment.index == b.selected
+		}
 		style := normalStyle
-		if b.focused && i == b.selected {
+		if b.focused && selected {
 			style = selectedStyle
 		}
-		label := item.Label
-		available := bounds.X + bounds.Width - x
-		if textWidth(label) > available {
-			label = clipString(label, available)
-		}
-		labelWidth := textWidth(label)
-		if labelWidth > 0 {
+		if room := bounds.X + bounds.Width - x; room > 0 {
+			label = clipString(label, room)
 			ctx.Buffer.SetString(x, bounds.Y, label, style)
-			x += labelWidth
+			x += textWidth(label)
 		}
-
 		if x >= bounds.X+bounds.Width {
 			break
 		}
@@ -145,6 +165,9 @@ func (b *Breadcrumb) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	switch m := msg.(type) {
 	case runtime.MouseMsg:
 		if m.Action == runtime.MousePress && m.Button == runtime.MouseLeft {
+			if segment, ok := b.segmentAt(m.X, m.Y); ok && segment.index < 0 {
+				return b.openOverflow(segment)
+			}
 			index := b.itemAtPosition(m.X, m.Y)
 			if index >= 0 && index < len(b.Items) {
 				b.selected = index
@@ -184,6 +207,13 @@ func (b *Breadcrumb) HandleMessage(msg runtime.Message) runtime.HandleResult {
 				return runtime.Handled()
 			}
 		case terminal.KeyEnter:
+			if from, to := b.hiddenRange(b.ContentBounds().Width); b.selected >= from && b.selected < to {
+				for _, segment := range b.segments(b.ContentBounds().X, b.ContentBounds().Width) {
+					if segment.index < 0 {
+						return b.openOverflow(segment)
+					}
+				}
+			}
 			b.activateItem(b.selected)
 			return runtime.Handled()
 		}
@@ -205,32 +235,115 @@ func (b *Breadcrumb) activateItem(index int) {
 	}
 }
 
+// openOverflow lists every item in a popup below the ellipsis.
+func (b *Breadcrumb) openOverflow(ellipsis crumbSegment) runtime.HandleResult {
+	items := make([]*MenuItem, len(b.Items))
+	for i, item := range b.Items {
+		index := i
+		items[i] = &MenuItem{Title: item.Label, OnSelect: func() {
+			b.selected = index
+			b.activateItem(index)
+			b.Invalidate()
+		}}
+	}
+	menu := NewContextMenu(items...)
+	menu.SetLabel("Breadcrumbs")
+	menu.ScrollTo(0, b.selected)
+	bounds := b.ContentBounds()
+	anchor := runtime.Rect{X: ellipsis.x, Y: bounds.Y, Width: ellipsis.width, Height: 1}
+	return runtime.ShowContextMenuNear(anchor, menu)
+}
+
 // itemAtPosition returns the item index at the given screen position.
-// Returns -1 if no item is at that position.
+// Returns -1 if no item is at that position, including the ellipsis.
 func (b *Breadcrumb) itemAtPosition(x, y int) int {
+	if segment, ok := b.segmentAt(x, y); ok {
+		return segment.index
+	}
+	return -1
+}
+
+// segmentAt returns the drawn segment at the given screen position.
+func (b *Breadcrumb) segmentAt(x, y int) (crumbSegment, bool) {
 	bounds := b.ContentBounds()
 	if y < bounds.Y || y >= bounds.Y+bounds.Height {
-		return -1
+		return crumbSegment{}, false
 	}
+	for _, segment := range b.segments(bounds.X, bounds.Width) {
+		if x >= segment.x && x < segment.x+segment.width {
+			return segment, true
+		}
+	}
+	return crumbSegment{}, false
+}
 
-	sep := b.separator
-	if sep == "" {
-		sep = " > "
+// segments lays the shown items and the ellipsis out from x.
+func (b *Breadcrumb) segments(x, width int) []crumbSegment {
+	from, to := b.hiddenRange(width)
+	sepWidth := textWidth(b.sep())
+	segments := make([]crumbSegment, 0, len(b.Items))
+	add := func(index int, label string) {
+		if len(segments) > 0 {
+			x += sepWidth
+		}
+		segments = append(segments, crumbSegment{index: index, x: x, width: textWidth(label)})
+		x += textWidth(label)
+	}
+	for i, item := range b.Items {
+		switch {
+		case i < from || i >= to:
+			add(i, item.Label)
+		case i == from:
+			add(-1, breadcrumbEllipsis)
+		}
 	}
-	sepWidth := textWidth(sep)
+	return segments
+}
 
-	currentX := bounds.X
+// hiddenRange returns the items [from, to) that collapse into the
+// ellipsis to fit width and the item cap, or an empty range. The items
+// nearest the last one are kept, as they are closest to where the user is.
+func (b *Breadcrumb) hiddenRange(width int) (from, to int) {
+	count := len(b.Items)
+	if count <= 2 {
+		return 0, 0
+	}
+	limit := count
+	if b.maxVisible > 0 {
+		limit = max(2, b.maxVisible)
+	}
+	sepWidth := textWidth(b.sep())
+	full := 0
 	for i, item := range b.Items {
 		if i > 0 {
-			currentX += sepWidth
+			full += sepWidth
 		}
-		itemEnd := currentX + textWidth(item.Label)
-		if x >= currentX && x < itemEnd {
-			return i
+		full += textWidth(item.Label)
+	}
+	if limit >= count && full <= width {
+		return 0, 0
+	}
+	used := textWidth(b.Items[0].Label) + 2*sepWidth + textWidth(breadcrumbEllipsis) + textWidth(b.Items[count-1].Label)
+	to = count - 1
+	for shown := 2; to > 1 && shown < limit; shown++ {
+		next := textWidth(b.Items[to-1].Label) + sepWidth
+		if used+next > width {
+			break
 		}
-		currentX = itemEnd
+		used += next
+		to--
 	}
-	return -1
+	if to <= 1 {
+		return 0, 0
+	}
+	return 1, to
+}
+
+func (b *Breadcrumb) sep() string {
+	if b.separator == "" {
+		return " > "
+	}
+	return b.separator
 }
 
 func (b *Breadcrumb) syncA11y() {
@@ -261,11 +374,7 @@ func (b *Breadcrumb) pathString() string {
 		}
 		parts = append(parts, item.Label)
 	}
-	sep := b.separator
-	if sep == "" {
-		sep = " > "
-	}
-	return strings.Join(parts, sep)
+	return strings.Join(parts, b.sep())
 }
 
 var _ runtime.Widget = (*Breadcrumb)(nil)
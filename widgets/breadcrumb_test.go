@@ -201,3 +201,42 @@ func TestBreadcrumbCustomSeparator(t *testing.T) {
 		t.Errorf("Width with custom separator = %d, want 5", size.Width)
 	}
 }
+
+func TestBreadcrumbCollapsesMiddleItems(t *testing.T) {
+	bc := NewBreadcrumb(
+		BreadcrumbItem{Label: "Home"},
+		BreadcrumbItem{Label: "Projects"},
+		BreadcrumbItem{Label: "Fluffy"},
+		BreadcrumbItem{Label: "Docs"},
+		BreadcrumbItem{Label: "API"},
+	)
+	if _, rows := renderRows(t, bc, 40, 1); rows[0] != "Home > Projects > Fluffy > Docs > API" {
+		t.Fatalf("row = %q, want every item when they fit", rows[0])
+	}
+	if _, rows := renderRows(t, bc, 24, 1); rows[0] != "Home > … > Docs > API" {
+		t.Fatalf("row = %q, want the middle items collapsed", rows[0])
+	}
+
+	bc.SetMaxVisible(2)
+	if _, rows := renderRows(t, bc, 40, 1); rows[0] != "Home > … > API" {
+		t.Fatalf("row = %q, want only the first and last items", rows[0])
+	}
+
+	navigated := -1
+	bc.OnNavigate(func(index int) { navigated = index })
+	result := bc.HandleMessage(runtime.MouseMsg{X: 7, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if len(result.Commands) != 1 {
+		t.Fatalf("expected the ellipsis to open a popup, got %+v", result.Commands)
+	}
+	layer := result.Commands[0].(runtime.PushOverlay).Widget.(runtime.ChildProvider)
+	menu := layer.ChildWidgets()[0].(*Menu)
+	if len(menu.Items) != 5 {
+		t.Fatalf("popup items = %d, want the whole path", len(menu.Items))
+	}
+	menu.Focus()
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if navigated != 1 || bc.Selected() != 1 {
+		t.Fatalf("navigated = %d, selected = %d, want Projects", navigated, bc.Selected())
+	}
+}
@@ -340,8 +340,14 @@ func (b *Button) Render(ctx runtime.RenderContext) {
 	style := b.style
 	switch b.variant {
 	case VariantPrimary:
+		if !b.styleSet {
+			style = themeStyle("primary")
+		}
 		style = style.Bold(true)
 	case VariantDanger:
+		if !b.styleSet {
+			style = themeStyle("danger")
+		}
 		style = style.Bold(true).Underline(true)
 	}
 	resolved := ctx.ResolveStyle(b)
@@ -30,15 +30,42 @@ type CalendarDayState struct {
 	InRange     bool
 	RangeStart  bool
 	RangeEnd    bool
+	Events      []CalEvent
 }
 
+// CalEvent is an appointment shown as a colored dot under its day.
+type CalEvent struct {
+	Date  time.Time
+	Color backend.Color
+	Label string
+}
+
+// calendarDay keys events by day, ignoring the time of day.
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func calendarDayOf(date time.Time) calendarDay {
+	return calendarDay{year: date.Year(), month: date.Month(), day: date.Day()}
+}
+
+// defaultEventsPerDay is how many event dots a day shows by default.
+const defaultEventsPerDay = 3
+
 // DayRenderFunc customizes day rendering.
 type DayRenderFunc func(ctx runtime.RenderContext, date time.Time, state CalendarDayState)
 
 // CalendarOption configures a calendar.
 type CalendarOption = Option[Calendar]
 
-// Calendar displays a month grid with selectable days.
+// Calendar displays a month grid with selectable days. Arrow keys move by
+// day and week, Page Up/Down or Ctrl+Left/Right by month, and Ctrl+Up/Down
+// by year. Pressing y or clicking the header title focuses the year, where
+// typing four digits jumps straight to that year. Days with events show a
+// dot per event, and the focused or hovered day lists its events in a
+// popover.
 type Calendar struct {
 	FocusableBase
 
@@ -53,6 +80,13 @@ type Calendar struct {
 	selectionMode CalendarSelectionMode
 	weekStart     time.Weekday
 	showWeekNums  bool
+	minYear       int
+	maxYear       int
+	yearEntry     bool
+	yearDigits    []rune
+	events        map[calendarDay][]CalEvent
+	eventsPerDay  int
+	hover         time.Time
 
 	label         string
 	headerFormat  string
@@ -113,6 +147,7 @@ func NewCalendar(opts ...CalendarOption) *Calendar {
 		highlightStyle: backend.DefaultStyle().Foreground(backend.ColorYellow),
 		outsideStyle:   backend.DefaultStyle().Dim(true),
 		rangeStyle:     backend.DefaultStyle().Reverse(true),
+		eventsPerDay:   defaultEventsPerDay,
 		now:            func() time.Time { return time.Now() },
 	}
 	for _, opt := range opts {
@@ -359,6 +394,27 @@ func (c *Calendar) SetMaxDate(date *time.Time) {
 	c.maxDate.Set(date)
 }
 
+// SetYearRange limits selectable dates to the years min through max,
+// inclusive. Zero leaves that end open.
+func (c *Calendar) SetYearRange(min, max int) {
+	if c == nil {
+		return
+	}
+	if min > 0 && max > 0 && min > max {
+		min, max = max, min
+	}
+	c.minYear, c.maxYear = min, max
+	c.invalidate()
+}
+
+// YearRange returns the selectable years, with zero for an open end.
+func (c *Calendar) YearRange() (int, int) {
+	if c == nil {
+		return 0, 0
+	}
+	return c.minYear, c.maxYear
+}
+
 // SetHighlightDates sets highlighted dates.
 func (c *Calendar) SetHighlightDates(dates []time.Time) {
 	if c == nil || c.highlightDates == nil {
@@ -367,6 +423,61 @@ func (c *Calendar) SetHighlightDates(dates []time.Time) {
 	c.highlightDates.Set(dates)
 }
 
+// AddEvent adds an event on its date.
+func (c *Calendar) AddEvent(event CalEvent) {
+	if c == nil || event.Date.IsZero() {
+		return
+	}
+	if c.events == nil {
+		c.events = make(map[calendarDay][]CalEvent)
+	}
+	key := calendarDayOf(event.Date)
+	c.events[key] = append(c.events[key], event)
+	c.invalidate()
+	c.services.Relayout()
+}
+
+// Events returns the events on date in the order they were added.
+func (c *Calendar) Events(date time.Time) []CalEvent {
+	if c == nil {
+		return nil
+	}
+	events := c.events[calendarDayOf(date)]
+	if len(events) == 0 {
+		return nil
+	}
+	return append([]CalEvent(nil), events...)
+}
+
+// ClearEvents removes all events.
+func (c *Calendar) ClearEvents() {
+	if c == nil {
+		return
+	}
+	c.events = nil
+	c.invalidate()
+	c.services.Relayout()
+}
+
+// SetEventsPerDay sets how many event dots a day shows. Zero hides the
+// dots; the popover still lists every event.
+func (c *Calendar) SetEventsPerDay(n int) {
+	if c == nil {
+		return
+	}
+	c.eventsPerDay = max(0, n)
+	c.invalidate()
+	c.services.Relayout()
+}
+
+// EventsPerDay returns how many event dots a day shows.
+func (c *Calendar) EventsPerDay() int {
+	if c == nil {
+		return 0
+	}
+	return c.eventsPerDay
+}
+
 // SetWeekStart updates the week start day.
 func (c *Calendar) SetWeekStart(start time.Weekday) {
 	if c == nil {
@@ -440,10 +551,14 @@ func (c *Calendar) StyleType() string {
 	return "Calendar"
 }
 
-// Measure returns the desired size.
+// Measure returns the desired size, with a dot row under each week when
+// there are events to show.
 func (c *Calendar) Measure(constraints runtime.Constraints) runtime.Size {
 	return c.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
 		height := 8
+		if c.showDots() {
+			height = 14
+		}
 		if contentConstraints.MaxHeight > 0 {
 			height = min(height, contentConstraints.MaxHeight)
 		}
@@ -490,6 +605,14 @@ func (c *Calendar) Render(ctx runtime.RenderContext) {
 	header := month.Format(c.headerFormat)
 	headerX := content.X + max(0, (content.Width-len(header))/2)
 	ctx.Buffer.SetString(headerX, layout.headerY, header, headerStyle)
+	if c.yearEntry {
+		year := string(c.yearDigits) + strings.Repeat("_", 4-len(c.yearDigits))
+		entry := month.Format("January") + " " + year
+		entryX := content.X + max(0, (content.Width-textWidth(entry))/2)
+		ctx.Buffer.SetString(headerX, layout.headerY, strings.Repeat(" ", textWidth(header)), baseStyle)
+		ctx.Buffer.SetString(entryX, layout.headerY, entry, headerStyle)
+		ctx.Buffer.SetString(entryX+textWidth(entry)-4, layout.headerY, year, headerStyle.Reverse(true))
+	}
 	if content.Width >= 2 {
 		ctx.Buffer.Set(content.X, layout.headerY, '<', headerStyle)
 		ctx.Buffer.Set(content.X+content.Width-1, layout.headerY, '>', headerStyle)
@@ -505,7 +628,7 @@ func (c *Calendar) Render(ctx runtime.RenderContext) {
 
 	date := layout.start
 	for row := 0; row < layout.rows; row++ {
-		rowY := layout.gridY + row
+		rowY := layout.gridY + row*layout.rowHeight
 		if rowY >= content.Y+content.Height {
 			break
 		}
@@ -526,19 +649,136 @@ func (c *Calendar) Render(ctx runtime.RenderContext) {
 			} else {
 				c.renderDayCell(ctx.Sub(cellBounds), date, state, baseStyle, selectedStyle, todayStyle, disabledStyle, highlightStyle, outsideStyle, rangeStyle)
 			}
+			c.renderDots(ctx.Buffer, cellBounds, layout, date, baseStyle)
 			date = date.AddDate(0, 0, 1)
 			x += layout.cellWidth
 		}
 	}
+	c.renderEventPopover(ctx.Buffer, content, layout, baseStyle)
+}
+
+// renderDots draws a dot per event under the day number, or after it when
+// the weeks are packed one row each.
+func (c *Calendar) renderDots(buf *runtime.Buffer, cell runtime.Rect, layout calendarLayout, date time.Time, base backend.Style) {
+	if !c.showDots() {
+		return
+	}
+	events := c.events[calendarDayOf(date)]
+	x, y, width := cell.X, cell.Y+1, cell.Width
+	if layout.rowHeight == 1 {
+		x, y, width = cell.X+2, cell.Y, cell.Width-2
+	}
+	if y >= c.ContentBounds().Y+c.ContentBounds().Height {
+		return
+	}
+	for i := 0; i < len(events) && i < c.eventsPerDay && i < width; i++ {
+		buf.Set(x+i, y, '•', base.Foreground(events[i].Color))
+	}
+}
+
+// eventPopoverDate returns the day whose events the popover lists: the
+// hovered day, or the cursor day while focused.
+func (c *Calendar) eventPopoverDate() (time.Time, bool) {
+	if !c.hover.IsZero() && len(c.events[calendarDayOf(c.hover)]) > 0 {
+		return c.hover, true
+	}
+	if c.focused && !c.yearEntry {
+		cursor := c.cursorDate()
+		if len(c.events[calendarDayOf(cursor)]) > 0 {
+			return cursor, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// renderEventPopover lists the popover day's events next to its cell,
+// below it when there is room and above it otherwise.
+func (c *Calendar) renderEventPopover(buf *runtime.Buffer, content runtime.Rect, layout calendarLayout, base backend.Style) {
+	date, ok := c.eventPopoverDate()
+	if !ok {
+		return
+	}
+	cell, ok := c.cellBounds(content, layout, date)
+	if !ok {
+		return
+	}
+	events := c.events[calendarDayOf(date)]
+	lines := make([]string, 0, len(events))
+	width := 0
+	for _, event := range events {
+		line := "• " + event.Label
+		lines = append(lines, line)
+		width = max(width, textWidth(line)+2)
+	}
+	width = min(width, content.Width)
+	height := min(len(lines), content.Height)
+	y := cell.Y + layout.rowHeight
+	if y+height > content.Y+content.Height {
+		y = max(content.Y, cell.Y-height)
+	}
+	x := clampInt(cell.X, content.X, max(content.X, content.X+content.Width-width))
+	style := base.Reverse(true)
+	buf.Fill(runtime.Rect{X: x, Y: y, Width: width, Height: height}, ' ', style)
+	for i := 0; i < height; i++ {
+		text := truncateString(lines[i], max(0, width-2))
+		buf.SetString(x+1, y+i, text, style)
+		buf.Set(x+1, y+i, '•', style.Foreground(events[i].Color))
+	}
+}
+
+// cellBounds returns the grid cell of date, if it is in view.
+func (c *Calendar) cellBounds(content runtime.Rect, layout calendarLayout, date time.Time) (runtime.Rect, bool) {
+	if layout.rows <= 0 {
+		return runtime.Rect{}, false
+	}
+	offset := int(normalizeDate(date).Sub(layout.start).Hours()+12) / 24
+	if offset < 0 || offset >= layout.rows*7 {
+		return runtime.Rect{}, false
+	}
+	return runtime.Rect{
+		X:      content.X + layout.weekNumWidth + (offset%7)*layout.cellWidth,
+		Y:      layout.gridY + (offset/7)*layout.rowHeight,
+		Width:  layout.cellWidth,
+		Height: 1,
+	}, true
+}
+
+// dateAt returns the day drawn at x, y, if any.
+func (c *Calendar) dateAt(content runtime.Rect, layout calendarLayout, x, y int) (time.Time, bool) {
+	if layout.rows <= 0 || y < layout.gridY || layout.cellWidth <= 0 {
+		return time.Time{}, false
+	}
+	row := (y - layout.gridY) / layout.rowHeight
+	if row >= layout.rows {
+		return time.Time{}, false
+	}
+	dx := x - (content.X + layout.weekNumWidth)
+	col := dx / layout.cellWidth
+	if dx < 0 || col >= 7 {
+		return time.Time{}, false
+	}
+	return layout.start.AddDate(0, 0, row*7+col), true
+}
+
+// showDots reports whether event dots are drawn.
+func (c *Calendar) showDots() bool {
+	return c.eventsPerDay > 0 && len(c.events) > 0
 }
 
 // HandleMessage handles keyboard navigation and mouse selection.
 func (c *Calendar) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if c == nil || !c.focused {
+	if c == nil {
+		return runtime.Unhandled()
+	}
+	if m, ok := msg.(runtime.MouseMsg); ok && m.Action == runtime.MouseMove {
+		return c.handleHover(m.X, m.Y)
+	}
+	if !c.focused {
 		return runtime.Unhandled()
 	}
 	switch m := msg.(type) {
 	case runtime.KeyMsg:
+		c.setHover(time.Time{})
 		return c.handleKey(m)
 	case runtime.MouseMsg:
 		if m.Action == runtime.MousePress && m.Button == runtime.MouseLeft {
@@ -549,7 +789,16 @@ func (c *Calendar) HandleMessage(msg runtime.Message) runtime.HandleResult {
 }
 
 func (c *Calendar) handleKey(key runtime.KeyMsg) runtime.HandleResult {
+	if c.yearEntry {
+		if result, ok := c.handleYearKey(key); ok {
+			return result
+		}
+	}
 	current := c.cursorDate()
+	if months, ok := calendarJump(key); ok {
+		c.selectDate(addMonthsClamped(current, months))
+		return runtime.Handled()
+	}
 	switch key.Key {
 	case terminal.KeyLeft:
 		c.selectDate(current.AddDate(0, 0, -1))
@@ -578,10 +827,105 @@ func (c *Calendar) handleKey(key runtime.KeyMsg) runtime.HandleResult {
 	case terminal.KeyEnter:
 		c.selectDate(current)
 		return runtime.Handled()
+	case terminal.KeyRune:
+		if key.Rune == 'y' && !key.Ctrl && !key.Alt {
+			c.startYearEntry()
+			return runtime.Handled()
+		}
 	}
 	return runtime.Unhandled()
 }
 
+// calendarJump returns the months a Ctrl+arrow key jumps: Left and Right
+// move by a month, Up and Down by a year.
+func calendarJump(key runtime.KeyMsg) (int, bool) {
+	if !key.Ctrl || key.Shift || key.Alt {
+		return 0, false
+	}
+	switch key.Key {
+	case terminal.KeyLeft:
+		return -1, true
+	case terminal.KeyRight:
+		return 1, true
+	case terminal.KeyUp:
+		return -12, true
+	case terminal.KeyDown:
+		return 12, true
+	}
+	return 0, false
+}
+
+func (c *Calendar) startYearEntry() {
+	c.yearEntry = true
+	c.yearDigits = c.yearDigits[:0]
+	c.invalidate()
+}
+
+func (c *Calendar) stopYearEntry() {
+	c.yearEntry = false
+	c.yearDigits = c.yearDigits[:0]
+	c.invalidate()
+}
+
+// handleYearKey takes digits while the year header has focus. Other keys
+// leave the header and, apart from Escape and Enter, move the cursor as
+// usual.
+func (c *Calendar) handleYearKey(key runtime.KeyMsg) (runtime.HandleResult, bool) {
+	switch key.Key {
+	case terminal.KeyRune:
+		if key.Rune < '0' || key.Rune > '9' || key.Ctrl || key.Alt {
+			break
+		}
+		c.yearDigits = append(c.yearDigits, key.Rune)
+		if len(c.yearDigits) == 4 {
+			year := 0
+			for _, digit := range c.yearDigits {
+				year = year*10 + int(digit-'0')
+			}
+			c.stopYearEntry()
+			current := c.cursorDate()
+			c.selectDate(addMonthsClamped(current, (year-current.Year())*12))
+			return runtime.Handled(), true
+		}
+		c.invalidate()
+		return runtime.Handled(), true
+	case terminal.KeyBackspace:
+		if len(c.yearDigits) > 0 {
+			c.yearDigits = c.yearDigits[:len(c.yearDigits)-1]
+			c.invalidate()
+		}
+		return runtime.Handled(), true
+	case terminal.KeyEscape, terminal.KeyEnter:
+		c.stopYearEntry()
+		return runtime.Handled(), true
+	}
+	c.stopYearEntry()
+	return runtime.HandleResult{}, false
+}
+
+// handleHover tracks the day under the pointer for the event popover.
+func (c *Calendar) handleHover(x, y int) runtime.HandleResult {
+	content := c.ContentBounds()
+	if !content.Contains(x, y) {
+		c.setHover(time.Time{})
+		return runtime.Unhandled()
+	}
+	date, _ := c.dateAt(content, c.layout(content), x, y)
+	c.setHover(date)
+	return runtime.Handled()
+}
+
+func (c *Calendar) setHover(date time.Time) {
+	if sameDay(date, c.hover) && date.IsZero() == c.hover.IsZero() {
+		return
+	}
+	hadPopover := len(c.events[calendarDayOf(c.hover)]) > 0 && !c.hover.IsZero()
+	c.hover = date
+	if hadPopover || (!date.IsZero() && len(c.events[calendarDayOf(date)]) > 0) {
+		c.invalidate()
+	}
+}
+
 func (c *Calendar) handleClick(x, y int) runtime.HandleResult {
 	content := c.ContentBounds()
 	if !content.Contains(x, y) {
@@ -600,21 +944,14 @@ func (c *Calendar) handleClick(x, y int) runtime.HandleResult {
 			c.selectDate(addMonthsClamped(c.cursorDate(), 1))
 			return runtime.Handled()
 		}
+		c.startYearEntry()
+		return runtime.Handled()
 	}
-	if y < layout.gridY {
-		return runtime.Unhandled()
-	}
-	row := y - layout.gridY
-	if row < 0 || row >= layout.rows {
-		return runtime.Unhandled()
-	}
-	col := (x - (content.X + layout.weekNumWidth)) / layout.cellWidth
-	if col < 0 || col >= 7 {
-		return runtime.Unhandled()
+	if c.yearEntry {
+		c.stopYearEntry()
 	}
-	offset := row*7 + col
-	date := layout.start.AddDate(0, 0, offset)
-	if c.isDisabled(date) {
+	date, ok := c.dateAt(content, layout, x, y)
+	if !ok || c.isDisabled(date) {
 		return runtime.Unhandled()
 	}
 	c.selectDate(date)
@@ -652,6 +989,7 @@ func (c *Calendar) dayState(date time.Time, month time.Time) CalendarDayState {
 	state.Disabled = c.isDisabled(date)
 	state.Today = sameDay(date, normalizeDate(c.now()))
 	state.Highlighted = c.isHighlighted(date)
+	state.Events = c.events[calendarDayOf(date)]
 
 	selected := c.selectedDate.Get()
 	state.Selected = sameDay(date, selected)
@@ -706,6 +1044,9 @@ func (c *Calendar) isDisabled(date time.Time) bool {
 		return false
 	}
 	date = normalizeDate(date)
+	if (c.minYear > 0 && date.Year() < c.minYear) || (c.maxYear > 0 && date.Year() > c.maxYear) {
+		return true
+	}
 	if c.minDate != nil {
 		if min := c.minDate.Get(); min != nil && date.Before(*min) {
 			return true
@@ -759,6 +1100,12 @@ func (c *Calendar) clampDate(date time.Time) time.Time {
 	if c == nil {
 		return date
 	}
+	if c.minYear > 0 && date.Year() < c.minYear {
+		date = time.Date(c.minYear, time.January, 1, 0, 0, 0, 0, date.Location())
+	}
+	if c.maxYear > 0 && date.Year() > c.maxYear {
+		date = time.Date(c.maxYear, time.December, 31, 0, 0, 0, 0, date.Location())
+	}
 	if c.minDate != nil {
 		if min := c.minDate.Get(); min != nil && date.Before(*min) {
 			return *min
@@ -829,7 +1176,13 @@ func (c *Calendar) syncA11y() {
 		return
 	}
 	if !value.IsZero() {
-		c.Base.Value = &accessibility.ValueInfo{Text: value.Format("2006-01-02")}
+		text := value.Format("2006-01-02")
+		if n := len(c.events[calendarDayOf(value)]); n == 1 {
+			text += ", 1 event"
+		} else if n > 1 {
+			text += fmt.Sprintf(", %d events", n)
+		}
+		c.Base.Value = &accessibility.ValueInfo{Text: text}
 	} else {
 		c.Base.Value = nil
 	}
@@ -840,6 +1193,7 @@ type calendarLayout struct {
 	weekdayY     int
 	gridY        int
 	rows         int
+	rowHeight    int
 	cellWidth    int
 	weekNumWidth int
 	start        time.Time
@@ -847,10 +1201,15 @@ type calendarLayout struct {
 
 func (c *Calendar) layout(bounds runtime.Rect) calendarLayout {
 	layout := calendarLayout{
-		headerY:  bounds.Y,
-		weekdayY: bounds.Y + 1,
-		gridY:    bounds.Y + 2,
-		rows:     max(0, bounds.Height-2),
+		headerY:   bounds.Y,
+		weekdayY:  bounds.Y + 1,
+		gridY:     bounds.Y + 2,
+		rows:      max(0, bounds.Height-2),
+		rowHeight: 1,
+	}
+	if c.showDots() && layout.rows >= 12 {
+		layout.rowHeight = 2
+		layout.rows /= 2
 	}
 	if layout.rows > 6 {
 		layout.rows = 6
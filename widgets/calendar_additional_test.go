@@ -1,9 +1,11 @@
 package widgets
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/terminal"
 )
@@ -54,3 +56,85 @@ func TestCalendarMouseHeaderNavigation(t *testing.T) {
 		t.Fatalf("expected previous month, got %v", prev)
 	}
 }
+
+func TestCalendarMonthYearJumpsAndYearEntry(t *testing.T) {
+	cal := NewCalendar()
+	cal.SetSelectedDate(time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC))
+	cal.SetYearRange(2020, 2030)
+	cal.Focus()
+
+	cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Ctrl: true})
+	if got := cal.SelectedDate(); !sameDay(got, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Ctrl+Right = %v, want the end of February", got)
+	}
+	cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Ctrl: true})
+	if got := cal.SelectedDate(); !sameDay(got, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Ctrl+Down = %v, want a year later", got)
+	}
+
+	cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'y'})
+	for _, r := range "2028" {
+		cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if got := cal.SelectedDate(); !sameDay(got, time.Date(2028, time.February, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("typed year = %v, want 2028", got)
+	}
+
+	cal.Layout(runtime.Rect{X: 0, Y: 0, Width: 28, Height: 8})
+	cal.HandleMessage(runtime.MouseMsg{X: 12, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	for _, r := range "1999" {
+		cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if got := cal.SelectedDate(); !sameDay(got, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("year before the range = %v, want clamped to 2020", got)
+	}
+	for i := 0; i < 20; i++ {
+		cal.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Ctrl: true})
+	}
+	if got := cal.SelectedDate(); got.Year() != 2030 {
+		t.Fatalf("year after jumping past the range = %d, want 2030", got.Year())
+	}
+}
+
+func TestCalendarEventsDotsAndPopover(t *testing.T) {
+	day := time.Date(2026, time.March, 10, 9, 30, 0, 0, time.UTC)
+	cal := NewCalendar(WithNowFunc(func() time.Time { return day }))
+	cal.SetSelectedDate(day)
+	cal.SetEventsPerDay(2)
+	for _, label := range []string{"Standup", "Review", "Retro"} {
+		cal.AddEvent(CalEvent{Date: day, Color: backend.ColorGreen, Label: label})
+	}
+	cal.AddEvent(CalEvent{Date: time.Date(2026, time.March, 25, 0, 0, 0, 0, time.UTC), Color: backend.ColorRed, Label: "Launch"})
+
+	if got := cal.Events(time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)); len(got) != 3 || got[2].Label != "Retro" {
+		t.Fatalf("events on the 10th = %+v", got)
+	}
+	if size := cal.Measure(runtime.Constraints{MaxWidth: 28, MaxHeight: 20}); size.Height != 14 {
+		t.Fatalf("expected a dot row per week, height %d", size.Height)
+	}
+
+	// March 2026 starts on a Sunday, so the 10th is row 1, column 2.
+	buf, rows := renderRows(t, cal, 28, 14)
+	if rows[5] != "        ••" {
+		t.Fatalf("expected two dots under the 10th, got %q", rows[5])
+	}
+	if fg := buf.Get(8, 5).Style.FG(); fg != backend.ColorGreen {
+		t.Fatalf("dot color = %v, want green", fg)
+	}
+	if strings.Contains(strings.Join(rows, "\n"), "Standup") {
+		t.Fatal("expected no popover while unfocused")
+	}
+
+	cal.Focus()
+	_, rows = renderRows(t, cal, 28, 14)
+	if !strings.Contains(rows[6], "• Standup") || !strings.Contains(rows[8], "• Retro") {
+		t.Fatalf("expected the focused day's events below it:\n%s", strings.Join(rows, "\n"))
+	}
+
+	cal.HandleMessage(runtime.MouseMsg{X: 12, Y: 8, Action: runtime.MouseMove})
+	_, rows = renderRows(t, cal, 28, 14)
+	text := strings.Join(rows, "\n")
+	if !strings.Contains(text, "• Launch") || strings.Contains(text, "Standup") {
+		t.Fatalf("expected the hovered day's events:\n%s", text)
+	}
+}
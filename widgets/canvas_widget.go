@@ -5,7 +5,20 @@ import (
 	"github.com/odvcencio/fluffyui/runtime"
 )
 
-// CanvasWidget is a widget that draws using a Canvas.
+// CanvasResizeMode controls how a preserved canvas carries its pixels
+// over to a new size.
+type CanvasResizeMode int
+
+const (
+	// CanvasResizeClip keeps pixels in place and drops those that no
+	// longer fit.
+	CanvasResizeClip CanvasResizeMode = iota
+	// CanvasResizeScale stretches the old pixels to the new size.
+	CanvasResizeScale
+)
+
+// CanvasWidget is a widget that draws using a Canvas. By default the canvas
+// is cleared before every draw and recreated empty when the widget resizes.
 type CanvasWidget struct {
 	Component
 
@@ -14,6 +27,9 @@ type CanvasWidget struct {
 	draw       func(canvas *graphics.Canvas)
 	cellWidth  int
 	cellHeight int
+	preserve   bool
+	resizeMode CanvasResizeMode
+	onResize   func(oldW, oldH, newW, newH int)
 }
 
 // CanvasOption configures a CanvasWidget.
@@ -54,6 +70,34 @@ func (w *CanvasWidget) SetBlitter(blitter graphics.Blitter) {
 	w.canvas = nil
 }
 
+// SetPreserveOnResize keeps the canvas pixels between draws and copies
+// them into the new canvas on resize, per the resize mode, so draw can add
+// to what is already there. Changing the blitter still starts empty.
+func (w *CanvasWidget) SetPreserveOnResize(enabled bool) {
+	if w == nil {
+		return
+	}
+	w.preserve = enabled
+}
+
+// SetResizeMode sets how preserved pixels carry over on resize.
+func (w *CanvasWidget) SetResizeMode(mode CanvasResizeMode) {
+	if w == nil {
+		return
+	}
+	w.resizeMode = mode
+}
+
+// OnResize registers a callback run after the canvas changes size, with
+// the old and new sizes in pixels. The old size is zero for the first
+// canvas.
+func (w *CanvasWidget) OnResize(fn func(oldW, oldH, newW, newH int)) {
+	if w == nil {
+		return
+	}
+	w.onResize = fn
+}
+
 // Deprecated: prefer WithCanvasBlitter during construction or SetBlitter for mutation.
 func (w *CanvasWidget) WithBlitter(blitter graphics.Blitter) *CanvasWidget {
 	w.SetBlitter(blitter)
@@ -80,15 +124,28 @@ func (w *CanvasWidget) Layout(bounds runtime.Rect) {
 	w.Component.Layout(bounds)
 	content := w.ContentBounds()
 	if content.Width <= 0 || content.Height <= 0 {
+		if w.preserve {
+			return
+		}
 		w.canvas = nil
 		w.cellWidth = 0
 		w.cellHeight = 0
 		return
 	}
-	if w.canvas == nil || content.Width != w.cellWidth || content.Height != w.cellHeight {
-		w.canvas = graphics.NewCanvasWithBlitter(content.Width, content.Height, w.blitter)
-		w.cellWidth = content.Width
-		w.cellHeight = content.Height
+	if w.canvas != nil && content.Width == w.cellWidth && content.Height == w.cellHeight {
+		return
+	}
+	prev := w.canvas
+	w.canvas = graphics.NewCanvasWithBlitter(content.Width, content.Height, w.blitter)
+	w.cellWidth = content.Width
+	w.cellHeight = content.Height
+	if w.preserve {
+		w.canvas.CopyFrom(prev, w.resizeMode == CanvasResizeScale)
+	}
+	if w.onResize != nil {
+		oldW, oldH := prev.Size()
+		newW, newH := w.canvas.Size()
+		w.onResize(oldW, oldH, newW, newH)
 	}
 }
 
@@ -101,7 +158,9 @@ func (w *CanvasWidget) Render(ctx runtime.RenderContext) {
 	if content.Width <= 0 || content.Height <= 0 {
 		return
 	}
-	w.canvas.Clear()
+	if !w.preserve {
+		w.canvas.Clear()
+	}
 	w.draw(w.canvas)
 	w.canvas.Render(ctx.Buffer, content.X, content.Y)
 }
@@ -0,0 +1,69 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestCanvasWidgetPreserveOnResize(t *testing.T) {
+	draws := 0
+	widget := NewCanvasWidget(func(c *graphics.Canvas) {
+		if draws == 0 {
+			c.SetPixel(1, 1, backend.ColorRed)
+		}
+		draws++
+	}, WithCanvasBlitter(&graphics.HalfBlockBlitter{}))
+	var sizes [][4]int
+	widget.OnResize(func(oldW, oldH, newW, newH int) {
+		sizes = append(sizes, [4]int{oldW, oldH, newW, newH})
+	})
+	widget.SetPreserveOnResize(true)
+	render := func(width, height int) {
+		widget.Layout(runtime.Rect{Width: width, Height: height})
+		widget.Render(runtime.RenderContext{Buffer: runtime.NewBuffer(width, height)})
+	}
+
+	render(2, 1)
+	render(2, 1)
+	if !widget.canvas.GetPixel(1, 1).Set {
+		t.Fatal("expected a preserving canvas to keep pixels between draws")
+	}
+	render(4, 2)
+	if !widget.canvas.GetPixel(1, 1).Set || widget.canvas.GetPixel(3, 3).Set {
+		t.Fatal("expected the clip mode to keep the pixel in place")
+	}
+
+	widget.SetResizeMode(CanvasResizeScale)
+	render(8, 4)
+	if !widget.canvas.GetPixel(2, 2).Set || !widget.canvas.GetPixel(3, 3).Set {
+		t.Fatal("expected the scale mode to stretch the pixel")
+	}
+	want := [][4]int{{0, 0, 2, 2}, {2, 2, 4, 4}, {4, 4, 8, 8}}
+	if len(sizes) != len(want) {
+		t.Fatalf("resizes = %v, want %v", sizes, want)
+	}
+	for i := range want {
+		if sizes[i] != want[i] {
+			t.Fatalf("resizes = %v, want %v", sizes, want)
+		}
+	}
+}
+
+func TestCanvasWidgetClearsByDefault(t *testing.T) {
+	draws := 0
+	widget := NewCanvasWidget(func(c *graphics.Canvas) {
+		if draws == 0 {
+			c.SetPixel(0, 0, backend.ColorRed)
+		}
+		draws++
+	})
+	widget.Layout(runtime.Rect{Width: 2, Height: 1})
+	widget.Render(runtime.RenderContext{Buffer: runtime.NewBuffer(2, 1)})
+	widget.Render(runtime.RenderContext{Buffer: runtime.NewBuffer(2, 1)})
+	if widget.canvas.GetPixel(0, 0).Set {
+		t.Fatal("expected the canvas cleared before each draw")
+	}
+}
@@ -138,20 +138,32 @@ func (s *Sparkline) syncA11y() {
 	}
 }
 
-// BarData describes a bar entry.
+// BarData describes a bar entry. Entries that share a Label but name
+// different Series are drawn as one category, grouped or stacked by series.
 type BarData struct {
-	Label string
-	Value float64
+	Label  string
+	Value  float64
+	Series string
 }
 
-// BarChart renders horizontal bars.
+// barChartVerticalHeight is the bar height vertical charts ask for.
+const barChartVerticalHeight = 8
+
+// BarChart renders horizontal or vertical bars. With SetSeries, or Data
+// entries that name a Series, it draws several series per category, grouped
+// or stacked.
 type BarChart struct {
 	Base
-	Data       *state.Signal[[]BarData]
-	ShowValues bool
-	ShowLabels bool
-	Style      backend.Style
-	label      string
+	Data        *state.Signal[[]BarData]
+	ShowValues  bool
+	ShowLabels  bool
+	ShowLegend  bool
+	Style       backend.Style
+	label       string
+	series      []BarSeries
+	categories  []string
+	mode        BarChartMode
+	orientation Orientation
 }
 
 // NewBarChart creates a bar chart.
@@ -160,6 +172,7 @@ func NewBarChart(data *state.Signal[[]BarData]) *BarChart {
 		Data:       data,
 		ShowValues: true,
 		ShowLabels: true,
+		ShowLegend: true,
 		Style:      backend.DefaultStyle(),
 		label:      "Bar Chart",
 	}
@@ -177,7 +190,17 @@ func (b *BarChart) StyleType() string {
 func (b *BarChart) Measure(constraints runtime.Constraints) runtime.Size {
 	return b.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
 		height := 0
-		if b != nil && b.Data != nil {
+		g, grouped := b.groups()
+		if b != nil && b.orientation == Vertical {
+			height = barChartVerticalHeight
+			for _, shown := range []bool{b.ShowValues, b.ShowLabels, b.ShowLegend && grouped} {
+				if shown {
+					height++
+				}
+			}
+		} else if grouped {
+			height = b.seriesRows(g)
+		} else if b != nil && b.Data != nil {
 			height = len(b.Data.Get())
 		}
 		if height <= 0 {
@@ -189,10 +212,27 @@ func (b *BarChart) Measure(constraints runtime.Constraints) runtime.Size {
 
 // Render draws the bars.
 func (b *BarChart) Render(ctx runtime.RenderContext) {
-	if b == nil || b.Data == nil {
+	if b == nil {
+		return
+	}
+	g, grouped := b.groups()
+	if grouped {
+		b.syncA11y()
+		if b.orientation == Vertical {
+			b.renderVertical(ctx, g)
+			return
+		}
+		b.renderSeries(ctx, g)
+		return
+	}
+	if b.Data == nil {
 		return
 	}
 	b.syncA11y()
+	if b.orientation == Vertical {
+		b.renderVertical(ctx, singleGroups(b.Data.Get()))
+		return
+	}
 	bounds := b.ContentBounds()
 	if bounds.Width <= 0 || bounds.Height <= 0 {
 		return
@@ -264,6 +304,10 @@ func (b *BarChart) syncA11y() {
 		label = "Bar Chart"
 	}
 	b.Base.Label = label
+	if g, ok := b.groups(); ok {
+		b.syncSeriesA11y(g)
+		return
+	}
 	entries := []BarData(nil)
 	if b.Data != nil {
 		entries = b.Data.Get()
@@ -0,0 +1,515 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+// BarChartMode selects how a BarChart draws multiple series.
+type BarChartMode int
+
+const (
+	// BarChartGrouped draws one bar per series for each category.
+	BarChartGrouped BarChartMode = iota
+	// BarChartStacked draws one bar per category split into series segments.
+	BarChartStacked
+	// BarChartSingle draws one bar per Data entry, ignoring BarData.Series.
+	BarChartSingle
+)
+
+// BarVariant is the name SetVariant uses for BarChartMode.
+type BarVariant = BarChartMode
+
+// Bar variants for SetVariant.
+const (
+	GroupedBars = BarChartGrouped
+	StackedBars = BarChartStacked
+	SingleBars  = BarChartSingle
+)
+
+// BarSeries is one series of a multi-series bar chart. Values holds one
+// value per category. A zero Style picks a color from the default palette.
+type BarSeries struct {
+	Label  string
+	Values []float64
+	Style  backend.Style
+}
+
+var barSeriesPalette = []backend.Color{
+	backend.ColorBlue,
+	backend.ColorGreen,
+	backend.ColorYellow,
+	backend.ColorMagenta,
+	backend.ColorCyan,
+	backend.ColorRed,
+}
+
+// SetSeries switches the chart to multi-series mode. Data is ignored while
+// series are set; pass nil to return to Data.
+func (b *BarChart) SetSeries(series []BarSeries) {
+	if b == nil {
+		return
+	}
+	b.series = append([]BarSeries(nil), series...)
+	b.Invalidate()
+}
+
+// Series returns the multi-series data.
+func (b *BarChart) Series() []BarSeries {
+	if b == nil {
+		return nil
+	}
+	return append([]BarSeries(nil), b.series...)
+}
+
+// SetCategories sets the category labels for multi-series mode.
+func (b *BarChart) SetCategories(labels []string) {
+	if b == nil {
+		return
+	}
+	b.categories = append([]string(nil), labels...)
+	b.Invalidate()
+}
+
+// SetMode selects grouped or stacked drawing for multi-series mode.
+func (b *BarChart) SetMode(mode BarChartMode) {
+	if b == nil {
+		return
+	}
+	b.mode = mode
+	b.Invalidate()
+}
+
+// Mode returns the multi-series drawing mode.
+func (b *BarChart) Mode() BarChartMode {
+	if b == nil {
+		return BarChartGrouped
+	}
+	return b.mode
+}
+
+// SetVariant selects single, grouped, or stacked bars. Grouped and stacked
+// bars apply to SetSeries data and to Data entries with a Series; SingleBars
+// draws every Data entry as its own bar.
+func (b *BarChart) SetVariant(variant BarVariant) {
+	b.SetMode(variant)
+}
+
+// Variant returns the bar variant.
+func (b *BarChart) Variant() BarVariant {
+	return b.Mode()
+}
+
+// SetOrientation selects horizontal bars (the default), with categories down
+// the side, or vertical bars, with categories along the bottom.
+func (b *BarChart) SetOrientation(orientation Orientation) {
+	if b == nil {
+		return
+	}
+	b.orientation = orientation
+	b.Invalidate()
+}
+
+// Orientation returns the bar direction.
+func (b *BarChart) Orientation() Orientation {
+	if b == nil {
+		return Horizontal
+	}
+	return b.orientation
+}
+
+// barGroups is the series data a chart draws: SetSeries data, or Data
+// entries grouped by their Series field.
+type barGroups struct {
+	series     []BarSeries
+	categories []string
+	// plain draws bars in the chart style instead of series colors.
+	plain bool
+}
+
+// groups returns the series to draw, or false to draw Data as single bars.
+func (b *BarChart) groups() (barGroups, bool) {
+	if b == nil {
+		return barGroups{}, false
+	}
+	if len(b.series) > 0 {
+		return barGroups{series: b.series, categories: b.categories}, true
+	}
+	if b.mode == BarChartSingle || b.Data == nil {
+		return barGroups{}, false
+	}
+	entries := b.Data.Get()
+	grouped := false
+	for _, entry := range entries {
+		if entry.Series != "" {
+			grouped = true
+			break
+		}
+	}
+	if !grouped {
+		return barGroups{}, false
+	}
+	return groupBarData(entries), true
+}
+
+// groupBarData turns entries into one series per Series name and one
+// category per Label, both in first-seen order.
+func groupBarData(entries []BarData) barGroups {
+	var g barGroups
+	categoryIndex := map[string]int{}
+	seriesIndex := map[string]int{}
+	for _, entry := range entries {
+		c, ok := categoryIndex[entry.Label]
+		if !ok {
+			c = len(g.categories)
+			categoryIndex[entry.Label] = c
+			g.categories = append(g.categories, entry.Label)
+		}
+		i, ok := seriesIndex[entry.Series]
+		if !ok {
+			i = len(g.series)
+			seriesIndex[entry.Series] = i
+			g.series = append(g.series, BarSeries{Label: entry.Series})
+		}
+		values := g.series[i].Values
+		for len(values) <= c {
+			values = append(values, 0)
+		}
+		values[c] += entry.Value
+		g.series[i].Values = values
+	}
+	return g
+}
+
+// singleGroups wraps Data entries as one uncolored series, for drawing
+// single bars vertically.
+func singleGroups(entries []BarData) barGroups {
+	g := barGroups{plain: true, series: []BarSeries{{Values: make([]float64, len(entries))}}}
+	for i, entry := range entries {
+		g.categories = append(g.categories, entry.Label)
+		g.series[0].Values[i] = entry.Value
+	}
+	return g
+}
+
+func (g barGroups) categoryCount() int {
+	count := len(g.categories)
+	for _, series := range g.series {
+		count = max(count, len(series.Values))
+	}
+	return count
+}
+
+func (b *BarChart) seriesRows(g barGroups) int {
+	rows := g.categoryCount()
+	if b.mode != BarChartStacked {
+		rows *= len(g.series)
+	}
+	if b.ShowLegend {
+		rows++
+	}
+	return rows
+}
+
+func (g barGroups) seriesValue(series, category int) float64 {
+	values := g.series[series].Values
+	if category < len(values) {
+		return values[category]
+	}
+	return 0
+}
+
+func (g barGroups) categoryTotal(category int) float64 {
+	total := 0.0
+	for i := range g.series {
+		if v := g.seriesValue(i, category); v > 0 {
+			total += v
+		}
+	}
+	return total
+}
+
+func (g barGroups) categoryLabel(category int) string {
+	if category < len(g.categories) {
+		return g.categories[category]
+	}
+	return ""
+}
+
+func (g barGroups) seriesStyle(base backend.Style, index int) backend.Style {
+	if g.plain {
+		return base
+	}
+	style := g.series[index].Style
+	if style == (backend.Style{}) {
+		style = backend.DefaultStyle().Foreground(barSeriesPalette[index%len(barSeriesPalette)])
+	}
+	return mergeBackendStyles(base, style)
+}
+
+func (b *BarChart) renderSeries(ctx runtime.RenderContext, g barGroups) {
+	bounds := b.ContentBounds()
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	categories := g.categoryCount()
+	if categories == 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, b, backend.DefaultStyle(), false), b.Style)
+	stacked := b.mode == BarChartStacked
+
+	maxVal := 0.0
+	labelWidth, valueWidth := 0, 0
+	for c := 0; c < categories; c++ {
+		if b.ShowLabels {
+			labelWidth = max(labelWidth, textWidth(g.categoryLabel(c))+1)
+		}
+		if stacked {
+			total := g.categoryTotal(c)
+			maxVal = max(maxVal, total)
+			if b.ShowValues {
+				valueWidth = max(valueWidth, textWidth(formatFloat(total))+1)
+			}
+			continue
+		}
+		for i := range g.series {
+			v := g.seriesValue(i, c)
+			maxVal = max(maxVal, v)
+			if b.ShowValues {
+				valueWidth = max(valueWidth, textWidth(formatFloat(v))+1)
+			}
+		}
+	}
+	if maxVal <= 0 {
+		maxVal = 1
+	}
+	barWidth := max(bounds.Width-labelWidth-valueWidth, 1)
+
+	y := bounds.Y
+	bottom := bounds.Y + bounds.Height
+	if b.ShowLegend {
+		bottom--
+	}
+	for c := 0; c < categories && y < bottom; c++ {
+		if stacked {
+			b.renderRow(ctx, g, bounds, y, style, g.categoryLabel(c), labelWidth, valueWidth, barWidth, maxVal, c, -1)
+			y++
+			continue
+		}
+		for i := range g.series {
+			if y >= bottom {
+				break
+			}
+			label := ""
+			if i == 0 {
+				label = g.categoryLabel(c)
+			}
+			b.renderRow(ctx, g, bounds, y, style, label, labelWidth, valueWidth, barWidth, maxVal, c, i)
+			y++
+		}
+	}
+	if b.ShowLegend && bounds.Height > 0 {
+		b.renderLegend(ctx, g, bounds, bounds.Y+bounds.Height-1, style)
+	}
+}
+
+// renderRow draws one bar. series is -1 for a stacked bar of all series.
+func (b *BarChart) renderRow(ctx runtime.RenderContext, g barGroups, bounds runtime.Rect, y int, style backend.Style, label string, labelWidth, valueWidth, barWidth int, maxVal float64, category, series int) {
+	writePadded(ctx.Buffer, bounds.X, y, bounds.Width, "", style)
+	x := bounds.X
+	if labelWidth > 0 {
+		ctx.Buffer.SetString(x, y, truncateString(label, labelWidth-1), style)
+		x += labelWidth
+	}
+	var value float64
+	filled := 0
+	if series >= 0 {
+		value = g.seriesValue(series, category)
+		filled = barCells(value, maxVal, barWidth)
+		ctx.Buffer.SetString(x, y, strings.Repeat("█", filled), g.seriesStyle(style, series))
+	} else {
+		value = g.categoryTotal(category)
+		running := 0.0
+		for i := range g.series {
+			v := g.seriesValue(i, category)
+			if v <= 0 {
+				continue
+			}
+			running += v
+			end := barCells(running, maxVal, barWidth)
+			if end > filled {
+				ctx.Buffer.SetString(x+filled, y, strings.Repeat("█", end-filled), g.seriesStyle(style, i))
+				filled = end
+			}
+		}
+	}
+	if filled < barWidth {
+		ctx.Buffer.SetString(x+filled, y, strings.Repeat("░", barWidth-filled), style)
+	}
+	if valueWidth > 0 {
+		ctx.Buffer.SetString(x+barWidth+1, y, truncateString(formatFloat(value), valueWidth-1), style)
+	}
+}
+
+// renderVertical draws bars upward from a baseline: values above the bars,
+// category labels below, and the legend on the last row. Bars of a category
+// sit side by side with a gap between categories.
+func (b *BarChart) renderVertical(ctx runtime.RenderContext, g barGroups) {
+	bounds := b.ContentBounds()
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	categories := g.categoryCount()
+	if categories == 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, b, backend.DefaultStyle(), false), b.Style)
+	ctx.Buffer.Fill(bounds, ' ', style)
+	stacked := b.mode == BarChartStacked
+	legend := b.ShowLegend && !g.plain
+
+	perCategory := len(g.series)
+	if stacked {
+		perCategory = 1
+	}
+	maxVal := 0.0
+	for c := 0; c < categories; c++ {
+		if stacked {
+			maxVal = max(maxVal, g.categoryTotal(c))
+			continue
+		}
+		for i := range g.series {
+			maxVal = max(maxVal, g.seriesValue(i, c))
+		}
+	}
+	if maxVal <= 0 {
+		maxVal = 1
+	}
+
+	top := bounds.Y
+	if b.ShowValues {
+		top++
+	}
+	baseline := bounds.Y + bounds.Height - 1 // last bar row
+	if legend {
+		baseline--
+	}
+	if b.ShowLabels {
+		baseline--
+	}
+	barHeight := baseline - top + 1
+	if barHeight < 1 {
+		return
+	}
+	gaps := categories - 1
+	barWidth := max((bounds.Width-gaps)/(categories*perCategory), 1)
+	groupWidth := barWidth * perCategory
+
+	for c := 0; c < categories; c++ {
+		x := bounds.X + c*(groupWidth+1)
+		if x >= bounds.X+bounds.Width {
+			break
+		}
+		groupRoom := min(groupWidth, bounds.X+bounds.Width-x)
+		if stacked {
+			filled := 0
+			running := 0.0
+			for i := range g.series {
+				v := g.seriesValue(i, c)
+				if v <= 0 {
+					continue
+				}
+				running += v
+				end := barCells(running, maxVal, barHeight)
+				fillColumn(ctx, bounds, x, barWidth, baseline-filled, end-filled, g.seriesStyle(style, i))
+				filled = max(filled, end)
+			}
+			fillTrack(ctx, bounds, x, barWidth, baseline-filled, barHeight-filled, style)
+			if b.ShowValues {
+				ctx.Buffer.SetString(x, bounds.Y, truncateString(formatFloat(g.categoryTotal(c)), groupRoom), style)
+			}
+		} else {
+			for i := range g.series {
+				bx := x + i*barWidth
+				if bx >= bounds.X+bounds.Width {
+					break
+				}
+				v := g.seriesValue(i, c)
+				filled := barCells(v, maxVal, barHeight)
+				fillColumn(ctx, bounds, bx, barWidth, baseline, filled, g.seriesStyle(style, i))
+				fillTrack(ctx, bounds, bx, barWidth, baseline-filled, barHeight-filled, style)
+				if b.ShowValues {
+					room := min(barWidth, bounds.X+bounds.Width-bx)
+					ctx.Buffer.SetString(bx, bounds.Y, truncateString(formatFloat(v), room), style)
+				}
+			}
+		}
+		if b.ShowLabels {
+			ctx.Buffer.SetString(x, baseline+1, truncateString(g.categoryLabel(c), groupRoom), style)
+		}
+	}
+	if legend {
+		b.renderLegend(ctx, g, bounds, bounds.Y+bounds.Height-1, style)
+	}
+}
+
+// fillColumn draws a filled bar segment barWidth wide and height rows tall
+// from row y upward.
+func fillColumn(ctx runtime.RenderContext, bounds runtime.Rect, x, barWidth, y, height int, style backend.Style) {
+	drawColumn(ctx, bounds, x, barWidth, y, height, '█', style)
+}
+
+// fillTrack draws the empty track above a bar like fillColumn.
+func fillTrack(ctx runtime.RenderContext, bounds runtime.Rect, x, barWidth, y, height int, style backend.Style) {
+	drawColumn(ctx, bounds, x, barWidth, y, height, '░', style)
+}
+
+// drawColumn fills a column with r, clipped to the right edge of bounds.
+func drawColumn(ctx runtime.RenderContext, bounds runtime.Rect, x, barWidth, y, height int, r rune, style backend.Style) {
+	barWidth = min(barWidth, bounds.X+bounds.Width-x)
+	for row := 0; row < height; row++ {
+		for col := 0; col < barWidth; col++ {
+			ctx.Buffer.Set(x+col, y-row, r, style)
+		}
+	}
+}
+
+func barCells(value, maxVal float64, width int) int {
+	cells := int(value / maxVal * float64(width))
+	return min(max(cells, 0), width)
+}
+
+func (b *BarChart) renderLegend(ctx runtime.RenderContext, g barGroups, bounds runtime.Rect, y int, style backend.Style) {
+	writePadded(ctx.Buffer, bounds.X, y, bounds.Width, "", style)
+	x := bounds.X
+	right := bounds.X + bounds.Width
+	for i, series := range g.series {
+		entry := "■ " + series.Label
+		width := textWidth(entry)
+		if x+width > right {
+			break
+		}
+		ctx.Buffer.SetString(x, y, "■", g.seriesStyle(style, i))
+		ctx.Buffer.SetString(x+2, y, series.Label, style)
+		x += width + 2
+	}
+}
+
+func (b *BarChart) syncSeriesA11y(g barGroups) {
+	categories := g.categoryCount()
+	mode := "grouped"
+	if b.mode == BarChartStacked {
+		mode = "stacked"
+	}
+	b.Base.Description = fmt.Sprintf("%d categories, %d series, %s", categories, len(g.series), mode)
+	names := make([]string, 0, len(g.series))
+	for _, series := range g.series {
+		names = append(names, series.Label)
+	}
+	b.Base.Value = &accessibility.ValueInfo{Text: strings.Join(names, ", ")}
+}
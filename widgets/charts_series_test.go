@@ -0,0 +1,145 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/state"
+)
+
+func latencySeries() []BarSeries {
+	return []BarSeries{
+		{Label: "p50", Values: []float64{10, 20}, Style: backend.DefaultStyle().Foreground(backend.ColorGreen)},
+		{Label: "p99", Values: []float64{30, 20}, Style: backend.DefaultStyle().Foreground(backend.ColorRed)},
+	}
+}
+
+func TestBarChartGrouped(t *testing.T) {
+	chart := NewBarChart(nil)
+	chart.SetCategories([]string{"Auth", "API"})
+	chart.SetSeries(latencySeries())
+
+	if size := chart.Measure(runtime.Constraints{MaxWidth: 30, MaxHeight: 20}); size.Height != 5 {
+		t.Fatalf("height = %d, want 4 bars plus legend", size.Height)
+	}
+	_, rows := renderRows(t, chart, 22, 5)
+	want := []string{
+		"Auth ███░░░░░░░░ 10.00",
+		"     ███████████ 30.00",
+		"API  ███████░░░░ 20.00",
+		"     ███████░░░░ 20.00",
+		"■ p50  ■ p99",
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestBarChartStacked(t *testing.T) {
+	chart := NewBarChart(nil)
+	chart.SetCategories([]string{"Auth", "API"})
+	chart.SetSeries(latencySeries())
+	chart.SetMode(BarChartStacked)
+	chart.ShowValues = false
+
+	buf, rows := renderRows(t, chart, 14, 3)
+	if rows[0] != "Auth █████████" || rows[1] != "API  █████████" {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+	// Auth is 10 + 30 of a 40 scale: the first quarter is p50.
+	if fg := buf.Get(5, 0).Style.ForegroundColor(); fg != backend.ColorGreen {
+		t.Fatalf("first segment color = %v, want green", fg)
+	}
+	if fg := buf.Get(13, 0).Style.ForegroundColor(); fg != backend.ColorRed {
+		t.Fatalf("last segment color = %v, want red", fg)
+	}
+	if chart.AccessibleDescription() != "2 categories, 2 series, stacked" {
+		t.Fatalf("description = %q", chart.AccessibleDescription())
+	}
+}
+
+func TestBarChartGroupsDataBySeries(t *testing.T) {
+	data := state.NewSignal([]BarData{
+		{Label: "Auth", Series: "p50", Value: 10},
+		{Label: "Auth", Series: "p99", Value: 30},
+		{Label: "API", Series: "p50", Value: 20},
+		{Label: "API", Series: "p99", Value: 20},
+	})
+	chart := NewBarChart(data)
+	_, rows := renderRows(t, chart, 22, 5)
+	if rows[0] != "Auth ███░░░░░░░░ 10.00" || rows[1] != "     ███████████ 30.00" || rows[4] != "■ p50  ■ p99" {
+		t.Fatalf("unexpected rows %q", rows)
+	}
+
+	chart.SetVariant(SingleBars)
+	if size := chart.Measure(runtime.Constraints{MaxWidth: 30, MaxHeight: 20}); size.Height != 4 {
+		t.Fatalf("single bars height = %d, want one row per entry", size.Height)
+	}
+}
+
+func TestBarChartVertical(t *testing.T) {
+	chart := NewBarChart(nil)
+	chart.SetCategories([]string{"Auth", "API"})
+	chart.SetSeries(latencySeries())
+	chart.SetOrientation(Vertical)
+	chart.ShowValues = false
+
+	if size := chart.Measure(runtime.Constraints{MaxWidth: 30, MaxHeight: 20}); size.Height != 10 {
+		t.Fatalf("height = %d, want bars plus labels and legend", size.Height)
+	}
+	buf, rows := renderRows(t, chart, 9, 5)
+	// Three bar rows scale to 30: Auth is 10 and 30, API 20 and 20.
+	want := []string{
+		"░░██ ░░░░",
+		"░░██ ████",
+		"████ ████",
+		"Auth API",
+		"■ p50",
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+	if fg := buf.Get(0, 2).Style.ForegroundColor(); fg != backend.ColorGreen {
+		t.Fatalf("p50 bar = %v, want green", fg)
+	}
+	if fg := buf.Get(2, 0).Style.ForegroundColor(); fg != backend.ColorRed {
+		t.Fatalf("top of p99 bar = %v, want red", fg)
+	}
+
+	chart.SetVariant(StackedBars)
+	buf, rows = renderRows(t, chart, 9, 5)
+	if rows[0] != "████ ████" || rows[3] != "Auth API" {
+		t.Fatalf("stacked rows %q", rows)
+	}
+	// API stacks 20 + 20 of 40: the bottom row is p50, the top p99.
+	if fg := buf.Get(5, 2).Style.ForegroundColor(); fg != backend.ColorGreen {
+		t.Fatalf("bottom segment = %v, want green", fg)
+	}
+	if fg := buf.Get(5, 0).Style.ForegroundColor(); fg != backend.ColorRed {
+		t.Fatalf("top segment = %v, want red", fg)
+	}
+}
+
+func TestBarChartVerticalClipsToBounds(t *testing.T) {
+	chart := NewBarChart(nil)
+	chart.SetCategories([]string{"Auth", "API"})
+	chart.SetSeries(latencySeries())
+	chart.SetOrientation(Vertical)
+
+	// Two groups of two one-cell bars need five columns; give it four.
+	buf := runtime.NewBuffer(8, 6)
+	chart.Layout(runtime.Rect{Width: 4, Height: 6})
+	chart.Render(runtime.RenderContext{Buffer: buf, Bounds: runtime.Rect{Width: 4, Height: 6}})
+	for y := 0; y < 6; y++ {
+		for x := 4; x < 8; x++ {
+			if r := buf.Get(x, y).Rune; r != ' ' && r != 0 {
+				t.Fatalf("cell (%d,%d) = %q, want nothing drawn past the chart", x, y, r)
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@ type Checkbox struct {
 	label    *state.Signal[string]
 	checked  *state.Signal[*bool]
 	onChange func(value *bool)
+	services runtime.Services
 
 	style      backend.Style
 	focusStyle backend.Style
@@ -44,13 +45,42 @@ func (c *Checkbox) SetChecked(value *bool) {
 	if c == nil || c.checked == nil {
 		return
 	}
+	changed := !sameTriState(c.checked.Get(), value)
 	c.checked.Set(value)
 	c.syncState()
+	if changed {
+		c.services.AnnounceChange(c)
+	}
 	if c.onChange != nil {
 		c.onChange(value)
 	}
 }
 
+// Bind attaches app services.
+func (c *Checkbox) Bind(services runtime.Services) {
+	if c == nil {
+		return
+	}
+	c.services = services
+}
+
+// Unbind releases app services.
+func (c *Checkbox) Unbind() {
+	if c == nil {
+		return
+	}
+	c.services = runtime.Services{}
+}
+
+// sameTriState reports whether two checkbox values are equal, treating
+// nil as indeterminate.
+func sameTriState(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 // Checked returns the current value.
 func (c *Checkbox) Checked() *bool {
 	if c == nil || c.checked == nil {
@@ -201,3 +231,4 @@ func (c *Checkbox) syncState() {
 
 var _ runtime.Widget = (*Checkbox)(nil)
 var _ runtime.Focusable = (*Checkbox)(nil)
+var _ runtime.Bindable = (*Checkbox)(nil)
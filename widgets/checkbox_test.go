@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestCheckboxAnnouncesToggle(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	cb := NewCheckbox("Wrap lines")
+	cb.Bind(runtime.NewApp(runtime.AppConfig{Announcer: announcer}).Services())
+	cb.Focus()
+
+	cb.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '})
+	checked := true
+	cb.SetChecked(&checked)
+	history := announcer.History()
+	if len(history) != 1 || history[0].Message != "Wrap lines, checkbox, checked" {
+		t.Fatalf("announcements = %v, want one for the toggle", history)
+	}
+	if history[0].Priority != accessibility.PriorityPolite {
+		t.Fatalf("priority = %v, want polite", history[0].Priority)
+	}
+}
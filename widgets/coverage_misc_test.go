@@ -120,7 +120,7 @@ func TestPerformanceDashboardSummary(t *testing.T) {
 func TestLineChartSpinnerAndGauge(t *testing.T) {
 	chart := NewLineChart()
 	chart.AddSeries(ChartSeries{Data: []float64{1, 3, 2, 4}, Color: backend.ColorRGB(100, 200, 255), Smooth: true, Fill: true})
-	chart.SetYAxis(0, 5)
+	chart.SetYAxis(AxisConfig{Min: 0, Max: 5})
 	chart.AutoYAxis()
 	_ = chart.StyleType()
 	_ = flufftest.RenderToString(chart, 20, 6)
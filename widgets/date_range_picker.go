@@ -153,6 +153,15 @@ func (d *DateRangePicker) SetRange(start, end *time.Time) {
 	d.syncInputs()
 }
 
+// SetYearRange limits selectable dates to the years min through max.
+// Zero leaves that end open.
+func (d *DateRangePicker) SetYearRange(min, max int) {
+	if d == nil || d.calendar == nil {
+		return
+	}
+	d.calendar.SetYearRange(min, max)
+}
+
 // SelectedRange returns the selected range and a flag if both ends are set.
 func (d *DateRangePicker) SelectedRange() (time.Time, time.Time, bool) {
 	if d == nil || d.calendar == nil {
@@ -268,11 +277,24 @@ func (d *DateRangePicker) Render(ctx runtime.RenderContext) {
 	}
 }
 
-// HandleMessage forwards messages to child widgets.
+// HandleMessage forwards messages to child widgets. Ctrl+arrow in the
+// start or end input jumps that end of the range by month or year.
 func (d *DateRangePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if d == nil {
 		return runtime.Unhandled()
 	}
+	if key, ok := msg.(runtime.KeyMsg); ok && d.calendar != nil {
+		if months, ok := calendarJump(key); ok {
+			switch {
+			case d.startInput != nil && d.startInput.IsFocused():
+				d.jumpRange(true, months)
+				return runtime.Handled()
+			case d.endInput != nil && d.endInput.IsFocused():
+				d.jumpRange(false, months)
+				return runtime.Handled()
+			}
+		}
+	}
 	if d.startInput != nil {
 		if result := d.startInput.HandleMessage(msg); result.Handled {
 			return result
@@ -307,6 +329,35 @@ func (d *DateRangePicker) ChildWidgets() []runtime.Widget {
 	return children
 }
 
+// jumpRange moves one end of the range by months, starting from the
+// calendar cursor when that end is unset. The other end moves along if
+// the range would otherwise turn inside out.
+func (d *DateRangePicker) jumpRange(isStart bool, months int) {
+	start := d.calendar.RangeStart()
+	end := d.calendar.RangeEnd()
+	from := d.calendar.cursorDate()
+	if isStart && start != nil {
+		from = *start
+	} else if !isStart && end != nil {
+		from = *end
+	}
+	moved := d.calendar.clampDate(addMonthsClamped(from, months))
+	if isStart {
+		start = &moved
+		if end != nil && end.Before(moved) {
+			end = &moved
+		}
+	} else {
+		end = &moved
+		if start != nil && start.After(moved) {
+			start = &moved
+		}
+	}
+	d.calendar.SetRange(start, end)
+	d.calendar.SetDisplayedMonth(moved)
+	d.syncInputs()
+}
+
 func (d *DateRangePicker) handleInputChange(isStart bool, text string) {
 	if d == nil || d.updating {
 		return
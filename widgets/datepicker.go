@@ -142,6 +142,15 @@ func (d *DatePicker) SetMaxDate(date *time.Time) {
 	d.calendar.SetMaxDate(date)
 }
 
+// SetYearRange limits selectable dates to the years min through max.
+// Zero leaves that end open.
+func (d *DatePicker) SetYearRange(min, max int) {
+	if d == nil || d.calendar == nil {
+		return
+	}
+	d.calendar.SetYearRange(min, max)
+}
+
 // SetHighlightDates updates highlighted dates.
 func (d *DatePicker) SetHighlightDates(dates []time.Time) {
 	if d == nil || d.calendar == nil {
@@ -227,11 +236,19 @@ func (d *DatePicker) Render(ctx runtime.RenderContext) {
 	runtime.RenderChild(ctx, d.calendar)
 }
 
-// HandleMessage forwards messages to children.
+// HandleMessage forwards messages to children. Ctrl+arrow jumps by month
+// or year from the input as well as the calendar.
 func (d *DatePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if d == nil {
 		return runtime.Unhandled()
 	}
+	if key, ok := msg.(runtime.KeyMsg); ok && d.input != nil && d.input.IsFocused() && d.calendar != nil {
+		if months, ok := calendarJump(key); ok {
+			d.calendar.SetSelectedDate(addMonthsClamped(d.calendar.cursorDate(), months))
+			d.syncInput()
+			return runtime.Handled()
+		}
+	}
 	if d.input != nil {
 		if result := d.input.HandleMessage(msg); result.Handled {
 			return result
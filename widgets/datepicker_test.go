@@ -3,6 +3,9 @@ package widgets
 import (
 	"testing"
 	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
 )
 
 func TestDatePickerSyncFromCalendar(t *testing.T) {
@@ -23,3 +26,28 @@ func TestDatePickerParseInput(t *testing.T) {
 		t.Fatalf("selected date = %v, want 2026-03-01", selected)
 	}
 }
+
+func TestDateRangePickerJumpsFocusedSide(t *testing.T) {
+	picker := NewDateRangePicker()
+	start := time.Date(2026, time.March, 3, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC)
+	picker.SetRange(&start, &end)
+
+	picker.EndInput().Focus()
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Ctrl: true})
+	gotStart, gotEnd, ok := picker.SelectedRange()
+	if !ok || !sameDay(gotStart, start) || !sameDay(gotEnd, time.Date(2026, time.April, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("range = %v - %v, want the end a month later", gotStart, gotEnd)
+	}
+	if got := picker.EndInput().Text(); got != "2026-04-08" {
+		t.Fatalf("end input = %q", got)
+	}
+
+	picker.EndInput().Blur()
+	picker.StartInput().Focus()
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Ctrl: true})
+	gotStart, gotEnd, _ = picker.SelectedRange()
+	if !sameDay(gotStart, time.Date(2027, time.March, 3, 0, 0, 0, 0, time.UTC)) || !sameDay(gotEnd, gotStart) {
+		t.Fatalf("range = %v - %v, want start a year later pulling the end along", gotStart, gotEnd)
+	}
+}
@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"strings"
+	"sync/atomic"
 	"time"
 	"unicode"
 
@@ -18,6 +19,13 @@ type DialogButton struct {
 	OnClick func()
 }
 
+// DialogResult reports how a dialog was answered. ButtonIndex is -1 and
+// ButtonLabel is empty when the dialog was dismissed with Escape.
+type DialogResult struct {
+	ButtonIndex int
+	ButtonLabel string
+}
+
 // Dialog is a modal message container with optional custom content.
 // Dialog supports keyboard shortcuts, auto-dismiss timers, and dismiss callbacks.
 type Dialog struct {
@@ -36,6 +44,10 @@ type Dialog struct {
 	startTime   time.Time
 	paused      bool
 
+	buttonRects []runtime.Rect
+	results     chan DialogResult
+	awaited     atomic.Bool // Result has been called
+
 	style    backend.Style
 	styleSet bool
 }
@@ -113,6 +125,7 @@ func NewDialog(title, body string, buttons ...DialogButton) *Dialog {
 		Buttons:     buttons,
 		dismissable: true,
 		style:       backend.DefaultStyle(),
+		results:     make(chan DialogResult, 1),
 	}
 	dialog.Base.Role = accessibility.RoleDialog
 	dialog.Base.Label = title
@@ -190,6 +203,27 @@ func (d *Dialog) OnDismiss(fn func()) *Dialog {
 	return d
 }
 
+// Result returns a channel that receives the dialog's answer when a button
+// is activated or the dialog is dismissed with Escape. The channel has a
+// buffer of one and is sent to from the UI goroutine, so it never blocks the
+// app loop; an answer given before anyone reads stays buffered. Result may
+// be called from any goroutine. Once it has been called, answering the
+// dialog also pops the overlay it was pushed in, so call it before pushing
+// the dialog:
+//
+//	results := dialog.Result()
+//	go func() {
+//		result := <-results
+//		...
+//	}()
+func (d *Dialog) Result() <-chan DialogResult {
+	if d == nil {
+		return nil
+	}
+	d.awaited.Store(true)
+	return d.results
+}
+
 // SetAutoDismiss enables auto-dismiss after duration (0 = disabled).
 func (d *Dialog) SetAutoDismiss(duration time.Duration) {
 	if d == nil {
@@ -391,6 +425,7 @@ func (d *Dialog) Render(ctx runtime.RenderContext) {
 	}
 
 	// Buttons
+	d.buttonRects = d.buttonRects[:0]
 	if len(d.Buttons) == 0 {
 		return
 	}
@@ -412,6 +447,7 @@ func (d *Dialog) Render(ctx runtime.RenderContext) {
 			style = style.Reverse(true)
 		}
 		ctx.Buffer.SetString(x, buttonY, label, style)
+		d.buttonRects = append(d.buttonRects, runtime.Rect{X: x, Y: buttonY, Width: labelWidth, Height: 1})
 		x += labelWidth + 2
 	}
 }
@@ -431,6 +467,16 @@ func (d *Dialog) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		}
 	}
 
+	// Mouse: releasing over a button clicks it
+	if mouse, ok := msg.(runtime.MouseMsg); ok && mouse.Button == runtime.MouseLeft && mouse.Action == runtime.MouseRelease {
+		for i, rect := range d.buttonRects {
+			if rect.Contains(mouse.X, mouse.Y) && i < len(d.Buttons) {
+				d.selected = i
+				return d.activate(i)
+			}
+		}
+	}
+
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		// Pass non-key events to content
@@ -442,21 +488,21 @@ func (d *Dialog) HandleMessage(msg runtime.Message) runtime.HandleResult {
 
 	// Escape handling
 	if key.Key == terminal.KeyEscape {
-		if d.dismissable && d.onDismiss != nil {
+		if !d.dismissable {
+			return runtime.Handled()
+		}
+		if d.onDismiss != nil {
 			d.onDismiss()
 		}
-		return runtime.Handled()
+		return d.answer(DialogResult{ButtonIndex: -1})
 	}
 
 	// Check keyboard shortcuts (case-insensitive)
-	for _, btn := range d.Buttons {
+	for i, btn := range d.Buttons {
 		if btn.Key != 0 && (key.Rune == btn.Key ||
 			key.Rune == unicode.ToLower(btn.Key) ||
 			key.Rune == unicode.ToUpper(btn.Key)) {
-			if btn.OnClick != nil {
-				btn.OnClick()
-			}
-			return runtime.Handled()
+			return d.activate(i)
 		}
 	}
 
@@ -471,9 +517,7 @@ func (d *Dialog) HandleMessage(msg runtime.Message) runtime.HandleResult {
 			return runtime.Handled()
 		case terminal.KeyEnter:
 			if d.selected >= 0 && d.selected < len(d.Buttons) {
-				if d.Buttons[d.selected].OnClick != nil {
-					d.Buttons[d.selected].OnClick()
-				}
+				return d.activate(d.selected)
 			}
 			return runtime.Handled()
 		}
@@ -487,6 +531,29 @@ func (d *Dialog) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Handled()
 }
 
+// activate runs the button's callback and reports it as the result.
+func (d *Dialog) activate(index int) runtime.HandleResult {
+	button := d.Buttons[index]
+	if button.OnClick != nil {
+		button.OnClick()
+	}
+	return d.answer(DialogResult{ButtonIndex: index, ButtonLabel: button.Label})
+}
+
+// answer sends result to the Result channel and, if Result was called,
+// pops the dialog's overlay.
+func (d *Dialog) answer(result DialogResult) runtime.HandleResult {
+	select {
+	case d.results <- result:
+	default:
+		// An earlier answer is still unread; keep it.
+	}
+	if !d.awaited.Load() {
+		return runtime.Handled()
+	}
+	return runtime.WithCommand(runtime.PopOverlay{})
+}
+
 // ChildWidgets returns the content widget for proper widget tree traversal.
 func (d *Dialog) ChildWidgets() []runtime.Widget {
 	if d.Content == nil {
@@ -0,0 +1,168 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+const modalDialogMinWidth = 40
+
+// ModalDialog is a Dialog packaged for runtime.PushOverlay{Modal: true}.
+// It centers the dialog, turns Enter, Escape, and button clicks into a
+// single result callback, and pops its overlay once answered. The screen
+// restores focus to the widget focused before the overlay was pushed.
+type ModalDialog struct {
+	Base
+	dialog   *Dialog
+	input    *Input
+	onResult func(text string, ok bool)
+	closed   bool
+}
+
+// Confirm builds an OK/Cancel dialog. onResult receives true for OK and
+// false for Cancel or Escape.
+func Confirm(title, msg string, onResult func(ok bool)) *ModalDialog {
+	m := newModalDialog(title, msg, nil)
+	m.onResult = func(_ string, ok bool) {
+		if onResult != nil {
+			onResult(ok)
+		}
+	}
+	return m
+}
+
+// Prompt builds a dialog with a text input. Enter or OK reports the input
+// text with ok true; Escape or Cancel reports an empty string and false.
+func Prompt(title, msg string, onResult func(text string, ok bool)) *ModalDialog {
+	m := newModalDialog(title, msg, NewInput())
+	m.onResult = onResult
+	return m
+}
+
+func newModalDialog(title, msg string, input *Input) *ModalDialog {
+	m := &ModalDialog{input: input}
+	m.dialog = NewDialog(title, msg,
+		DialogButton{Label: "OK", OnClick: func() { m.finish(true) }},
+		DialogButton{Label: "Cancel", OnClick: func() { m.finish(false) }},
+	)
+	m.dialog.SetOnDismiss(func() { m.finish(false) })
+	if input != nil {
+		m.dialog.SetContent(VBox(FlexFixed(NewText(msg)), FlexFixed(input)))
+		input.Focus()
+	}
+	m.dialog.Focus()
+	return m
+}
+
+// Dialog returns the underlying dialog for styling.
+func (m *ModalDialog) Dialog() *Dialog {
+	if m == nil {
+		return nil
+	}
+	return m.dialog
+}
+
+// Input returns the prompt's text input, or nil for Confirm.
+func (m *ModalDialog) Input() *Input {
+	if m == nil {
+		return nil
+	}
+	return m.input
+}
+
+// SetButtonLabels renames the OK and Cancel buttons.
+func (m *ModalDialog) SetButtonLabels(ok, cancel string) {
+	if m == nil || len(m.dialog.Buttons) < 2 {
+		return
+	}
+	m.dialog.Buttons[0].Label = ok
+	m.dialog.Buttons[1].Label = cancel
+}
+
+// Closed reports whether a result has been delivered.
+func (m *ModalDialog) Closed() bool {
+	return m != nil && m.closed
+}
+
+func (m *ModalDialog) finish(ok bool) {
+	if m.closed {
+		return
+	}
+	m.closed = true
+	text := ""
+	if ok && m.input != nil {
+		text = m.input.Text()
+	}
+	if m.onResult != nil {
+		m.onResult(text, ok)
+	}
+}
+
+// Measure fills the available space so the dialog can be centered.
+func (m *ModalDialog) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout centers the dialog within bounds.
+func (m *ModalDialog) Layout(bounds runtime.Rect) {
+	m.Base.Layout(bounds)
+	width := max(textWidth(m.dialog.Title), textWidth(m.dialog.Body)) + 4
+	width = min(max(width, modalDialogMinWidth), bounds.Width)
+	size := m.dialog.Measure(runtime.Constraints{MaxWidth: width, MaxHeight: bounds.Height})
+	m.dialog.Layout(runtime.Rect{
+		X:      bounds.X + (bounds.Width-size.Width)/2,
+		Y:      bounds.Y + (bounds.Height-size.Height)/2,
+		Width:  size.Width,
+		Height: size.Height,
+	})
+}
+
+// Render draws the dialog.
+func (m *ModalDialog) Render(ctx runtime.RenderContext) {
+	if m == nil {
+		return
+	}
+	runtime.RenderChild(ctx, m.dialog)
+}
+
+// HandleMessage routes input to the dialog and pops the overlay once a
+// result is chosen. All input is consumed while the dialog is open.
+func (m *ModalDialog) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if m == nil || m.closed {
+		return runtime.Unhandled()
+	}
+	if key, ok := msg.(runtime.KeyMsg); ok && m.input != nil {
+		switch key.Key {
+		case terminal.KeyEscape:
+			m.finish(false)
+		case terminal.KeyEnter:
+			m.finish(true)
+		case terminal.KeyTab:
+			// Keep focus in the input.
+		default:
+			m.input.HandleMessage(msg)
+		}
+	} else {
+		m.dialog.HandleMessage(msg)
+	}
+	if m.closed {
+		return runtime.WithCommand(runtime.PopOverlay{})
+	}
+	return runtime.Handled()
+}
+
+// ChildWidgets returns the dialog.
+func (m *ModalDialog) ChildWidgets() []runtime.Widget {
+	if m == nil {
+		return nil
+	}
+	return []runtime.Widget{m.dialog}
+}
+
+// HitSelf routes mouse hits within the overlay to the modal.
+func (m *ModalDialog) HitSelf() bool {
+	return true
+}
+
+var _ runtime.Widget = (*ModalDialog)(nil)
+var _ runtime.ChildProvider = (*ModalDialog)(nil)
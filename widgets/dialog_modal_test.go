@@ -0,0 +1,83 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func popsOverlay(result runtime.HandleResult) bool {
+	for _, cmd := range result.Commands {
+		if _, ok := cmd.(runtime.PopOverlay); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConfirmKeys(t *testing.T) {
+	var got []bool
+	confirm := Confirm("Delete", "Delete 3 files?", func(ok bool) { got = append(got, ok) })
+	if result := confirm.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}); !popsOverlay(result) {
+		t.Fatalf("Enter should pop the overlay, got %#v", result)
+	}
+	if !confirm.Closed() || len(got) != 1 || !got[0] {
+		t.Fatalf("results = %v, want [true]", got)
+	}
+	if result := confirm.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape}); result.Handled {
+		t.Fatal("closed confirm should ignore further input")
+	}
+
+	got = nil
+	confirm = Confirm("Delete", "Delete 3 files?", func(ok bool) { got = append(got, ok) })
+	confirm.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if len(got) != 1 || got[0] {
+		t.Fatalf("results = %v after Escape, want [false]", got)
+	}
+}
+
+func TestConfirmCancelClick(t *testing.T) {
+	result := true
+	confirm := Confirm("Quit", "Discard changes?", func(ok bool) { result = ok })
+	confirm.SetButtonLabels("Discard", "Keep")
+	_, rows := renderRows(t, confirm, 60, 12)
+	if !strings.Contains(strings.Join(rows, "\n"), "[Keep]") {
+		t.Fatalf("rows = %q, want relabeled buttons", rows)
+	}
+	rect := confirm.Dialog().buttonRects[1]
+	res := confirm.HandleMessage(runtime.MouseMsg{X: rect.X + 1, Y: rect.Y, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if !popsOverlay(res) {
+		t.Fatalf("click should pop the overlay, got %#v", res)
+	}
+	if result {
+		t.Fatal("clicking Keep should report false")
+	}
+}
+
+func TestPromptText(t *testing.T) {
+	var text string
+	var ok bool
+	prompt := Prompt("Rename", "New name:", func(s string, accepted bool) { text, ok = s, accepted })
+	_, rows := renderRows(t, prompt, 60, 12)
+	if !strings.Contains(strings.Join(rows, "\n"), "New name:") {
+		t.Fatalf("prompt rows = %q", rows)
+	}
+	for _, r := range "notes" {
+		prompt.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if result := prompt.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}); !popsOverlay(result) {
+		t.Fatalf("Enter should pop the overlay, got %#v", result)
+	}
+	if text != "notes" || !ok {
+		t.Fatalf("result = %q, %v; want notes, true", text, ok)
+	}
+
+	prompt = Prompt("Rename", "New name:", func(s string, accepted bool) { text, ok = s, accepted })
+	prompt.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	prompt.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if text != "" || ok {
+		t.Fatalf("result = %q, %v after Escape; want empty, false", text, ok)
+	}
+}
@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -212,6 +213,79 @@ func TestDialog_EnterActivatesSelected(t *testing.T) {
 	}
 }
 
+func TestDialog_ClickActivatesButton(t *testing.T) {
+	clicked := ""
+	dialog := NewDialog("Test", "Body",
+		DialogButton{Label: "A", OnClick: func() { clicked = "A" }},
+		DialogButton{Label: "B", OnClick: func() { clicked = "B" }},
+	)
+	dialog.Focus()
+	_, rows := renderRows(t, dialog, 20, 6)
+	y := len(rows) - 2
+	x := strings.Index(rows[y], "[B]")
+	if x < 0 {
+		t.Fatalf("button row = %q", rows[y])
+	}
+	dialog.HandleMessage(runtime.MouseMsg{X: len([]rune(rows[y][:x])) + 1, Y: y, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if clicked != "B" {
+		t.Fatalf("clicked = %q, want B", clicked)
+	}
+}
+
+func TestDialog_ResultChannel(t *testing.T) {
+	dialog := NewDialog("Test", "Body",
+		DialogButton{Label: "Save"},
+		DialogButton{Label: "Discard"},
+	)
+	dialog.Focus()
+
+	// Without a Result reader the dialog leaves its overlay alone but keeps
+	// the answer for a late reader.
+	if result := dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}); len(result.Commands) != 0 {
+		t.Fatalf("expected no commands before Result is called, got %v", result.Commands)
+	}
+
+	results := dialog.Result()
+	if got := <-results; got != (DialogResult{ButtonIndex: 0, ButtonLabel: "Save"}) {
+		t.Fatalf("early result = %+v, want Save", got)
+	}
+	dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	result := dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if len(result.Commands) != 1 {
+		t.Fatalf("expected PopOverlay command, got %v", result.Commands)
+	}
+	if _, ok := result.Commands[0].(runtime.PopOverlay); !ok {
+		t.Fatalf("expected PopOverlay command, got %T", result.Commands[0])
+	}
+	select {
+	case got := <-results:
+		if got != (DialogResult{ButtonIndex: 1, ButtonLabel: "Discard"}) {
+			t.Fatalf("result = %+v, want Discard", got)
+		}
+	default:
+		t.Fatal("expected a result on the channel")
+	}
+
+	dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if got := <-results; got != (DialogResult{ButtonIndex: -1}) {
+		t.Fatalf("escape result = %+v, want ButtonIndex -1", got)
+	}
+}
+
+func TestDialog_ResultDoesNotBlock(t *testing.T) {
+	dialog := NewDialog("Test", "Body", DialogButton{Label: "OK"})
+	dialog.Focus()
+	results := dialog.Result()
+	dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	dialog.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if got := <-results; got.ButtonLabel != "OK" {
+		t.Fatalf("result = %+v", got)
+	}
+	if len(results) != 0 {
+		t.Fatalf("expected the second answer to be dropped")
+	}
+}
+
 func TestDialog_WithContent(t *testing.T) {
 	content := NewText("Custom content")
 	dialog := NewDialog("Title", "").WithContent(content)
@@ -0,0 +1,515 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/diff"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// DiffMode selects how a DiffView lays out changes.
+type DiffMode int
+
+const (
+	// DiffUnified shows one column with removed lines before added ones.
+	DiffUnified DiffMode = iota
+	// DiffSideBySide shows the old text on the left and the new on the
+	// right, with removed and added lines paired up.
+	DiffSideBySide
+	// DiffSplit is another name for DiffSideBySide.
+	DiffSplit = DiffSideBySide
+)
+
+// DiffOp is the kind of change for a diff line.
+type DiffOp = diff.Op
+
+const (
+	DiffEqual  = diff.Equal
+	DiffInsert = diff.Insert
+	DiffDelete = diff.Delete
+)
+
+// DefaultDiffContextLines is how many unchanged lines a DiffView shows
+// around each change.
+const DefaultDiffContextLines = 3
+
+// DiffLine is a line of a line-level diff.
+type DiffLine = diff.Line
+
+// diffRow is a displayed row. In side-by-side mode left and right hold the
+// old and new lines; unified rows only use left. A row with gap > 0 stands
+// for that many hidden unchanged lines. The spans, when set, mark the
+// characters that changed within a line paired with its replacement.
+type diffRow struct {
+	left, right           *DiffLine
+	leftSpans, rightSpans []diff.Span
+	gap                   int
+}
+
+// DiffView shows a line-level diff of two texts, either unified or side by
+// side. Unchanged lines far from any change are collapsed, and within a
+// changed line that replaces another the changed characters are
+// highlighted. The arrow keys, Page Up/Down, Home/End, and the mouse wheel
+// scroll; n and N jump to the next and previous hunk.
+type DiffView struct {
+	FocusableBase
+	lines        []DiffLine
+	intraline    [][]diff.Span
+	mode         DiffMode
+	contextLines int
+	rows         []diffRow
+	hunks        []int
+	offset       int
+	label        string
+	style        backend.Style
+	addedStyle   backend.Style
+	removedStyle backend.Style
+	gapStyle     backend.Style
+	addedSpan    backend.Style
+	removedSpan  backend.Style
+}
+
+// NewDiffView creates a unified diff view from text a to text b.
+func NewDiffView(a, b string) *DiffView {
+	d := &DiffView{
+		contextLines: DefaultDiffContextLines,
+		label:        "Diff",
+		style:        backend.DefaultStyle(),
+		addedStyle:   backend.DefaultStyle().Background(backend.ColorGreen),
+		removedStyle: backend.DefaultStyle().Background(backend.ColorRed),
+		gapStyle:     backend.DefaultStyle().Dim(true),
+		addedSpan:    backend.DefaultStyle().Background(backend.ColorBrightGreen).Bold(true),
+		removedSpan:  backend.DefaultStyle().Background(backend.ColorBrightRed).Bold(true),
+	}
+	d.Base.Role = accessibility.RoleText
+	d.SetTexts(a, b)
+	return d
+}
+
+// SetTexts replaces the compared texts and scrolls back to the top.
+func (d *DiffView) SetTexts(a, b string) {
+	if d == nil {
+		return
+	}
+	d.lines = diff.Text(a, b)
+	d.intraline = intralineSpans(d.lines)
+	d.offset = 0
+	d.rebuild()
+}
+
+// Lines returns the full diff, including collapsed lines.
+func (d *DiffView) Lines() []DiffLine {
+	if d == nil {
+		return nil
+	}
+	return d.lines
+}
+
+// SetMode switches between unified and side-by-side layout.
+func (d *DiffView) SetMode(mode DiffMode) {
+	if d == nil || d.mode == mode {
+		return
+	}
+	d.mode = mode
+	d.rebuild()
+}
+
+// Mode returns the layout mode.
+func (d *DiffView) Mode() DiffMode {
+	if d == nil {
+		return DiffUnified
+	}
+	return d.mode
+}
+
+// SetContextLines sets how many unchanged lines are shown around each
+// change. A negative count shows every line.
+func (d *DiffView) SetContextLines(n int) {
+	if d == nil {
+		return
+	}
+	d.contextLines = n
+	d.rebuild()
+}
+
+// SetStyles sets the styles for added, removed, and collapsed lines.
+func (d *DiffView) SetStyles(added, removed, gap backend.Style) {
+	if d == nil {
+		return
+	}
+	d.addedStyle = added
+	d.removedStyle = removed
+	d.gapStyle = gap
+	d.Invalidate()
+}
+
+// SetIntralineStyles sets the styles for the changed characters within
+// added and removed lines.
+func (d *DiffView) SetIntralineStyles(added, removed backend.Style) {
+	if d == nil {
+		return
+	}
+	d.addedSpan = added
+	d.removedSpan = removed
+	d.Invalidate()
+}
+
+// Hunks returns how many hunks the diff has. A hunk is a run of changed
+// rows.
+func (d *DiffView) Hunks() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.hunks)
+}
+
+// NextHunk scrolls the next hunk below the top row to the top, as far as
+// the scroll range allows, and reports whether the view moved.
+func (d *DiffView) NextHunk() bool {
+	if d == nil {
+		return false
+	}
+	for _, row := range d.hunks {
+		if row > d.offset {
+			return d.scrollTo(row)
+		}
+	}
+	return false
+}
+
+// PrevHunk scrolls the previous hunk above the top row to the top and
+// reports whether the view moved.
+func (d *DiffView) PrevHunk() bool {
+	if d == nil {
+		return false
+	}
+	for i := len(d.hunks) - 1; i >= 0; i-- {
+		if d.hunks[i] < d.offset {
+			return d.scrollTo(d.hunks[i])
+		}
+	}
+	return false
+}
+
+// SetLabel updates the accessibility label.
+func (d *DiffView) SetLabel(label string) {
+	if d == nil {
+		return
+	}
+	d.label = label
+	d.syncA11y()
+}
+
+// StyleType returns the selector type name.
+func (d *DiffView) StyleType() string {
+	return "DiffView"
+}
+
+// DiffLines computes a line-level diff of a and b. Removed lines come
+// before the lines added in their place. See the diff package.
+func DiffLines(a, b []string) []DiffLine {
+	return diff.Lines(a, b)
+}
+
+// intralineSpans pairs each removed line with the line added in its place
+// and diffs their characters. Pairs with nothing in common keep no spans,
+// so the whole line reads as changed.
+func intralineSpans(lines []DiffLine) [][]diff.Span {
+	spans := make([][]diff.Span, len(lines))
+	for i := 0; i < len(lines); {
+		if lines[i].Op == DiffEqual {
+			i++
+			continue
+		}
+		start := i
+		for i < len(lines) && lines[i].Op == DiffDelete {
+			i++
+		}
+		added := i
+		for i < len(lines) && lines[i].Op == DiffInsert {
+			i++
+		}
+		for k := 0; start+k < added && added+k < i; k++ {
+			chars := diff.Chars(lines[start+k].Text, lines[added+k].Text)
+			shared := false
+			for _, span := range chars {
+				if span.Op == DiffEqual && strings.TrimSpace(span.Text) != "" {
+					shared = true
+					break
+				}
+			}
+			if !shared {
+				continue
+			}
+			for _, span := range chars {
+				if span.Op != DiffInsert {
+					spans[start+k] = append(spans[start+k], span)
+				}
+				if span.Op != DiffDelete {
+					spans[added+k] = append(spans[added+k], span)
+				}
+			}
+		}
+	}
+	return spans
+}
+
+// rebuild lays the diff out into rows for the current mode and context.
+func (d *DiffView) rebuild() {
+	shown := make([]bool, len(d.lines))
+	for i, line := range d.lines {
+		if line.Op != DiffEqual || d.contextLines < 0 {
+			shown[i] = true
+			continue
+		}
+		for k := max(0, i-d.contextLines); k <= min(len(d.lines)-1, i+d.contextLines); k++ {
+			if d.lines[k].Op != DiffEqual {
+				shown[i] = true
+				break
+			}
+		}
+	}
+
+	d.rows = d.rows[:0]
+	d.hunks = d.hunks[:0]
+	for i := 0; i < len(d.lines); {
+		if !shown[i] {
+			gap := 0
+			for ; i < len(d.lines) && !shown[i]; i++ {
+				gap++
+			}
+			d.rows = append(d.rows, diffRow{gap: gap})
+			continue
+		}
+		line := &d.lines[i]
+		if line.Op != DiffEqual && (i == 0 || d.lines[i-1].Op == DiffEqual) {
+			d.hunks = append(d.hunks, len(d.rows))
+		}
+		if d.mode == DiffUnified || line.Op == DiffEqual {
+			d.rows = append(d.rows, diffRow{left: line, right: line, leftSpans: d.intraline[i]})
+			i++
+			continue
+		}
+		// Pair the removed lines of a change with the lines added in
+		// their place.
+		var removed, added []int
+		for ; i < len(d.lines) && d.lines[i].Op == DiffDelete; i++ {
+			removed = append(removed, i)
+		}
+		for ; i < len(d.lines) && d.lines[i].Op == DiffInsert; i++ {
+			added = append(added, i)
+		}
+		for k := 0; k < max(len(removed), len(added)); k++ {
+			var row diffRow
+			if k < len(removed) {
+				row.left, row.leftSpans = &d.lines[removed[k]], d.intraline[removed[k]]
+			}
+			if k < len(added) {
+				row.right, row.rightSpans = &d.lines[added[k]], d.intraline[added[k]]
+			}
+			d.rows = append(d.rows, row)
+		}
+	}
+	d.scrollBy(0)
+	d.syncA11y()
+	d.Invalidate()
+}
+
+// Measure asks for room to show every row.
+func (d *DiffView) Measure(constraints runtime.Constraints) runtime.Size {
+	return d.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := 0
+		for _, line := range d.lines {
+			width = max(width, textWidth(line.Text))
+		}
+		// The change marker and a space, twice with a divider side by side.
+		width += 2
+		if d.mode == DiffSideBySide {
+			width = width*2 + 1
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: len(d.rows)})
+	})
+}
+
+// Layout keeps the scroll offset in range for the new height.
+func (d *DiffView) Layout(bounds runtime.Rect) {
+	d.Base.Layout(bounds)
+	d.scrollBy(0)
+}
+
+// Render draws the visible rows.
+func (d *DiffView) Render(ctx runtime.RenderContext) {
+	if d == nil {
+		return
+	}
+	d.syncA11y()
+	content := d.ContentBounds()
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, d, backend.DefaultStyle(), false), d.style)
+	ctx.Buffer.Fill(content, ' ', style)
+	end := min(len(d.rows), d.offset+content.Height)
+	for i, row := range d.rows[d.offset:end] {
+		y := content.Y + i
+		if row.gap > 0 {
+			text := fmt.Sprintf("⋯ %d unchanged lines", row.gap)
+			if row.gap == 1 {
+				text = "⋯ 1 unchanged line"
+			}
+			ctx.Buffer.SetString(content.X, y, truncateString(text, content.Width), mergeBackendStyles(style, d.gapStyle))
+			continue
+		}
+		if d.mode == DiffUnified {
+			d.drawLine(ctx, row.left, row.leftSpans, content.X, y, content.Width, style)
+			continue
+		}
+		half := (content.Width - 1) / 2
+		d.drawLine(ctx, row.left, row.leftSpans, content.X, y, half, style)
+		ctx.Buffer.Set(content.X+half, y, '│', style)
+		d.drawLine(ctx, row.right, row.rightSpans, content.X+half+1, y, content.Width-half-1, style)
+	}
+}
+
+// drawLine draws a line with its change marker across width cells,
+// emphasizing the changed spans if it has any.
+func (d *DiffView) drawLine(ctx runtime.RenderContext, line *DiffLine, spans []diff.Span, x, y, width int, style backend.Style) {
+	if line == nil || width <= 0 {
+		return
+	}
+	spanStyle := style
+	switch line.Op {
+	case DiffInsert:
+		style = mergeBackendStyles(style, d.addedStyle)
+		spanStyle = mergeBackendStyles(style, d.addedSpan)
+	case DiffDelete:
+		style = mergeBackendStyles(style, d.removedStyle)
+		spanStyle = mergeBackendStyles(style, d.removedSpan)
+	}
+	ctx.Buffer.Fill(runtime.Rect{X: x, Y: y, Width: width, Height: 1}, ' ', style)
+	if len(spans) == 0 {
+		ctx.Buffer.SetString(x, y, truncateString(line.Op.String()+" "+line.Text, width), style)
+		return
+	}
+	ctx.Buffer.SetString(x, y, truncateString(line.Op.String()+" ", width), style)
+	col, right := x+2, x+width
+	for _, span := range spans {
+		if col >= right {
+			break
+		}
+		text := truncateString(span.Text, right-col)
+		spanCells := style
+		if span.Op != DiffEqual {
+			spanCells = spanStyle
+		}
+		ctx.Buffer.SetString(col, y, text, spanCells)
+		col += textWidth(text)
+	}
+}
+
+// HandleMessage scrolls the diff.
+func (d *DiffView) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if d == nil {
+		return runtime.Unhandled()
+	}
+	page := max(1, d.ContentBounds().Height-1)
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		switch m.Button {
+		case runtime.MouseWheelUp:
+			d.scrollBy(-3)
+		case runtime.MouseWheelDown:
+			d.scrollBy(3)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.KeyMsg:
+		if !d.focused {
+			return runtime.Unhandled()
+		}
+		switch m.Key {
+		case terminal.KeyUp:
+			d.scrollBy(-1)
+		case terminal.KeyDown:
+			d.scrollBy(1)
+		case terminal.KeyPageUp:
+			d.scrollBy(-page)
+		case terminal.KeyPageDown:
+			d.scrollBy(page)
+		case terminal.KeyHome:
+			d.scrollBy(-len(d.rows))
+		case terminal.KeyEnd:
+			d.scrollBy(len(d.rows))
+		case terminal.KeyRune:
+			switch m.Rune {
+			case 'n':
+				d.NextHunk()
+			case 'N':
+				d.PrevHunk()
+			default:
+				return runtime.Unhandled()
+			}
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// Offset returns the index of the first visible row.
+func (d *DiffView) Offset() int {
+	if d == nil {
+		return 0
+	}
+	return d.offset
+}
+
+// scrollTo puts row at the top of the view, as far as the scroll range
+// allows, and reports whether the view moved.
+func (d *DiffView) scrollTo(row int) bool {
+	before := d.offset
+	d.scrollBy(row - d.offset)
+	return d.offset != before
+}
+
+// scrollBy moves the view by delta rows, keeping it in range.
+func (d *DiffView) scrollBy(delta int) {
+	offset := clampInt(d.offset+delta, 0, max(0, len(d.rows)-d.ContentBounds().Height))
+	if offset != d.offset {
+		d.offset = offset
+		d.Invalidate()
+	}
+}
+
+func (d *DiffView) syncA11y() {
+	if d == nil {
+		return
+	}
+	if d.Base.Role == "" {
+		d.Base.Role = accessibility.RoleText
+	}
+	label := strings.TrimSpace(d.label)
+	if label == "" {
+		label = "Diff"
+	}
+	d.Base.Label = label
+	added, removed := 0, 0
+	for _, line := range d.lines {
+		switch line.Op {
+		case DiffInsert:
+			added++
+		case DiffDelete:
+			removed++
+		}
+	}
+	d.Base.Value = &accessibility.ValueInfo{Text: fmt.Sprintf("%d added, %d removed", added, removed)}
+}
+
+var _ runtime.Widget = (*DiffView)(nil)
+var _ runtime.Focusable = (*DiffView)(nil)
@@ -0,0 +1,125 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestDiffLinesLCS(t *testing.T) {
+	lines := DiffLines([]string{"a", "b", "c", "d"}, []string{"a", "c", "x", "d"})
+	want := []DiffLine{
+		{Op: DiffEqual, Text: "a"},
+		{Op: DiffDelete, Text: "b"},
+		{Op: DiffEqual, Text: "c"},
+		{Op: DiffInsert, Text: "x"},
+		{Op: DiffEqual, Text: "d"},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("lines = %+v", lines)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Fatalf("line %d = %+v, want %+v", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestDiffViewModesAndContext(t *testing.T) {
+	view := NewDiffView("one\ntwo\nthree\nfour\nfive\n", "one\ntwo\nthree\nfour\nFIVE\n")
+	view.SetContextLines(1)
+
+	buf, rows := renderRows(t, view, 20, 4)
+	want := []string{"⋯ 3 unchanged lines", "  four", "- five", "+ FIVE"}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("unified row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+	if buf.Get(19, 3).Style != backend.DefaultStyle().Background(backend.ColorGreen) {
+		t.Fatal("expected added lines on a green background across the row")
+	}
+	if buf.Get(0, 2).Style != backend.DefaultStyle().Background(backend.ColorRed) {
+		t.Fatal("expected removed lines on a red background")
+	}
+
+	view.SetMode(DiffSideBySide)
+	_, rows = renderRows(t, view, 21, 3)
+	if want := "- five    │+ FIVE"; rows[2] != want {
+		t.Fatalf("side-by-side row = %q, want %q", rows[2], want)
+	}
+}
+
+func TestDiffViewScrolls(t *testing.T) {
+	view := NewDiffView("a\nb\nc\nd\ne\nf", "")
+	view.Focus()
+	renderRows(t, view, 10, 3)
+
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if view.Offset() != 1 {
+		t.Fatalf("offset = %d after Down", view.Offset())
+	}
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	if view.Offset() != 3 {
+		t.Fatalf("offset = %d, want Page Down to stop at the last page", view.Offset())
+	}
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageUp})
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageUp})
+	if view.Offset() != 0 {
+		t.Fatalf("offset = %d after Page Up", view.Offset())
+	}
+}
+
+func TestDiffViewIntralineHighlight(t *testing.T) {
+	view := NewDiffView("total := a + b\n", "total := a - b\n")
+	buf, rows := renderRows(t, view, 20, 2)
+	if rows[0] != "- total := a + b" || rows[1] != "+ total := a - b" {
+		t.Fatalf("rows = %q", rows)
+	}
+	added := backend.DefaultStyle().Background(backend.ColorGreen)
+	if buf.Get(2, 1).Style != added {
+		t.Fatalf("unchanged characters style = %+v", buf.Get(2, 1).Style)
+	}
+	if got := buf.Get(13, 1).Style; got == added || got.Attributes()&backend.AttrBold == 0 {
+		t.Fatalf("changed character style = %+v, want emphasized", got)
+	}
+	if got := buf.Get(13, 0).Style; got == backend.DefaultStyle().Background(backend.ColorRed) {
+		t.Fatal("expected the removed character emphasized too")
+	}
+}
+
+func TestDiffViewHunkNavigation(t *testing.T) {
+	var before, after strings.Builder
+	for i := 0; i < 30; i++ {
+		fmt.Fprintf(&before, "line %d\n", i)
+		if i == 5 || i == 20 {
+			fmt.Fprintf(&after, "changed %d\n", i)
+			continue
+		}
+		fmt.Fprintf(&after, "line %d\n", i)
+	}
+	view := NewDiffView(before.String(), after.String())
+	view.SetContextLines(-1)
+	view.Focus()
+	renderRows(t, view, 20, 4)
+	if view.Hunks() != 2 {
+		t.Fatalf("hunks = %d, want 2", view.Hunks())
+	}
+
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'n'})
+	if view.Offset() != 5 {
+		t.Fatalf("offset = %d after n, want the first hunk", view.Offset())
+	}
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'n'})
+	if view.Offset() != 21 {
+		t.Fatalf("offset = %d after n, want the second hunk", view.Offset())
+	}
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'N'})
+	if view.Offset() != 5 {
+		t.Fatalf("offset = %d after N, want the first hunk", view.Offset())
+	}
+}
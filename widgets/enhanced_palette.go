@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"sort"
+	"time"
 
 	"github.com/odvcencio/fluffyui/keybind"
 )
@@ -10,9 +11,11 @@ import (
 type EnhancedPalette struct {
 	Widget   *PaletteWidget
 	registry *keybind.CommandRegistry
-	recent   []string
+	history  map[string]PaletteUsage
+	store    PaletteHistoryStore
 	pinned   []string
 	keymaps  []*keybind.Keymap
+	now      func() time.Time
 }
 
 // NewEnhancedPalette creates a palette from a registry.
@@ -20,6 +23,8 @@ func NewEnhancedPalette(registry *keybind.CommandRegistry) *EnhancedPalette {
 	palette := &EnhancedPalette{
 		Widget:   NewPaletteWidget("Commands"),
 		registry: registry,
+		history:  map[string]PaletteUsage{},
+		now:      time.Now,
 	}
 	palette.Refresh()
 	return palette
@@ -68,19 +73,61 @@ func (p *EnhancedPalette) Refresh() {
 	p.Widget.SetItems(items)
 }
 
-// Record marks a command as recently used.
+// SetHistoryStore loads usage history from store and saves to it on every
+// Record, so frequently and recently used commands lead the Recent group
+// across runs. A history that fails to load is treated as empty.
+func (p *EnhancedPalette) SetHistoryStore(store PaletteHistoryStore) {
+	if p == nil {
+		return
+	}
+	p.store = store
+	p.history = map[string]PaletteUsage{}
+	if store != nil {
+		if history, err := store.Load(); err == nil {
+			for id, usage := range history {
+				p.history[id] = usage
+			}
+		}
+	}
+	p.Refresh()
+}
+
+// Record marks a command as used, raising its frecency.
 func (p *EnhancedPalette) Record(id string) {
 	if p == nil || id == "" {
 		return
 	}
-	p.recent = append([]string{id}, p.recent...)
-	p.recent = unique(p.recent)
-	if len(p.recent) > 10 {
-		p.recent = p.recent[:10]
+	if p.history == nil {
+		p.history = map[string]PaletteUsage{}
+	}
+	now := p.now()
+	usage := p.history[id]
+	usage.Count++
+	usage.LastUsed = now
+	p.history[id] = usage
+	pruneHistory(p.history, now)
+	p.saveHistory()
+	p.Refresh()
+}
+
+// ResetHistory forgets all recorded usage, including the stored history.
+func (p *EnhancedPalette) ResetHistory() {
+	if p == nil {
+		return
 	}
+	p.history = map[string]PaletteUsage{}
+	p.saveHistory()
 	p.Refresh()
 }
 
+// saveHistory writes usage to the store. Failures are ignored: history is a
+// convenience and must never break the palette.
+func (p *EnhancedPalette) saveHistory() {
+	if p.store != nil {
+		_ = p.store.Save(p.history)
+	}
+}
+
 // Pin adds a command to pinned list.
 func (p *EnhancedPalette) Pin(id string) {
 	if p == nil || id == "" {
@@ -107,11 +154,15 @@ func (p *EnhancedPalette) Unpin(id string) {
 }
 
 func (p *EnhancedPalette) buildRecent(shortcuts map[string][]keybind.Key) []PaletteItem {
-	if p == nil || len(p.recent) == 0 {
+	if p == nil || len(p.history) == 0 {
 		return nil
 	}
-	items := make([]PaletteItem, 0, len(p.recent))
-	for _, id := range p.recent {
+	recent := rankHistory(p.history, p.now())
+	if len(recent) > paletteRecentLimit {
+		recent = recent[:paletteRecentLimit]
+	}
+	items := make([]PaletteItem, 0, len(recent))
+	for _, id := range recent {
 		if cmd, ok := p.registry.Get(id); ok {
 			shortcut := keybind.FormatKeySequences(shortcuts[cmd.ID])
 			items = append(items, PaletteItem{
@@ -0,0 +1,363 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/state"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// filterBarDebounce is how long typing must pause before the query applies.
+const filterBarDebounce = 100 * time.Millisecond
+
+// FilterTarget is a widget a FilterBar narrows, such as a List with
+// SetFilter or a Table with SetRowFilter.
+type FilterTarget interface {
+	SetFilterQuery(query string)
+	// FilterCount returns how many rows match the query out of all rows.
+	FilterCount() (shown, total int)
+}
+
+// FilterBar is a one-line incremental filter input built on Input. Typing
+// updates the query of its attached targets once typing pauses for the
+// debounce interval; Enter applies it at once and Esc clears it. The bar
+// shows how many rows match as "N of M".
+//
+// The debounce runs on the app clock and applies the query through the app
+// scheduler, so targets only change on the UI goroutine. Until the bar is
+// bound to an app, edits apply immediately.
+type FilterBar struct {
+	FocusableBase
+	services   runtime.Services
+	input      *Input
+	query      string
+	timer      state.Timer
+	pending    uint64
+	debounce   time.Duration
+	targets    []FilterTarget
+	onChange   func(query string)
+	label      string
+	style      backend.Style
+	countStyle backend.Style
+}
+
+// NewFilterBar creates an empty filter bar.
+func NewFilterBar() *FilterBar {
+	b := &FilterBar{
+		input:      NewInput(),
+		debounce:   filterBarDebounce,
+		label:      "Filter",
+		style:      backend.DefaultStyle(),
+		countStyle: backend.DefaultStyle().Dim(true),
+	}
+	b.Base.Role = accessibility.RoleTextbox
+	b.input.SetPlaceholder("Filter")
+	b.input.SetOnChange(b.edited)
+	b.syncInputStyle()
+	b.syncA11y()
+	return b
+}
+
+// Attach adds targets whose query follows the bar.
+func (b *FilterBar) Attach(targets ...FilterTarget) {
+	if b == nil {
+		return
+	}
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		b.targets = append(b.targets, target)
+		target.SetFilterQuery(b.query)
+	}
+	b.syncA11y()
+	b.Invalidate()
+}
+
+// SetOnChange registers a handler called with each applied query.
+func (b *FilterBar) SetOnChange(fn func(query string)) {
+	if b == nil {
+		return
+	}
+	b.onChange = fn
+}
+
+// SetDebounce sets how long typing must pause before the query applies.
+// Zero applies every keystroke immediately.
+func (b *FilterBar) SetDebounce(d time.Duration) {
+	if b == nil {
+		return
+	}
+	b.debounce = max(d, 0)
+}
+
+// SetPlaceholder sets the text shown while the query is empty.
+func (b *FilterBar) SetPlaceholder(text string) {
+	if b == nil {
+		return
+	}
+	b.input.SetPlaceholder(text)
+}
+
+// SetLabel updates the accessibility label.
+func (b *FilterBar) SetLabel(label string) {
+	if b == nil {
+		return
+	}
+	b.label = label
+	b.syncA11y()
+}
+
+// SetStyles updates the input and match count styles.
+func (b *FilterBar) SetStyles(text, count backend.Style) {
+	if b == nil {
+		return
+	}
+	b.style = text
+	b.countStyle = count
+	b.syncInputStyle()
+}
+
+// StyleType returns the selector type name.
+func (b *FilterBar) StyleType() string {
+	return "FilterBar"
+}
+
+// Input returns the text input the bar edits the query with.
+func (b *FilterBar) Input() *Input {
+	if b == nil {
+		return nil
+	}
+	return b.input
+}
+
+// Text returns the text typed so far, which may not be applied yet.
+func (b *FilterBar) Text() string {
+	if b == nil {
+		return ""
+	}
+	return b.input.Text()
+}
+
+// Query returns the applied query.
+func (b *FilterBar) Query() string {
+	if b == nil {
+		return ""
+	}
+	return b.query
+}
+
+// SetQuery replaces the text and applies it immediately.
+func (b *FilterBar) SetQuery(query string) {
+	if b == nil {
+		return
+	}
+	b.input.SetText(query)
+	b.apply()
+}
+
+// Clear empties the query, restoring every row of the targets.
+func (b *FilterBar) Clear() {
+	b.SetQuery("")
+}
+
+// Focus focuses the bar and its input.
+func (b *FilterBar) Focus() {
+	if b == nil {
+		return
+	}
+	b.FocusableBase.Focus()
+	b.input.Focus()
+}
+
+// Blur removes focus from the bar and its input.
+func (b *FilterBar) Blur() {
+	if b == nil {
+		return
+	}
+	b.FocusableBase.Blur()
+	b.input.Blur()
+}
+
+// Bind attaches app services. The debounce needs them to reach the UI
+// goroutine.
+func (b *FilterBar) Bind(services runtime.Services) {
+	if b == nil {
+		return
+	}
+	b.services = services
+	b.input.Bind(services)
+}
+
+// Unbind releases app services and drops any pending query.
+func (b *FilterBar) Unbind() {
+	if b == nil {
+		return
+	}
+	b.stopTimer()
+	b.services = runtime.Services{}
+	b.input.Unbind()
+}
+
+// edited schedules the typed text to apply once typing pauses.
+func (b *FilterBar) edited(string) {
+	b.syncA11y()
+	b.Invalidate()
+	scheduler := b.services.Scheduler()
+	if b.debounce <= 0 || scheduler == nil {
+		b.apply()
+		return
+	}
+	b.stopTimer()
+	b.pending++
+	pending := b.pending
+	b.timer = b.services.Clock().AfterFunc(b.debounce, func() {
+		scheduler.Schedule(func() {
+			if b.pending == pending {
+				b.apply()
+			}
+		})
+	})
+}
+
+func (b *FilterBar) stopTimer() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.pending++
+}
+
+// apply hands the typed text to the targets as the query.
+func (b *FilterBar) apply() {
+	b.stopTimer()
+	b.query = b.input.Text()
+	for _, target := range b.targets {
+		target.SetFilterQuery(b.query)
+	}
+	if b.onChange != nil {
+		b.onChange(b.query)
+	}
+	b.syncA11y()
+	b.Invalidate()
+	b.services.Invalidate()
+}
+
+// counts sums the match counts of the targets.
+func (b *FilterBar) counts() (shown, total int) {
+	for _, target := range b.targets {
+		s, t := target.FilterCount()
+		shown += s
+		total += t
+	}
+	return shown, total
+}
+
+// Measure returns a single row.
+func (b *FilterBar) Measure(constraints runtime.Constraints) runtime.Size {
+	return b.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		return contentConstraints.Constrain(runtime.Size{Width: contentConstraints.MaxWidth, Height: 1})
+	})
+}
+
+// Render draws the query and the match count.
+func (b *FilterBar) Render(ctx runtime.RenderContext) {
+	if b == nil {
+		return
+	}
+	b.syncA11y()
+	outer := b.bounds
+	content := b.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, b, backend.DefaultStyle(), b.focused), b.style)
+	countStyle := mergeBackendStyles(style, b.countStyle)
+	ctx.Buffer.Fill(outer, ' ', style)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+
+	right := content.X + content.Width
+	if len(b.targets) > 0 {
+		shown, total := b.counts()
+		count := fmt.Sprintf("%d of %d", shown, total)
+		if textWidth(count)+4 <= content.Width {
+			right -= textWidth(count)
+			ctx.Buffer.SetString(right, content.Y, count, countStyle)
+			right--
+		}
+	}
+
+	ctx.Buffer.SetString(content.X, content.Y, "/", countStyle)
+	x := content.X + 2
+	if right <= x {
+		return
+	}
+	b.input.Layout(runtime.Rect{X: x, Y: content.Y, Width: right - x, Height: 1})
+	b.input.Render(ctx)
+}
+
+// HandleMessage applies the query on Enter and clears it on Esc. Other
+// keys edit the text through the input.
+func (b *FilterBar) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if b == nil || !b.focused {
+		return runtime.Unhandled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	switch key.Key {
+	case terminal.KeyEscape:
+		if b.Text() == "" {
+			return runtime.Unhandled()
+		}
+		b.Clear()
+		return runtime.Handled()
+	case terminal.KeyEnter:
+		b.apply()
+		return runtime.Handled()
+	case terminal.KeyTab:
+		return runtime.Unhandled()
+	}
+	return b.input.HandleMessage(msg)
+}
+
+// syncInputStyle draws the input in the bar's text style.
+func (b *FilterBar) syncInputStyle() {
+	b.input.SetStyle(b.style)
+	b.input.SetFocusStyle(b.style)
+}
+
+func (b *FilterBar) syncA11y() {
+	if b == nil {
+		return
+	}
+	if b.Base.Role == "" {
+		b.Base.Role = accessibility.RoleTextbox
+	}
+	label := strings.TrimSpace(b.label)
+	if label == "" {
+		label = "Filter"
+	}
+	b.Base.Label = label
+	b.Base.Value = &accessibility.ValueInfo{Text: b.Text()}
+	if len(b.targets) > 0 {
+		shown, total := b.counts()
+		b.Base.Description = fmt.Sprintf("%d of %d", shown, total)
+	} else {
+		b.Base.Description = ""
+	}
+}
+
+var _ runtime.Widget = (*FilterBar)(nil)
+var _ runtime.Focusable = (*FilterBar)(nil)
+var _ runtime.Bindable = (*FilterBar)(nil)
+var _ FilterTarget = (*List[any])(nil)
+var _ FilterTarget = (*Table)(nil)
@@ -0,0 +1,149 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func typeFilter(bar *FilterBar, text string) {
+	for _, r := range text {
+		bar.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+}
+
+// bindFilterBar binds bar to an app on a manual clock. The returned advance
+// moves the clock and runs what the debounce scheduled, as the app loop
+// would.
+func bindFilterBar(bar *FilterBar) (advance func(time.Duration)) {
+	clock := runtime.NewManualClock()
+	app := runtime.NewApp(runtime.AppConfig{Clock: clock})
+	bar.Bind(app.Services())
+	return func(d time.Duration) {
+		clock.Advance(d)
+		app.StateQueue().Flush()
+	}
+}
+
+func TestFilterBarDebouncesList(t *testing.T) {
+	list := NewList[string](NewSliceAdapter([]string{"apple", "banana", "cherry", "grape"}, nil))
+	list.SetFilter(func(item, query string) bool { return strings.Contains(item, query) })
+	bar := NewFilterBar()
+	advance := bindFilterBar(bar)
+	bar.Attach(list)
+	bar.Focus()
+
+	typeFilter(bar, "ap")
+	if shown, total := list.FilterCount(); shown != 4 || total != 4 {
+		t.Fatalf("filter applied before the debounce: %d of %d", shown, total)
+	}
+	advance(filterBarDebounce - time.Millisecond)
+	if bar.Query() != "" {
+		t.Fatalf("query applied before the debounce: %q", bar.Query())
+	}
+	advance(time.Millisecond)
+	if bar.Query() != "ap" {
+		t.Fatalf("query = %q, want ap", bar.Query())
+	}
+	if shown, _ := list.FilterCount(); shown != 2 {
+		t.Fatalf("shown = %d, want apple and grape", shown)
+	}
+	if _, rows := renderRows(t, bar, 20, 1); rows[0] != "/ ap          2 of 4" {
+		t.Fatalf("bar = %q", rows[0])
+	}
+
+	bar.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if shown, _ := list.FilterCount(); shown != 4 || bar.Text() != "" {
+		t.Fatalf("expected Esc to clear the filter at once, shown = %d", shown)
+	}
+}
+
+func TestListFilterPreservesSelection(t *testing.T) {
+	list := NewList[string](NewSliceAdapter([]string{"apple", "banana", "cherry", "grape"}, nil))
+	list.SetFilter(func(item, query string) bool { return strings.Contains(item, query) })
+	list.SetSelected(3)
+
+	list.SetFilterQuery("ap")
+	if list.SelectedIndex() != 3 {
+		t.Fatalf("selected = %d, want grape kept at 3", list.SelectedIndex())
+	}
+	list.Focus()
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if item, _ := list.SelectedItem(); item != "apple" || list.SelectedIndex() != 0 {
+		t.Fatalf("Up selected %q at %d, want apple", item, list.SelectedIndex())
+	}
+
+	list.SetFilterQuery("an")
+	if item, _ := list.SelectedItem(); item != "banana" {
+		t.Fatalf("selected %q, want the first match when the selection is filtered out", item)
+	}
+	list.SetFilterQuery("zzz")
+	if _, ok := list.SelectedItem(); ok || list.SelectedIndex() != -1 {
+		t.Fatalf("expected no selection without matches")
+	}
+	list.SetFilterQuery("")
+	if shown, total := list.FilterCount(); shown != 4 || total != 4 {
+		t.Fatalf("clearing the filter shows %d of %d", shown, total)
+	}
+}
+
+func TestTableRowFilter(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"}, TableColumn{Title: "Team"})
+	table.SetRows([][]string{{"ada", "core"}, {"bob", "docs"}, {"cy", "core"}})
+	table.SetRowFilter(func(row []string, query string) bool { return row[1] == query })
+	table.SetSelected(2)
+	bar := NewFilterBar()
+	bar.SetDebounce(0)
+	bar.Attach(table)
+
+	bar.SetQuery("core")
+	if table.SelectedIndex() != 2 {
+		t.Fatalf("selected = %d, want cy kept at 2", table.SelectedIndex())
+	}
+	_, rows := renderRows(t, table, 12, 4)
+	if !strings.HasPrefix(rows[1], "ada") || !strings.HasPrefix(rows[2], "cy") || rows[3] != "" {
+		t.Fatalf("rows = %q, want only the core team", rows)
+	}
+	if got := table.SelectedRow(); got[0] != "cy" {
+		t.Fatalf("selected row = %v", got)
+	}
+
+	bar.Clear()
+	if shown, total := table.FilterCount(); shown != 3 || total != 3 || table.SelectedIndex() != 2 {
+		t.Fatalf("after clearing: %d of %d, selected %d", shown, total, table.SelectedIndex())
+	}
+}
+
+func TestFilterBarAppliesOnEnter(t *testing.T) {
+	bar := NewFilterBar()
+	bindFilterBar(bar)
+	bar.SetDebounce(time.Second)
+	var applied []string
+	bar.SetOnChange(func(query string) { applied = append(applied, query) })
+	bar.Focus()
+
+	typeFilter(bar, "log")
+	bar.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if len(applied) != 0 || bar.Text() != "lo" {
+		t.Fatalf("applied %v, text %q", applied, bar.Text())
+	}
+	bar.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if len(applied) != 1 || applied[0] != "lo" {
+		t.Fatalf("applied = %v, want [lo]", applied)
+	}
+}
+
+func TestFilterBarAppliesImmediatelyUnbound(t *testing.T) {
+	bar := NewFilterBar()
+	var applied []string
+	bar.SetOnChange(func(query string) { applied = append(applied, query) })
+	bar.Focus()
+
+	typeFilter(bar, "ab")
+	if len(applied) != 2 || applied[1] != "ab" {
+		t.Fatalf("applied = %v, want each edit applied without an app", applied)
+	}
+}
@@ -30,7 +30,11 @@ func HBox(children ...runtime.FlexChild) *runtime.Flex {
 // Flex child helpers - these delegate to runtime.
 func FlexFixed(w runtime.Widget) runtime.FlexChild { return runtime.Fixed(w) }
 func FlexFlexible(w runtime.Widget, grow float64) runtime.FlexChild { return runtime.Flexible(w, grow) }
+func FlexFlexibleShrink(w runtime.Widget, grow, shrink float64) runtime.FlexChild {
+	return runtime.FlexibleShrink(w, grow, shrink)
+}
 func FlexExpanded(w runtime.Widget) runtime.FlexChild { return runtime.Expanded(w) }
+func FlexConstrained(w runtime.Widget, min, max int) runtime.FlexChild { return runtime.Constrained(w, min, max) }
 func FlexSized(w runtime.Widget, basis int) runtime.FlexChild { return runtime.Sized(w, basis) }
 func FlexSpace() runtime.FlexChild { return runtime.Space() }
 func FlexFixedSpace(size int) runtime.FlexChild { return runtime.FixedSpace(size) }
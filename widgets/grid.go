@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/odvcencio/fluffyui/accessibility"
@@ -18,13 +19,27 @@ type GridChild struct {
 }
 
 // Grid lays out children in rows and columns.
+//
+// With breakpoints added, the grid instead flows its children left to right,
+// top to bottom in the order they were added, using the column count of the
+// widest breakpoint that fits its width (Cols below the narrowest). Each
+// child keeps its ColSpan, up to the column count; Row, Col, and RowSpan are
+// ignored. The grid re-flows whenever its width crosses a breakpoint, such
+// as when the terminal is resized.
 type Grid struct {
 	Base
-	Rows     int
-	Cols     int
-	Gap      int
-	Children []GridChild
-	label    string
+	Rows        int
+	Cols        int
+	Gap         int
+	Children    []GridChild
+	label       string
+	breakpoints []gridBreakpoint
+}
+
+// gridBreakpoint is the column count a flowing grid uses from minWidth.
+type gridBreakpoint struct {
+	minWidth int
+	cols     int
 }
 
 // NewGrid creates a grid with the given dimensions.
@@ -61,6 +76,78 @@ func (g *Grid) Add(child runtime.Widget, row, col, rowSpan, colSpan int) {
 	})
 }
 
+// AddBreakpoint makes the grid use cols columns when its width is at least
+// minWidth, and switches it to flow layout. Adding a breakpoint at an
+// existing width replaces it.
+func (g *Grid) AddBreakpoint(minWidth, cols int) {
+	if g == nil {
+		return
+	}
+	if cols <= 0 {
+		cols = 1
+	}
+	for i := range g.breakpoints {
+		if g.breakpoints[i].minWidth == minWidth {
+			g.breakpoints[i].cols = cols
+			g.Invalidate()
+			return
+		}
+	}
+	g.breakpoints = append(g.breakpoints, gridBreakpoint{minWidth: minWidth, cols: cols})
+	sort.Slice(g.breakpoints, func(i, j int) bool {
+		return g.breakpoints[i].minWidth < g.breakpoints[j].minWidth
+	})
+	g.Invalidate()
+}
+
+// Columns returns the column count at the grid's current width.
+func (g *Grid) Columns() int {
+	if g == nil {
+		return 0
+	}
+	return g.columnsFor(g.ContentBounds().Width)
+}
+
+// columnsFor returns the column count for a content width.
+func (g *Grid) columnsFor(width int) int {
+	cols := max(g.Cols, 1)
+	for _, bp := range g.breakpoints {
+		if width >= bp.minWidth {
+			cols = bp.cols
+		}
+	}
+	return cols
+}
+
+// cells returns the children with their cells for a content width, and the
+// row and column counts.
+func (g *Grid) cells(width int) ([]GridChild, int, int) {
+	cols := g.columnsFor(width)
+	if len(g.breakpoints) == 0 {
+		return g.Children, max(g.Rows, 1), cols
+	}
+	out := make([]GridChild, 0, len(g.Children))
+	row, col := 0, 0
+	for _, child := range g.Children {
+		if child.Widget == nil {
+			continue
+		}
+		span := clampInt(child.ColSpan, 1, cols)
+		if col > 0 && col+span > cols {
+			row, col = row+1, 0
+		}
+		out = append(out, GridChild{Widget: child.Widget, Row: row, Col: col, RowSpan: 1, ColSpan: span})
+		col += span
+		if col >= cols {
+			row, col = row+1, 0
+		}
+	}
+	if col > 0 {
+		row++
+	}
+	return out, max(row, 1), cols
+}
+
 // SetLabel updates the accessibility label.
 func (g *Grid) SetLabel(label string) {
 	if g == nil {
@@ -73,14 +160,7 @@ func (g *Grid) SetLabel(label string) {
 // Measure estimates the grid size.
 func (g *Grid) Measure(constraints runtime.Constraints) runtime.Size {
 	return g.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		rows := g.Rows
-		cols := g.Cols
-		if rows <= 0 {
-			rows = 1
-		}
-		if cols <= 0 {
-			cols = 1
-		}
+		_, rows, cols := g.cells(contentConstraints.MaxWidth)
 		maxW, maxH := 0, 0
 		for _, child := range g.Children {
 			if child.Widget == nil {
@@ -104,14 +184,7 @@ func (g *Grid) Measure(constraints runtime.Constraints) runtime.Size {
 func (g *Grid) Layout(bounds runtime.Rect) {
 	g.Base.Layout(bounds)
 	content := g.ContentBounds()
-	rows := g.Rows
-	cols := g.Cols
-	if rows <= 0 {
-		rows = 1
-	}
-	if cols <= 0 {
-		cols = 1
-	}
+	children, rows, cols := g.cells(content.Width)
 	totalGapW := g.Gap * max(0, cols-1)
 	totalGapH := g.Gap * max(0, rows-1)
 	cellW := 0
@@ -122,7 +195,7 @@ func (g *Grid) Layout(bounds runtime.Rect) {
 	if rows > 0 {
 		cellH = max(0, (content.Height-totalGapH)/rows)
 	}
-	for _, child := range g.Children {
+	for _, child := range children {
 		if child.Widget == nil {
 			continue
 		}
@@ -0,0 +1,64 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestGridBreakpointsReflowChildren(t *testing.T) {
+	grid := NewGrid(1, 1)
+	grid.AddBreakpoint(80, 4)
+	grid.AddBreakpoint(40, 2)
+	labels := make([]*Label, 4)
+	for i := range labels {
+		labels[i] = NewLabel("cell")
+		grid.Add(labels[i], 0, 0, 1, 1)
+	}
+
+	grid.Layout(runtime.Rect{Width: 100, Height: 10})
+	if grid.Columns() != 4 {
+		t.Fatalf("columns at 100 = %d, want 4", grid.Columns())
+	}
+	if got := labels[3].Bounds(); got != (runtime.Rect{X: 75, Width: 25, Height: 10}) {
+		t.Fatalf("fourth cell at 100 = %+v", got)
+	}
+
+	grid.Layout(runtime.Rect{Width: 60, Height: 10})
+	if grid.Columns() != 2 {
+		t.Fatalf("columns at 60 = %d, want 2", grid.Columns())
+	}
+	if got := labels[2].Bounds(); got != (runtime.Rect{Y: 5, Width: 30, Height: 5}) {
+		t.Fatalf("third cell at 60 = %+v, want it wrapped to the second row", got)
+	}
+
+	grid.Layout(runtime.Rect{Width: 30, Height: 8})
+	if grid.Columns() != 1 {
+		t.Fatalf("columns at 30 = %d, want the base column count", grid.Columns())
+	}
+	if got := labels[3].Bounds(); got != (runtime.Rect{Y: 6, Width: 30, Height: 2}) {
+		t.Fatalf("fourth cell at 30 = %+v", got)
+	}
+}
+
+func TestGridBreakpointFlowKeepsColSpan(t *testing.T) {
+	grid := NewGrid(1, 1)
+	grid.AddBreakpoint(0, 3)
+	wide := NewLabel("wide")
+	next := NewLabel("next")
+	after := NewLabel("after")
+	grid.Add(wide, 0, 0, 1, 2)
+	grid.Add(next, 0, 0, 1, 2)
+	grid.Add(after, 0, 0, 1, 5)
+
+	grid.Layout(runtime.Rect{Width: 30, Height: 9})
+	if got := wide.Bounds(); got != (runtime.Rect{Width: 20, Height: 3}) {
+		t.Fatalf("wide = %+v", got)
+	}
+	if got := next.Bounds(); got != (runtime.Rect{Y: 3, Width: 20, Height: 3}) {
+		t.Fatalf("next = %+v, want it wrapped because it does not fit", got)
+	}
+	if got := after.Bounds(); got != (runtime.Rect{Y: 6, Width: 30, Height: 3}) {
+		t.Fatalf("after = %+v, want its span clamped to the row", got)
+	}
+}
@@ -0,0 +1,446 @@
+package widgets
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
+)
+
+// ColorScale maps a value normalized to [0, 1] to a color.
+type ColorScale func(t float64) backend.Color
+
+// NewColorScale interpolates between evenly spaced RGB color stops.
+// Non-RGB stops are used as-is without blending.
+func NewColorScale(stops ...backend.Color) ColorScale {
+	stops = append([]backend.Color(nil), stops...)
+	return func(t float64) backend.Color {
+		switch len(stops) {
+		case 0:
+			return backend.ColorDefault
+		case 1:
+			return stops[0]
+		}
+		t = math.Max(0, math.Min(1, t))
+		pos := t * float64(len(stops)-1)
+		i := min(int(pos), len(stops)-2)
+		from, to := stops[i], stops[i+1]
+		frac := pos - float64(i)
+		if !from.IsRGB() || !to.IsRGB() {
+			if frac < 0.5 {
+				return from
+			}
+			return to
+		}
+		r1, g1, b1 := from.RGB()
+		r2, g2, b2 := to.RGB()
+		lerp := func(a, b uint8) uint8 {
+			return uint8(math.Round(float64(a) + (float64(b)-float64(a))*frac))
+		}
+		return backend.ColorRGB(lerp(r1, r2), lerp(g1, g2), lerp(b1, b2))
+	}
+}
+
+var (
+	// ViridisColorScale is a perceptually uniform purple-to-yellow scale.
+	ViridisColorScale = NewColorScale(
+		backend.ColorRGB(68, 1, 84),
+		backend.ColorRGB(59, 82, 139),
+		backend.ColorRGB(33, 145, 140),
+		backend.ColorRGB(94, 201, 98),
+		backend.ColorRGB(253, 231, 37),
+	)
+	// HeatColorScale runs from black through red and yellow to white.
+	HeatColorScale = NewColorScale(
+		backend.ColorRGB(0, 0, 0),
+		backend.ColorRGB(200, 30, 0),
+		backend.ColorRGB(255, 200, 0),
+		backend.ColorRGB(255, 255, 255),
+	)
+)
+
+// Heatmap renders a matrix of values as colored cells.
+type Heatmap struct {
+	Base
+	data      [][]float64
+	cols      int
+	scale     ColorScale
+	rowLabels []string
+	colLabels []string
+	minValue  float64
+	maxValue  float64
+	fixed     bool
+	smooth    bool
+	blitter   graphics.Blitter
+	canvas    *graphics.Canvas
+	grid      runtime.Rect
+	onHover   func(row, col int, value float64)
+	hoverRow  int
+	hoverCol  int
+	label     string
+}
+
+// NewHeatmap creates a heatmap. Rows may have different lengths; missing
+// and NaN values are left blank.
+func NewHeatmap(data [][]float64) *Heatmap {
+	h := &Heatmap{
+		scale:    ViridisColorScale,
+		smooth:   true,
+		hoverRow: -1,
+		hoverCol: -1,
+		label:    "Heatmap",
+	}
+	h.Base.Role = accessibility.RoleChart
+	h.SetData(data)
+	return h
+}
+
+// StyleType returns the selector type name.
+func (h *Heatmap) StyleType() string {
+	return "Heatmap"
+}
+
+// SetData replaces the matrix.
+func (h *Heatmap) SetData(data [][]float64) {
+	if h == nil {
+		return
+	}
+	h.data = make([][]float64, len(data))
+	h.cols = 0
+	for i, row := range data {
+		h.data[i] = append([]float64(nil), row...)
+		h.cols = max(h.cols, len(row))
+	}
+	h.syncA11y()
+	h.Invalidate()
+}
+
+// Data returns a copy of the matrix.
+func (h *Heatmap) Data() [][]float64 {
+	if h == nil {
+		return nil
+	}
+	out := make([][]float64, len(h.data))
+	for i, row := range h.data {
+		out[i] = append([]float64(nil), row...)
+	}
+	return out
+}
+
+// SetColorScale sets the mapping from values to colors. Nil restores
+// ViridisColorScale.
+func (h *Heatmap) SetColorScale(scale ColorScale) {
+	if h == nil {
+		return
+	}
+	if scale == nil {
+		scale = ViridisColorScale
+	}
+	h.scale = scale
+	h.Invalidate()
+}
+
+// SetRowLabels sets labels drawn left of each row.
+func (h *Heatmap) SetRowLabels(labels ...string) {
+	if h == nil {
+		return
+	}
+	h.rowLabels = append([]string(nil), labels...)
+	h.Invalidate()
+}
+
+// SetColumnLabels sets labels drawn above each column.
+func (h *Heatmap) SetColumnLabels(labels ...string) {
+	if h == nil {
+		return
+	}
+	h.colLabels = append([]string(nil), labels...)
+	h.Invalidate()
+}
+
+// SetRange clamps values to [minValue, maxValue] before coloring.
+func (h *Heatmap) SetRange(minValue, maxValue float64) {
+	if h == nil || maxValue <= minValue {
+		return
+	}
+	h.minValue, h.maxValue = minValue, maxValue
+	h.fixed = true
+	h.syncA11y()
+	h.Invalidate()
+}
+
+// AutoRange scales colors to the data's own minimum and maximum.
+func (h *Heatmap) AutoRange() {
+	if h == nil {
+		return
+	}
+	h.fixed = false
+	h.syncA11y()
+	h.Invalidate()
+}
+
+// Range returns the value range mapped onto the color scale.
+func (h *Heatmap) Range() (float64, float64) {
+	if h == nil {
+		return 0, 1
+	}
+	if h.fixed {
+		return h.minValue, h.maxValue
+	}
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	for _, row := range h.data {
+		for _, v := range row {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			minValue = math.Min(minValue, v)
+			maxValue = math.Max(maxValue, v)
+		}
+	}
+	if minValue > maxValue {
+		return 0, 1
+	}
+	if minValue == maxValue {
+		maxValue = minValue + 1
+	}
+	return minValue, maxValue
+}
+
+// SetSmooth enables interpolated gradients drawn with a pixel blitter when
+// each data cell spans several terminal cells. Disabled, or when the
+// terminal cannot draw colored pixels, cells use background colors.
+func (h *Heatmap) SetSmooth(smooth bool) {
+	if h == nil {
+		return
+	}
+	h.smooth = smooth
+	h.Invalidate()
+}
+
+// SetBlitter sets the blitter used for smooth gradients. By default the
+// best blitter for the terminal is detected on first use.
+func (h *Heatmap) SetBlitter(blitter graphics.Blitter) {
+	if h == nil {
+		return
+	}
+	h.blitter = blitter
+	h.canvas = nil
+	h.Invalidate()
+}
+
+// OnCellHover registers a callback for when the mouse moves onto a cell.
+func (h *Heatmap) OnCellHover(fn func(row, col int, value float64)) {
+	if h == nil {
+		return
+	}
+	h.onHover = fn
+}
+
+// Value returns the value at row, col, or NaN when out of range.
+func (h *Heatmap) Value(row, col int) float64 {
+	if h == nil || row < 0 || row >= len(h.data) || col < 0 || col >= len(h.data[row]) {
+		return math.NaN()
+	}
+	return h.data[row][col]
+}
+
+// CellAt maps screen coordinates to a matrix cell.
+func (h *Heatmap) CellAt(x, y int) (row, col int, ok bool) {
+	if h == nil || len(h.data) == 0 || h.cols == 0 || !h.grid.Contains(x, y) {
+		return 0, 0, false
+	}
+	row = (y - h.grid.Y) * len(h.data) / h.grid.Height
+	col = (x - h.grid.X) * h.cols / h.grid.Width
+	return row, col, true
+}
+
+// Measure requests one terminal cell per value plus room for labels.
+func (h *Heatmap) Measure(constraints runtime.Constraints) runtime.Size {
+	return h.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		size := runtime.Size{Width: h.labelWidth() + h.cols, Height: len(h.data)}
+		if len(h.colLabels) > 0 {
+			size.Height++
+		}
+		return contentConstraints.Constrain(size)
+	})
+}
+
+func (h *Heatmap) labelWidth() int {
+	width := 0
+	for _, label := range h.rowLabels {
+		width = max(width, text.Width(label))
+	}
+	if width > 0 {
+		width++
+	}
+	return width
+}
+
+// Render draws labels and the colored grid.
+func (h *Heatmap) Render(ctx runtime.RenderContext) {
+	if h == nil {
+		return
+	}
+	h.syncA11y()
+	content := h.ContentBounds()
+	h.grid = runtime.Rect{}
+	if content.Width <= 0 || content.Height <= 0 || len(h.data) == 0 || h.cols == 0 {
+		return
+	}
+	style := resolveBaseStyle(ctx, h, backend.DefaultStyle(), false)
+	grid := content
+	labelWidth := min(h.labelWidth(), content.Width-1)
+	grid.X += labelWidth
+	grid.Width -= labelWidth
+	if len(h.colLabels) > 0 && grid.Height > 1 {
+		grid.Y++
+		grid.Height--
+	}
+	h.grid = grid
+
+	for i, label := range h.rowLabels {
+		if i >= len(h.data) || labelWidth <= 1 {
+			break
+		}
+		top := grid.Y + i*grid.Height/len(h.data)
+		bottom := grid.Y + (i+1)*grid.Height/len(h.data)
+		if bottom <= top {
+			continue
+		}
+		ctx.Buffer.SetString(content.X, top+(bottom-top-1)/2, truncateString(label, labelWidth-1), style)
+	}
+	if grid.Y > content.Y {
+		for i, label := range h.colLabels {
+			if i >= h.cols {
+				break
+			}
+			left := grid.X + i*grid.Width/h.cols
+			right := grid.X + (i+1)*grid.Width/h.cols
+			if right <= left {
+				continue
+			}
+			ctx.Buffer.SetString(left, content.Y, truncateString(label, right-left), style)
+		}
+	}
+
+	minValue, maxValue := h.Range()
+	if h.useCanvas(grid) {
+		h.renderCanvas(ctx, grid, minValue, maxValue)
+		return
+	}
+	for y := 0; y < grid.Height; y++ {
+		row := y * len(h.data) / grid.Height
+		for x := 0; x < grid.Width; x++ {
+			col := x * h.cols / grid.Width
+			cellStyle := style
+			if v := h.Value(row, col); !math.IsNaN(v) {
+				cellStyle = style.Background(h.colorFor(v, minValue, maxValue))
+			}
+			ctx.Buffer.Set(grid.X+x, grid.Y+y, ' ', cellStyle)
+		}
+	}
+}
+
+func (h *Heatmap) colorFor(value, minValue, maxValue float64) backend.Color {
+	t := (value - minValue) / (maxValue - minValue)
+	return h.scale(math.Max(0, math.Min(1, t)))
+}
+
+// useCanvas reports whether the grid is large enough for interpolation
+// to add detail and a color blitter is available.
+func (h *Heatmap) useCanvas(grid runtime.Rect) bool {
+	if !h.smooth || grid.Width < 2*h.cols || grid.Height < 2*len(h.data) {
+		return false
+	}
+	if h.blitter == nil {
+		h.blitter = graphics.BestBlitter(nil)
+	}
+	return h.blitter.SupportsColor()
+}
+
+func (h *Heatmap) renderCanvas(ctx runtime.RenderContext, grid runtime.Rect, minValue, maxValue float64) {
+	if h.canvas == nil {
+		h.canvas = graphics.NewCanvasWithBlitter(grid.Width, grid.Height, h.blitter)
+	} else if w, ht := h.canvas.CellSize(); w != grid.Width || ht != grid.Height {
+		h.canvas = graphics.NewCanvasWithBlitter(grid.Width, grid.Height, h.blitter)
+	}
+	canvas := h.canvas
+	canvas.Clear()
+	pw, ph := canvas.Size()
+	rows := len(h.data)
+	for py := 0; py < ph; py++ {
+		fy := (float64(py)+0.5)/float64(ph)*float64(rows) - 0.5
+		for px := 0; px < pw; px++ {
+			fx := (float64(px)+0.5)/float64(pw)*float64(h.cols) - 0.5
+			v := h.interpolate(fy, fx)
+			if math.IsNaN(v) {
+				continue
+			}
+			canvas.SetPixel(px, py, h.colorFor(v, minValue, maxValue))
+		}
+	}
+	canvas.Render(ctx.Buffer, grid.X, grid.Y)
+}
+
+// interpolate samples the matrix bilinearly at fractional coordinates,
+// falling back to the nearest value next to missing data.
+func (h *Heatmap) interpolate(fy, fx float64) float64 {
+	fy = math.Max(0, math.Min(fy, float64(len(h.data)-1)))
+	fx = math.Max(0, math.Min(fx, float64(h.cols-1)))
+	r0, c0 := int(fy), int(fx)
+	r1, c1 := min(r0+1, len(h.data)-1), min(c0+1, h.cols-1)
+	ty, tx := fy-float64(r0), fx-float64(c0)
+	v00, v01 := h.Value(r0, c0), h.Value(r0, c1)
+	v10, v11 := h.Value(r1, c0), h.Value(r1, c1)
+	if math.IsNaN(v00) || math.IsNaN(v01) || math.IsNaN(v10) || math.IsNaN(v11) {
+		return h.Value(int(math.Round(fy)), int(math.Round(fx)))
+	}
+	top := v00 + (v01-v00)*tx
+	bottom := v10 + (v11-v10)*tx
+	return top + (bottom-top)*ty
+}
+
+// HandleMessage reports hovered cells.
+func (h *Heatmap) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if h == nil {
+		return runtime.Unhandled()
+	}
+	mouse, ok := msg.(runtime.MouseMsg)
+	if !ok || mouse.Action != runtime.MouseMove {
+		return runtime.Unhandled()
+	}
+	row, col, inside := h.CellAt(mouse.X, mouse.Y)
+	if !inside {
+		h.hoverRow, h.hoverCol = -1, -1
+		return runtime.Unhandled()
+	}
+	if row == h.hoverRow && col == h.hoverCol {
+		return runtime.Handled()
+	}
+	h.hoverRow, h.hoverCol = row, col
+	if h.onHover != nil {
+		h.onHover(row, col, h.Value(row, col))
+	}
+	return runtime.Handled()
+}
+
+func (h *Heatmap) syncA11y() {
+	if h == nil {
+		return
+	}
+	if h.Base.Role == "" {
+		h.Base.Role = accessibility.RoleChart
+	}
+	h.Base.Label = h.label
+	h.Base.Description = fmt.Sprintf("%d rows by %d columns", len(h.data), h.cols)
+	minValue, maxValue := h.Range()
+	h.Base.Value = &accessibility.ValueInfo{
+		Text: fmt.Sprintf("range %s to %s", formatFloat(minValue), formatFloat(maxValue)),
+	}
+}
+
+var _ runtime.Widget = (*Heatmap)(nil)
@@ -0,0 +1,92 @@
+package widgets
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestColorScaleInterpolates(t *testing.T) {
+	scale := NewColorScale(backend.ColorRGB(0, 0, 0), backend.ColorRGB(200, 100, 0))
+	if got := scale(0.5); got != backend.ColorRGB(100, 50, 0) {
+		t.Fatalf("scale(0.5) = %v", got)
+	}
+	if got := scale(2); got != backend.ColorRGB(200, 100, 0) {
+		t.Fatalf("scale(2) should clamp, got %v", got)
+	}
+}
+
+func TestHeatmapRendersCells(t *testing.T) {
+	black := backend.ColorRGB(0, 0, 0)
+	white := backend.ColorRGB(255, 255, 255)
+	heatmap := NewHeatmap([][]float64{{0, 10}, {5, math.NaN()}})
+	heatmap.SetColorScale(NewColorScale(black, white))
+	heatmap.SetRowLabels("a", "bb")
+	heatmap.SetColumnLabels("x", "y")
+
+	buf, rows := renderRows(t, heatmap, 5, 3)
+	if !strings.HasPrefix(rows[1], "a") || !strings.HasPrefix(rows[2], "bb") {
+		t.Fatalf("row labels = %q", rows)
+	}
+	if got := buf.Get(3, 0).Rune; got != 'x' {
+		t.Fatalf("column label = %q, want x", got)
+	}
+	if bg := buf.Get(3, 1).Style.BG(); bg != black {
+		t.Fatalf("min cell bg = %v, want black", bg)
+	}
+	if bg := buf.Get(4, 1).Style.BG(); bg != white {
+		t.Fatalf("max cell bg = %v, want white", bg)
+	}
+	if bg := buf.Get(4, 2).Style.BG(); bg == white || bg == black {
+		t.Fatalf("NaN cell should be blank, got %v", bg)
+	}
+}
+
+func TestHeatmapRangeClamp(t *testing.T) {
+	heatmap := NewHeatmap([][]float64{{-5, 50}})
+	heatmap.SetRange(0, 10)
+	heatmap.SetColorScale(NewColorScale(backend.ColorRGB(0, 0, 0), backend.ColorRGB(100, 100, 100)))
+	buf, _ := renderRows(t, heatmap, 2, 1)
+	if bg := buf.Get(0, 0).Style.BG(); bg != backend.ColorRGB(0, 0, 0) {
+		t.Fatalf("below range = %v", bg)
+	}
+	if bg := buf.Get(1, 0).Style.BG(); bg != backend.ColorRGB(100, 100, 100) {
+		t.Fatalf("above range = %v", bg)
+	}
+	if lo, hi := heatmap.Range(); lo != 0 || hi != 10 {
+		t.Fatalf("range = %v..%v", lo, hi)
+	}
+}
+
+func TestHeatmapHover(t *testing.T) {
+	heatmap := NewHeatmap([][]float64{{1, 2}, {3, 4}})
+	heatmap.SetSmooth(false)
+	var got []float64
+	heatmap.OnCellHover(func(row, col int, value float64) {
+		got = append(got, value)
+	})
+	renderRows(t, heatmap, 4, 4)
+
+	heatmap.HandleMessage(runtime.MouseMsg{X: 3, Y: 3, Action: runtime.MouseMove})
+	heatmap.HandleMessage(runtime.MouseMsg{X: 2, Y: 2, Action: runtime.MouseMove})
+	heatmap.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Action: runtime.MouseMove})
+	if len(got) != 2 || got[0] != 4 || got[1] != 1 {
+		t.Fatalf("hover values = %v, want [4 1]", got)
+	}
+	if result := heatmap.HandleMessage(runtime.MouseMsg{X: 9, Y: 9, Action: runtime.MouseMove}); result.Handled {
+		t.Fatalf("hover outside the grid should be unhandled")
+	}
+}
+
+func TestHeatmapSmoothUsesBlitter(t *testing.T) {
+	heatmap := NewHeatmap([][]float64{{0, 1}, {1, 0}})
+	heatmap.SetBlitter(&graphics.HalfBlockBlitter{})
+	buf, _ := renderRows(t, heatmap, 8, 4)
+	if r := buf.Get(0, 0).Rune; r != '▀' && r != '▄' && r != '█' {
+		t.Fatalf("expected half-block pixels, got %q", r)
+	}
+}
@@ -0,0 +1,377 @@
+package widgets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/keybind"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// HelpCommandID is the command Install registers to open the help overlay.
+const HelpCommandID = "help.show"
+
+const (
+	helpOverlayMaxWidth = 72
+	helpOverlayKeyWidth = 18
+)
+
+// helpRow is a category header or a command line in the help overlay.
+type helpRow struct {
+	header      string
+	keys        string
+	title       string
+	description string
+}
+
+// HelpOverlay lists the commands in a registry with the keys bound to them
+// in a keymap stack, grouped by category. Typing filters the list; arrows,
+// Page Up/Down, and the mouse wheel scroll it; Esc closes it.
+//
+// Push it as a modal overlay, or call Install to open it with "?".
+type HelpOverlay struct {
+	FocusableBase
+	registry *keybind.CommandRegistry
+	stack    *keybind.KeymapStack
+	title    string
+	query    string
+	rows     []helpRow
+	offset   int
+	box      runtime.Rect
+	visible  int // command rows shown at the last layout
+
+	headerStyle backend.Style
+	keyStyle    backend.Style
+	descStyle   backend.Style
+}
+
+// NewHelpOverlay creates a help overlay for the commands in registry. Key
+// hints come from every keymap in stack, which may be nil.
+func NewHelpOverlay(registry *keybind.CommandRegistry, stack *keybind.KeymapStack) *HelpOverlay {
+	h := &HelpOverlay{
+		registry:    registry,
+		stack:       stack,
+		title:       "Keyboard Shortcuts",
+		headerStyle: backend.DefaultStyle().Bold(true),
+		keyStyle:    backend.DefaultStyle().Foreground(backend.ColorCyan),
+		descStyle:   backend.DefaultStyle().Dim(true),
+	}
+	h.Base.Role = accessibility.RoleDialog
+	h.Refresh()
+	return h
+}
+
+// Install registers HelpCommandID in the registry and binds "?" to it on
+// the keymap stack. The command pushes the overlay as a modal layer.
+func (h *HelpOverlay) Install() {
+	if h == nil || h.registry == nil {
+		return
+	}
+	h.registry.Register(h.Command())
+	if h.stack != nil {
+		h.stack.Push(&keybind.Keymap{
+			Name: "help",
+			Bindings: []keybind.Binding{
+				{Key: keybind.MustParseKeySequence("?"), Command: HelpCommandID},
+				{Key: keybind.MustParseKeySequence("shift+?"), Command: HelpCommandID},
+			},
+		})
+	}
+}
+
+// Command returns the command that opens the overlay.
+func (h *HelpOverlay) Command() keybind.Command {
+	return keybind.Command{
+		ID:          HelpCommandID,
+		Title:       "Keyboard Shortcuts",
+		Description: "Show commands and their keys",
+		Category:    "Help",
+		Handler: func(ctx keybind.Context) {
+			if ctx.App == nil {
+				return
+			}
+			h.Reset()
+			ctx.App.ExecuteCommand(runtime.PushOverlay{Widget: h, Modal: true, Scrim: runtime.ScrimDim})
+		},
+	}
+}
+
+// SetTitle updates the overlay title.
+func (h *HelpOverlay) SetTitle(title string) {
+	if h == nil {
+		return
+	}
+	h.title = title
+}
+
+// SetStyles updates the category header, key, and description styles.
+func (h *HelpOverlay) SetStyles(header, keys, description backend.Style) {
+	if h == nil {
+		return
+	}
+	h.headerStyle = header
+	h.keyStyle = keys
+	h.descStyle = description
+}
+
+// Query returns the current filter text.
+func (h *HelpOverlay) Query() string {
+	if h == nil {
+		return ""
+	}
+	return h.query
+}
+
+// SetQuery filters the list to commands matching query.
+func (h *HelpOverlay) SetQuery(query string) {
+	if h == nil {
+		return
+	}
+	h.query = query
+	h.Refresh()
+}
+
+// Reset clears the filter and scrolls to the top.
+func (h *HelpOverlay) Reset() {
+	if h == nil {
+		return
+	}
+	h.query = ""
+	h.offset = 0
+	h.Refresh()
+}
+
+// Refresh rebuilds the list from the registry and keymaps.
+func (h *HelpOverlay) Refresh() {
+	if h == nil {
+		return
+	}
+	var shortcuts map[string][]keybind.Key
+	if h.stack != nil {
+		shortcuts = keybind.CommandShortcuts(h.stack.All()...)
+	}
+	commands := h.registry.List()
+	sort.Slice(commands, func(i, j int) bool {
+		ci, cj := helpCategory(commands[i]), helpCategory(commands[j])
+		if ci != cj {
+			return ci < cj
+		}
+		return commandTitle(commands[i]) < commandTitle(commands[j])
+	})
+	query := strings.ToLower(strings.TrimSpace(h.query))
+	h.rows = h.rows[:0]
+	category := ""
+	for _, cmd := range commands {
+		row := helpRow{
+			keys:        keybind.FormatKeySequences(shortcuts[cmd.ID]),
+			title:       commandTitle(cmd),
+			description: cmd.Description,
+		}
+		if query != "" && !helpMatches(query, cmd, row) {
+			continue
+		}
+		if c := helpCategory(cmd); c != category || len(h.rows) == 0 {
+			category = c
+			h.rows = append(h.rows, helpRow{header: c})
+		}
+		h.rows = append(h.rows, row)
+	}
+	h.scrollBy(0)
+	h.syncA11y()
+	h.Invalidate()
+}
+
+func helpCategory(cmd keybind.Command) string {
+	if category := strings.TrimSpace(cmd.Category); category != "" {
+		return category
+	}
+	return "General"
+}
+
+func helpMatches(query string, cmd keybind.Command, row helpRow) bool {
+	for _, field := range []string{row.title, row.description, row.keys, cmd.ID, helpCategory(cmd)} {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Measure fills the available space so the list can be centered.
+func (h *HelpOverlay) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout centers the help box within bounds.
+func (h *HelpOverlay) Layout(bounds runtime.Rect) {
+	h.Base.Layout(bounds)
+	width := min(helpOverlayMaxWidth, bounds.Width)
+	// The border (carrying the title), search line, and separator take
+	// four rows.
+	height := min(max(len(h.rows), 1)+4, bounds.Height)
+	h.box = runtime.Rect{
+		X:      bounds.X + (bounds.Width-width)/2,
+		Y:      bounds.Y + (bounds.Height-height)/2,
+		Width:  width,
+		Height: height,
+	}
+	h.visible = max(0, height-4)
+	h.scrollBy(0)
+}
+
+// Render draws the help box.
+func (h *HelpOverlay) Render(ctx runtime.RenderContext) {
+	if h == nil {
+		return
+	}
+	h.syncA11y()
+	b := h.box
+	if b.Width < 10 || b.Height < 5 {
+		return
+	}
+	baseStyle := resolveBaseStyle(ctx, h, backend.DefaultStyle(), false)
+	ctx.Buffer.Fill(b, ' ', baseStyle)
+	ctx.Buffer.DrawRoundedBox(b, baseStyle)
+
+	inner := b.Inset(1, 1, 1, 1)
+	title := " " + truncateString(h.title, inner.Width-2) + " "
+	ctx.Buffer.SetString(b.X+(b.Width-textWidth(title))/2, b.Y, title, baseStyle.Bold(true))
+
+	search := "/ " + h.query
+	searchStyle := baseStyle
+	if h.query == "" {
+		search = "Type to filter"
+		searchStyle = mergeBackendStyles(baseStyle, h.descStyle)
+	}
+	ctx.Buffer.SetString(inner.X+1, inner.Y, truncateString(search, inner.Width-2), searchStyle)
+	for x := inner.X; x < inner.X+inner.Width; x++ {
+		ctx.Buffer.Set(x, inner.Y+1, '─', baseStyle)
+	}
+
+	headerStyle := mergeBackendStyles(baseStyle, h.headerStyle)
+	keyStyle := mergeBackendStyles(baseStyle, h.keyStyle)
+	descStyle := mergeBackendStyles(baseStyle, h.descStyle)
+	keyWidth := min(helpOverlayKeyWidth, inner.Width/3)
+	y := inner.Y + 2
+	if len(h.rows) == 0 {
+		ctx.Buffer.SetString(inner.X+1, y, truncateString("No matching commands", inner.Width-2), descStyle)
+		return
+	}
+	end := min(len(h.rows), h.offset+h.visible)
+	for _, row := range h.rows[h.offset:end] {
+		if row.header != "" {
+			ctx.Buffer.SetString(inner.X+1, y, truncateString(row.header, inner.Width-2), headerStyle)
+			y++
+			continue
+		}
+		x := inner.X + 3
+		ctx.Buffer.SetString(x, y, truncateString(row.keys, keyWidth), keyStyle)
+		x += keyWidth + 1
+		room := inner.X + inner.Width - 1 - x
+		title := truncateString(row.title, room)
+		ctx.Buffer.SetString(x, y, title, baseStyle)
+		if row.description != "" {
+			x += textWidth(title) + 2
+			room = inner.X + inner.Width - 1 - x
+			if room > 0 {
+				ctx.Buffer.SetString(x, y, truncateString(row.description, room), descStyle)
+			}
+		}
+		y++
+	}
+	if len(h.rows) > h.visible {
+		position := fmt.Sprintf(" %d-%d of %d ", h.offset+1, end, len(h.rows))
+		ctx.Buffer.SetString(b.X+b.Width-1-textWidth(position), b.Y+b.Height-1, position, baseStyle)
+	}
+}
+
+// HandleMessage filters, scrolls, and closes the overlay.
+func (h *HelpOverlay) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if h == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		switch mouse.Button {
+		case runtime.MouseWheelUp:
+			h.scrollBy(-3)
+		case runtime.MouseWheelDown:
+			h.scrollBy(3)
+		}
+		return runtime.Handled()
+	}
+	key, ok := msg.(runtime.KeyMsg)
+	if !ok {
+		return runtime.Unhandled()
+	}
+	switch key.Key {
+	case terminal.KeyEscape:
+		return runtime.WithCommand(runtime.PopOverlay{})
+	case terminal.KeyUp:
+		h.scrollBy(-1)
+	case terminal.KeyDown:
+		h.scrollBy(1)
+	case terminal.KeyPageUp:
+		h.scrollBy(-max(1, h.visible-1))
+	case terminal.KeyPageDown:
+		h.scrollBy(max(1, h.visible-1))
+	case terminal.KeyHome:
+		h.offset = 0
+	case terminal.KeyEnd:
+		h.scrollBy(len(h.rows))
+	case terminal.KeyBackspace:
+		if h.query != "" {
+			runes := []rune(h.query)
+			h.SetQuery(string(runes[:len(runes)-1]))
+		}
+	case terminal.KeyRune:
+		if key.Ctrl || key.Alt {
+			break
+		}
+		if key.Rune == '?' && h.query == "" {
+			return runtime.WithCommand(runtime.PopOverlay{})
+		}
+		h.offset = 0
+		h.SetQuery(h.query + string(key.Rune))
+	}
+	// The overlay is modal: keep keys from reaching the app below.
+	h.Invalidate()
+	return runtime.Handled()
+}
+
+// scrollBy moves the list by delta rows, keeping it in range.
+func (h *HelpOverlay) scrollBy(delta int) {
+	h.offset = clampInt(h.offset+delta, 0, max(0, len(h.rows)-h.visible))
+}
+
+// HitSelf routes mouse hits within the overlay to the help box.
+func (h *HelpOverlay) HitSelf() bool {
+	return true
+}
+
+func (h *HelpOverlay) syncA11y() {
+	if h == nil {
+		return
+	}
+	if h.Base.Role == "" {
+		h.Base.Role = accessibility.RoleDialog
+	}
+	h.Base.Label = h.title
+	commands := 0
+	for _, row := range h.rows {
+		if row.header == "" {
+			commands++
+		}
+	}
+	if h.query != "" {
+		h.Base.Description = fmt.Sprintf("%d commands matching %q", commands, h.query)
+	} else {
+		h.Base.Description = fmt.Sprintf("%d commands", commands)
+	}
+}
+
+var _ runtime.Widget = (*HelpOverlay)(nil)
+var _ runtime.Focusable = (*HelpOverlay)(nil)
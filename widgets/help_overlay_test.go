@@ -0,0 +1,101 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/keybind"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func newTestHelpOverlay() *HelpOverlay {
+	registry := keybind.NewRegistry()
+	registry.RegisterAll(
+		keybind.Command{ID: "file.save", Title: "Save", Description: "Write the buffer", Category: "File"},
+		keybind.Command{ID: "file.open", Title: "Open", Category: "File"},
+		keybind.Command{ID: "view.zoom", Title: "Zoom", Category: "View"},
+		keybind.Command{ID: "app.quit", Title: "Quit"},
+	)
+	stack := &keybind.KeymapStack{}
+	stack.Push(&keybind.Keymap{Name: "app", Bindings: []keybind.Binding{
+		{Key: keybind.MustParseKeySequence("ctrl+s"), Command: "file.save"},
+	}})
+	return NewHelpOverlay(registry, stack)
+}
+
+func TestHelpOverlay_GroupsByCategory(t *testing.T) {
+	h := newTestHelpOverlay()
+	var got []string
+	for _, row := range h.rows {
+		if row.header != "" {
+			got = append(got, "["+row.header+"]")
+		} else {
+			got = append(got, row.title)
+		}
+	}
+	want := "[File] Open Save [General] Quit [View] Zoom"
+	if strings.Join(got, " ") != want {
+		t.Fatalf("rows = %v, want %s", got, want)
+	}
+
+	_, rows := renderRows(t, h, 60, 12)
+	screen := strings.Join(rows, "\n")
+	for _, text := range []string{"Keyboard Shortcuts", "File", "Save", "Write the buffer"} {
+		if !strings.Contains(screen, text) {
+			t.Fatalf("expected %q in:\n%s", text, screen)
+		}
+	}
+}
+
+func TestHelpOverlay_Search(t *testing.T) {
+	h := newTestHelpOverlay()
+	for _, r := range "buffer" {
+		h.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if len(h.rows) != 2 || h.rows[0].header != "File" || h.rows[1].title != "Save" {
+		t.Fatalf("rows = %+v, want File/Save", h.rows)
+	}
+	h.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if h.Query() != "buffe" {
+		t.Fatalf("query = %q", h.Query())
+	}
+	h.SetQuery("nothing")
+	if len(h.rows) != 0 {
+		t.Fatalf("expected no rows, got %+v", h.rows)
+	}
+}
+
+func TestHelpOverlay_Dismiss(t *testing.T) {
+	h := newTestHelpOverlay()
+	for _, msg := range []runtime.KeyMsg{{Key: terminal.KeyEscape}, {Key: terminal.KeyRune, Rune: '?'}} {
+		result := h.HandleMessage(msg)
+		if len(result.Commands) != 1 {
+			t.Fatalf("%+v: expected a command", msg)
+		}
+		if _, ok := result.Commands[0].(runtime.PopOverlay); !ok {
+			t.Fatalf("%+v: command = %T, want PopOverlay", msg, result.Commands[0])
+		}
+	}
+}
+
+func TestHelpOverlay_Scroll(t *testing.T) {
+	h := newTestHelpOverlay()
+	h.Layout(runtime.Rect{Width: 60, Height: 6})
+	if h.visible != 2 {
+		t.Fatalf("visible = %d, want 2", h.visible)
+	}
+	h.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd})
+	if h.offset != len(h.rows)-2 {
+		t.Fatalf("offset = %d, want %d", h.offset, len(h.rows)-2)
+	}
+	h.HandleMessage(runtime.MouseMsg{Button: runtime.MouseWheelUp})
+	h.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if h.offset != len(h.rows)-6 {
+		t.Fatalf("offset = %d, want %d", h.offset, len(h.rows)-6)
+	}
+	h.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome})
+	if h.offset != 0 {
+		t.Fatalf("offset = %d, want 0", h.offset)
+	}
+}
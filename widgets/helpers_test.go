@@ -0,0 +1,27 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	flufftest "github.com/odvcencio/fluffyui/testing"
+)
+
+// renderRows renders w at width x height and returns the buffer with each
+// row as text, trailing spaces trimmed.
+func renderRows(t *testing.T, w runtime.Widget, width, height int) (*runtime.Buffer, []string) {
+	t.Helper()
+	buf := flufftest.LayoutAndRender(w, width, height)
+	rows := make([]string, height)
+	for y := range rows {
+		var line strings.Builder
+		for x := 0; x < width; x++ {
+			if r := buf.Get(x, y).Rune; r != 0 {
+				line.WriteRune(r)
+			}
+		}
+		rows[y] = strings.TrimRight(line.String(), " ")
+	}
+	return buf, rows
+}
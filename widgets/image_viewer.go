@@ -0,0 +1,370 @@
+package widgets
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// ImageFit controls how an ImageViewer scales its image at zoom 1.
+type ImageFit int
+
+const (
+	// FitContain scales the image to fit inside the viewer, keeping its
+	// aspect ratio.
+	FitContain ImageFit = iota
+	// FitFill stretches the image to fill the viewer.
+	FitFill
+	// FitNone draws the image at one image pixel per canvas pixel.
+	FitNone
+)
+
+const (
+	imageViewerMinZoom  = 0.1
+	imageViewerMaxZoom  = 16.0
+	imageViewerZoomStep = 1.25
+)
+
+// ImageViewer shows an image.Image with the best blitter the terminal
+// supports. The image is scaled by its fit and then by the zoom; + and -
+// zoom, 0 resets, and the arrow keys, Page Up/Down, and the mouse wheel
+// scroll when the image is larger than the viewer.
+type ImageViewer struct {
+	FocusableBase
+	img     image.Image
+	blitter graphics.Blitter
+	fit     ImageFit
+	zoom    float64
+	scrollX int
+	scrollY int
+	label   string
+
+	canvas     *graphics.Canvas
+	cellWidth  int
+	cellHeight int
+}
+
+// ImageViewerOption configures an image viewer.
+type ImageViewerOption = Option[ImageViewer]
+
+// WithImageViewerBlitter overrides the blitter chosen for the terminal.
+func WithImageViewerBlitter(blitter graphics.Blitter) ImageViewerOption {
+	return func(v *ImageViewer) {
+		if v == nil {
+			return
+		}
+		v.SetBlitter(blitter)
+	}
+}
+
+// WithImageFit sets how the image is scaled.
+func WithImageFit(fit ImageFit) ImageViewerOption {
+	return func(v *ImageViewer) {
+		if v == nil {
+			return
+		}
+		v.fit = fit
+	}
+}
+
+// NewImageViewer creates a viewer for img, fitted inside its bounds.
+func NewImageViewer(img image.Image, opts ...ImageViewerOption) *ImageViewer {
+	v := &ImageViewer{
+		img:   img,
+		zoom:  1,
+		label: "Image",
+	}
+	v.Base.Role = accessibility.RoleGroup
+	for _, opt := range opts {
+		if opt != nil {
+			opt(v)
+		}
+	}
+	v.syncA11y()
+	return v
+}
+
+// SetImage replaces the image and resets the zoom and scroll.
+func (v *ImageViewer) SetImage(img image.Image) {
+	if v == nil {
+		return
+	}
+	v.img = img
+	v.zoom = 1
+	v.scrollX, v.scrollY = 0, 0
+	v.syncA11y()
+	v.Invalidate()
+}
+
+// Image returns the displayed image.
+func (v *ImageViewer) Image() image.Image {
+	if v == nil {
+		return nil
+	}
+	return v.img
+}
+
+// SetBlitter sets the blitter used to draw pixels to cells. Nil picks the
+// best one for the terminal.
+func (v *ImageViewer) SetBlitter(blitter graphics.Blitter) {
+	if v == nil {
+		return
+	}
+	v.blitter = blitter
+	v.canvas = nil
+	v.Invalidate()
+}
+
+// SetFit sets how the image is scaled at zoom 1.
+func (v *ImageViewer) SetFit(fit ImageFit) {
+	if v == nil {
+		return
+	}
+	v.fit = fit
+	v.scrollBy(0, 0)
+	v.syncA11y()
+	v.Invalidate()
+}
+
+// Fit returns how the image is scaled.
+func (v *ImageViewer) Fit() ImageFit {
+	if v == nil {
+		return FitContain
+	}
+	return v.fit
+}
+
+// Zoom sets the zoom factor on top of the fit, where 1 shows the fitted
+// image. It is clamped between 0.1 and 16.
+func (v *ImageViewer) Zoom(factor float64) {
+	if v == nil || factor <= 0 || math.IsNaN(factor) {
+		return
+	}
+	v.zoom = math.Min(math.Max(factor, imageViewerMinZoom), imageViewerMaxZoom)
+	v.scrollBy(0, 0)
+	v.syncA11y()
+	v.Invalidate()
+}
+
+// ZoomLevel returns the zoom factor.
+func (v *ImageViewer) ZoomLevel() float64 {
+	if v == nil {
+		return 1
+	}
+	return v.zoom
+}
+
+// Scroll returns the scroll offset in canvas pixels.
+func (v *ImageViewer) Scroll() (x, y int) {
+	if v == nil {
+		return 0, 0
+	}
+	return v.scrollX, v.scrollY
+}
+
+// SetLabel updates the accessibility label.
+func (v *ImageViewer) SetLabel(label string) {
+	if v == nil {
+		return
+	}
+	v.label = label
+	v.syncA11y()
+}
+
+// StyleType returns the selector type name.
+func (v *ImageViewer) StyleType() string {
+	return "ImageViewer"
+}
+
+// Measure fills the available space.
+func (v *ImageViewer) Measure(constraints runtime.Constraints) runtime.Size {
+	return v.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		return contentConstraints.Constrain(contentConstraints.MaxSize())
+	})
+}
+
+// Layout sizes the canvas to the content bounds.
+func (v *ImageViewer) Layout(bounds runtime.Rect) {
+	v.Base.Layout(bounds)
+	content := v.ContentBounds()
+	if content.Width <= 0 || content.Height <= 0 {
+		v.canvas = nil
+		v.cellWidth, v.cellHeight = 0, 0
+		return
+	}
+	if v.canvas == nil || content.Width != v.cellWidth || content.Height != v.cellHeight {
+		blitter := v.blitter
+		if blitter == nil {
+			blitter = graphics.BestBlitter(nil)
+		}
+		v.canvas = graphics.NewCanvasWithBlitter(content.Width, content.Height, blitter)
+		v.cellWidth, v.cellHeight = content.Width, content.Height
+	}
+	v.scrollBy(0, 0)
+}
+
+// Render draws the visible part of the image, centered when it is smaller
+// than the viewer.
+func (v *ImageViewer) Render(ctx runtime.RenderContext) {
+	if v == nil {
+		return
+	}
+	v.syncA11y()
+	content := v.ContentBounds()
+	if v.canvas == nil || content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	v.canvas.Clear()
+	if v.img != nil {
+		canvasW, canvasH := v.canvas.Size()
+		w, h := v.scaledSize()
+		x, y := -v.scrollX, -v.scrollY
+		if w < canvasW {
+			x = (canvasW - w) / 2
+		}
+		if h < canvasH {
+			y = (canvasH - h) / 2
+		}
+		v.canvas.DrawImageScaled(x, y, w, h, v.img)
+	}
+	v.canvas.Render(ctx.Buffer, content.X, content.Y)
+}
+
+// scaledSize returns the image size in canvas pixels after fit and zoom.
+func (v *ImageViewer) scaledSize() (int, int) {
+	if v.img == nil {
+		return 0, 0
+	}
+	bounds := v.img.Bounds()
+	w, h := float64(bounds.Dx()), float64(bounds.Dy())
+	if w <= 0 || h <= 0 {
+		return 0, 0
+	}
+	canvasW, canvasH := v.canvas.Size()
+	switch v.fit {
+	case FitContain:
+		if canvasW > 0 && canvasH > 0 {
+			scale := math.Min(float64(canvasW)/w, float64(canvasH)/h)
+			w, h = w*scale, h*scale
+		}
+	case FitFill:
+		if canvasW > 0 && canvasH > 0 {
+			w, h = float64(canvasW), float64(canvasH)
+		}
+	}
+	return max(1, int(math.Round(w*v.zoom))), max(1, int(math.Round(h*v.zoom)))
+}
+
+// HandleMessage zooms and scrolls.
+func (v *ImageViewer) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if v == nil {
+		return runtime.Unhandled()
+	}
+	cellW, cellH := v.pixelsPerCell()
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		switch m.Button {
+		case runtime.MouseWheelUp:
+			v.scrollBy(0, -3*cellH)
+		case runtime.MouseWheelDown:
+			v.scrollBy(0, 3*cellH)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.KeyMsg:
+		if !v.focused {
+			return runtime.Unhandled()
+		}
+		page := max(1, v.ContentBounds().Height-1) * cellH
+		switch m.Key {
+		case terminal.KeyUp:
+			v.scrollBy(0, -cellH)
+		case terminal.KeyDown:
+			v.scrollBy(0, cellH)
+		case terminal.KeyLeft:
+			v.scrollBy(-cellW, 0)
+		case terminal.KeyRight:
+			v.scrollBy(cellW, 0)
+		case terminal.KeyPageUp:
+			v.scrollBy(0, -page)
+		case terminal.KeyPageDown:
+			v.scrollBy(0, page)
+		case terminal.KeyRune:
+			switch m.Rune {
+			case '+', '=':
+				v.Zoom(v.zoom * imageViewerZoomStep)
+			case '-':
+				v.Zoom(v.zoom / imageViewerZoomStep)
+			case '0':
+				v.Zoom(1)
+			default:
+				return runtime.Unhandled()
+			}
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// pixelsPerCell returns the canvas resolution of one cell.
+func (v *ImageViewer) pixelsPerCell() (int, int) {
+	if v.canvas == nil {
+		return 1, 1
+	}
+	pixelW, pixelH := v.canvas.Size()
+	cellW, cellH := v.canvas.CellSize()
+	if cellW <= 0 || cellH <= 0 {
+		return 1, 1
+	}
+	return max(1, pixelW/cellW), max(1, pixelH/cellH)
+}
+
+// scrollBy moves the view by dx, dy canvas pixels, keeping the image
+// edges within the viewer.
+func (v *ImageViewer) scrollBy(dx, dy int) {
+	if v.canvas == nil {
+		return
+	}
+	canvasW, canvasH := v.canvas.Size()
+	w, h := v.scaledSize()
+	x := clampInt(v.scrollX+dx, 0, max(0, w-canvasW))
+	y := clampInt(v.scrollY+dy, 0, max(0, h-canvasH))
+	if x != v.scrollX || y != v.scrollY {
+		v.scrollX, v.scrollY = x, y
+		v.Invalidate()
+	}
+}
+
+func (v *ImageViewer) syncA11y() {
+	if v == nil {
+		return
+	}
+	if v.Base.Role == "" {
+		v.Base.Role = accessibility.RoleGroup
+	}
+	label := strings.TrimSpace(v.label)
+	if label == "" {
+		label = "Image"
+	}
+	v.Base.Label = label
+	if v.img == nil {
+		v.Base.Value = nil
+		return
+	}
+	bounds := v.img.Bounds()
+	v.Base.Value = &accessibility.ValueInfo{
+		Text: fmt.Sprintf("%d by %d pixels, %.0f%% zoom", bounds.Dx(), bounds.Dy(), v.zoom*100),
+	}
+}
+
+var _ runtime.Widget = (*ImageViewer)(nil)
+var _ runtime.Focusable = (*ImageViewer)(nil)
@@ -0,0 +1,68 @@
+package widgets
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func solidImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	return img
+}
+
+func TestImageViewerFits(t *testing.T) {
+	viewer := NewImageViewer(solidImage(4, 2), WithImageViewerBlitter(&graphics.BrailleBlitter{}))
+
+	// The 8x4 pixel image is centered in the 8x8 canvas.
+	buf, _ := renderRows(t, viewer, 4, 2)
+	if top, bottom := buf.Get(0, 0).Rune, buf.Get(0, 1).Rune; top != '⣤' || bottom != '⠛' {
+		t.Fatalf("cells = %q %q, want the contained image centered", top, bottom)
+	}
+
+	viewer.SetFit(FitFill)
+	buf, _ = renderRows(t, viewer, 4, 2)
+	if got := buf.Get(3, 1).Rune; got != '⣿' {
+		t.Fatalf("bottom-right = %q, want the filled image to cover the viewer", got)
+	}
+}
+
+func TestImageViewerZoomAndScroll(t *testing.T) {
+	viewer := NewImageViewer(solidImage(8, 8), WithImageViewerBlitter(&graphics.BrailleBlitter{}))
+	viewer.Focus()
+	renderRows(t, viewer, 4, 2)
+
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if x, _ := viewer.Scroll(); x != 0 {
+		t.Fatalf("scroll x = %d, want no scrolling while the image fits", x)
+	}
+
+	viewer.Zoom(2)
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	if x, y := viewer.Scroll(); x != 2 || y != 8 {
+		t.Fatalf("scroll = %d,%d, want one cell right and the bottom edge", x, y)
+	}
+
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '-'})
+	if viewer.ZoomLevel() != 1.6 {
+		t.Fatalf("zoom = %v after -", viewer.ZoomLevel())
+	}
+	if _, y := viewer.Scroll(); y != 5 {
+		t.Fatalf("scroll y = %d, want it clamped to the smaller image", y)
+	}
+	viewer.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '0'})
+	if x, y := viewer.Scroll(); viewer.ZoomLevel() != 1 || x != 0 || y != 0 {
+		t.Fatal("expected 0 to reset the zoom and scroll")
+	}
+}
@@ -29,6 +29,8 @@ type Input struct {
 	validators  []forms.Validator
 	valErrors   []forms.ValidationError
 	valMessages []string
+	async       inputAsync
+	keyBindings *InputKeyBindings
 
 	// Callbacks
 	onSubmit func(text string)
@@ -111,14 +113,22 @@ func (i *Input) SetValidators(validators ...forms.Validator) {
 		return
 	}
 	i.validators = validators
+	i.async.setValidators(validators)
+	i.validateAsync()
 }
 
-// Validate runs validation rules and returns validation errors.
+// Validate runs validation rules and returns validation errors. Errors
+// from async validators appear once their check for the current text
+// finishes.
 func (i *Input) Validate() []forms.ValidationError {
 	if i == nil {
 		return nil
 	}
 	errs, messages := validateValue(i.Text(), i.validators)
+	if len(errs) == 0 && len(i.async.errs) > 0 {
+		errs = append([]forms.ValidationError(nil), i.async.errs...)
+		messages = validationMessages(errs)
+	}
 	i.valErrors = errs
 	i.valMessages = messages
 	return errs
@@ -165,6 +175,7 @@ func (i *Input) SetText(text string) {
 	i.text.WriteString(text)
 	i.cursorPos = runeCount(text)
 	i.syncA11y()
+	i.validateAsync()
 }
 
 // Clear clears the input text.
@@ -172,6 +183,7 @@ func (i *Input) Clear() {
 	i.text.Reset()
 	i.cursorPos = 0
 	i.syncA11y()
+	i.validateAsync()
 }
 
 // CursorPos returns the current cursor position.
@@ -275,6 +287,7 @@ func (i *Input) Render(ctx runtime.RenderContext) {
 	if content.Width == 0 || content.Height == 0 {
 		return
 	}
+	content = i.renderValidating(ctx, content, style)
 
 	text := i.text.String()
 	runes := []rune(text)
@@ -310,7 +323,7 @@ func (i *Input) Render(ctx runtime.RenderContext) {
 	}
 
 	// Draw text with selection highlighting
-	selectionStyle := style.Reverse(true)
+	selectionStyle := selectionStyleFor(style)
 	sel := i.selection.Normalize()
 	hasSelection := !i.selection.IsEmpty()
 
@@ -343,6 +356,10 @@ func (i *Input) Render(ctx runtime.RenderContext) {
 
 // HandleMessage processes keyboard input.
 func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if _, ok := msg.(runtime.TickMsg); ok {
+		i.tickValidating()
+		return runtime.Unhandled()
+	}
 	if !i.focused {
 		return runtime.Unhandled()
 	}
@@ -351,6 +368,9 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if !ok {
 		return runtime.Unhandled()
 	}
+	if i.handleEditingKey(key) {
+		return runtime.Handled()
+	}
 
 	switch key.Key {
 	case terminal.KeyCtrlC:
@@ -409,6 +429,14 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyLeft:
+		if key.Shift {
+			if key.Ctrl {
+				i.extendSelection(i.wordBoundaryLeft())
+			} else {
+				i.extendSelection(i.cursorPos - 1)
+			}
+			return runtime.Handled()
+		}
 		if i.collapseSelection(true) {
 			return runtime.Handled()
 		}
@@ -421,6 +449,14 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyRight:
+		if key.Shift {
+			if key.Ctrl {
+				i.extendSelection(i.wordBoundaryRight())
+			} else {
+				i.extendSelection(i.cursorPos + 1)
+			}
+			return runtime.Handled()
+		}
 		if i.collapseSelection(false) {
 			return runtime.Handled()
 		}
@@ -433,6 +469,10 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyHome:
+		if key.Shift {
+			i.extendSelection(0)
+			return runtime.Handled()
+		}
 		if i.HasSelection() {
 			i.selection = Selection{}
 		}
@@ -440,6 +480,10 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyEnd:
+		if key.Shift {
+			i.extendSelection(len(i.textRunes()))
+			return runtime.Handled()
+		}
 		if i.HasSelection() {
 			i.selection = Selection{}
 		}
@@ -447,6 +491,10 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Handled()
 
 	case terminal.KeyRune:
+		if key.Ctrl {
+			// Unbound Ctrl+letter shortcuts are not text.
+			return runtime.Unhandled()
+		}
 		// Insert character
 		if i.HasSelection() {
 			i.deleteSelection()
@@ -477,6 +525,7 @@ func (i *Input) HandleMessage(msg runtime.Message) runtime.HandleResult {
 
 func (i *Input) notifyChange() {
 	i.syncA11y()
+	i.validateAsync()
 	if i.onChange != nil {
 		i.onChange(i.text.String())
 	}
@@ -713,6 +762,37 @@ func (i *Input) SelectLine() {
 	i.SelectAll() // Single-line input has only one line
 }
 
+// Selection returns the selected rune range with start <= end. Both are
+// equal to the cursor offset when nothing is selected.
+func (i *Input) Selection() (start, end int) {
+	if i == nil {
+		return 0, 0
+	}
+	if i.selection.IsEmpty() {
+		return i.cursorPos, i.cursorPos
+	}
+	sel := i.selection.Normalize()
+	return sel.Start, sel.End
+}
+
+// SelectedText returns the selected text.
+func (i *Input) SelectedText() string {
+	return i.GetSelectedText()
+}
+
+// extendSelection moves the cursor to pos, keeping the selection anchored
+// where it started.
+func (i *Input) extendSelection(pos int) {
+	pos = clampInt(pos, 0, len(i.textRunes()))
+	anchor := i.cursorPos
+	if !i.selection.IsEmpty() {
+		anchor = selectionAnchor(i.selection, i.cursorPos)
+	}
+	i.selection = Selection{Start: anchor, End: pos}
+	i.cursorPos = pos
+	i.services.Invalidate()
+}
+
 // HasSelection returns true if text is selected.
 func (i *Input) HasSelection() bool {
 	if i == nil {
@@ -771,48 +851,11 @@ func findWordBoundaries(text []rune, pos int) (start, end int) {
 }
 
 func (i *Input) wordBoundaryLeft() int {
-	runes := i.textRunes()
-	if len(runes) == 0 {
-		return 0
-	}
-	pos := i.cursorPos - 1
-	if pos < 0 {
-		return 0
-	}
-	if pos >= len(runes) {
-		pos = len(runes) - 1
-	}
-
-	// Skip whitespace
-	for pos > 0 && runes[pos] == ' ' {
-		pos--
-	}
-	// Skip word characters
-	for pos > 0 && runes[pos-1] != ' ' {
-		pos--
-	}
-	return pos
+	return graphemeWordLeft(i.textRunes(), i.cursorPos)
 }
 
 func (i *Input) wordBoundaryRight() int {
-	runes := i.textRunes()
-	pos := i.cursorPos
-	if pos < 0 {
-		pos = 0
-	}
-	if pos > len(runes) {
-		pos = len(runes)
-	}
-
-	// Skip word characters
-	for pos < len(runes) && runes[pos] != ' ' {
-		pos++
-	}
-	// Skip whitespace
-	for pos < len(runes) && runes[pos] == ' ' {
-		pos++
-	}
-	return pos
+	return graphemeWordRight(i.textRunes(), i.cursorPos)
 }
 
 func multilineWordBoundaryLeft(text []rune, cursor int) int {
@@ -1378,6 +1421,9 @@ func (m *MultilineInput) HandleMessage(msg runtime.Message) runtime.HandleResult
 		return runtime.Handled()
 
 	case terminal.KeyRune:
+		if key.Ctrl {
+			return runtime.Unhandled()
+		}
 		if m.HasSelection() {
 			m.deleteSelection()
 		}
@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/forms"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+// inputAsync tracks the async validators of an Input. Checks run in the
+// background; their results are applied on the app loop.
+type inputAsync struct {
+	check      *forms.FieldBase
+	errs       []forms.ValidationError
+	validating bool
+	frame      int
+}
+
+// setValidators cancels any running check and keeps a field base to run
+// the async validators in validators, if there are any.
+func (a *inputAsync) setValidators(validators []forms.Validator) {
+	if a.check != nil {
+		a.check.CancelAsync()
+	}
+	*a = inputAsync{}
+	check := forms.NewFieldBase("", nil, validators...)
+	if check.HasAsyncValidators() {
+		a.check = &check
+	}
+}
+
+// Validating reports whether an async validator is checking the current
+// text. The input shows a spinner while it is.
+func (i *Input) Validating() bool {
+	return i != nil && i.async.validating
+}
+
+// validateAsync restarts the async validators for the current text once
+// the synchronous validators pass. A change while a check runs cancels it.
+func (i *Input) validateAsync() {
+	check := i.async.check
+	if check == nil {
+		return
+	}
+	check.CancelAsync()
+	i.async.errs = nil
+	text := i.Text()
+	if errs, _ := validateValue(text, i.validators); len(errs) > 0 {
+		i.setValidating(false)
+		return
+	}
+	scheduler := i.services.Scheduler()
+	i.setValidating(check.StartValidateAsync(text, func(errs []forms.ValidationError) {
+		apply := func() {
+			if i.async.check != check || i.Text() != text {
+				return
+			}
+			i.async.errs = errs
+			i.setValidating(false)
+		}
+		if scheduler == nil {
+			apply()
+			return
+		}
+		scheduler.Schedule(apply)
+	}))
+}
+
+func (i *Input) setValidating(inFlight bool) {
+	if i.async.validating == inFlight {
+		return
+	}
+	i.async.validating = inFlight
+	i.async.frame = 0
+	i.services.Invalidate()
+}
+
+// tickValidating advances the spinner while a check is in flight.
+func (i *Input) tickValidating() {
+	if !i.async.validating {
+		return
+	}
+	i.async.frame++
+	i.services.Invalidate()
+}
+
+// renderValidating draws the spinner at the right edge of content while a
+// check is in flight and returns the space left for the text.
+func (i *Input) renderValidating(ctx runtime.RenderContext, content runtime.Rect, style backend.Style) runtime.Rect {
+	frames := SpinnerFrames[SpinnerLine]
+	if !i.async.validating || len(frames) == 0 || content.Width < 3 {
+		return content
+	}
+	frame := frames[i.async.frame%len(frames)]
+	ctx.Buffer.SetString(content.X+content.Width-1, content.Y, frame, style)
+	content.Width -= 2
+	return content
+}
@@ -0,0 +1,63 @@
+package widgets
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/forms"
+	"github.com/odvcencio/fluffyui/testing/widgettest"
+)
+
+func TestInputAsyncValidatorShowsSpinner(t *testing.T) {
+	release := make(chan struct{})
+	in := NewInput()
+	in.SetValidators(
+		forms.Required("required"),
+		forms.AsyncValidator(func(ctx context.Context, value any) error {
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if value == "ab" {
+				return errors.New("taken")
+			}
+			return nil
+		}),
+	)
+	in.Focus()
+	h := widgettest.New(t, in, 20, 1)
+
+	spinnerShown := func() bool {
+		r, _, _ := h.Backend.CaptureCell(19, 0)
+		return r != ' ' && r != 0
+	}
+	waitFor := func(what string, cond func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for !cond() {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %s; screen:\n%s", what, h.Capture())
+			}
+			h.Wait(5 * time.Millisecond)
+		}
+	}
+
+	h.InjectKeyString("ab")
+	waitFor("spinner", spinnerShown)
+	close(release)
+	waitFor("spinner to clear", func() bool { return !spinnerShown() })
+	h.Close()
+
+	if in.Validating() {
+		t.Fatalf("expected validation to have finished")
+	}
+	if errs := in.Errors(); len(errs) != 1 || errs[0] != "taken" {
+		t.Fatalf("Errors() = %v, want [taken]", errs)
+	}
+	if in.Valid() {
+		t.Fatalf("expected async error to fail validation")
+	}
+}
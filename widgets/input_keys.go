@@ -0,0 +1,184 @@
+package widgets
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/keybind"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// InputKeyBindings maps Input line-editing actions to key presses. An
+// action with no keys is disabled.
+type InputKeyBindings struct {
+	LineStart      []keybind.KeyPress
+	LineEnd        []keybind.KeyPress
+	WordLeft       []keybind.KeyPress
+	WordRight      []keybind.KeyPress
+	DeleteWordBack []keybind.KeyPress
+	DeleteToStart  []keybind.KeyPress
+	DeleteToEnd    []keybind.KeyPress
+}
+
+// ReadlineKeyBindings returns the default Emacs/readline bindings:
+// Ctrl+A/Ctrl+E for line start/end, Alt+B/Alt+F for word left/right,
+// Ctrl+W to delete the previous word, and Ctrl+U/Ctrl+K to delete to the
+// start/end of the line.
+func ReadlineKeyBindings() InputKeyBindings {
+	ctrl := func(r rune) []keybind.KeyPress {
+		return []keybind.KeyPress{{Key: terminal.KeyRune, Rune: r, Ctrl: true}}
+	}
+	alt := func(r rune) []keybind.KeyPress {
+		return []keybind.KeyPress{{Key: terminal.KeyRune, Rune: r, Alt: true}}
+	}
+	return InputKeyBindings{
+		LineStart:      ctrl('a'),
+		LineEnd:        ctrl('e'),
+		WordLeft:       alt('b'),
+		WordRight:      alt('f'),
+		DeleteWordBack: ctrl('w'),
+		DeleteToStart:  ctrl('u'),
+		DeleteToEnd:    ctrl('k'),
+	}
+}
+
+// SetKeyBindings replaces the line-editing bindings. Pass
+// InputKeyBindings{} to disable them.
+func (i *Input) SetKeyBindings(bindings InputKeyBindings) {
+	if i == nil {
+		return
+	}
+	i.keyBindings = &bindings
+}
+
+// KeyBindings returns the active line-editing bindings.
+func (i *Input) KeyBindings() InputKeyBindings {
+	if i == nil || i.keyBindings == nil {
+		return ReadlineKeyBindings()
+	}
+	return *i.keyBindings
+}
+
+// handleEditingKey runs the line-editing action bound to key, if any.
+func (i *Input) handleEditingKey(key runtime.KeyMsg) bool {
+	press := editingKeyPress(key)
+	bindings := i.KeyBindings()
+	switch {
+	case matchesKeyPress(bindings.LineStart, press):
+		i.selection = Selection{}
+		i.cursorPos = 0
+	case matchesKeyPress(bindings.LineEnd, press):
+		i.selection = Selection{}
+		i.cursorPos = len(i.textRunes())
+	case matchesKeyPress(bindings.WordLeft, press):
+		i.selection = Selection{}
+		i.cursorPos = i.wordBoundaryLeft()
+	case matchesKeyPress(bindings.WordRight, press):
+		i.selection = Selection{}
+		i.cursorPos = i.wordBoundaryRight()
+	case matchesKeyPress(bindings.DeleteWordBack, press):
+		if i.HasSelection() {
+			i.deleteSelection()
+		} else {
+			i.deleteRange(i.wordBoundaryLeft(), i.cursorPos)
+		}
+	case matchesKeyPress(bindings.DeleteToStart, press):
+		i.selection = Selection{}
+		i.deleteRange(0, i.cursorPos)
+	case matchesKeyPress(bindings.DeleteToEnd, press):
+		i.selection = Selection{}
+		i.deleteRange(i.cursorPos, len(i.textRunes()))
+	default:
+		return false
+	}
+	i.services.Invalidate()
+	return true
+}
+
+// deleteRange removes runes [start, end) and leaves the cursor at start.
+func (i *Input) deleteRange(start, end int) {
+	runes := i.textRunes()
+	start = clampInt(start, 0, len(runes))
+	end = clampInt(end, start, len(runes))
+	if start == end {
+		i.cursorPos = start
+		return
+	}
+	runes = append(runes[:start], runes[end:]...)
+	i.setTextRunes(runes)
+	i.cursorPos = start
+	i.notifyChange()
+}
+
+// editingKeyPress normalizes key for binding lookup. Terminals report
+// Ctrl+letter combinations without a named key as KeyNone with the letter
+// as the rune.
+func editingKeyPress(key runtime.KeyMsg) keybind.KeyPress {
+	press := keybind.KeyPressFromKeyMsg(key)
+	if press.Key == terminal.KeyNone && press.Rune != 0 && (press.Ctrl || press.Alt) {
+		press.Key = terminal.KeyRune
+	}
+	if press.Key == terminal.KeyRune && press.Ctrl {
+		press.Rune = unicode.ToLower(press.Rune)
+	}
+	return press
+}
+
+func matchesKeyPress(presses []keybind.KeyPress, press keybind.KeyPress) bool {
+	for _, candidate := range presses {
+		if candidate.Equal(press) {
+			return true
+		}
+	}
+	return false
+}
+
+// clusterOffsets returns the rune offset of each grapheme cluster
+// boundary in runes, starting with 0 and ending with len(runes).
+func clusterOffsets(runes []rune) []int {
+	offsets := []int{0}
+	pos := 0
+	backend.ForEachCluster(string(runes), func(cluster string, _ int) bool {
+		pos += utf8.RuneCountInString(cluster)
+		offsets = append(offsets, pos)
+		return true
+	})
+	return offsets
+}
+
+// graphemeWordLeft returns the start of the word before pos, skipping
+// whitespace first. It never splits a grapheme cluster.
+func graphemeWordLeft(runes []rune, pos int) int {
+	offsets := clusterOffsets(runes)
+	idx := len(offsets) - 1
+	for idx > 0 && offsets[idx] > pos {
+		idx--
+	}
+	for idx > 0 && unicode.IsSpace(runes[offsets[idx-1]]) {
+		idx--
+	}
+	for idx > 0 && !unicode.IsSpace(runes[offsets[idx-1]]) {
+		idx--
+	}
+	return offsets[idx]
+}
+
+// graphemeWordRight returns the start of the word after pos. It never
+// splits a grapheme cluster.
+func graphemeWordRight(runes []rune, pos int) int {
+	offsets := clusterOffsets(runes)
+	last := len(offsets) - 1
+	idx := 0
+	for idx < last && offsets[idx] < pos {
+		idx++
+	}
+	for idx < last && !unicode.IsSpace(runes[offsets[idx]]) {
+		idx++
+	}
+	for idx < last && unicode.IsSpace(runes[offsets[idx]]) {
+		idx++
+	}
+	return offsets[idx]
+}
@@ -0,0 +1,127 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/keybind"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func ctrlKey(r rune) runtime.KeyMsg {
+	return runtime.KeyMsg{Key: terminal.KeyRune, Rune: r, Ctrl: true}
+}
+
+func altKey(r rune) runtime.KeyMsg {
+	return runtime.KeyMsg{Key: terminal.KeyRune, Rune: r, Alt: true}
+}
+
+func TestInputReadlineBindings(t *testing.T) {
+	in := NewInput()
+	in.Focus()
+	in.SetText("hello big world")
+	in.SetCursorOffset(8)
+
+	in.HandleMessage(ctrlKey('a'))
+	if in.CursorOffset() != 0 {
+		t.Fatalf("Ctrl+A: cursor = %d, want 0", in.CursorOffset())
+	}
+	in.HandleMessage(ctrlKey('e'))
+	if in.CursorOffset() != 15 {
+		t.Fatalf("Ctrl+E: cursor = %d, want 15", in.CursorOffset())
+	}
+	in.HandleMessage(altKey('b'))
+	if in.CursorOffset() != 10 {
+		t.Fatalf("Alt+B: cursor = %d, want 10", in.CursorOffset())
+	}
+	in.HandleMessage(altKey('b'))
+	in.HandleMessage(altKey('f'))
+	if in.CursorOffset() != 10 {
+		t.Fatalf("Alt+F: cursor = %d, want 10", in.CursorOffset())
+	}
+
+	in.HandleMessage(ctrlKey('w'))
+	if in.Text() != "hello world" || in.CursorOffset() != 6 {
+		t.Fatalf("Ctrl+W: text = %q cursor = %d", in.Text(), in.CursorOffset())
+	}
+	in.HandleMessage(ctrlKey('k'))
+	if in.Text() != "hello " {
+		t.Fatalf("Ctrl+K: text = %q", in.Text())
+	}
+	in.SetCursorOffset(3)
+	in.HandleMessage(ctrlKey('u'))
+	if in.Text() != "lo " || in.CursorOffset() != 0 {
+		t.Fatalf("Ctrl+U: text = %q cursor = %d", in.Text(), in.CursorOffset())
+	}
+}
+
+func TestInputBindingsFromTerminal(t *testing.T) {
+	in := NewInput()
+	in.Focus()
+	in.SetText("abc")
+	// tcell reports Ctrl+A as a control key with no named terminal key.
+	in.HandleMessage(runtime.KeyMsg{Key: terminal.KeyNone, Rune: 'a', Ctrl: true})
+	if in.CursorOffset() != 0 {
+		t.Fatalf("cursor = %d, want 0", in.CursorOffset())
+	}
+}
+
+func TestInputWordBoundariesGraphemes(t *testing.T) {
+	in := NewInput()
+	in.Focus()
+	// "e" + combining acute accent and a flag made of two regional indicators.
+	in.SetText("cafe\u0301 \U0001F1EF\U0001F1F5x")
+	in.HandleMessage(ctrlKey('w'))
+	if in.Text() != "cafe\u0301 " {
+		t.Fatalf("Ctrl+W: text = %q", in.Text())
+	}
+	in.HandleMessage(altKey('b'))
+	if in.CursorOffset() != 0 {
+		t.Fatalf("Alt+B: cursor = %d", in.CursorOffset())
+	}
+	in.SetCursorOffset(4)
+	in.HandleMessage(altKey('f'))
+	if in.CursorOffset() != 6 {
+		t.Fatalf("Alt+F from inside a cluster: cursor = %d, want 6", in.CursorOffset())
+	}
+}
+
+func TestInputKeyBindingsOverride(t *testing.T) {
+	in := NewInput()
+	in.Focus()
+	in.SetKeyBindings(InputKeyBindings{})
+	in.SetText("abc")
+	if res := in.HandleMessage(runtime.KeyMsg{Key: terminal.KeyNone, Rune: 'a', Ctrl: true}); res.Handled {
+		t.Fatalf("expected disabled bindings to leave Ctrl+A unhandled")
+	}
+	if in.CursorOffset() != 3 {
+		t.Fatalf("cursor moved to %d", in.CursorOffset())
+	}
+
+	bindings := ReadlineKeyBindings()
+	bindings.LineStart = []keybind.KeyPress{{Key: terminal.KeyHome, Alt: true}}
+	in.SetKeyBindings(bindings)
+	in.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome, Alt: true})
+	if in.CursorOffset() != 0 {
+		t.Fatalf("custom binding: cursor = %d", in.CursorOffset())
+	}
+}
+
+func TestInputIgnoresUnboundCtrlLetters(t *testing.T) {
+	in := NewInput()
+	in.Focus()
+	in.SetText("ab")
+	if res := in.HandleMessage(ctrlKey('g')); res.Handled {
+		t.Fatalf("expected unbound Ctrl+G to be unhandled")
+	}
+	if in.Text() != "ab" {
+		t.Fatalf("Ctrl+G typed into input: %q", in.Text())
+	}
+
+	area := NewTextArea()
+	area.Focus()
+	area.HandleMessage(ctrlKey('g'))
+	if area.Text() != "" {
+		t.Fatalf("Ctrl+G typed into text area: %q", area.Text())
+	}
+}
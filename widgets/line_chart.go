@@ -8,33 +8,54 @@ import (
 	"github.com/odvcencio/fluffyui/runtime"
 )
 
-// ChartSeries represents a line chart series.
+// ChartSeries represents a line chart series. NaN values leave a gap in
+// the line. All series share the X axis: sample i sits at the same column
+// in every series, spaced over the longest series, so a shorter series
+// ends early rather than stretching to the right edge.
 type ChartSeries struct {
+	Label  string
 	Data   []float64
 	Color  backend.Color
 	Smooth bool
 	Fill   bool
+	// Secondary plots the series against the secondary Y axis.
+	Secondary bool
 }
 
 // Axis controls min/max scaling for chart values.
+//
+// Deprecated: use AxisConfig with SetYAxis.
 type Axis struct {
 	Min  float64
 	Max  float64
 	Auto bool
 }
 
+// AxisConfig converts a to an AxisConfig. Auto leaves Min and Max unset.
+func (a Axis) AxisConfig() AxisConfig {
+	if a.Auto {
+		return AxisConfig{}
+	}
+	return AxisConfig{Min: a.Min, Max: a.Max}
+}
+
 // LineChart renders one or more series using a CanvasWidget.
 type LineChart struct {
 	CanvasWidget
-	series []ChartSeries
-	yAxis  Axis
-	label  string
+	series     []ChartSeries
+	xAxis      AxisConfig
+	yAxis      AxisConfig
+	y2Axis     AxisConfig
+	showX      bool
+	showY      bool
+	showY2     bool
+	label      string
+	plotBounds runtime.Rect
 }
 
 // NewLineChart creates an empty line chart.
 func NewLineChart() *LineChart {
 	chart := &LineChart{
-		yAxis: Axis{Auto: true},
 		label: "Line Chart",
 	}
 	chart.CanvasWidget = *NewCanvasWidget(chart.drawChart)
@@ -62,12 +83,14 @@ func (c *LineChart) AddSeries(series ChartSeries) {
 	c.Invalidate()
 }
 
-// SetYAxis fixes the Y axis range.
-func (c *LineChart) SetYAxis(minValue, maxValue float64) {
+// SetYRange fixes the Y axis range without showing the axis.
+//
+// Deprecated: use SetYAxis with AxisConfig{Min: minValue, Max: maxValue}.
+func (c *LineChart) SetYRange(minValue, maxValue float64) {
 	if c == nil {
 		return
 	}
-	c.yAxis = Axis{Min: minValue, Max: maxValue, Auto: false}
+	c.yAxis.Min, c.yAxis.Max = minValue, maxValue
 	c.Invalidate()
 }
 
@@ -76,7 +99,7 @@ func (c *LineChart) AutoYAxis() {
 	if c == nil {
 		return
 	}
-	c.yAxis.Auto = true
+	c.yAxis.Min, c.yAxis.Max = 0, 0
 	c.Invalidate()
 }
 
@@ -89,46 +112,62 @@ func (c *LineChart) drawChart(canvas *graphics.Canvas) {
 		return
 	}
 
-	minY, maxY := c.yAxis.Min, c.yAxis.Max
-	if c.yAxis.Auto {
-		minY, maxY = chartSeriesRange(c.series)
+	scales := c.scales(c.plotBounds.Width, c.plotBounds.Height)
+	if c.showX && c.xAxis.Grid {
+		drawGridColumns(canvas, scales.x, w, h)
 	}
-	if maxY == minY {
-		maxY = minY + 1
+	if c.showY && c.yAxis.Grid {
+		drawGridRows(canvas, scales.y, w, h)
 	}
 
+	count := chartSeriesLength(c.series)
 	for _, s := range c.series {
-		points := chartSeriesPoints(s.Data, w, h, minY, maxY)
-		if len(points) < 2 {
-			continue
+		scale := scales.y
+		if s.Secondary {
+			scale = scales.y2
 		}
-		canvas.SetStrokeColor(s.Color)
-		if s.Smooth {
-			canvas.DrawSpline(points)
-		} else {
-			for i := 1; i < len(points); i++ {
-				canvas.DrawLineAA(points[i-1].X, points[i-1].Y, points[i].X, points[i].Y)
+		for _, points := range chartSeriesSegments(s.Data, count, w, h, scale.min, scale.max) {
+			if len(points) < 2 {
+				for _, p := range points {
+					canvas.SetPixel(p.X, p.Y, s.Color)
+				}
+				continue
+			}
+			canvas.SetStrokeColor(s.Color)
+			if s.Smooth {
+				canvas.DrawSpline(points)
+			} else {
+				for i := 1; i < len(points); i++ {
+					canvas.DrawLineAA(points[i-1].X, points[i-1].Y, points[i].X, points[i].Y)
+				}
+			}
+			if s.Fill {
+				fillPoints := make([]graphics.Point, 0, len(points)+2)
+				fillPoints = append(fillPoints, points...)
+				fillPoints = append(fillPoints,
+					graphics.Point{X: points[len(points)-1].X, Y: h - 1},
+					graphics.Point{X: points[0].X, Y: h - 1},
+				)
+				canvas.SetFillColor(dimColor(s.Color, 0.3))
+				canvas.FillPolygon(fillPoints)
 			}
-		}
-		if s.Fill {
-			fillPoints := make([]graphics.Point, 0, len(points)+2)
-			fillPoints = append(fillPoints, points...)
-			fillPoints = append(fillPoints,
-				graphics.Point{X: w - 1, Y: h - 1},
-				graphics.Point{X: 0, Y: h - 1},
-			)
-			canvas.SetFillColor(dimColor(s.Color, 0.3))
-			canvas.FillPolygon(fillPoints)
 		}
 	}
 }
 
-func chartSeriesRange(series []ChartSeries) (float64, float64) {
+// chartSeriesRange returns the finite data range of the selected series.
+func chartSeriesRange(series []ChartSeries, include func(ChartSeries) bool) (float64, float64) {
 	minY := 0.0
 	maxY := 1.0
 	initialized := false
 	for _, s := range series {
+		if include != nil && !include(s) {
+			continue
+		}
 		for _, v := range s.Data {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
 			if !initialized {
 				minY = v
 				maxY = v
@@ -149,21 +188,44 @@ func chartSeriesRange(series []ChartSeries) (float64, float64) {
 	return minY, maxY
 }
 
-func chartSeriesPoints(data []float64, w, h int, minY, maxY float64) []graphics.Point {
-	if len(data) < 2 {
+// chartSeriesLength returns the longest series length, which sets the X
+// spacing shared by all series.
+func chartSeriesLength(series []ChartSeries) int {
+	count := 0
+	for _, s := range series {
+		count = max(count, len(s.Data))
+	}
+	return count
+}
+
+// chartSeriesSegments maps data to pixel points, splitting the line at
+// NaN or infinite values so missing samples leave a gap.
+func chartSeriesSegments(data []float64, count, w, h int, minY, maxY float64) [][]graphics.Point {
+	if count < 2 || len(data) == 0 {
 		return nil
 	}
-	points := make([]graphics.Point, len(data))
 	span := maxY - minY
 	if span == 0 {
 		span = 1
 	}
+	var segments [][]graphics.Point
+	var current []graphics.Point
 	for i, v := range data {
-		x := int(math.Round(float64(i) / float64(len(data)-1) * float64(w-1)))
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			if len(current) > 0 {
+				segments = append(segments, current)
+				current = nil
+			}
+			continue
+		}
+		x := int(math.Round(float64(i) / float64(count-1) * float64(w-1)))
 		y := int(math.Round((1 - (v-minY)/span) * float64(h-1)))
-		points[i] = graphics.Point{X: x, Y: y}
+		current = append(current, graphics.Point{X: x, Y: y})
+	}
+	if len(current) > 0 {
+		segments = append(segments, current)
 	}
-	return points
+	return segments
 }
 
 func dimColor(color backend.Color, factor float64) backend.Color {
@@ -0,0 +1,347 @@
+package widgets
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/graphics"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/text"
+)
+
+// AxisConfig describes a labeled chart axis.
+type AxisConfig struct {
+	// Label is drawn beside the axis. Y axis labels sit above the plot.
+	Label string
+	// Min and Max fix the axis range when Max > Min. Otherwise the Y axes
+	// auto-scale to the data, rounded out to whole ticks, and the X axis
+	// spans the sample indices.
+	Min float64
+	Max float64
+	// Ticks is the approximate number of tick marks. Zero picks a count
+	// from the available space.
+	Ticks int
+	// Grid draws a faint gridline at each tick.
+	Grid bool
+	// Format renders tick labels. Nil picks a precision from the tick step.
+	Format func(value float64) string
+}
+
+func (a AxisConfig) fixed() bool {
+	return a.Max > a.Min
+}
+
+func (a AxisConfig) format(value, step float64) string {
+	if a.Format != nil {
+		return a.Format(value)
+	}
+	decimals := 0
+	if step > 0 && step < 1 {
+		decimals = int(math.Ceil(-math.Log10(step) - 1e-9))
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
+var chartGridColor = backend.ColorRGB(70, 70, 70)
+
+// SetXAxis shows an X axis below the plot.
+func (c *LineChart) SetXAxis(axis AxisConfig) {
+	if c == nil {
+		return
+	}
+	c.xAxis = axis
+	c.showX = true
+	c.Invalidate()
+}
+
+// SetYAxis shows the primary Y axis left of the plot. A fixed Min/Max
+// replaces auto-scaling.
+func (c *LineChart) SetYAxis(axis AxisConfig) {
+	if c == nil {
+		return
+	}
+	c.yAxis = axis
+	c.showY = true
+	c.Invalidate()
+}
+
+// SetSecondaryYAxis shows a Y axis right of the plot for series with
+// Secondary set, so a second quantity can use its own scale.
+func (c *LineChart) SetSecondaryYAxis(axis AxisConfig) {
+	if c == nil {
+		return
+	}
+	c.y2Axis = axis
+	c.showY2 = true
+	c.Invalidate()
+}
+
+// axisScale is a resolved axis range with its tick values.
+type axisScale struct {
+	min   float64
+	max   float64
+	step  float64
+	ticks []float64
+}
+
+func (s axisScale) fraction(value float64) float64 {
+	if s.max == s.min {
+		return 0
+	}
+	return (value - s.min) / (s.max - s.min)
+}
+
+type chartScales struct {
+	x  axisScale
+	y  axisScale
+	y2 axisScale
+}
+
+// scales resolves all three axes for a plot of the given cell size.
+func (c *LineChart) scales(width, height int) chartScales {
+	return chartScales{
+		x:  c.xScale(width),
+		y:  c.yScale(c.yAxis, c.showY, height, false),
+		y2: c.yScale(c.y2Axis, c.showY2, height, true),
+	}
+}
+
+func (c *LineChart) yScale(axis AxisConfig, shown bool, height int, secondary bool) axisScale {
+	count := axis.Ticks
+	if count <= 0 {
+		count = min(max(height/2, 2), 6)
+	}
+	if axis.fixed() {
+		return niceScale(axis.Min, axis.Max, count, false)
+	}
+	minY, maxY := chartSeriesRange(c.series, func(s ChartSeries) bool {
+		return s.Secondary == secondary
+	})
+	if maxY == minY {
+		maxY = minY + 1
+	}
+	if !shown {
+		return axisScale{min: minY, max: maxY}
+	}
+	return niceScale(minY, maxY, count, true)
+}
+
+func (c *LineChart) xScale(width int) axisScale {
+	count := c.xAxis.Ticks
+	if count <= 0 {
+		count = min(max(width/10, 2), 10)
+	}
+	if c.xAxis.fixed() {
+		return niceScale(c.xAxis.Min, c.xAxis.Max, count, false)
+	}
+	return niceScale(0, float64(max(chartSeriesLength(c.series)-1, 1)), count, false)
+}
+
+// niceScale picks a 1, 2, or 5 × 10^n tick step for roughly count ticks.
+// When expand is set the range grows to whole steps.
+func niceScale(minValue, maxValue float64, count int, expand bool) axisScale {
+	if count < 2 {
+		count = 2
+	}
+	if maxValue <= minValue {
+		maxValue = minValue + 1
+	}
+	step := niceNumber(niceNumber(maxValue-minValue, false)/float64(count-1), true)
+	if expand {
+		minValue = math.Floor(minValue/step) * step
+		maxValue = math.Ceil(maxValue/step) * step
+	}
+	scale := axisScale{min: minValue, max: maxValue, step: step}
+	const epsilon = 1e-9
+	for i := math.Ceil(minValue/step - epsilon); i*step <= maxValue+step*epsilon; i++ {
+		value := i * step
+		if value == 0 {
+			value = 0 // drop negative zero
+		}
+		scale.ticks = append(scale.ticks, value)
+	}
+	return scale
+}
+
+func niceNumber(value float64, round bool) float64 {
+	if value <= 0 {
+		return 1
+	}
+	exp := math.Floor(math.Log10(value))
+	fraction := value / math.Pow(10, exp)
+	var nice float64
+	switch {
+	case round && fraction < 1.5, !round && fraction <= 1:
+		nice = 1
+	case round && fraction < 3, !round && fraction <= 2:
+		nice = 2
+	case round && fraction < 7, !round && fraction <= 5:
+		nice = 5
+	default:
+		nice = 10
+	}
+	return nice * math.Pow(10, exp)
+}
+
+// Render draws the axes around the plot and the series inside it.
+func (c *LineChart) Render(ctx runtime.RenderContext) {
+	if c == nil || c.draw == nil {
+		return
+	}
+	content := c.ContentBounds()
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	plot, scales := c.layoutPlot(content)
+	if plot.Width <= 0 || plot.Height <= 0 {
+		return
+	}
+	if c.canvas == nil || plot.Width != c.cellWidth || plot.Height != c.cellHeight {
+		c.canvas = graphics.NewCanvasWithBlitter(plot.Width, plot.Height, c.blitter)
+		c.cellWidth = plot.Width
+		c.cellHeight = plot.Height
+	}
+	c.plotBounds = plot
+	c.canvas.Clear()
+	c.draw(c.canvas)
+	c.canvas.Render(ctx.Buffer, plot.X, plot.Y)
+
+	style := resolveBaseStyle(ctx, c, backend.DefaultStyle(), false)
+	if c.showY {
+		c.renderYAxis(ctx, content, plot, scales.y, c.yAxis, style, false)
+	}
+	if c.showY2 {
+		c.renderYAxis(ctx, content, plot, scales.y2, c.y2Axis, style, true)
+	}
+	if c.showX {
+		c.renderXAxis(ctx, content, plot, scales.x, style)
+	}
+}
+
+// layoutPlot reserves room for axis lines and labels and returns the plot
+// area with the scales resolved for it.
+func (c *LineChart) layoutPlot(content runtime.Rect) (runtime.Rect, chartScales) {
+	plot := content
+	if (c.showY && c.yAxis.Label != "") || (c.showY2 && c.y2Axis.Label != "") {
+		plot.Y++
+		plot.Height--
+	}
+	if c.showX {
+		plot.Height -= 2
+		if c.xAxis.Label != "" {
+			plot.Height--
+		}
+	}
+	scales := c.scales(plot.Width, plot.Height)
+	if c.showY {
+		gutter := tickLabelWidth(scales.y, c.yAxis) + 1
+		plot.X += gutter
+		plot.Width -= gutter
+	}
+	if c.showY2 {
+		plot.Width -= tickLabelWidth(scales.y2, c.y2Axis) + 1
+	}
+	scales.x = c.xScale(plot.Width)
+	return plot, scales
+}
+
+func tickLabelWidth(scale axisScale, axis AxisConfig) int {
+	width := 0
+	for _, tick := range scale.ticks {
+		width = max(width, text.Width(axis.format(tick, scale.step)))
+	}
+	return width
+}
+
+func tickRow(plot runtime.Rect, scale axisScale, value float64) int {
+	return plot.Y + int(math.Round((1-scale.fraction(value))*float64(plot.Height-1)))
+}
+
+func tickColumn(plot runtime.Rect, scale axisScale, value float64) int {
+	return plot.X + int(math.Round(scale.fraction(value)*float64(plot.Width-1)))
+}
+
+func (c *LineChart) renderYAxis(ctx runtime.RenderContext, content, plot runtime.Rect, scale axisScale, axis AxisConfig, style backend.Style, secondary bool) {
+	lineX := plot.X - 1
+	tickRune := '┤'
+	if secondary {
+		lineX = plot.X + plot.Width
+		tickRune = '├'
+	}
+	for y := plot.Y; y < plot.Y+plot.Height; y++ {
+		ctx.Buffer.Set(lineX, y, '│', style)
+	}
+	for _, tick := range scale.ticks {
+		y := tickRow(plot, scale, tick)
+		ctx.Buffer.Set(lineX, y, tickRune, style)
+		label := axis.format(tick, scale.step)
+		if secondary {
+			ctx.Buffer.SetString(lineX+1, y, label, style)
+		} else {
+			ctx.Buffer.SetString(lineX-text.Width(label), y, label, style)
+		}
+	}
+	if axis.Label == "" {
+		return
+	}
+	label := text.Truncate(axis.Label, content.Width, "…")
+	x := content.X
+	if secondary {
+		x = content.X + content.Width - text.Width(label)
+	}
+	ctx.Buffer.SetString(x, content.Y, label, style)
+}
+
+func (c *LineChart) renderXAxis(ctx runtime.RenderContext, content, plot runtime.Rect, scale axisScale, style backend.Style) {
+	lineY := plot.Y + plot.Height
+	for x := plot.X; x < plot.X+plot.Width; x++ {
+		ctx.Buffer.Set(x, lineY, '─', style)
+	}
+	if c.showY {
+		ctx.Buffer.Set(plot.X-1, lineY, '└', style)
+	}
+	if c.showY2 {
+		ctx.Buffer.Set(plot.X+plot.Width, lineY, '┘', style)
+	}
+	nextFree := content.X
+	for _, tick := range scale.ticks {
+		x := tickColumn(plot, scale, tick)
+		ctx.Buffer.Set(x, lineY, '┬', style)
+		label := c.xAxis.format(tick, scale.step)
+		width := text.Width(label)
+		start := min(max(x-width/2, content.X), content.X+content.Width-width)
+		if start < nextFree {
+			continue
+		}
+		ctx.Buffer.SetString(start, lineY+1, label, style)
+		nextFree = start + width + 1
+	}
+	if c.xAxis.Label == "" {
+		return
+	}
+	label := text.Truncate(c.xAxis.Label, content.Width, "…")
+	x := plot.X + (plot.Width-text.Width(label))/2
+	x = min(max(x, content.X), content.X+content.Width-text.Width(label))
+	ctx.Buffer.SetString(x, lineY+2, label, style)
+}
+
+// drawGridRows draws dotted horizontal gridlines at the Y ticks.
+func drawGridRows(canvas *graphics.Canvas, scale axisScale, w, h int) {
+	for _, tick := range scale.ticks {
+		y := int(math.Round((1 - scale.fraction(tick)) * float64(h-1)))
+		for x := 0; x < w; x += 2 {
+			canvas.SetPixel(x, y, chartGridColor)
+		}
+	}
+}
+
+// drawGridColumns draws dotted vertical gridlines at the X ticks.
+func drawGridColumns(canvas *graphics.Canvas, scale axisScale, w, h int) {
+	for _, tick := range scale.ticks {
+		x := int(math.Round(scale.fraction(tick) * float64(w-1)))
+		for y := 0; y < h; y += 2 {
+			canvas.SetPixel(x, y, chartGridColor)
+		}
+	}
+}
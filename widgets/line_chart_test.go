@@ -0,0 +1,108 @@
+package widgets
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+)
+
+func TestNiceScale(t *testing.T) {
+	scale := niceScale(3, 97, 5, true)
+	if scale.min != 0 || scale.max != 100 || scale.step != 20 {
+		t.Fatalf("scale = %v..%v step %v, want 0..100 step 20", scale.min, scale.max, scale.step)
+	}
+	want := []float64{0, 20, 40, 60, 80, 100}
+	if !reflect.DeepEqual(scale.ticks, want) {
+		t.Fatalf("ticks = %v, want %v", scale.ticks, want)
+	}
+
+	fixed := niceScale(0, 1, 3, false)
+	if fixed.min != 0 || fixed.max != 1 || fixed.step != 0.5 {
+		t.Fatalf("fixed = %v..%v step %v", fixed.min, fixed.max, fixed.step)
+	}
+	if got := (AxisConfig{}).format(0.5, fixed.step); got != "0.5" {
+		t.Fatalf("format = %q, want 0.5", got)
+	}
+}
+
+func TestChartSeriesSegmentsGapOnNaN(t *testing.T) {
+	data := []float64{1, 2, math.NaN(), 3, 4}
+	segments := chartSeriesSegments(data, len(data), 9, 5, 0, 4)
+	if len(segments) != 2 {
+		t.Fatalf("segments = %d, want 2", len(segments))
+	}
+	if len(segments[0]) != 2 || len(segments[1]) != 2 {
+		t.Fatalf("segment lengths = %d, %d, want 2, 2", len(segments[0]), len(segments[1]))
+	}
+	if segments[1][0].X != 6 {
+		t.Fatalf("second segment starts at x=%d, want 6", segments[1][0].X)
+	}
+
+	minY, maxY := chartSeriesRange([]ChartSeries{{Data: data}}, nil)
+	if minY != 1 || maxY != 4 {
+		t.Fatalf("range = %v..%v, want 1..4", minY, maxY)
+	}
+}
+
+func TestLineChartAxes(t *testing.T) {
+	chart := NewLineChart()
+	chart.SetSeries([]ChartSeries{
+		{Label: "req/s", Data: []float64{10, 40, 25, 90}, Color: backend.ColorGreen},
+		{Label: "errors", Data: []float64{0.1, 0.3, 0.2, 0.4}, Color: backend.ColorRed, Secondary: true},
+	})
+	chart.SetXAxis(AxisConfig{Label: "time"})
+	chart.SetYAxis(AxisConfig{Label: "req/s", Grid: true})
+	chart.SetSecondaryYAxis(AxisConfig{Label: "err"})
+
+	_, rows := renderRows(t, chart, 40, 12)
+	if !strings.HasPrefix(rows[0], "req/s") || !strings.HasSuffix(rows[0], "err") {
+		t.Fatalf("axis labels row = %q", rows[0])
+	}
+	if !strings.HasPrefix(rows[1], "100┤") {
+		t.Fatalf("top tick row = %q, want 100┤ prefix", rows[1])
+	}
+	if !strings.HasSuffix(rows[1], "├0.4") {
+		t.Fatalf("top tick row = %q, want ├0.4 suffix", rows[1])
+	}
+	axisRow := rows[9]
+	if !strings.HasPrefix(strings.TrimLeft(axisRow, " "), "└") || !strings.HasSuffix(axisRow, "┘") {
+		t.Fatalf("x axis row = %q", axisRow)
+	}
+	if !strings.Contains(rows[10], "0") || !strings.Contains(rows[10], "2") {
+		t.Fatalf("x tick labels = %q", rows[10])
+	}
+	if strings.TrimSpace(rows[11]) != "time" {
+		t.Fatalf("x label row = %q", rows[11])
+	}
+}
+
+func TestLineChartFixedYAxis(t *testing.T) {
+	chart := NewLineChart()
+	chart.SetSeries([]ChartSeries{{Data: []float64{1, 2}}})
+	chart.SetYAxis(AxisConfig{Min: 0, Max: 10, Ticks: 3})
+
+	_, rows := renderRows(t, chart, 20, 5)
+	if !strings.HasPrefix(rows[0], "10┤") || !strings.HasPrefix(rows[4], "0┤") {
+		t.Fatalf("rows = %q", rows)
+	}
+}
+
+func TestLineChartSetYRange(t *testing.T) {
+	chart := NewLineChart()
+	chart.SetYRange(0, 10)
+	if got := chart.yScale(chart.yAxis, chart.showY, 5, false); got.min != 0 || got.max != 10 {
+		t.Fatalf("scale = %v..%v, want 0..10", got.min, got.max)
+	}
+	if chart.showY {
+		t.Fatalf("expected SetYRange to leave the axis hidden")
+	}
+	if got := (Axis{Min: 1, Max: 2}).AxisConfig(); got.Min != 1 || got.Max != 2 {
+		t.Fatalf("AxisConfig() = %+v", got)
+	}
+	if got := (Axis{Min: 1, Max: 2, Auto: true}).AxisConfig(); got.fixed() {
+		t.Fatalf("expected auto axis to convert to an auto-scaled config, got %+v", got)
+	}
+}
@@ -3,6 +3,7 @@ package widgets
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
@@ -12,6 +13,10 @@ import (
 	"github.com/odvcencio/fluffyui/terminal"
 )
 
+// listDragScrollInterval is how often a drag held over the first or last
+// visible row scrolls the list.
+const listDragScrollInterval = 100 * time.Millisecond
+
 // RenderFunc renders an item.
 type RenderFunc[T any] func(item T, index int, selected bool, ctx runtime.RenderContext)
 
@@ -22,6 +27,24 @@ type ListAdapter[T any] interface {
 	Render(item T, index int, selected bool, ctx runtime.RenderContext)
 }
 
+// ListMover is implemented by adapters that can reorder their items. Move
+// relocates the item at from so that it ends up at index to.
+type ListMover interface {
+	Move(from, to int) bool
+}
+
+// movedItems returns a copy of items with the item at from moved to to.
+func movedItems[T any](items []T, from, to int) ([]T, bool) {
+	if from < 0 || from >= len(items) || to < 0 || to >= len(items) {
+		return items, false
+	}
+	out := make([]T, 0, len(items))
+	out = append(out, items[:from]...)
+	out = append(out, items[from+1:]...)
+	out = append(out[:to], append([]T{items[from]}, out[to:]...)...)
+	return out, true
+}
+
 // SliceAdapter adapts a slice to a ListAdapter.
 type SliceAdapter[T any] struct {
 	items  []T
@@ -58,6 +81,16 @@ func (s *SliceAdapter[T]) Render(item T, index int, selected bool, ctx runtime.R
 	s.render(item, index, selected, ctx)
 }
 
+// Move reorders the adapter's copy of the slice.
+func (s *SliceAdapter[T]) Move(from, to int) bool {
+	if s == nil {
+		return false
+	}
+	items, ok := movedItems(s.items, from, to)
+	s.items = items
+	return ok
+}
+
 // SignalAdapter adapts a signal slice to a ListAdapter.
 type SignalAdapter[T any] struct {
 	items  *state.Signal[[]T]
@@ -98,9 +131,84 @@ func (s *SignalAdapter[T]) Render(item T, index int, selected bool, ctx runtime.
 	s.render(item, index, selected, ctx)
 }
 
+// Move sets the signal to a reordered copy of its slice.
+func (s *SignalAdapter[T]) Move(from, to int) bool {
+	if s == nil || s.items == nil {
+		return false
+	}
+	items, ok := movedItems(s.items.Get(), from, to)
+	if ok {
+		s.items.Set(items)
+	}
+	return ok
+}
+
+// ListSignalAdapter adapts a ListSignal to a ListAdapter.
+// Lists bound to it receive granular change events so the selection
+// follows the same item across inserts, removals, and moves.
+type ListSignalAdapter[T any] struct {
+	items  *state.ListSignal[T]
+	render RenderFunc[T]
+}
+
+// NewListSignalAdapter creates a list signal adapter.
+func NewListSignalAdapter[T any](items *state.ListSignal[T], render RenderFunc[T]) *ListSignalAdapter[T] {
+	return &ListSignalAdapter[T]{items: items, render: render}
+}
+
+// Count returns the item count.
+func (s *ListSignalAdapter[T]) Count() int {
+	if s == nil || s.items == nil {
+		return 0
+	}
+	return s.items.Len()
+}
+
+// Item returns an item.
+func (s *ListSignalAdapter[T]) Item(index int) T {
+	if s == nil || s.items == nil {
+		var zero T
+		return zero
+	}
+	item, _ := s.items.At(index)
+	return item
+}
+
+// Render draws an item.
+func (s *ListSignalAdapter[T]) Render(item T, index int, selected bool, ctx runtime.RenderContext) {
+	if s == nil || s.render == nil {
+		return
+	}
+	s.render(item, index, selected, ctx)
+}
+
+// ObserveChanges forwards granular list changes.
+func (s *ListSignalAdapter[T]) ObserveChanges(scheduler state.Scheduler, fn func(state.ListChange)) func() {
+	if s == nil || s.items == nil {
+		return func() {}
+	}
+	return s.items.ObserveChanges(scheduler, fn)
+}
+
+// Move moves the item within the list signal.
+func (s *ListSignalAdapter[T]) Move(from, to int) bool {
+	if s == nil || s.items == nil {
+		return false
+	}
+	return s.items.Move(from, to)
+}
+
+// listChangeSource is implemented by adapters that emit granular changes.
+type listChangeSource interface {
+	ObserveChanges(scheduler state.Scheduler, fn func(state.ListChange)) func()
+}
+
 // List renders a list of items.
 type List[T any] struct {
 	FocusableBase
+	services      runtime.Services
+	wheel         runtime.WheelScroller
+	changeUnsub   func()
 	adapter       ListAdapter[T]
 	selected      int
 	offset        int
@@ -108,6 +216,21 @@ type List[T any] struct {
 	label         string
 	style         backend.Style
 	selectedStyle backend.Style
+
+	reorderable    bool
+	onReorder      func(fromIndex, toIndex int)
+	dragStyle      backend.Style
+	indicatorStyle backend.Style
+	dragFrom       int // row under the press, or -1
+	dragTo         int
+	dragging       bool
+	dragY          int       // screen row of the pointer during a drag
+	dragScrolled   time.Time // last tick that auto-scrolled, zero until one arrives
+
+	filter      func(item T, query string) bool
+	filterQuery string
+	visible     []int // item indexes matching the filter, nil when unfiltered
+	filterCount int   // adapter count when visible was built
 }
 
 // NewList creates a list widget.
@@ -118,12 +241,83 @@ func NewList[T any](adapter ListAdapter[T]) *List[T] {
 		label:         "List",
 		style:         backend.DefaultStyle(),
 		selectedStyle: backend.DefaultStyle().Reverse(true),
+
+		dragStyle:      backend.DefaultStyle().Dim(true).Italic(true),
+		indicatorStyle: backend.DefaultStyle().Bold(true),
+		dragFrom:       -1,
 	}
 	list.Base.Role = accessibility.RoleList
 	list.syncA11y()
 	return list
 }
 
+// Bind attaches app services and observes granular adapter changes.
+func (l *List[T]) Bind(services runtime.Services) {
+	if l == nil {
+		return
+	}
+	l.services = services
+	if l.changeUnsub != nil {
+		l.changeUnsub()
+		l.changeUnsub = nil
+	}
+	if source, ok := l.adapter.(listChangeSource); ok {
+		l.changeUnsub = source.ObserveChanges(services.Scheduler(), l.applyListChange)
+	}
+}
+
+// Unbind releases app services.
+func (l *List[T]) Unbind() {
+	if l == nil {
+		return
+	}
+	if l.changeUnsub != nil {
+		l.changeUnsub()
+		l.changeUnsub = nil
+	}
+	l.services = runtime.Services{}
+}
+
+// applyListChange keeps the selection on the same item after a change.
+func (l *List[T]) applyListChange(change state.ListChange) {
+	if l == nil {
+		return
+	}
+	sel := l.itemIndex(l.selected)
+	switch change.Kind {
+	case state.ListAdded:
+		if sel >= change.Index && l.adapter.Count() > change.Count {
+			sel += change.Count
+		}
+	case state.ListRemoved:
+		if sel >= change.Index+change.Count {
+			sel -= change.Count
+		} else if sel >= change.Index {
+			sel = change.Index
+		}
+	case state.ListMoved:
+		switch {
+		case sel == change.From:
+			sel = change.To
+		case change.From < sel && sel <= change.To:
+			sel--
+		case change.To <= sel && sel < change.From:
+			sel++
+		}
+	}
+	if count := l.adapter.Count(); sel >= count {
+		sel = count - 1
+	}
+	sel = max(sel, 0)
+	l.selected = sel
+	if l.visible != nil {
+		l.refilter(sel)
+	}
+	l.syncA11y()
+	l.Invalidate()
+	l.services.Invalidate()
+}
+
 // SetStyle updates the list base style.
 func (l *List[T]) SetStyle(style backend.Style) {
 	if l == nil {
@@ -140,6 +334,154 @@ func (l *List[T]) SetSelectedStyle(style backend.Style) {
 	l.selectedStyle = style
 }
 
+// SetReorderable lets rows be dragged with the mouse, or moved with
+// Alt+Up/Down while the list has focus. Dropping a row calls the OnReorder
+// handler; the list never changes its items itself. Holding a drag over the
+// first or last visible row scrolls the list.
+func (l *List[T]) SetReorderable(reorderable bool) {
+	if l == nil {
+		return
+	}
+	l.reorderable = reorderable
+	if !reorderable {
+		l.endDrag()
+	}
+}
+
+// OnReorder registers the handler called when a dragged row is dropped at a
+// new index. toIndex is the index the item should have once moved, as with
+// state.ListSignal.Move; the handler is responsible for moving it, for
+// example with MoveItem.
+func (l *List[T]) OnReorder(fn func(fromIndex, toIndex int)) {
+	if l == nil {
+		return
+	}
+	l.onReorder = fn
+}
+
+// SetFilter sets the predicate a filter query narrows the list with, as
+// from a FilterBar. Rows are filtered only while the query is not empty.
+func (l *List[T]) SetFilter(fn func(item T, query string) bool) {
+	if l == nil {
+		return
+	}
+	l.filter = fn
+	l.Refilter()
+}
+
+// SetFilterQuery filters the list to the items matching query, keeping the
+// selected item selected when it still matches.
+func (l *List[T]) SetFilterQuery(query string) {
+	if l == nil {
+		return
+	}
+	l.filterQuery = query
+	l.Refilter()
+}
+
+// FilterQuery returns the current filter query.
+func (l *List[T]) FilterQuery() string {
+	if l == nil {
+		return ""
+	}
+	return l.filterQuery
+}
+
+// FilterCount returns how many items match the filter out of all items.
+func (l *List[T]) FilterCount() (shown, total int) {
+	if l == nil || l.adapter == nil {
+		return 0, 0
+	}
+	return l.rowCount(), l.adapter.Count()
+}
+
+// Refilter reapplies the filter, e.g. after items change in an adapter
+// that does not report changes.
+func (l *List[T]) Refilter() {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	l.refilter(l.itemIndex(l.selected))
+	l.syncA11y()
+	l.Invalidate()
+}
+
+// refilter rebuilds the matching rows and selects the row of item, or the
+// first row when item no longer matches.
+func (l *List[T]) refilter(item int) {
+	l.visible = nil
+	count := l.adapter.Count()
+	l.filterCount = count
+	if l.filter == nil || l.filterQuery == "" {
+		l.selected = max(item, 0)
+		return
+	}
+	l.visible = make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		if l.filter(l.adapter.Item(i), l.filterQuery) {
+			l.visible = append(l.visible, i)
+		}
+	}
+	l.selected = max(l.rowOf(item), 0)
+	l.offset = 0
+}
+
+// rowCount returns the number of rows shown.
+func (l *List[T]) rowCount() int {
+	if l.visible != nil {
+		return len(l.visible)
+	}
+	return l.adapter.Count()
+}
+
+// itemIndex returns the adapter index of a row, or -1.
+func (l *List[T]) itemIndex(row int) int {
+	if l.visible == nil {
+		return row
+	}
+	if row < 0 || row >= len(l.visible) {
+		return -1
+	}
+	return l.visible[row]
+}
+
+// rowOf returns the row showing the item at index, or -1 when it is
+// filtered out.
+func (l *List[T]) rowOf(index int) int {
+	if l.visible == nil {
+		return index
+	}
+	for row, item := range l.visible {
+		if item == index {
+			return row
+		}
+	}
+	return -1
+}
+
+// MoveItem moves an item through the adapter, if it implements ListMover.
+func (l *List[T]) MoveItem(from, to int) bool {
+	if l == nil {
+		return false
+	}
+	mover, ok := l.adapter.(ListMover)
+	if !ok || !mover.Move(from, to) {
+		return false
+	}
+	l.Invalidate()
+	return true
+}
+
+// SetReorderStyles updates the styles of the dragged row and of the drop
+// indicator line.
+func (l *List[T]) SetReorderStyles(dragged, indicator backend.Style) {
+	if l == nil {
+		return
+	}
+	l.dragStyle = dragged
+	l.indicatorStyle = indicator
+}
+
 // StyleType returns the selector type name.
 func (l *List[T]) StyleType() string {
 	return "List"
@@ -172,7 +514,7 @@ func (l *List[T]) Measure(constraints runtime.Constraints) runtime.Size {
 	return l.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
 		count := 0
 		if l != nil && l.adapter != nil {
-			count = l.adapter.Count()
+			count = l.rowCount()
 		}
 		height := min(count, contentConstraints.MaxHeight)
 		if height <= 0 {
@@ -199,47 +541,121 @@ func (l *List[T]) Render(ctx runtime.RenderContext) {
 	if content.Width <= 0 || content.Height <= 0 {
 		return
 	}
-	count := l.adapter.Count()
+	if l.visible != nil && l.adapter.Count() != l.filterCount {
+		l.refilter(l.itemIndex(l.selected))
+	}
+	count := l.rowCount()
 	if l.selected < 0 {
 		l.selected = 0
 	}
 	if l.selected >= count {
 		l.selected = count - 1
 	}
-	if l.selected < l.offset {
-		l.offset = l.selected
+	if l.dragging {
+		// Dragging scrolls on its own; keep the selection from pulling the
+		// view back.
+		l.offset = clampInt(l.offset, 0, max(0, count-content.Height))
+	} else {
+		if l.selected < l.offset {
+			l.offset = l.selected
+		}
+		if l.selected >= l.offset+content.Height {
+			l.offset = l.selected - content.Height + 1
+		}
 	}
-	if l.selected >= l.offset+content.Height {
-		l.offset = l.selected - content.Height + 1
+	// While dragging, a drop indicator line takes a row at the drop gap and
+	// pushes the rows after it down.
+	gap := -1
+	if l.dragging && l.dragTo != l.dragFrom {
+		gap = l.dragTo
+		if l.dragTo > l.dragFrom {
+			gap++
+		}
 	}
-	for i := 0; i < content.Height; i++ {
-		index := l.offset + i
-		if index < 0 || index >= count {
-			break
+	y := content.Y
+	for index := max(l.offset, 0); index < count && y < content.Y+content.Height; index++ {
+		if index == gap {
+			l.renderDropIndicator(ctx, runtime.Rect{X: content.X, Y: y, Width: content.Width, Height: 1}, baseStyle)
+			y++
+			if y >= content.Y+content.Height {
+				break
+			}
 		}
-		item := l.adapter.Item(index)
-		rowBounds := runtime.Rect{X: content.X, Y: content.Y + i, Width: content.Width, Height: 1}
+		itemIndex := l.itemIndex(index)
+		item := l.adapter.Item(itemIndex)
+		rowBounds := runtime.Rect{X: content.X, Y: y, Width: content.Width, Height: 1}
 		rowCtx := ctx.Sub(rowBounds)
 		if index == l.selected {
 			ctx.Buffer.Fill(rowBounds, ' ', selectedStyle)
 		}
-		l.adapter.Render(item, index, index == l.selected, rowCtx)
+		l.adapter.Render(item, itemIndex, index == l.selected, rowCtx)
+		if l.dragging && index == l.dragFrom {
+			restyleRow(ctx.Buffer, rowBounds, l.dragStyle)
+		}
+		y++
+	}
+	if gap == count && y < content.Y+content.Height {
+		l.renderDropIndicator(ctx, runtime.Rect{X: content.X, Y: y, Width: content.Width, Height: 1}, baseStyle)
 	}
 }
 
-// HandleMessage handles navigation.
+func (l *List[T]) renderDropIndicator(ctx runtime.RenderContext, row runtime.Rect, baseStyle backend.Style) {
+	style := mergeBackendStyles(baseStyle, l.indicatorStyle)
+	for x := row.X; x < row.X+row.Width; x++ {
+		ctx.Buffer.Set(x, row.Y, '─', style)
+	}
+}
+
+// restyleRow merges style into the cells the adapter drew for a row.
+func restyleRow(buf *runtime.Buffer, row runtime.Rect, style backend.Style) {
+	for x := row.X; x < row.X+row.Width; x++ {
+		cell := buf.Get(x, row.Y)
+		buf.Set(x, row.Y, cell.Rune, mergeBackendStyles(cell.Style, style))
+	}
+}
+
+// HandleMessage handles navigation, and mouse dragging when the list is
+// reorderable.
 func (l *List[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if l == nil || !l.focused || l.adapter == nil {
+	if l == nil || l.adapter == nil {
+		return runtime.Unhandled()
+	}
+	if tick, ok := msg.(runtime.TickMsg); ok {
+		l.tickDrag(tick.Time)
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		if delta, ok := l.wheel.Delta(l.services.ScrollConfig(), mouse, runtime.DefaultWheelLines); ok {
+			l.ScrollBy(0, delta)
+			return runtime.Handled()
+		}
+		if l.reorderable && l.visible == nil {
+			return l.handleDrag(mouse)
+		}
+	}
+	if !l.focused {
 		return runtime.Unhandled()
 	}
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
-	count := l.adapter.Count()
+	count := l.rowCount()
 	if count == 0 {
 		return runtime.Unhandled()
 	}
+	if key.Alt && l.reorderable && l.visible == nil && (key.Key == terminal.KeyUp || key.Key == terminal.KeyDown) {
+		to := l.selected - 1
+		if key.Key == terminal.KeyDown {
+			to = l.selected + 1
+		}
+		if to >= 0 && to < count && l.onReorder != nil {
+			l.onReorder(l.selected, to)
+			l.setSelected(to)
+			l.Invalidate()
+		}
+		return runtime.Handled()
+	}
 	switch key.Key {
 	case terminal.KeyUp:
 		l.setSelected(l.selected - 1)
@@ -260,20 +676,119 @@ func (l *List[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		l.setSelected(count - 1)
 		return runtime.Handled()
 	case terminal.KeyEnter:
-		item := l.adapter.Item(l.selected)
+		index := l.itemIndex(l.selected)
 		if l.onSelect != nil {
-			l.onSelect(l.selected, item)
+			l.onSelect(index, l.adapter.Item(index))
 		}
 		return runtime.Handled()
 	}
 	return runtime.Unhandled()
 }
 
+// handleDrag picks up a row on press, tracks the drop target while the
+// mouse moves, and reports the move on release.
+func (l *List[T]) handleDrag(mouse runtime.MouseMsg) runtime.HandleResult {
+	switch mouse.Action {
+	case runtime.MousePress:
+		l.endDrag()
+		index, ok := l.rowAt(mouse.Y)
+		if mouse.Button != runtime.MouseLeft || !ok || !l.ContentBounds().Contains(mouse.X, mouse.Y) {
+			return runtime.Unhandled()
+		}
+		l.dragFrom, l.dragTo = index, index
+		return runtime.Handled()
+	case runtime.MouseMove:
+		if l.dragFrom < 0 {
+			return runtime.Unhandled()
+		}
+		l.dragging = true
+		l.dragY = mouse.Y
+		l.dragScrolled = time.Time{}
+		l.autoScroll(mouse.Y)
+		if index, ok := l.rowAt(mouse.Y); ok {
+			l.dragTo = index
+		}
+		l.Invalidate()
+		return runtime.Handled()
+	case runtime.MouseRelease:
+		if l.dragFrom < 0 {
+			return runtime.Unhandled()
+		}
+		from, to, dragging := l.dragFrom, l.dragTo, l.dragging
+		l.endDrag()
+		if dragging && from != to && l.onReorder != nil {
+			l.onReorder(from, to)
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// rowAt returns the item index at screen row y, clamped to the items. The
+// rows shifted by the drop indicator are ignored so the target stays put
+// while it is drawn.
+func (l *List[T]) rowAt(y int) (int, bool) {
+	count := l.adapter.Count()
+	if count == 0 {
+		return 0, false
+	}
+	return clampInt(l.offset+y-l.ContentBounds().Y, 0, count-1), true
+}
+
+// autoScroll scrolls one row when a drag reaches the first or last visible
+// row and there are more items beyond it. It reports whether it scrolled.
+func (l *List[T]) autoScroll(y int) bool {
+	content := l.ContentBounds()
+	count := l.adapter.Count()
+	switch {
+	case y <= content.Y && l.offset > 0:
+		l.offset--
+	case y >= content.Y+content.Height-1 && l.offset+content.Height < count:
+		l.offset++
+	default:
+		return false
+	}
+	return true
+}
+
+// tickDrag keeps scrolling while a drag rests over the first or last
+// visible row, one row every listDragScrollInterval, and moves the drop
+// target with it.
+func (l *List[T]) tickDrag(now time.Time) {
+	if !l.dragging {
+		return
+	}
+	if l.dragScrolled.IsZero() {
+		l.dragScrolled = now
+		return
+	}
+	if now.Sub(l.dragScrolled) < listDragScrollInterval {
+		return
+	}
+	l.dragScrolled = now
+	if !l.autoScroll(l.dragY) {
+		return
+	}
+	if index, ok := l.rowAt(l.dragY); ok {
+		l.dragTo = index
+	}
+	l.Invalidate()
+}
+
+func (l *List[T]) endDrag() {
+	if l.dragging {
+		l.Invalidate()
+	}
+	l.dragFrom, l.dragTo = -1, -1
+	l.dragging = false
+	l.dragScrolled = time.Time{}
+}
+
 func (l *List[T]) setSelected(index int) {
 	if l == nil || l.adapter == nil {
 		return
 	}
-	count := l.adapter.Count()
+	count := l.rowCount()
 	if count == 0 {
 		l.selected = 0
 		return
@@ -287,25 +802,34 @@ func (l *List[T]) setSelected(index int) {
 	l.selected = index
 	l.syncA11y()
 	if l.onSelect != nil {
-		l.onSelect(l.selected, l.adapter.Item(l.selected))
+		item := l.itemIndex(l.selected)
+		l.onSelect(item, l.adapter.Item(item))
 	}
 }
 
-// SetSelected updates the selected index.
+// SetSelected updates the selected index. While filtered, an item that is
+// filtered out is not selected.
 func (l *List[T]) SetSelected(index int) {
-	if l == nil {
+	if l == nil || l.adapter == nil {
+		return
+	}
+	row := l.rowOf(index)
+	if row < 0 {
 		return
 	}
-	l.setSelected(index)
+	l.setSelected(row)
 	l.Invalidate()
 }
 
-// SelectedIndex returns the current selection index.
+// SelectedIndex returns the adapter index of the selected item.
 func (l *List[T]) SelectedIndex() int {
-	if l == nil {
+	if l == nil || l.adapter == nil {
 		return 0
 	}
-	return l.selected
+	if l.visible != nil && len(l.visible) == 0 {
+		return -1
+	}
+	return l.itemIndex(l.selected)
 }
 
 // SelectedItem returns the selected item.
@@ -314,10 +838,10 @@ func (l *List[T]) SelectedItem() (T, bool) {
 	if l == nil || l.adapter == nil {
 		return zero, false
 	}
-	if l.selected < 0 || l.selected >= l.adapter.Count() {
+	if l.selected < 0 || l.selected >= l.rowCount() {
 		return zero, false
 	}
-	return l.adapter.Item(l.selected), true
+	return l.adapter.Item(l.itemIndex(l.selected)), true
 }
 
 // ScrollBy scrolls selection by delta.
@@ -368,7 +892,7 @@ func (l *List[T]) ScrollToEnd() {
 	if l == nil || l.adapter == nil {
 		return
 	}
-	l.setSelected(l.adapter.Count() - 1)
+	l.setSelected(l.rowCount() - 1)
 	l.Invalidate()
 }
 
@@ -384,10 +908,14 @@ func (l *List[T]) syncA11y() {
 		label = "List"
 	}
 	l.Base.Label = label
-	count := l.adapter.Count()
-	l.Base.Description = fmt.Sprintf("%d items", count)
+	count := l.rowCount()
+	if l.visible != nil {
+		l.Base.Description = fmt.Sprintf("%d of %d items", count, l.adapter.Count())
+	} else {
+		l.Base.Description = fmt.Sprintf("%d items", count)
+	}
 	if count > 0 && l.selected >= 0 && l.selected < count {
-		item := l.adapter.Item(l.selected)
+		item := l.adapter.Item(l.itemIndex(l.selected))
 		l.Base.Value = &accessibility.ValueInfo{Text: fmt.Sprint(item)}
 	} else {
 		l.Base.Value = nil
@@ -397,4 +925,9 @@ func (l *List[T]) syncA11y() {
 var _ scroll.Controller = (*List[any])(nil)
 
 var _ runtime.Widget = (*List[any])(nil)
+var _ runtime.Bindable = (*List[any])(nil)
+var _ ListAdapter[any] = (*ListSignalAdapter[any])(nil)
+var _ ListMover = (*SliceAdapter[any])(nil)
+var _ ListMover = (*SignalAdapter[any])(nil)
+var _ ListMover = (*ListSignalAdapter[any])(nil)
 var _ runtime.Focusable = (*List[any])(nil)
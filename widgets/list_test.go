@@ -0,0 +1,202 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/state"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestListSignalAdapterKeepsSelection(t *testing.T) {
+	items := state.NewListSignal("alpha", "beta", "gamma")
+	list := NewList[string](NewListSignalAdapter(items, func(string, int, bool, runtime.RenderContext) {}))
+	list.Bind(runtime.Services{})
+	defer list.Unbind()
+
+	list.SetSelected(1)
+	items.InsertAt(0, "first")
+	if got, _ := list.SelectedItem(); got != "beta" {
+		t.Fatalf("after insert selected = %q, want beta", got)
+	}
+	items.Move(2, 0)
+	if got, _ := list.SelectedItem(); got != "beta" || list.SelectedIndex() != 0 {
+		t.Fatalf("after move selected = %q at %d, want beta at 0", got, list.SelectedIndex())
+	}
+	items.RemoveAt(0)
+	if got, _ := list.SelectedItem(); got != "first" {
+		t.Fatalf("after removing selection selected = %q, want first", got)
+	}
+	items.Reset(nil)
+	if list.SelectedIndex() != 0 {
+		t.Fatalf("expected selection to clamp after reset, got %d", list.SelectedIndex())
+	}
+}
+
+func TestListDragReorder(t *testing.T) {
+	items := state.NewSignal([]string{"alpha", "beta", "gamma", "delta"})
+	list := NewList[string](NewSignalAdapter(items, func(item string, _ int, _ bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	}))
+	list.SetReorderable(true)
+	from, to := -1, -1
+	list.OnReorder(func(fromIndex, toIndex int) { from, to = fromIndex, toIndex })
+	renderRows(t, list, 10, 6)
+
+	press := func(action runtime.MouseAction, y int) runtime.HandleResult {
+		return list.HandleMessage(runtime.MouseMsg{X: 1, Y: y, Button: runtime.MouseLeft, Action: action})
+	}
+	if !press(runtime.MousePress, 0).Handled {
+		t.Fatalf("expected press on a row to be handled")
+	}
+	press(runtime.MouseMove, 1)
+	press(runtime.MouseMove, 2)
+	buf, rows := renderRows(t, list, 10, 6)
+	want := []string{"alpha", "beta", "gamma", "──────────", "delta", ""}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("drag rows = %q, want %q", rows, want)
+		}
+	}
+	if buf.Get(0, 0).Style.Attributes()&backend.AttrDim == 0 {
+		t.Fatalf("expected the dragged row to be dimmed")
+	}
+
+	press(runtime.MouseRelease, 2)
+	if from != 0 || to != 2 {
+		t.Fatalf("OnReorder(%d, %d), want (0, 2)", from, to)
+	}
+	if got := items.Get(); got[0] != "alpha" || got[2] != "gamma" {
+		t.Fatalf("list mutated its items: %v", got)
+	}
+	if _, rows := renderRows(t, list, 10, 6); rows[3] != "delta" {
+		t.Fatalf("expected the indicator to clear after release, rows = %q", rows)
+	}
+
+	press(runtime.MousePress, 3)
+	press(runtime.MouseMove, 1)
+	if _, rows := renderRows(t, list, 10, 6); rows[1] != "──────────" || rows[2] != "beta" {
+		t.Fatalf("drag up rows = %q", rows)
+	}
+	press(runtime.MouseRelease, 1)
+	if from != 3 || to != 1 {
+		t.Fatalf("OnReorder(%d, %d), want (3, 1)", from, to)
+	}
+
+	from, to = -1, -1
+	press(runtime.MousePress, 1)
+	press(runtime.MouseRelease, 1)
+	if from != -1 {
+		t.Fatalf("a click without a drag should not reorder")
+	}
+}
+
+func TestListKeyboardReorderMovesItem(t *testing.T) {
+	items := state.NewSignal([]string{"alpha", "beta", "gamma"})
+	list := NewList[string](NewSignalAdapter(items, func(string, int, bool, runtime.RenderContext) {}))
+	list.SetReorderable(true)
+	list.OnReorder(func(from, to int) { list.MoveItem(from, to) })
+	list.Focus()
+
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Alt: true})
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Alt: true})
+	if got := items.Get(); got[0] != "beta" || got[1] != "gamma" || got[2] != "alpha" {
+		t.Fatalf("items = %v, want [beta gamma alpha]", got)
+	}
+	if item, _ := list.SelectedItem(); item != "alpha" || list.SelectedIndex() != 2 {
+		t.Fatalf("selected %q at %d, want alpha at 2", item, list.SelectedIndex())
+	}
+	if !list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown, Alt: true}).Handled {
+		t.Fatalf("expected Alt+Down on the last row to be handled")
+	}
+	list.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp, Alt: true})
+	if got := items.Get(); got[1] != "alpha" {
+		t.Fatalf("items = %v, want alpha moved back up", got)
+	}
+}
+
+func TestListDragAutoScrolls(t *testing.T) {
+	items := make([]string, 10)
+	for i := range items {
+		items[i] = string(rune('a' + i))
+	}
+	list := NewList[string](NewSliceAdapter(items, func(item string, _ int, _ bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	}))
+	list.SetReorderable(true)
+	from, to := -1, -1
+	list.OnReorder(func(fromIndex, toIndex int) { from, to = fromIndex, toIndex })
+	renderRows(t, list, 5, 3)
+
+	list.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	for i := 0; i < 3; i++ {
+		list.HandleMessage(runtime.MouseMsg{X: 0, Y: 2, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	}
+	if _, rows := renderRows(t, list, 5, 3); rows[0] != "d" {
+		t.Fatalf("rows = %q, want the list scrolled by three", rows)
+	}
+	list.HandleMessage(runtime.MouseMsg{X: 0, Y: 2, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if from != 0 || to != 5 {
+		t.Fatalf("OnReorder(%d, %d), want (0, 5)", from, to)
+	}
+	if !list.MoveItem(from, to) {
+		t.Fatalf("expected the slice adapter to move the item")
+	}
+	if got := list.adapter.Item(5); got != "a" || items[0] != "a" {
+		t.Fatalf("item 5 = %q, caller slice[0] = %q", got, items[0])
+	}
+}
+
+func TestListDragAutoScrollsWhileHeld(t *testing.T) {
+	items := make([]string, 10)
+	for i := range items {
+		items[i] = string(rune('a' + i))
+	}
+	list := NewList[string](NewSliceAdapter(items, func(item string, _ int, _ bool, ctx runtime.RenderContext) {
+		ctx.Buffer.SetString(ctx.Bounds.X, ctx.Bounds.Y, item, backend.DefaultStyle())
+	}))
+	list.SetReorderable(true)
+	to := -1
+	list.OnReorder(func(_, toIndex int) { to = toIndex })
+	renderRows(t, list, 5, 3)
+
+	list.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	list.HandleMessage(runtime.MouseMsg{X: 0, Y: 2, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	start := time.Unix(0, 0)
+	for i := 0; i <= 3; i++ {
+		list.HandleMessage(runtime.TickMsg{Time: start.Add(time.Duration(i) * listDragScrollInterval)})
+	}
+	list.HandleMessage(runtime.TickMsg{Time: start.Add(3*listDragScrollInterval + time.Millisecond)})
+	if _, rows := renderRows(t, list, 5, 3); rows[0] != "e" {
+		t.Fatalf("rows = %q, want the list scrolled by four while held", rows)
+	}
+	list.HandleMessage(runtime.MouseMsg{X: 0, Y: 2, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if to != 6 {
+		t.Fatalf("dropped at %d, want 6", to)
+	}
+
+	_, released := renderRows(t, list, 5, 3)
+	list.HandleMessage(runtime.TickMsg{Time: start.Add(time.Minute)})
+	if _, rows := renderRows(t, list, 5, 3); rows[0] != released[0] {
+		t.Fatalf("rows = %q, ticks after release should not scroll", rows)
+	}
+}
+
+func TestListWheelUsesScrollConfig(t *testing.T) {
+	items := state.NewSignal([]string{"a", "b", "c", "d", "e", "f"})
+	list := NewList[string](NewSignalAdapter(items, func(string, int, bool, runtime.RenderContext) {}))
+	list.HandleMessage(runtime.MouseMsg{Button: runtime.MouseWheelDown})
+	if list.SelectedIndex() != runtime.DefaultWheelLines {
+		t.Fatalf("selected = %d, want the default wheel step", list.SelectedIndex())
+	}
+
+	app := runtime.NewApp(runtime.AppConfig{Scroll: runtime.ScrollConfig{LinesPerNotch: 1, Natural: true}})
+	list.Bind(app.Services())
+	defer list.Unbind()
+	list.HandleMessage(runtime.MouseMsg{Button: runtime.MouseWheelDown})
+	if list.SelectedIndex() != 2 {
+		t.Fatalf("selected = %d, want one natural-direction line up", list.SelectedIndex())
+	}
+}
@@ -0,0 +1,515 @@
+package widgets
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/scroll"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+const (
+	LogDebug LogLevel = iota
+	LogInfo
+	LogWarn
+	LogError
+)
+
+// String returns the level tag shown before each entry.
+func (l LogLevel) String() string {
+	switch l {
+	case LogDebug:
+		return "DEBUG"
+	case LogInfo:
+		return "INFO"
+	case LogWarn:
+		return "WARN"
+	case LogError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(l))
+}
+
+// themeToken returns the theme token entries of the level are drawn with.
+func (l LogLevel) themeToken() string {
+	switch l {
+	case LogDebug:
+		return "text_muted"
+	case LogWarn:
+		return "warning"
+	case LogError:
+		return "error"
+	}
+	return "info"
+}
+
+// LogEntry is one line of a LogPanel.
+type LogEntry struct {
+	Time    time.Time
+	Level   LogLevel
+	Message string
+}
+
+const defaultLogCapacity = 1000
+
+// LogPanel shows timestamped log entries, newest at the bottom. It follows
+// new entries until the user scrolls up, and resumes once scrolled back to
+// the end. Entries may be added from any goroutine.
+type LogPanel struct {
+	FocusableBase
+	mu         sync.Mutex
+	entries    []LogEntry
+	capacity   int
+	minLevel   LogLevel
+	offset     int
+	follow     bool
+	timeFormat string
+	label      string
+	services   runtime.Services
+	scrollbar  scroll.Scrollbar
+}
+
+// NewLogPanel creates an empty log panel keeping the last 1000 entries.
+func NewLogPanel() *LogPanel {
+	p := &LogPanel{
+		capacity:   defaultLogCapacity,
+		follow:     true,
+		timeFormat: "15:04:05",
+		label:      "Log",
+		scrollbar: scroll.Scrollbar{
+			Orientation:  scroll.Vertical,
+			Track:        backend.DefaultStyle(),
+			Thumb:        backend.DefaultStyle().Reverse(true),
+			MinThumbSize: 1,
+			Chars:        scroll.DefaultScrollbarChars(),
+		},
+	}
+	p.Base.Role = accessibility.RoleList
+	p.syncA11y()
+	return p
+}
+
+// Log appends a message stamped with the current time. A message with
+// several lines becomes one entry per line.
+func (p *LogPanel) Log(level LogLevel, message string) {
+	if p == nil {
+		return
+	}
+	now := time.Now()
+	for _, line := range strings.Split(strings.TrimRight(message, "\n"), "\n") {
+		p.Append(LogEntry{Time: now, Level: level, Message: line})
+	}
+}
+
+// Debug logs a message at LogDebug.
+func (p *LogPanel) Debug(message string) { p.Log(LogDebug, message) }
+
+// Info logs a message at LogInfo.
+func (p *LogPanel) Info(message string) { p.Log(LogInfo, message) }
+
+// Warn logs a message at LogWarn.
+func (p *LogPanel) Warn(message string) { p.Log(LogWarn, message) }
+
+// Error logs a message at LogError.
+func (p *LogPanel) Error(message string) { p.Log(LogError, message) }
+
+// Append adds an entry, dropping the oldest ones beyond the capacity.
+func (p *LogPanel) Append(entry LogEntry) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.entries = append(p.entries, entry)
+	p.trimLocked()
+	services := p.services
+	p.mu.Unlock()
+	services.Invalidate()
+}
+
+// Entries returns the retained entries, oldest first, regardless of the
+// level filter.
+func (p *LogPanel) Entries() []LogEntry {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]LogEntry(nil), p.entries...)
+}
+
+// Clear removes every entry.
+func (p *LogPanel) Clear() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.entries = nil
+	p.offset = 0
+	p.follow = true
+	p.mu.Unlock()
+	p.Invalidate()
+}
+
+// SetLevelFilter hides entries below level.
+func (p *LogPanel) SetLevelFilter(level LogLevel) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.minLevel = level
+	p.mu.Unlock()
+	p.Invalidate()
+}
+
+// LevelFilter returns the lowest level shown.
+func (p *LogPanel) LevelFilter() LogLevel {
+	if p == nil {
+		return LogDebug
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.minLevel
+}
+
+// SetCapacity sets how many entries are kept; zero or less keeps all.
+func (p *LogPanel) SetCapacity(capacity int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.capacity = capacity
+	p.trimLocked()
+	p.mu.Unlock()
+	p.Invalidate()
+}
+
+// Capacity returns how many entries are kept, or 0 when unlimited.
+func (p *LogPanel) Capacity() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return max(0, p.capacity)
+}
+
+// SetTimeFormat sets the time layout shown before each entry. An empty
+// layout hides the time.
+func (p *LogPanel) SetTimeFormat(layout string) {
+	if p == nil {
+		return
+	}
+	p.timeFormat = layout
+	p.Invalidate()
+}
+
+// Following reports whether the panel scrolls to new entries.
+func (p *LogPanel) Following() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.follow
+}
+
+// SetLabel updates the accessibility label.
+func (p *LogPanel) SetLabel(label string) {
+	if p == nil {
+		return
+	}
+	p.label = label
+	p.syncA11y()
+}
+
+// Writer returns an io.Writer that logs each written line at level, so a
+// standard logger can write into the panel:
+//
+//	log.SetOutput(panel.Writer(widgets.LogInfo))
+func (p *LogPanel) Writer(level LogLevel) io.Writer {
+	return &logPanelWriter{panel: p, level: level}
+}
+
+// Bind attaches app services.
+func (p *LogPanel) Bind(services runtime.Services) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.services = services
+	p.mu.Unlock()
+}
+
+// Unbind releases app services.
+func (p *LogPanel) Unbind() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.services = runtime.Services{}
+	p.mu.Unlock()
+}
+
+// StyleType returns the selector type name.
+func (p *LogPanel) StyleType() string {
+	return "LogPanel"
+}
+
+// Measure fills the available space.
+func (p *LogPanel) Measure(constraints runtime.Constraints) runtime.Size {
+	return p.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		return contentConstraints.MaxSize()
+	})
+}
+
+// Render draws the entries in view, each colored by its level.
+func (p *LogPanel) Render(ctx runtime.RenderContext) {
+	if p == nil {
+		return
+	}
+	p.syncA11y()
+	outer := p.bounds
+	content := p.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	baseStyle := resolveBaseStyle(ctx, p, backend.DefaultStyle(), false)
+	ctx.Buffer.Fill(outer, ' ', baseStyle)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	visible := p.visibleLocked()
+	p.clampOffsetLocked(len(visible))
+	offset := p.offset
+	p.mu.Unlock()
+
+	width := content.Width
+	showBar := len(visible) > content.Height && content.Width > 1
+	if showBar {
+		width--
+	}
+	end := min(len(visible), offset+content.Height)
+	for i := offset; i < end; i++ {
+		entry := visible[i]
+		style := mergeBackendStyles(baseStyle, themeStyle(entry.Level.themeToken()))
+		ctx.Buffer.SetString(content.X, content.Y+i-offset, truncateString(p.format(entry), width), style)
+	}
+	if showBar {
+		barBounds := runtime.Rect{X: content.X + width, Y: content.Y, Width: 1, Height: content.Height}
+		drawScrollbar(ctx.Buffer, barBounds, p.scrollbar, len(visible), content.Height, offset)
+	}
+}
+
+// format lays an entry out as "15:04:05 WARN  message".
+func (p *LogPanel) format(entry LogEntry) string {
+	line := fmt.Sprintf("%-5s %s", entry.Level, entry.Message)
+	if p.timeFormat != "" {
+		line = entry.Time.Format(p.timeFormat) + " " + line
+	}
+	return line
+}
+
+// HandleMessage scrolls the log.
+func (p *LogPanel) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if p == nil {
+		return runtime.Unhandled()
+	}
+	switch ev := msg.(type) {
+	case runtime.KeyMsg:
+		if !p.focused {
+			return runtime.Unhandled()
+		}
+		switch ev.Key {
+		case terminal.KeyUp:
+			p.ScrollBy(0, -1)
+		case terminal.KeyDown:
+			p.ScrollBy(0, 1)
+		case terminal.KeyPageUp:
+			p.PageBy(-1)
+		case terminal.KeyPageDown:
+			p.PageBy(1)
+		case terminal.KeyHome:
+			p.ScrollToStart()
+		case terminal.KeyEnd:
+			p.ScrollToEnd()
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		switch ev.Button {
+		case runtime.MouseWheelUp:
+			p.ScrollBy(0, -3)
+		case runtime.MouseWheelDown:
+			p.ScrollBy(0, 3)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// ScrollBy scrolls by dy entries. Scrolling up pauses following new
+// entries; reaching the end resumes it.
+func (p *LogPanel) ScrollBy(dx, dy int) {
+	if p == nil || dy == 0 {
+		return
+	}
+	p.mu.Lock()
+	p.scrollToLocked(p.offset + dy)
+	p.mu.Unlock()
+	p.Invalidate()
+}
+
+// ScrollTo scrolls to entry y of the filtered entries.
+func (p *LogPanel) ScrollTo(x, y int) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.scrollToLocked(y)
+	p.mu.Unlock()
+	p.Invalidate()
+}
+
+// PageBy scrolls by pages.
+func (p *LogPanel) PageBy(pages int) {
+	if p == nil {
+		return
+	}
+	p.ScrollBy(0, pages*max(1, p.ContentBounds().Height))
+}
+
+// ScrollToStart scrolls to the oldest entry.
+func (p *LogPanel) ScrollToStart() {
+	p.ScrollTo(0, 0)
+}
+
+// ScrollToEnd scrolls to the newest entry and resumes following.
+func (p *LogPanel) ScrollToEnd() {
+	if p == nil {
+		return
+	}
+	p.ScrollTo(0, len(p.Entries()))
+}
+
+// Offset returns the first entry in view.
+func (p *LogPanel) Offset() int {
+	if p == nil {
+		return 0
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.offset
+}
+
+func (p *LogPanel) scrollToLocked(offset int) {
+	p.follow = false
+	p.offset = offset
+	p.clampOffsetLocked(len(p.visibleLocked()))
+}
+
+// clampOffsetLocked keeps the offset in range, pinning it to the end while
+// following and resuming following once the end is in view.
+func (p *LogPanel) clampOffsetLocked(total int) {
+	last := max(0, total-p.ContentBounds().Height)
+	if p.follow {
+		p.offset = last
+		return
+	}
+	p.offset = clampInt(p.offset, 0, last)
+	p.follow = p.offset == last
+}
+
+// trimLocked drops the oldest entries beyond the capacity, keeping a
+// paused view on the same entries.
+func (p *LogPanel) trimLocked() {
+	drop := len(p.entries) - p.capacity
+	if p.capacity <= 0 || drop <= 0 {
+		return
+	}
+	if !p.follow {
+		for _, entry := range p.entries[:drop] {
+			if entry.Level >= p.minLevel {
+				p.offset--
+			}
+		}
+		p.offset = max(0, p.offset)
+	}
+	p.entries = append([]LogEntry(nil), p.entries[drop:]...)
+}
+
+func (p *LogPanel) visibleLocked() []LogEntry {
+	if p.minLevel <= LogDebug {
+		return p.entries
+	}
+	visible := make([]LogEntry, 0, len(p.entries))
+	for _, entry := range p.entries {
+		if entry.Level >= p.minLevel {
+			visible = append(visible, entry)
+		}
+	}
+	return visible
+}
+
+func (p *LogPanel) syncA11y() {
+	if p == nil {
+		return
+	}
+	if p.Base.Role == "" {
+		p.Base.Role = accessibility.RoleList
+	}
+	label := strings.TrimSpace(p.label)
+	if label == "" {
+		label = "Log"
+	}
+	p.Base.Label = label
+	p.mu.Lock()
+	count := len(p.entries)
+	p.mu.Unlock()
+	p.Base.Description = fmt.Sprintf("%d entries", count)
+}
+
+// logPanelWriter logs each complete line written to it, holding back a
+// trailing partial line until its newline arrives.
+type logPanelWriter struct {
+	mu      sync.Mutex
+	panel   *LogPanel
+	level   LogLevel
+	pending []byte
+}
+
+func (w *logPanelWriter) Write(data []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pending = append(w.pending, data...)
+	for {
+		end := bytes.IndexByte(w.pending, '\n')
+		if end < 0 {
+			break
+		}
+		line := strings.TrimSuffix(string(w.pending[:end]), "\r")
+		w.pending = w.pending[end+1:]
+		w.panel.Log(w.level, line)
+	}
+	return len(data), nil
+}
+
+var _ runtime.Widget = (*LogPanel)(nil)
+var _ runtime.Focusable = (*LogPanel)(nil)
+var _ runtime.Bindable = (*LogPanel)(nil)
+var _ runtime.Unbindable = (*LogPanel)(nil)
+var _ scroll.Controller = (*LogPanel)(nil)
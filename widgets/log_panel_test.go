@@ -0,0 +1,90 @@
+package widgets
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestLogPanelFollowsAndPauses(t *testing.T) {
+	panel := NewLogPanel()
+	panel.SetTimeFormat("")
+	for i := 1; i <= 5; i++ {
+		panel.Info(fmt.Sprintf("line %d", i))
+	}
+	_, rows := renderRows(t, panel, 20, 3)
+	if !strings.HasPrefix(rows[2], "INFO  line 5") {
+		t.Fatalf("rows = %q, want the newest entry at the bottom", rows)
+	}
+
+	panel.Focus()
+	panel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if panel.Following() {
+		t.Fatal("expected scrolling up to pause following")
+	}
+	panel.Warn("line 6")
+	_, rows = renderRows(t, panel, 20, 3)
+	if !strings.HasPrefix(rows[2], "INFO  line 4") {
+		t.Fatalf("rows = %q, want the paused view to stay put", rows)
+	}
+
+	panel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd})
+	_, rows = renderRows(t, panel, 20, 3)
+	if !panel.Following() || !strings.HasPrefix(rows[2], "WARN  line 6") {
+		t.Fatalf("rows = %q, want End to resume following", rows)
+	}
+}
+
+func TestLogPanelFilterAndCapacity(t *testing.T) {
+	panel := NewLogPanel()
+	at := time.Date(2026, 1, 2, 9, 30, 0, 0, time.UTC)
+	panel.Append(LogEntry{Time: at, Level: LogDebug, Message: "dial"})
+	panel.Append(LogEntry{Time: at, Level: LogError, Message: "refused"})
+	panel.Append(LogEntry{Time: at, Level: LogInfo, Message: "retrying"})
+
+	panel.SetLevelFilter(LogInfo)
+	_, rows := renderRows(t, panel, 30, 3)
+	if rows[0] != "09:30:00 ERROR refused" || rows[1] != "09:30:00 INFO  retrying" || rows[2] != "" {
+		t.Fatalf("rows = %q, want debug entries hidden", rows)
+	}
+
+	panel.SetCapacity(2)
+	entries := panel.Entries()
+	if len(entries) != 2 || entries[0].Message != "refused" {
+		t.Fatalf("entries = %+v, want the oldest dropped", entries)
+	}
+}
+
+func TestLogPanelWriter(t *testing.T) {
+	panel := NewLogPanel()
+	logger := log.New(panel.Writer(LogWarn), "", 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			logger.Printf("worker %d", i)
+		}(i)
+	}
+	wg.Wait()
+
+	writer := panel.Writer(LogInfo)
+	fmt.Fprint(writer, "partial")
+	if len(panel.Entries()) != 4 {
+		t.Fatalf("entries = %d, want a partial line held back", len(panel.Entries()))
+	}
+	fmt.Fprint(writer, " line\nnext\n")
+	entries := panel.Entries()
+	if len(entries) != 6 || entries[4].Message != "partial line" || entries[5].Level != LogInfo {
+		t.Fatalf("entries = %+v", entries)
+	}
+	if entries[0].Level != LogWarn || !strings.HasPrefix(entries[0].Message, "worker ") {
+		t.Fatalf("logger entry = %+v", entries[0])
+	}
+}
@@ -0,0 +1,609 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/markdown"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/scroll"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// Heading is a document heading found by a MarkdownView.
+type Heading struct {
+	Level  int
+	Text   string
+	Anchor string
+}
+
+// MarkdownViewOption configures a MarkdownView.
+type MarkdownViewOption = Option[MarkdownView]
+
+// WithMarkdownViewLabel sets the accessibility label.
+func WithMarkdownViewLabel(label string) MarkdownViewOption {
+	return func(m *MarkdownView) {
+		if m == nil {
+			return
+		}
+		m.label = label
+	}
+}
+
+// WithMarkdownViewRenderer uses a custom markdown renderer.
+func WithMarkdownViewRenderer(renderer *markdown.Renderer) MarkdownViewOption {
+	return func(m *MarkdownView) {
+		if m == nil {
+			return
+		}
+		m.renderer = renderer
+	}
+}
+
+// MarkdownView is a scrolling document reader. Unlike RichText it wraps
+// prose at word boundaries, and it keeps a table of contents: press t to
+// open the heading list, move with Up and Down, and press Enter to jump.
+// Only the rows in view are drawn, so long documents stay cheap to render.
+type MarkdownView struct {
+	FocusableBase
+
+	source      string
+	renderer    *markdown.Renderer
+	lines       []markdown.StyledLine
+	wrapped     []richTextLine
+	width       int
+	offset      int
+	headings    []Heading
+	headingRows []int
+	pending     string
+	tocOpen     bool
+	tocSelected int
+	tocOffset   int
+	label       string
+	style       backend.Style
+	styleSet    bool
+	scrollbar   scroll.Scrollbar
+}
+
+// NewMarkdownView creates a view of the markdown source.
+func NewMarkdownView(source string, opts ...MarkdownViewOption) *MarkdownView {
+	m := &MarkdownView{
+		source: source,
+		label:  "Document",
+		style:  backend.DefaultStyle(),
+		scrollbar: scroll.Scrollbar{
+			Orientation:  scroll.Vertical,
+			Track:        backend.DefaultStyle(),
+			Thumb:        backend.DefaultStyle().Reverse(true),
+			MinThumbSize: 1,
+			Chars:        scroll.DefaultScrollbarChars(),
+		},
+	}
+	m.Base.Role = accessibility.RoleText
+	for _, opt := range opts {
+		if opt != nil {
+			opt(m)
+		}
+	}
+	m.parse()
+	m.syncA11y()
+	return m
+}
+
+// SetSource replaces the document and scrolls to the top.
+func (m *MarkdownView) SetSource(source string) {
+	if m == nil {
+		return
+	}
+	m.source = source
+	m.parse()
+	m.offset = 0
+	m.tocOpen = false
+	m.pending = ""
+	if m.width > 0 {
+		m.rewrap(m.width)
+	}
+	m.Invalidate()
+}
+
+// Source returns the markdown source.
+func (m *MarkdownView) Source() string {
+	if m == nil {
+		return ""
+	}
+	return m.source
+}
+
+// Headings returns the document headings in order.
+func (m *MarkdownView) Headings() []Heading {
+	if m == nil {
+		return nil
+	}
+	return append([]Heading(nil), m.headings...)
+}
+
+// ScrollToHeading scrolls so the first heading whose text or anchor
+// matches, ignoring case, is at the top. Before the first layout the
+// scroll is applied once the view is sized.
+func (m *MarkdownView) ScrollToHeading(text string) {
+	if m == nil {
+		return
+	}
+	index := m.findHeading(text)
+	if index < 0 {
+		return
+	}
+	if m.headingRows == nil {
+		m.pending = text
+		return
+	}
+	m.ScrollTo(0, m.headingRows[index])
+}
+
+// OpenTOC shows the heading list with the heading above the view selected.
+func (m *MarkdownView) OpenTOC() {
+	if m == nil || len(m.headings) == 0 {
+		return
+	}
+	m.tocOpen = true
+	m.tocSelected = 0
+	for i, row := range m.headingRows {
+		if row > m.offset {
+			break
+		}
+		m.tocSelected = i
+	}
+	m.tocOffset = 0
+	m.Invalidate()
+}
+
+// CloseTOC hides the heading list.
+func (m *MarkdownView) CloseTOC() {
+	if m == nil || !m.tocOpen {
+		return
+	}
+	m.tocOpen = false
+	m.Invalidate()
+}
+
+// TOCOpen reports whether the heading list is shown.
+func (m *MarkdownView) TOCOpen() bool {
+	return m != nil && m.tocOpen
+}
+
+// SetLabel updates the accessibility label.
+func (m *MarkdownView) SetLabel(label string) {
+	if m == nil {
+		return
+	}
+	m.label = label
+	m.syncA11y()
+}
+
+// SetStyle updates the base style.
+func (m *MarkdownView) SetStyle(style backend.Style) {
+	if m == nil {
+		return
+	}
+	m.style = style
+	m.styleSet = true
+	m.Invalidate()
+}
+
+// StyleType returns the selector type name.
+func (m *MarkdownView) StyleType() string {
+	return "MarkdownView"
+}
+
+// Measure returns the wrapped document size.
+func (m *MarkdownView) Measure(constraints runtime.Constraints) runtime.Size {
+	return m.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := contentConstraints.MaxWidth
+		if width <= 0 {
+			width = contentConstraints.MinWidth
+		}
+		if width <= 0 {
+			width = 1
+		}
+		m.rewrap(width)
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: len(m.wrapped)})
+	})
+}
+
+// Layout stores bounds and rewraps the document to the new width.
+func (m *MarkdownView) Layout(bounds runtime.Rect) {
+	m.FocusableBase.Layout(bounds)
+	content := m.ContentBounds()
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	width := content.Width
+	m.rewrap(width)
+	if len(m.wrapped) > content.Height && width > 1 {
+		m.rewrap(width - 1)
+	}
+	m.clampOffset()
+	if m.pending != "" {
+		text := m.pending
+		m.pending = ""
+		m.ScrollToHeading(text)
+	}
+}
+
+// Render draws the rows in view and, when open, the heading list.
+func (m *MarkdownView) Render(ctx runtime.RenderContext) {
+	if m == nil {
+		return
+	}
+	m.syncA11y()
+	outer := m.bounds
+	content := m.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	baseStyle := resolveBaseStyle(ctx, m, backend.DefaultStyle(), false)
+	if m.styleSet {
+		baseStyle = mergeBackendStyles(baseStyle, m.style)
+	}
+	ctx.Buffer.Fill(outer, ' ', baseStyle)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	if m.tocOpen {
+		m.renderTOC(ctx, content, baseStyle)
+		return
+	}
+	visibleWidth := content.Width
+	showBar := len(m.wrapped) > content.Height && content.Width > 1
+	if showBar {
+		visibleWidth--
+	}
+	end := min(len(m.wrapped), m.offset+content.Height)
+	for i := m.offset; i < end; i++ {
+		line := m.wrapped[i]
+		lineBounds := runtime.Rect{X: content.X, Y: content.Y + i - m.offset, Width: visibleWidth, Height: 1}
+		if line.BaseStyle != backend.DefaultStyle() {
+			ctx.Buffer.Fill(lineBounds, ' ', line.BaseStyle)
+		}
+		drawRichTextLine(ctx.Buffer, lineBounds, line)
+	}
+	if showBar {
+		barBounds := runtime.Rect{X: content.X + visibleWidth, Y: content.Y, Width: 1, Height: content.Height}
+		drawScrollbar(ctx.Buffer, barBounds, m.scrollbar, len(m.wrapped), content.Height, m.offset)
+	}
+}
+
+func (m *MarkdownView) renderTOC(ctx runtime.RenderContext, content runtime.Rect, baseStyle backend.Style) {
+	titleStyle := baseStyle.Bold(true)
+	ctx.Buffer.SetString(content.X, content.Y, truncateString("Contents", content.Width), titleStyle)
+	rows := content.Height - 1
+	if rows <= 0 {
+		return
+	}
+	if m.tocSelected < m.tocOffset {
+		m.tocOffset = m.tocSelected
+	}
+	if m.tocSelected >= m.tocOffset+rows {
+		m.tocOffset = m.tocSelected - rows + 1
+	}
+	selectedStyle := mergeBackendStyles(baseStyle, themeStyle("selection")).Reverse(true)
+	topLevel := 6
+	for _, heading := range m.headings {
+		topLevel = min(topLevel, heading.Level)
+	}
+	end := min(len(m.headings), m.tocOffset+rows)
+	for i := m.tocOffset; i < end; i++ {
+		heading := m.headings[i]
+		text := strings.Repeat("  ", max(0, heading.Level-topLevel)) + heading.Text
+		style := baseStyle
+		y := content.Y + 1 + i - m.tocOffset
+		if i == m.tocSelected {
+			style = selectedStyle
+			ctx.Buffer.Fill(runtime.Rect{X: content.X, Y: y, Width: content.Width, Height: 1}, ' ', style)
+		}
+		ctx.Buffer.SetString(content.X, y, truncateString(text, content.Width), style)
+	}
+}
+
+// HandleMessage scrolls the document and drives the heading list.
+func (m *MarkdownView) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if m == nil {
+		return runtime.Unhandled()
+	}
+	if m.tocOpen {
+		return m.handleTOC(msg)
+	}
+	switch ev := msg.(type) {
+	case runtime.KeyMsg:
+		if !m.focused {
+			return runtime.Unhandled()
+		}
+		switch ev.Key {
+		case terminal.KeyUp:
+			m.ScrollBy(0, -1)
+		case terminal.KeyDown:
+			m.ScrollBy(0, 1)
+		case terminal.KeyPageUp:
+			m.PageBy(-1)
+		case terminal.KeyPageDown:
+			m.PageBy(1)
+		case terminal.KeyHome:
+			m.ScrollToStart()
+		case terminal.KeyEnd:
+			m.ScrollToEnd()
+		case terminal.KeyRune:
+			if ev.Rune != 't' || len(m.headings) == 0 {
+				return runtime.Unhandled()
+			}
+			m.OpenTOC()
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		switch ev.Button {
+		case runtime.MouseWheelUp:
+			m.ScrollBy(0, -3)
+		case runtime.MouseWheelDown:
+			m.ScrollBy(0, 3)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+func (m *MarkdownView) handleTOC(msg runtime.Message) runtime.HandleResult {
+	switch ev := msg.(type) {
+	case runtime.KeyMsg:
+		if !m.focused {
+			return runtime.Unhandled()
+		}
+		switch ev.Key {
+		case terminal.KeyUp:
+			m.tocSelected = max(0, m.tocSelected-1)
+		case terminal.KeyDown:
+			m.tocSelected = min(len(m.headings)-1, m.tocSelected+1)
+		case terminal.KeyHome:
+			m.tocSelected = 0
+		case terminal.KeyEnd:
+			m.tocSelected = len(m.headings) - 1
+		case terminal.KeyEnter:
+			m.jumpToTOCSelection()
+		case terminal.KeyEscape:
+			m.CloseTOC()
+		case terminal.KeyRune:
+			if ev.Rune != 't' {
+				return runtime.Handled()
+			}
+			m.CloseTOC()
+		}
+		m.Invalidate()
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		content := m.ContentBounds()
+		if ev.Action != runtime.MousePress || ev.Button != runtime.MouseLeft || !content.Contains(ev.X, ev.Y) {
+			return runtime.Unhandled()
+		}
+		index := m.tocOffset + ev.Y - content.Y - 1
+		if ev.Y > content.Y && index < len(m.headings) {
+			m.tocSelected = index
+			m.jumpToTOCSelection()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+func (m *MarkdownView) jumpToTOCSelection() {
+	m.tocOpen = false
+	if m.tocSelected >= 0 && m.tocSelected < len(m.headingRows) {
+		m.ScrollTo(0, m.headingRows[m.tocSelected])
+	}
+	m.Invalidate()
+}
+
+// ScrollBy scrolls the document by dy rows.
+func (m *MarkdownView) ScrollBy(dx, dy int) {
+	if m == nil || dy == 0 {
+		return
+	}
+	m.offset += dy
+	m.clampOffset()
+	m.Invalidate()
+}
+
+// ScrollTo scrolls to row y.
+func (m *MarkdownView) ScrollTo(x, y int) {
+	if m == nil {
+		return
+	}
+	m.offset = y
+	m.clampOffset()
+	m.Invalidate()
+}
+
+// PageBy scrolls by pages.
+func (m *MarkdownView) PageBy(pages int) {
+	if m == nil {
+		return
+	}
+	m.ScrollBy(0, pages*max(1, m.ContentBounds().Height))
+}
+
+// ScrollToStart scrolls to the top.
+func (m *MarkdownView) ScrollToStart() {
+	m.ScrollTo(0, 0)
+}
+
+// ScrollToEnd scrolls to the bottom.
+func (m *MarkdownView) ScrollToEnd() {
+	if m == nil {
+		return
+	}
+	m.ScrollTo(0, len(m.wrapped))
+}
+
+// Offset returns the first row in view.
+func (m *MarkdownView) Offset() int {
+	if m == nil {
+		return 0
+	}
+	return m.offset
+}
+
+func (m *MarkdownView) findHeading(text string) int {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return -1
+	}
+	for i, heading := range m.headings {
+		if strings.EqualFold(heading.Text, text) || strings.EqualFold(heading.Anchor, text) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *MarkdownView) parse() {
+	if m.renderer == nil {
+		m.renderer = markdown.NewRenderer(nil)
+	}
+	m.lines = nil
+	m.headings = nil
+	m.wrapped = nil
+	m.headingRows = nil
+	m.width = 0
+	if strings.TrimSpace(m.source) == "" {
+		return
+	}
+	m.lines = m.renderer.Render("", m.source)
+	for _, line := range m.lines {
+		if line.HeadingLevel <= 0 {
+			continue
+		}
+		var sb strings.Builder
+		for _, span := range line.Spans {
+			sb.WriteString(span.Text)
+		}
+		m.headings = append(m.headings, Heading{
+			Level:  line.HeadingLevel,
+			Text:   strings.TrimSpace(sb.String()),
+			Anchor: line.Anchor,
+		})
+	}
+}
+
+func (m *MarkdownView) rewrap(width int) {
+	width = max(1, width)
+	if width == m.width && m.wrapped != nil {
+		return
+	}
+	m.width = width
+	m.wrapped = make([]richTextLine, 0, len(m.lines))
+	m.headingRows = make([]int, 0, len(m.headings))
+	for _, line := range m.lines {
+		if line.HeadingLevel > 0 {
+			m.headingRows = append(m.headingRows, len(m.wrapped))
+		}
+		m.wrapped = append(m.wrapped, wordWrapRichTextLine(line, width)...)
+	}
+}
+
+func (m *MarkdownView) clampOffset() {
+	maxOffset := max(0, len(m.wrapped)-m.ContentBounds().Height)
+	m.offset = clampInt(m.offset, 0, maxOffset)
+}
+
+func (m *MarkdownView) syncA11y() {
+	if m == nil {
+		return
+	}
+	if m.Base.Role == "" {
+		m.Base.Role = accessibility.RoleText
+	}
+	label := strings.TrimSpace(m.label)
+	if label == "" {
+		label = "Document"
+	}
+	m.Base.Label = label
+	if len(m.headings) > 0 {
+		m.Base.Description = "Press t for the table of contents"
+	} else {
+		m.Base.Description = ""
+	}
+}
+
+type styledRune struct {
+	r     rune
+	style backend.Style
+}
+
+// wordWrapRichTextLine wraps a line at spaces, falling back to breaking
+// words longer than the width. Code lines keep character wrapping so
+// indentation and alignment survive.
+func wordWrapRichTextLine(line markdown.StyledLine, width int) []richTextLine {
+	if line.IsCode || line.IsCodeHeader || (line.BlankLine && len(line.Spans) == 0) {
+		return wrapRichTextLine(line, width)
+	}
+	prefix := convertRichTextSpans(line.Prefix)
+	prefixWidth := richTextSpanWidth(prefix)
+	avail := width - prefixWidth
+	if avail <= 0 {
+		return wrapRichTextLine(line, width)
+	}
+	var cells []styledRune
+	for _, span := range convertRichTextSpans(line.Spans) {
+		for _, r := range span.Text {
+			cells = append(cells, styledRune{r: r, style: span.Style})
+		}
+	}
+
+	var out []richTextLine
+	anchor := line.Anchor
+	for start := 0; start < len(cells); {
+		end, used, lastSpace := start, 0, -1
+		for end < len(cells) && cells[end].r != '\n' {
+			rw := runewidth.RuneWidth(cells[end].r)
+			if used+rw > avail {
+				break
+			}
+			if cells[end].r == ' ' {
+				lastSpace = end
+			}
+			used += rw
+			end++
+		}
+		next := end
+		switch {
+		case end == len(cells):
+		case cells[end].r == '\n' || cells[end].r == ' ':
+			next = end + 1
+		case lastSpace > start:
+			end, next = lastSpace, lastSpace+1
+		case end == start:
+			end, next = start+1, start+1
+		}
+		current := newRichTextLine(prefix, anchor)
+		anchor = ""
+		for _, cell := range cells[start:end] {
+			if runewidth.RuneWidth(cell.r) > 0 {
+				appendRichTextRune(&current, cell.r, cell.style)
+			}
+		}
+		out = append(out, current)
+		start = next
+	}
+	if len(out) == 0 {
+		out = append(out, newRichTextLine(prefix, anchor))
+	}
+	return out
+}
+
+var _ runtime.Widget = (*MarkdownView)(nil)
+var _ runtime.Focusable = (*MarkdownView)(nil)
+var _ scroll.Controller = (*MarkdownView)(nil)
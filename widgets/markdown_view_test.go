@@ -0,0 +1,77 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+const markdownViewDoc = `# Guide
+
+The quick brown fox jumps over the lazy dog.
+
+## Setup
+
+- [x] install
+- [ ] configure
+
+## Usage
+
+Run it.
+`
+
+func TestMarkdownViewWordWrapAndHeadings(t *testing.T) {
+	view := NewMarkdownView(markdownViewDoc)
+	headings := view.Headings()
+	if len(headings) != 3 || headings[1].Text != "Setup" || headings[1].Level != 2 || headings[1].Anchor != "setup" {
+		t.Fatalf("headings = %+v", headings)
+	}
+
+	_, rows := renderRows(t, view, 16, 20)
+	text := strings.Join(rows, "\n")
+	if !strings.Contains(text, "The quick brown\nfox jumps over") {
+		t.Fatalf("expected prose wrapped at word boundaries:\n%s", text)
+	}
+	if !strings.Contains(text, "install") || !strings.Contains(text, "configure") {
+		t.Fatalf("expected task list items:\n%s", text)
+	}
+}
+
+func TestMarkdownViewScrollToHeadingAndTOC(t *testing.T) {
+	var doc strings.Builder
+	for i := 1; i <= 5; i++ {
+		fmt.Fprintf(&doc, "## Part %d\n\n", i)
+		for j := 0; j < 10; j++ {
+			fmt.Fprintf(&doc, "Line %d.%d\n\n", i, j)
+		}
+	}
+	view := NewMarkdownView(doc.String())
+	view.ScrollToHeading("part 3")
+	view.Focus()
+
+	_, rows := renderRows(t, view, 20, 5)
+	if !strings.HasPrefix(rows[0], "Part 3 ") {
+		t.Fatalf("expected a pending jump to apply on layout, top row %q", rows[0])
+	}
+
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 't'})
+	if !view.TOCOpen() {
+		t.Fatal("expected t to open the heading list")
+	}
+	_, rows = renderRows(t, view, 20, 5)
+	if rows[0] != "Contents" || !strings.HasPrefix(rows[3], "Part 3") {
+		t.Fatalf("unexpected heading list: %q", rows)
+	}
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if view.TOCOpen() {
+		t.Fatal("expected Enter to close the heading list")
+	}
+	_, rows = renderRows(t, view, 20, 5)
+	if !strings.HasPrefix(rows[0], "Part 4 ") {
+		t.Fatalf("expected a jump to Part 4, top row %q", rows[0])
+	}
+}
@@ -0,0 +1,287 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+// MasterDetail shows a list beside a detail pane for its selected item.
+// The detail pane is rebuilt from the detail function whenever the
+// selection changes, and shows an empty placeholder while nothing is
+// selected, such as when a filter hides every item.
+type MasterDetail[T any] struct {
+	Base
+	list     *List[T]
+	detail   func(item T) runtime.Widget
+	empty    runtime.Widget
+	pane     runtime.Widget
+	splitter *Splitter
+	shown    int
+	onSelect func(index int, item T)
+	services runtime.Services
+	mounted  bool
+	label    string
+}
+
+// NewMasterDetail creates a side-by-side list and detail pane. The list's
+// select callback is taken over; use SetOnSelect on the MasterDetail
+// instead.
+func NewMasterDetail[T any](list *List[T], detail func(item T) runtime.Widget) *MasterDetail[T] {
+	m := &MasterDetail[T]{
+		list:   list,
+		detail: detail,
+		empty:  NewLabel("Nothing selected"),
+		shown:  -1,
+		label:  "Master detail",
+	}
+	m.splitter = NewSplitter(list, m.empty)
+	m.splitter.Ratio = 0.35
+	m.pane = m.empty
+	if list != nil {
+		list.SetOnSelect(func(index int, item T) {
+			m.sync()
+			if m.onSelect != nil {
+				m.onSelect(index, item)
+			}
+		})
+	}
+	m.Base.Role = accessibility.RoleGroup
+	m.sync()
+	m.syncA11y()
+	return m
+}
+
+// SetOnSelect registers a callback for list selection changes.
+func (m *MasterDetail[T]) SetOnSelect(fn func(index int, item T)) {
+	if m == nil {
+		return
+	}
+	m.onSelect = fn
+}
+
+// SetEmpty sets the widget shown while nothing is selected.
+func (m *MasterDetail[T]) SetEmpty(empty runtime.Widget) {
+	if m == nil {
+		return
+	}
+	wasEmpty := m.pane == m.empty
+	m.empty = empty
+	if wasEmpty {
+		m.setPane(empty)
+	}
+}
+
+// Refresh rebuilds the detail pane for the current selection, for when the
+// selected item's data changed in place.
+func (m *MasterDetail[T]) Refresh() {
+	if m == nil {
+		return
+	}
+	m.shown = -1
+	m.sync()
+}
+
+// SetRatio sets the share of the space given to the list, between 0 and 1.
+func (m *MasterDetail[T]) SetRatio(ratio float64) {
+	if m == nil || ratio <= 0 || ratio >= 1 {
+		return
+	}
+	m.splitter.Ratio = ratio
+	m.services.Relayout()
+}
+
+// Ratio returns the share of the space given to the list.
+func (m *MasterDetail[T]) Ratio() float64 {
+	if m == nil {
+		return 0
+	}
+	return m.splitter.Ratio
+}
+
+// SetOrientation puts the detail pane right of the list with
+// SplitHorizontal or below it with SplitVertical.
+func (m *MasterDetail[T]) SetOrientation(orientation SplitterOrientation) {
+	if m == nil {
+		return
+	}
+	m.splitter.Orientation = orientation
+	m.services.Relayout()
+}
+
+// Orientation returns the split direction.
+func (m *MasterDetail[T]) Orientation() SplitterOrientation {
+	if m == nil {
+		return SplitHorizontal
+	}
+	return m.splitter.Orientation
+}
+
+// List returns the master list.
+func (m *MasterDetail[T]) List() *List[T] {
+	if m == nil {
+		return nil
+	}
+	return m.list
+}
+
+// Detail returns the widget in the detail pane.
+func (m *MasterDetail[T]) Detail() runtime.Widget {
+	if m == nil {
+		return nil
+	}
+	return m.pane
+}
+
+// SetLabel updates the accessibility label.
+func (m *MasterDetail[T]) SetLabel(label string) {
+	if m == nil {
+		return
+	}
+	m.label = label
+	m.syncA11y()
+}
+
+// Bind attaches app services.
+func (m *MasterDetail[T]) Bind(services runtime.Services) {
+	if m == nil {
+		return
+	}
+	m.services = services
+}
+
+// Unbind releases app services.
+func (m *MasterDetail[T]) Unbind() {
+	if m == nil {
+		return
+	}
+	m.services = runtime.Services{}
+}
+
+// Mount marks the container as mounted.
+func (m *MasterDetail[T]) Mount() {
+	if m == nil {
+		return
+	}
+	m.mounted = true
+}
+
+// Unmount marks the container as unmounted.
+func (m *MasterDetail[T]) Unmount() {
+	if m == nil {
+		return
+	}
+	m.mounted = false
+}
+
+// StyleType returns the selector type name.
+func (m *MasterDetail[T]) StyleType() string {
+	return "MasterDetail"
+}
+
+// Measure returns the size of the split panes.
+func (m *MasterDetail[T]) Measure(constraints runtime.Constraints) runtime.Size {
+	return m.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		return m.splitter.Measure(contentConstraints)
+	})
+}
+
+// Layout catches selection changes the list did not report, such as a
+// filter hiding the selected item, and positions the panes.
+func (m *MasterDetail[T]) Layout(bounds runtime.Rect) {
+	m.Base.Layout(bounds)
+	m.sync()
+	m.splitter.Layout(m.ContentBounds())
+}
+
+// Render draws the panes.
+func (m *MasterDetail[T]) Render(ctx runtime.RenderContext) {
+	if m == nil {
+		return
+	}
+	m.syncA11y()
+	m.splitter.Render(ctx)
+}
+
+// HandleMessage forwards messages to the panes.
+func (m *MasterDetail[T]) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if m == nil {
+		return runtime.Unhandled()
+	}
+	return m.splitter.HandleMessage(msg)
+}
+
+// ChildWidgets returns the splitter holding the panes.
+func (m *MasterDetail[T]) ChildWidgets() []runtime.Widget {
+	if m == nil {
+		return nil
+	}
+	return []runtime.Widget{m.splitter}
+}
+
+// sync shows the detail for the selected item if it changed.
+func (m *MasterDetail[T]) sync() {
+	index := -1
+	var item T
+	if m.list != nil {
+		if selected, ok := m.list.SelectedItem(); ok {
+			index, item = m.list.SelectedIndex(), selected
+		}
+	}
+	if index == m.shown && m.pane != nil {
+		return
+	}
+	m.shown = index
+	if index < 0 || m.detail == nil {
+		m.setPane(m.empty)
+		return
+	}
+	m.setPane(m.detail(item))
+}
+
+// setPane swaps the detail pane, binding and mounting the new widget.
+func (m *MasterDetail[T]) setPane(next runtime.Widget) {
+	prev := m.pane
+	if prev == next {
+		return
+	}
+	if prev != nil {
+		if m.mounted {
+			runtime.UnmountTree(prev)
+		}
+		runtime.UnbindTree(prev)
+	}
+	if next != nil {
+		runtime.BindTree(next, m.services)
+		if m.mounted {
+			runtime.MountTree(next)
+		}
+	}
+	m.pane = next
+	m.splitter.Second = next
+	if bounds := m.splitter.Bounds(); bounds.Width > 0 && bounds.Height > 0 {
+		m.splitter.Layout(bounds)
+	}
+	m.Invalidate()
+	m.services.Relayout()
+}
+
+func (m *MasterDetail[T]) syncA11y() {
+	if m == nil {
+		return
+	}
+	if m.Base.Role == "" {
+		m.Base.Role = accessibility.RoleGroup
+	}
+	label := strings.TrimSpace(m.label)
+	if label == "" {
+		label = "Master detail"
+	}
+	m.Base.Label = label
+}
+
+var _ runtime.Widget = (*MasterDetail[any])(nil)
+var _ runtime.ChildProvider = (*MasterDetail[any])(nil)
+var _ runtime.Bindable = (*MasterDetail[any])(nil)
+var _ runtime.Unbindable = (*MasterDetail[any])(nil)
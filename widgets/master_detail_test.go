@@ -0,0 +1,51 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestMasterDetailFollowsSelection(t *testing.T) {
+	list := NewList(NewSliceAdapter([]string{"apple", "pear", "plum"}, nil))
+	built := 0
+	view := NewMasterDetail(list, func(item string) runtime.Widget {
+		built++
+		return NewLabel("Detail: " + item)
+	})
+	var selected []int
+	view.SetOnSelect(func(index int, item string) { selected = append(selected, index) })
+	view.SetRatio(0.5)
+
+	_, rows := renderRows(t, view, 60, 3)
+	if !strings.Contains(rows[0], "Detail: apple") {
+		t.Fatalf("expected the first item's detail, got %q", rows[0])
+	}
+
+	list.Focus()
+	view.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	_, rows = renderRows(t, view, 60, 3)
+	if !strings.Contains(rows[0], "Detail: pear") {
+		t.Fatalf("expected the detail to follow the selection, got %q", rows[0])
+	}
+	if len(selected) != 1 || selected[0] != 1 {
+		t.Fatalf("onSelect calls = %v", selected)
+	}
+	if built != 2 {
+		t.Fatalf("detail built %d times, want once per selection", built)
+	}
+
+	list.SetFilter(func(item, query string) bool { return strings.Contains(item, query) })
+	list.SetFilterQuery("zzz")
+	_, rows = renderRows(t, view, 60, 3)
+	if !strings.Contains(rows[0], "Nothing selected") {
+		t.Fatalf("expected the empty state with no selection, got %q", rows[0])
+	}
+
+	view.SetOrientation(SplitVertical)
+	if view.Orientation() != SplitVertical || view.Ratio() != 0.5 {
+		t.Fatalf("orientation %v ratio %v", view.Orientation(), view.Ratio())
+	}
+}
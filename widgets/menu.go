@@ -6,23 +6,30 @@ import (
 
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/keybind"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/scroll"
 	"github.com/odvcencio/fluffyui/terminal"
 )
 
-// MenuItem describes a menu entry.
+// MenuItem describes a menu entry. Items with Children open a submenu beside
+// the menu. Separator items draw a divider and are never selected.
 type MenuItem struct {
-	ID       string
-	Title    string
-	Shortcut string
-	Children []*MenuItem
-	Expanded bool
-	Disabled bool
-	OnSelect func()
+	ID        string
+	Title     string
+	Shortcut  string
+	Children  []*MenuItem
+	Expanded  bool // set while the item's submenu is open
+	Disabled  bool
+	Separator bool
+	OnSelect  func()
 }
 
-// Menu renders a vertical menu.
+// Menu renders a vertical menu. Right or Enter on an item with Children,
+// or hovering it with the mouse, opens its submenu as an overlay beside the
+// item; Left or Esc closes it. Choosing an item runs OnSelect and closes
+// every open submenu. While the menu has focus, an item's Shortcut also
+// chooses it.
 type Menu struct {
 	FocusableBase
 	Items         []*MenuItem
@@ -31,30 +38,63 @@ type Menu struct {
 	label         string
 	style         backend.Style
 	selectedStyle backend.Style
-	indentCache   []string
-	flatCache     []menuRow
-	flatDirty     bool
-	itemsLen      int
-	itemsFirst    *MenuItem
+	disabledStyle backend.Style
+	parent        *Menu
+	popup         bool // the menu itself is an overlay, as with NewContextMenu
 }
 
+const menuSubmenuIndicator = "▸"
+
 // NewMenu creates a new menu.
 func NewMenu(items ...*MenuItem) *Menu {
 	menu := &Menu{
 		Items:         items,
-		selectedIndex: 0,
 		label:         "Menu",
 		style:         backend.DefaultStyle(),
 		selectedStyle: backend.DefaultStyle().Reverse(true),
-		flatDirty:     true,
-		itemsLen:      len(items),
-		itemsFirst:    firstItem(items),
+		disabledStyle: backend.DefaultStyle().Dim(true),
 	}
 	menu.Base.Role = accessibility.RoleMenu
+	menu.selectedIndex = menu.nextSelectable(-1, 1)
+	menu.syncA11y()
+	return menu
+}
+
+// NewContextMenu creates a menu to open with ShowAt or ShowNear. Choosing
+// an item also closes the context menu, as does focus moving elsewhere.
+func NewContextMenu(items ...*MenuItem) *Menu {
+	menu := NewMenu(items...)
+	menu.popup = true
+	menu.label = "Context menu"
 	menu.syncA11y()
 	return menu
 }
 
+// ShowAt opens the menu as a context menu with its top-left corner at x,
+// y, moved as needed to stay on screen. Return the result from
+// HandleMessage, typically on a right mouse press.
+func (m *Menu) ShowAt(x, y int) runtime.HandleResult {
+	if m == nil {
+		return runtime.Handled()
+	}
+	m.popup = true
+	return runtime.ShowContextMenu(runtime.Point{X: x, Y: y}, m)
+}
+
+// ShowNear opens the menu as a context menu below widget, or above it when
+// there is no room below.
+func (m *Menu) ShowNear(widget runtime.Widget) runtime.HandleResult {
+	if m == nil {
+		return runtime.Handled()
+	}
+	var anchor runtime.Rect
+	if bounded, ok := widget.(runtime.BoundsProvider); ok {
+		anchor = bounded.Bounds()
+	}
+	m.popup = true
+	return runtime.ShowContextMenuNear(anchor, m)
+}
+
 // SetStyle updates the menu base style.
 func (m *Menu) SetStyle(style backend.Style) {
 	if m == nil {
@@ -71,20 +111,27 @@ func (m *Menu) SetSelectedStyle(style backend.Style) {
 	m.selectedStyle = style
 }
 
+// SetDisabledStyle updates the style of disabled items.
+func (m *Menu) SetDisabledStyle(style backend.Style) {
+	if m == nil {
+		return
+	}
+	m.disabledStyle = style
+}
+
 // StyleType returns the selector type name.
 func (m *Menu) StyleType() string {
 	return "Menu"
 }
 
-// SetItems replaces the menu items and clears cached rows.
+// SetItems replaces the menu items.
 func (m *Menu) SetItems(items ...*MenuItem) {
 	if m == nil {
 		return
 	}
 	m.Items = items
-	m.itemsLen = len(items)
-	m.itemsFirst = firstItem(items)
-	m.flatDirty = true
+	m.offset = 0
+	m.selectedIndex = m.nextSelectable(-1, 1)
 	m.syncA11y()
 }
 
@@ -97,18 +144,41 @@ func (m *Menu) SetLabel(label string) {
 	m.syncA11y()
 }
 
-// Measure returns desired size.
+// Measure returns the width of the widest label with its shortcut and
+// submenu indicator, and one row per item.
 func (m *Menu) Measure(constraints runtime.Constraints) runtime.Size {
 	return m.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		count := len(m.flatten())
-		height := min(count, contentConstraints.MaxHeight)
+		titleWidth, shortcutWidth := m.columnWidths()
+		width := menuRowWidth(titleWidth, shortcutWidth)
+		height := min(len(m.Items), contentConstraints.MaxHeight)
 		if height <= 0 {
 			height = contentConstraints.MinHeight
 		}
-		return contentConstraints.Constrain(runtime.Size{Width: contentConstraints.MaxWidth, Height: height})
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: height})
 	})
 }
 
+// columnWidths returns the widest title and shortcut.
+func (m *Menu) columnWidths() (title, shortcut int) {
+	for _, item := range m.Items {
+		if item == nil || item.Separator {
+			continue
+		}
+		title = max(title, textWidth(item.Title))
+		shortcut = max(shortcut, textWidth(item.Shortcut))
+	}
+	return title, shortcut
+}
+
+// menuRowWidth lays a row out as " Title  Shortcut ▸".
+func menuRowWidth(titleWidth, shortcutWidth int) int {
+	width := 1 + titleWidth
+	if shortcutWidth > 0 {
+		width += 2 + shortcutWidth
+	}
+	return width + 2
+}
+
 // Render draws the menu.
 func (m *Menu) Render(ctx runtime.RenderContext) {
 	if m == nil {
@@ -122,151 +192,287 @@ func (m *Menu) Render(ctx runtime.RenderContext) {
 	}
 	baseStyle := mergeBackendStyles(resolveBaseStyle(ctx, m, backend.DefaultStyle(), false), m.style)
 	ctx.Buffer.Fill(outer, ' ', baseStyle)
-	if content.Width <= 0 || content.Height <= 0 {
-		return
-	}
-	rows := m.flatten()
-	if len(rows) == 0 {
+	if content.Width <= 0 || content.Height <= 0 || len(m.Items) == 0 {
 		return
 	}
-	if m.selectedIndex < 0 {
-		m.selectedIndex = 0
-	}
-	if m.selectedIndex >= len(rows) {
-		m.selectedIndex = len(rows) - 1
-	}
-	if m.selectedIndex < m.offset {
-		m.offset = m.selectedIndex
-	}
-	if m.selectedIndex >= m.offset+content.Height {
-		m.offset = m.selectedIndex - content.Height + 1
-	}
+	m.scrollToSelected(content.Height)
 	for i := 0; i < content.Height; i++ {
-		rowIndex := m.offset + i
-		if rowIndex < 0 || rowIndex >= len(rows) {
+		index := m.offset + i
+		if index >= len(m.Items) {
 			break
 		}
-		row := rows[rowIndex]
-		style := baseStyle
-		if rowIndex == m.selectedIndex {
-			style = mergeBackendStyles(baseStyle, m.selectedStyle)
+		item := m.Items[index]
+		if item == nil {
+			continue
 		}
-		prefix := "  "
-		if len(row.item.Children) > 0 {
-			if row.item.Expanded {
-				prefix = "- "
-			} else {
-				prefix = "+ "
-			}
+		y := content.Y + i
+		if item.Separator {
+			ctx.Buffer.SetString(content.X, y, strings.Repeat("─", content.Width), baseStyle)
+			continue
 		}
-		indent := m.indent(row.depth)
-		line := indent + prefix + row.item.Title
-		if row.item.Shortcut != "" {
-			line += " (" + row.item.Shortcut + ")"
+		style := baseStyle
+		if item.Disabled {
+			style = mergeBackendStyles(baseStyle, m.disabledStyle)
+		} else if index == m.selectedIndex {
+			style = mergeBackendStyles(baseStyle, m.selectedStyle)
 		}
-		line = truncateString(line, content.Width)
-		writePadded(ctx.Buffer, content.X, content.Y+i, content.Width, line, style)
+		m.renderItem(ctx, content.X, y, content.Width, item, style)
+	}
+}
+
+// renderItem draws the title left-aligned and the shortcut and submenu
+// indicator right-aligned.
+func (m *Menu) renderItem(ctx runtime.RenderContext, x, y, width int, item *MenuItem, style backend.Style) {
+	writePadded(ctx.Buffer, x, y, width, "", style)
+	right := x + width - 2
+	if len(item.Children) > 0 {
+		ctx.Buffer.SetString(x+width-1, y, menuSubmenuIndicator, style)
+	}
+	if item.Shortcut != "" {
+		shortcut := truncateString(item.Shortcut, max(0, width-4))
+		right -= textWidth(shortcut)
+		ctx.Buffer.SetString(right, y, shortcut, style)
+		right -= 2
+	}
+	if room := right - (x + 1); room > 0 {
+		ctx.Buffer.SetString(x+1, y, truncateString(item.Title, room), style)
+	}
+}
+
+func (m *Menu) scrollToSelected(height int) {
+	if m.selectedIndex < 0 {
+		return
+	}
+	if m.selectedIndex < m.offset {
+		m.offset = m.selectedIndex
+	}
+	if m.selectedIndex >= m.offset+height {
+		m.offset = m.selectedIndex - height + 1
 	}
 }
 
-// HandleMessage handles navigation and selection.
+// HandleMessage handles navigation, submenus, and selection.
 func (m *Menu) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if m == nil || !m.focused {
+	if m == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		return m.handleMouse(mouse)
+	}
+	if focus, ok := msg.(runtime.FocusChangedMsg); ok {
+		return m.handleFocusChanged(focus)
+	}
+	if !m.focused {
 		return runtime.Unhandled()
 	}
 	key, ok := msg.(runtime.KeyMsg)
 	if !ok {
 		return runtime.Unhandled()
 	}
-	rows := m.flatten()
 	switch key.Key {
 	case terminal.KeyUp:
-		m.setSelected(m.selectedIndex-1, len(rows))
+		m.setSelected(m.nextSelectable(m.selectedIndex, -1))
 		return runtime.Handled()
 	case terminal.KeyDown:
-		m.setSelected(m.selectedIndex+1, len(rows))
+		m.setSelected(m.nextSelectable(m.selectedIndex, 1))
 		return runtime.Handled()
-	case terminal.KeyLeft:
-		if row := m.selectedRow(rows); row != nil && row.item.Expanded {
-			row.item.Expanded = false
-			m.flatDirty = true
-		}
+	case terminal.KeyHome:
+		m.setSelected(m.nextSelectable(-1, 1))
+		return runtime.Handled()
+	case terminal.KeyEnd:
+		m.setSelected(m.nextSelectable(len(m.Items), -1))
 		return runtime.Handled()
 	case terminal.KeyRight:
-		if row := m.selectedRow(rows); row != nil && len(row.item.Children) > 0 {
-			row.item.Expanded = true
-			m.flatDirty = true
+		if item := m.selectedItem(); item != nil && len(item.Children) > 0 {
+			return m.openSubmenu(m.selectedIndex, false)
 		}
 		return runtime.Handled()
+	case terminal.KeyLeft, terminal.KeyEscape:
+		if m.parent != nil {
+			return runtime.WithCommand(runtime.PopOverlay{})
+		}
+		if key.Key == terminal.KeyLeft {
+			return runtime.Handled()
+		}
+		return runtime.Unhandled()
 	case terminal.KeyEnter:
-		if row := m.selectedRow(rows); row != nil && !row.item.Disabled {
-			if len(row.item.Children) > 0 {
-				row.item.Expanded = !row.item.Expanded
-				m.flatDirty = true
-			}
-			if row.item.OnSelect != nil {
-				row.item.OnSelect()
-			}
+		return m.choose(m.selectedIndex)
+	case terminal.KeyRune:
+		if key.Rune == ' ' && !key.Ctrl && !key.Alt {
+			return m.choose(m.selectedIndex)
+		}
+	}
+	if index := m.shortcutIndex(key); index >= 0 {
+		m.setSelected(index)
+		return m.choose(index)
+	}
+	return runtime.Unhandled()
+}
+
+func (m *Menu) handleMouse(mouse runtime.MouseMsg) runtime.HandleResult {
+	content := m.ContentBounds()
+	if !content.Contains(mouse.X, mouse.Y) {
+		return runtime.Unhandled()
+	}
+	index := m.offset + mouse.Y - content.Y
+	switch {
+	case mouse.Action == runtime.MouseMove:
+		if !m.selectable(index) {
+			return runtime.Handled()
+		}
+		m.setSelected(index)
+		if len(m.Items[index].Children) > 0 {
+			return m.openSubmenu(index, true)
 		}
 		return runtime.Handled()
+	case mouse.Action == runtime.MousePress && mouse.Button == runtime.MouseLeft:
+		if !m.selectable(index) {
+			return runtime.Handled()
+		}
+		m.setSelected(index)
+		return m.choose(index)
 	}
 	return runtime.Unhandled()
 }
 
-type menuRow struct {
-	item  *MenuItem
-	depth int
-}
-
-func (m *Menu) flatten() []menuRow {
-	currentFirst := firstItem(m.Items)
-	if m.itemsLen != len(m.Items) || m.itemsFirst != currentFirst {
-		m.itemsLen = len(m.Items)
-		m.itemsFirst = currentFirst
-		m.flatDirty = true
-	}
-	if !m.flatDirty {
-		return m.flatCache
-	}
-	rows := m.flatCache[:0]
-	var walk func(items []*MenuItem, depth int)
-	walk = func(items []*MenuItem, depth int) {
-		for _, item := range items {
-			if item == nil {
-				continue
-			}
-			rows = append(rows, menuRow{item: item, depth: depth})
-			if item.Expanded {
-				walk(item.Children, depth+1)
-			}
+// handleFocusChanged closes a context menu, with any open submenus, when
+// focus leaves them.
+func (m *Menu) handleFocusChanged(msg runtime.FocusChangedMsg) runtime.HandleResult {
+	prev, ok := msg.Prev.(*Menu)
+	if !ok || prev == nil {
+		return runtime.Unhandled()
+	}
+	if next, ok := msg.Next.(*Menu); ok && next != nil && next.root() == prev.root() {
+		return runtime.Unhandled()
+	}
+	if prev.root() != m.root() || !m.root().popup {
+		return runtime.Unhandled()
+	}
+	closes := []runtime.Command{runtime.PopOverlay{}}
+	for menu := prev; menu.parent != nil; menu = menu.parent {
+		closes = append(closes, runtime.PopOverlay{})
+	}
+	return runtime.WithCommands(closes...)
+}
+
+// root returns the menu at the top of a submenu chain.
+func (m *Menu) root() *Menu {
+	for m.parent != nil {
+		m = m.parent
+	}
+	return m
+}
+
+// choose opens the submenu of the item at index, or runs its OnSelect and
+// closes the submenus above the root menu, and the root too when it is a
+// context menu.
+func (m *Menu) choose(index int) runtime.HandleResult {
+	if !m.selectable(index) {
+		return runtime.Handled()
+	}
+	item := m.Items[index]
+	if len(item.Children) > 0 {
+		return m.openSubmenu(index, false)
+	}
+	if item.OnSelect != nil {
+		item.OnSelect()
+	}
+	var closes []runtime.Command
+	root := m
+	for ; root.parent != nil; root = root.parent {
+		closes = append(closes, runtime.PopOverlay{})
+	}
+	if root.popup {
+		closes = append(closes, runtime.PopOverlay{})
+	}
+	if len(closes) == 0 {
+		return runtime.Handled()
+	}
+	return runtime.WithCommands(closes...)
+}
+
+// openSubmenu pushes the children of the item at index as a menu beside
+// its row. A submenu opened by hovering closes again when the pointer
+// leaves both it and the row.
+func (m *Menu) openSubmenu(index int, hover bool) runtime.HandleResult {
+	item := m.Items[index]
+	if item.Expanded {
+		return runtime.Handled()
+	}
+	content := m.ContentBounds()
+	anchor := runtime.Rect{X: content.X, Y: content.Y + index - m.offset, Width: content.Width, Height: 1}
+	submenu := NewMenu(item.Children...)
+	submenu.parent = m
+	submenu.label = item.Title
+	submenu.style = m.style
+	submenu.selectedStyle = m.selectedStyle
+	submenu.disabledStyle = m.disabledStyle
+	item.Expanded = true
+	m.syncA11y()
+	popover := NewPopover(anchor, submenu,
+		WithPopoverPlacement(PopoverRight),
+		WithPopoverDismissOnOutside(true),
+		WithPopoverDismissOnMoveOutside(hover),
+		WithPopoverOnClose(func() {
+			item.Expanded = false
+			m.syncA11y()
+		}),
+	)
+	return runtime.WithCommand(runtime.PushOverlay{Widget: popover, Modal: true})
+}
+
+// shortcutIndex returns the item whose Shortcut matches key, or -1.
+func (m *Menu) shortcutIndex(key runtime.KeyMsg) int {
+	press := []keybind.KeyPress{keybind.KeyPressFromKeyMsg(key)}
+	for i, item := range m.Items {
+		if !m.selectable(i) || item.Shortcut == "" {
+			continue
+		}
+		seq, err := keybind.ParseKeySequence(strings.ToLower(item.Shortcut))
+		if err == nil && seq.Matches(press) {
+			return i
 		}
 	}
-	walk(m.Items, 0)
-	m.flatCache = rows
-	m.flatDirty = false
-	return m.flatCache
+	return -1
 }
 
-func (m *Menu) setSelected(index int, count int) {
-	if count == 0 {
-		m.selectedIndex = 0
-		return
+// selectable reports whether the item at index can be selected.
+func (m *Menu) selectable(index int) bool {
+	if index < 0 || index >= len(m.Items) {
+		return false
+	}
+	item := m.Items[index]
+	return item != nil && !item.Separator && !item.Disabled
+}
+
+// nextSelectable returns the first selectable index after from in
+// direction step, or from when there is none.
+func (m *Menu) nextSelectable(from, step int) int {
+	for i := from + step; i >= 0 && i < len(m.Items); i += step {
+		if m.selectable(i) {
+			return i
+		}
 	}
-	if index < 0 {
-		index = 0
+	if from < 0 || from >= len(m.Items) {
+		return -1
 	}
-	if index >= count {
-		index = count - 1
+	return from
+}
+
+func (m *Menu) setSelected(index int) {
+	if index < 0 || index >= len(m.Items) || index == m.selectedIndex {
+		return
 	}
 	m.selectedIndex = index
 	m.syncA11y()
+	m.Invalidate()
 }
 
-func (m *Menu) selectedRow(rows []menuRow) *menuRow {
-	if m.selectedIndex < 0 || m.selectedIndex >= len(rows) {
+func (m *Menu) selectedItem() *MenuItem {
+	if !m.selectable(m.selectedIndex) {
 		return nil
 	}
-	return &rows[m.selectedIndex]
+	return m.Items[m.selectedIndex]
 }
 
 // ScrollBy scrolls selection by delta.
@@ -274,29 +480,24 @@ func (m *Menu) ScrollBy(dx, dy int) {
 	if m == nil || dy == 0 {
 		return
 	}
-	rows := m.flatten()
-	m.setSelected(m.selectedIndex+dy, len(rows))
-	m.Invalidate()
-}
-
-func (m *Menu) indent(depth int) string {
-	if depth <= 0 {
-		return ""
-	}
-	if len(m.indentCache) == 0 {
-		m.indentCache = []string{""}
-	}
-	for len(m.indentCache) <= depth {
-		m.indentCache = append(m.indentCache, m.indentCache[len(m.indentCache)-1]+"  ")
-	}
-	return m.indentCache[depth]
+	m.moveBy(dy)
 }
 
-func firstItem(items []*MenuItem) *MenuItem {
-	if len(items) == 0 {
-		return nil
+// moveBy moves the selection delta selectable items, stopping at the ends.
+func (m *Menu) moveBy(delta int) {
+	step := 1
+	if delta < 0 {
+		step, delta = -1, -delta
+	}
+	index := m.selectedIndex
+	for ; delta > 0; delta-- {
+		next := m.nextSelectable(index, step)
+		if next == index || next < 0 {
+			break
+		}
+		index = next
 	}
-	return items[0]
+	m.setSelected(index)
 }
 
 // ScrollTo scrolls to an absolute row index.
@@ -304,9 +505,15 @@ func (m *Menu) ScrollTo(x, y int) {
 	if m == nil {
 		return
 	}
-	rows := m.flatten()
-	m.setSelected(y, len(rows))
-	m.Invalidate()
+	y = clampInt(y, 0, len(m.Items)-1)
+	if !m.selectable(y) {
+		if next := m.nextSelectable(y, 1); next != y {
+			y = next
+		} else {
+			y = m.nextSelectable(y, -1)
+		}
+	}
+	m.setSelected(y)
 }
 
 // PageBy scrolls by a number of pages.
@@ -314,13 +521,11 @@ func (m *Menu) PageBy(pages int) {
 	if m == nil {
 		return
 	}
-	rows := m.flatten()
 	pageSize := m.bounds.Height
 	if pageSize < 1 {
 		pageSize = 1
 	}
-	m.setSelected(m.selectedIndex+pages*pageSize, len(rows))
-	m.Invalidate()
+	m.moveBy(pages * pageSize)
 }
 
 // ScrollToStart scrolls to the first row.
@@ -328,9 +533,7 @@ func (m *Menu) ScrollToStart() {
 	if m == nil {
 		return
 	}
-	rows := m.flatten()
-	m.setSelected(0, len(rows))
-	m.Invalidate()
+	m.setSelected(m.nextSelectable(-1, 1))
 }
 
 // ScrollToEnd scrolls to the last row.
@@ -338,9 +541,7 @@ func (m *Menu) ScrollToEnd() {
 	if m == nil {
 		return
 	}
-	rows := m.flatten()
-	m.setSelected(len(rows)-1, len(rows))
-	m.Invalidate()
+	m.setSelected(m.nextSelectable(len(m.Items), -1))
 }
 
 func (m *Menu) syncA11y() {
@@ -355,12 +556,17 @@ func (m *Menu) syncA11y() {
 		label = "Menu"
 	}
 	m.Base.Label = label
-	rows := m.flatten()
-	m.Base.Description = fmt.Sprintf("%d items", len(rows))
-	if row := m.selectedRow(rows); row != nil && row.item != nil {
-		m.Base.Value = &accessibility.ValueInfo{Text: row.item.Title}
-		if len(row.item.Children) > 0 {
-			m.Base.State.Expanded = accessibility.BoolPtr(row.item.Expanded)
+	count := 0
+	for i := range m.Items {
+		if m.Items[i] != nil && !m.Items[i].Separator {
+			count++
+		}
+	}
+	m.Base.Description = fmt.Sprintf("%d items", count)
+	if item := m.selectedItem(); item != nil {
+		m.Base.Value = &accessibility.ValueInfo{Text: item.Title}
+		if len(item.Children) > 0 {
+			m.Base.State.Expanded = accessibility.BoolPtr(item.Expanded)
 		} else {
 			m.Base.State.Expanded = nil
 		}
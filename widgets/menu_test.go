@@ -0,0 +1,208 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func openSubmenu(t *testing.T, result runtime.HandleResult) *Menu {
+	t.Helper()
+	if len(result.Commands) != 1 {
+		t.Fatalf("expected one command, got %+v", result.Commands)
+	}
+	push, ok := result.Commands[0].(runtime.PushOverlay)
+	if !ok {
+		t.Fatalf("command = %T, want PushOverlay", result.Commands[0])
+	}
+	popover := push.Widget.(*Popover)
+	if popover.Placement != PopoverRight {
+		t.Fatalf("submenu placement = %v, want PopoverRight", popover.Placement)
+	}
+	submenu := popover.Child.(*Menu)
+	submenu.Focus()
+	return submenu
+}
+
+func popCount(result runtime.HandleResult) int {
+	count := 0
+	for _, cmd := range result.Commands {
+		if _, ok := cmd.(runtime.PopOverlay); ok {
+			count++
+		}
+	}
+	return count
+}
+
+func TestMenuCascadingSubmenus(t *testing.T) {
+	chosen := ""
+	recent := &MenuItem{Title: "Recent", Children: []*MenuItem{
+		{Title: "notes.md", OnSelect: func() { chosen = "notes.md" }},
+	}}
+	file := &MenuItem{Title: "File", Children: []*MenuItem{
+		{Title: "Open", Shortcut: "Ctrl+O"},
+		recent,
+	}}
+	menu := NewMenu(file)
+	menu.Layout(runtime.Rect{Width: 10, Height: 1})
+	menu.Focus()
+
+	submenu := openSubmenu(t, menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight}))
+	if !file.Expanded {
+		t.Fatalf("expected File to be expanded")
+	}
+	if popCount(submenu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})) != 1 {
+		t.Fatalf("expected Left to close the submenu")
+	}
+
+	submenu.Layout(runtime.Rect{X: 10, Width: 20, Height: 2})
+	submenu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	nested := openSubmenu(t, submenu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}))
+	if got := popCount(nested.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})); got != 2 {
+		t.Fatalf("choosing a nested item closed %d levels, want 2", got)
+	}
+	if chosen != "notes.md" {
+		t.Fatalf("chosen = %q", chosen)
+	}
+	if popCount(nested.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})) != 1 {
+		t.Fatalf("expected Esc to close one level")
+	}
+}
+
+func TestMenuSkipsSeparatorsAndDisabled(t *testing.T) {
+	menu := NewMenu(
+		&MenuItem{Title: "Cut", Disabled: true},
+		&MenuItem{Title: "Copy"},
+		&MenuItem{Separator: true},
+		&MenuItem{Title: "Paste", Disabled: true},
+		&MenuItem{Title: "Select All"},
+	)
+	if menu.selectedIndex != 1 {
+		t.Fatalf("initial selection = %d, want first enabled item", menu.selectedIndex)
+	}
+	menu.Focus()
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if menu.selectedIndex != 4 {
+		t.Fatalf("selection = %d, want to skip separator and disabled items", menu.selectedIndex)
+	}
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if menu.selectedIndex != 4 {
+		t.Fatalf("selection moved past the last item")
+	}
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyHome})
+	if menu.selectedIndex != 1 {
+		t.Fatalf("Home selected %d, want 1", menu.selectedIndex)
+	}
+}
+
+func TestMenuShortcutsAndWidth(t *testing.T) {
+	saved := false
+	menu := NewMenu(
+		&MenuItem{Title: "Open", Shortcut: "Ctrl+O"},
+		&MenuItem{Title: "Save As…", Shortcut: "Ctrl+S", OnSelect: func() { saved = true }},
+		&MenuItem{Separator: true},
+		&MenuItem{Title: "Export", Children: []*MenuItem{{Title: "PDF"}}},
+	)
+	// " Save As…  Ctrl+S ▸" with room for the submenu indicator.
+	if size := menu.Measure(runtime.Constraints{MaxWidth: 80, MaxHeight: 10}); size.Width != 19 || size.Height != 4 {
+		t.Fatalf("size = %+v, want 19x4", size)
+	}
+	_, rows := renderRows(t, menu, 19, 4)
+	want := []string{
+		" Open      Ctrl+O",
+		" Save As…  Ctrl+S",
+		"───────────────────",
+		" Export           ▸",
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+
+	menu.Focus()
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 's', Ctrl: true})
+	if !saved || menu.selectedIndex != 1 {
+		t.Fatalf("expected Ctrl+S to choose Save As")
+	}
+}
+
+func TestContextMenuClosesOnChoose(t *testing.T) {
+	chosen := ""
+	menu := NewContextMenu(
+		&MenuItem{Title: "Rename", OnSelect: func() { chosen = "rename" }},
+		&MenuItem{Title: "Move", Children: []*MenuItem{
+			{Title: "Up", OnSelect: func() { chosen = "up" }},
+		}},
+	)
+	screen := runtime.NewScreen(40, 10)
+	screen.SetRoot(NewLabel("files"))
+	result := runtime.ShowContextMenu(runtime.Point{X: 36, Y: 2}, menu)
+	push := result.Commands[0].(runtime.PushOverlay)
+	screen.PushLayer(push.Widget, push.Modal)
+	if screen.LayerCount() != 2 {
+		t.Fatalf("layers = %d, want the context menu on top", screen.LayerCount())
+	}
+	if bounds := menu.Bounds(); bounds.X+bounds.Width > 40 || bounds.Y != 2 {
+		t.Fatalf("menu bounds = %+v, want clamped to the screen at row 2", bounds)
+	}
+
+	menu.Focus()
+	if popCount(menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})) != 1 || chosen != "rename" {
+		t.Fatalf("expected Rename to run and close the menu, chosen = %q", chosen)
+	}
+
+	menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	submenu := openSubmenu(t, menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}))
+	if popCount(submenu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})) != 2 || chosen != "up" {
+		t.Fatalf("expected Up to close the submenu and the context menu, chosen = %q", chosen)
+	}
+}
+
+func TestContextMenuShowNearFlipsAbove(t *testing.T) {
+	screen := runtime.NewScreen(40, 10)
+	screen.SetRoot(NewLabel("files"))
+	button := NewButton("Actions")
+	button.Layout(runtime.Rect{X: 2, Y: 8, Width: 9, Height: 1})
+	menu := NewMenu(&MenuItem{Title: "Copy"}, &MenuItem{Title: "Paste"}, &MenuItem{Title: "Delete"})
+
+	push := menu.ShowNear(button).Commands[0].(runtime.PushOverlay)
+	screen.PushLayer(push.Widget, push.Modal)
+	if bounds := menu.Bounds(); bounds.X != 2 || bounds.Y != 5 || bounds.Height != 3 {
+		t.Fatalf("menu bounds = %+v, want above the button", bounds)
+	}
+
+	menu.Focus()
+	if popCount(menu.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})) != 1 {
+		t.Fatal("expected a shown menu to close itself on choose")
+	}
+}
+
+func TestContextMenuHoverAndFocusLoss(t *testing.T) {
+	menu := NewContextMenu(
+		&MenuItem{Title: "Rename"},
+		&MenuItem{Title: "Move", Children: []*MenuItem{{Title: "Up"}}},
+	)
+	menu.Layout(runtime.Rect{X: 5, Y: 2, Width: 10, Height: 2})
+
+	result := menu.HandleMessage(runtime.MouseMsg{X: 6, Y: 3, Action: runtime.MouseMove})
+	if menu.selectedIndex != 1 {
+		t.Fatalf("hover selected %d, want 1", menu.selectedIndex)
+	}
+	if popover := result.Commands[0].(runtime.PushOverlay).Widget.(*Popover); !popover.DismissOnMoveOutside {
+		t.Fatal("expected a hovered submenu to close when the pointer leaves")
+	}
+	submenu := openSubmenu(t, result)
+
+	if popCount(menu.HandleMessage(runtime.FocusChangedMsg{Prev: menu, Next: submenu})) != 0 {
+		t.Fatal("moving focus into a submenu closed the menu")
+	}
+	other := NewButton("OK")
+	if popCount(submenu.HandleMessage(runtime.FocusChangedMsg{Prev: submenu, Next: other})) != 2 {
+		t.Fatal("expected focus leaving the submenu to close it and the context menu")
+	}
+	if popCount(menu.HandleMessage(runtime.FocusChangedMsg{Prev: other, Next: menu})) != 0 {
+		t.Fatal("gaining focus closed the menu")
+	}
+}
@@ -0,0 +1,274 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/state"
+)
+
+// SparkSeries is one series of a MultiSparkline.
+type SparkSeries struct {
+	Data  *state.Signal[[]float64]
+	Color backend.Color
+	Label string
+}
+
+// MultiSparkline overlays several sparklines on one shared Y scale, taken
+// from the union of all series. Each series fills up to its value in its own
+// color; where a shorter series ends inside a cell, the taller one shows
+// behind it. It uses every row it is given, one row by default.
+type MultiSparkline struct {
+	Base
+	Series     []SparkSeries
+	Width      int
+	Style      backend.Style
+	label      string
+	showLegend bool
+}
+
+// sparkLevels are the partial block glyphs for 0/8 through 8/8 of a cell.
+var sparkLevels = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// NewMultiSparkline creates a sparkline that overlays series.
+func NewMultiSparkline(series ...SparkSeries) *MultiSparkline {
+	s := &MultiSparkline{
+		Series: series,
+		Style:  backend.DefaultStyle(),
+		label:  "Sparkline",
+	}
+	s.Base.Role = accessibility.RoleChart
+	s.syncA11y()
+	return s
+}
+
+// SetShowLegend toggles a legend at the right listing each series with its
+// current (last) value.
+func (s *MultiSparkline) SetShowLegend(show bool) {
+	if s == nil {
+		return
+	}
+	s.showLegend = show
+	s.Invalidate()
+}
+
+// SetLabel updates the accessibility label.
+func (s *MultiSparkline) SetLabel(label string) {
+	if s == nil {
+		return
+	}
+	s.label = label
+	s.syncA11y()
+}
+
+// StyleType returns the selector type name.
+func (s *MultiSparkline) StyleType() string {
+	return "Sparkline"
+}
+
+// Measure returns desired size.
+func (s *MultiSparkline) Measure(constraints runtime.Constraints) runtime.Size {
+	return s.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := s.Width
+		if width > 0 && s.showLegend {
+			width += 1 + s.legendWidth(1)
+		}
+		if width <= 0 {
+			width = contentConstraints.MaxWidth
+		}
+		if width <= 0 {
+			width = contentConstraints.MinWidth
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
+	})
+}
+
+// values returns the current data of each series.
+func (s *MultiSparkline) values() [][]float64 {
+	out := make([][]float64, len(s.Series))
+	for i, series := range s.Series {
+		if series.Data != nil {
+			out[i] = series.Data.Get()
+		}
+	}
+	return out
+}
+
+// legendEntries formats "● label value" for each series.
+func (s *MultiSparkline) legendEntries() []string {
+	entries := make([]string, len(s.Series))
+	for i, values := range s.values() {
+		entry := "● " + s.Series[i].Label
+		if len(values) > 0 {
+			entry = strings.TrimSpace(entry) + " " + formatFloat(values[len(values)-1])
+		}
+		entries[i] = entry
+	}
+	return entries
+}
+
+// legendWidth is the width of the legend laid out over rows: one entry per
+// row when they fit, otherwise all on one row.
+func (s *MultiSparkline) legendWidth(rows int) int {
+	entries := s.legendEntries()
+	if len(entries) == 0 {
+		return 0
+	}
+	if rows >= len(entries) {
+		width := 0
+		for _, entry := range entries {
+			width = max(width, textWidth(entry))
+		}
+		return width
+	}
+	return textWidth(strings.Join(entries, "  "))
+}
+
+// Render draws the overlaid series and the legend.
+func (s *MultiSparkline) Render(ctx runtime.RenderContext) {
+	if s == nil {
+		return
+	}
+	s.syncA11y()
+	bounds := s.ContentBounds()
+	if bounds.Width <= 0 || bounds.Height <= 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, s, backend.DefaultStyle(), false), s.Style)
+	ctx.Buffer.Fill(bounds, ' ', style)
+
+	chart := bounds
+	if s.showLegend {
+		legend := min(s.legendWidth(bounds.Height), bounds.Width)
+		chart.Width = max(0, bounds.Width-legend-1)
+		s.renderLegend(ctx, runtime.Rect{X: bounds.X + bounds.Width - legend, Y: bounds.Y, Width: legend, Height: bounds.Height}, style)
+	}
+	s.renderSeries(ctx, chart, style)
+}
+
+func (s *MultiSparkline) renderSeries(ctx runtime.RenderContext, bounds runtime.Rect, style backend.Style) {
+	if bounds.Width <= 0 {
+		return
+	}
+	data := s.values()
+	lo, hi, ok := 0.0, 0.0, false
+	for _, values := range data {
+		for _, v := range values {
+			if !ok {
+				lo, hi, ok = v, v, true
+			}
+			lo, hi = min(lo, v), max(hi, v)
+		}
+	}
+	if !ok {
+		return
+	}
+	if hi == lo {
+		hi = lo + 1
+	}
+	steps := bounds.Height * (len(sparkLevels) - 1)
+	levels := make([]int, len(data))
+	order := make([]int, 0, len(data))
+	for x := 0; x < bounds.Width; x++ {
+		order = order[:0]
+		for i, values := range data {
+			levels[i] = -1
+			if len(values) == 0 {
+				continue
+			}
+			idx := min(x*len(values)/bounds.Width, len(values)-1)
+			levels[i] = clampInt(int((values[idx]-lo)/(hi-lo)*float64(steps)), 0, steps)
+			order = append(order, i)
+		}
+		// Shortest first: each cell shows the lowest series that reaches it.
+		for a := 1; a < len(order); a++ {
+			for b := a; b > 0 && levels[order[b]] < levels[order[b-1]]; b-- {
+				order[b], order[b-1] = order[b-1], order[b]
+			}
+		}
+		for row := 0; row < bounds.Height; row++ {
+			y := bounds.Y + bounds.Height - 1 - row
+			base := row * (len(sparkLevels) - 1)
+			for n, i := range order {
+				fill := levels[i] - base
+				if fill <= 0 {
+					continue
+				}
+				cell := style.Foreground(s.Series[i].Color)
+				if fill >= len(sparkLevels)-1 {
+					ctx.Buffer.Set(bounds.X+x, y, sparkLevels[len(sparkLevels)-1], cell)
+					break
+				}
+				// A taller series that fills this cell shows behind the glyph.
+				if n+1 < len(order) && levels[order[n+1]]-base >= len(sparkLevels)-1 {
+					cell = cell.Background(s.Series[order[n+1]].Color)
+				}
+				ctx.Buffer.Set(bounds.X+x, y, sparkLevels[fill], cell)
+				break
+			}
+		}
+	}
+}
+
+func (s *MultiSparkline) renderLegend(ctx runtime.RenderContext, bounds runtime.Rect, style backend.Style) {
+	entries := s.legendEntries()
+	stacked := bounds.Height >= len(entries)
+	x, y := bounds.X, bounds.Y
+	right := bounds.X + bounds.Width
+	for i, entry := range entries {
+		if x >= right {
+			break
+		}
+		room := right - x
+		ctx.Buffer.SetString(x, y, "●", style.Foreground(s.Series[i].Color))
+		if room > 2 {
+			ctx.Buffer.SetString(x+2, y, truncateString(entry[len("● "):], room-2), style)
+		}
+		if stacked {
+			y++
+		} else {
+			x += textWidth(entry) + 2
+		}
+	}
+}
+
+// HandleMessage returns unhandled.
+func (s *MultiSparkline) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	return runtime.Unhandled()
+}
+
+func (s *MultiSparkline) syncA11y() {
+	if s == nil {
+		return
+	}
+	if s.Base.Role == "" {
+		s.Base.Role = accessibility.RoleChart
+	}
+	label := strings.TrimSpace(s.label)
+	if label == "" {
+		label = "Sparkline"
+	}
+	s.Base.Label = label
+	s.Base.Description = fmt.Sprintf("%d series", len(s.Series))
+	parts := make([]string, 0, len(s.Series))
+	for i, values := range s.values() {
+		if len(values) == 0 {
+			continue
+		}
+		name := strings.TrimSpace(s.Series[i].Label)
+		if name == "" {
+			name = fmt.Sprintf("series %d", i+1)
+		}
+		parts = append(parts, fmt.Sprintf("%s %s", name, formatFloat(values[len(values)-1])))
+	}
+	if len(parts) == 0 {
+		s.Base.Value = nil
+		return
+	}
+	s.Base.Value = &accessibility.ValueInfo{Text: strings.Join(parts, ", ")}
+}
+
+var _ runtime.Widget = (*MultiSparkline)(nil)
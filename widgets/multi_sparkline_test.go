@@ -0,0 +1,56 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/state"
+)
+
+func TestMultiSparklineSharedScale(t *testing.T) {
+	spark := NewMultiSparkline(
+		SparkSeries{Label: "cpu", Color: backend.ColorGreen, Data: state.NewSignal([]float64{0, 4, 8})},
+		SparkSeries{Label: "mem", Color: backend.ColorBlue, Data: state.NewSignal([]float64{16, 16, 16})},
+	)
+	buf, rows := renderRows(t, spark, 3, 2)
+	// Scale 0..16 over two rows: cpu reaches 0, 4, and 8 eighths; mem fills.
+	if rows[0] != "███" || rows[1] != "█▄█" {
+		t.Fatalf("rows = %q", rows)
+	}
+	if fg := buf.Get(0, 1).Style.ForegroundColor(); fg != backend.ColorBlue {
+		t.Fatalf("cell under empty cpu = %v, want mem blue", fg)
+	}
+	cell := buf.Get(1, 1).Style
+	if cell.ForegroundColor() != backend.ColorGreen || cell.BackgroundColor() != backend.ColorBlue {
+		t.Fatalf("partial cpu cell fg=%v bg=%v, want green on blue", cell.ForegroundColor(), cell.BackgroundColor())
+	}
+	if fg := buf.Get(2, 1).Style.ForegroundColor(); fg != backend.ColorGreen {
+		t.Fatalf("full cpu cell = %v, want green", fg)
+	}
+	if fg := buf.Get(2, 0).Style.ForegroundColor(); fg != backend.ColorBlue {
+		t.Fatalf("cell above cpu = %v, want mem blue", fg)
+	}
+}
+
+func TestMultiSparklineLegend(t *testing.T) {
+	cpu := state.NewSignal([]float64{1, 2})
+	spark := NewMultiSparkline(
+		SparkSeries{Label: "cpu", Color: backend.ColorGreen, Data: cpu},
+		SparkSeries{Label: "mem", Color: backend.ColorBlue, Data: state.NewSignal([]float64{3, 4})},
+	)
+	spark.SetShowLegend(true)
+
+	_, rows := renderRows(t, spark, 30, 2)
+	if rows[0][len(rows[0])-len("cpu 2.00"):] != "cpu 2.00" || rows[1][len(rows[1])-len("mem 4.00"):] != "mem 4.00" {
+		t.Fatalf("expected stacked legend at the right, got %q", rows)
+	}
+	cpu.Set([]float64{1, 5})
+	_, rows = renderRows(t, spark, 40, 1)
+	want := "● cpu 5.00  ● mem 4.00"
+	if len(rows[0]) < len(want) || rows[0][len(rows[0])-len(want):] != want {
+		t.Fatalf("expected one-line legend %q, got %q", want, rows[0])
+	}
+	if spark.AccessibleValue() == nil || spark.AccessibleValue().Text != "cpu 5.00, mem 4.00" {
+		t.Fatalf("value = %+v", spark.AccessibleValue())
+	}
+}
@@ -1,7 +1,9 @@
 package widgets
 
 import (
+	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
@@ -16,7 +18,10 @@ type MultiSelectOption struct {
 	Disabled bool
 }
 
-// MultiSelect renders a list of options with multiple selection.
+// MultiSelect renders a list of options with multiple selection. Space or
+// Enter toggles the highlighted option, Ctrl+A checks every option, and
+// Ctrl+D or Ctrl+Shift+A clears them. While any option is checked, a
+// status row under the list shows the count, such as (3/10).
 type MultiSelect struct {
 	FocusableBase
 	options       []MultiSelectOption
@@ -28,7 +33,11 @@ type MultiSelect struct {
 	selectedStyle backend.Style
 	checkedStyle  backend.Style
 	disabledStyle backend.Style
+	warningStyle  backend.Style
 	onChange      func(selected []MultiSelectOption)
+	maxSelected   int
+	warning       string
+	services      runtime.Services
 }
 
 // NewMultiSelect creates a new multi-select list.
@@ -41,6 +50,7 @@ func NewMultiSelect(options ...MultiSelectOption) *MultiSelect {
 		selectedStyle: backend.DefaultStyle().Reverse(true),
 		checkedStyle:  backend.DefaultStyle().Foreground(backend.ColorGreen),
 		disabledStyle: backend.DefaultStyle().Dim(true),
+		warningStyle:  backend.DefaultStyle().Foreground(backend.ColorYellow),
 		checked:       map[int]bool{},
 	}
 	m.Base.Role = accessibility.RoleList
@@ -57,7 +67,74 @@ func (m *MultiSelect) SetOptions(options []MultiSelectOption) {
 	m.selected = 0
 	m.offset = 0
 	m.checked = map[int]bool{}
+	m.warning = ""
 	m.syncA11y()
+	m.relayout()
+}
+
+// SetMaxSelected limits how many options can be checked at once. Checking
+// past the limit is refused with a warning in the status row. Zero or
+// less removes the limit.
+func (m *MultiSelect) SetMaxSelected(n int) {
+	if m == nil {
+		return
+	}
+	m.maxSelected = max(0, n)
+}
+
+// MaxSelected returns the selection limit, or 0 when there is none.
+func (m *MultiSelect) MaxSelected() int {
+	if m == nil {
+		return 0
+	}
+	return m.maxSelected
+}
+
+// SelectAll checks every enabled option, up to the selection limit.
+func (m *MultiSelect) SelectAll() {
+	if m == nil {
+		return
+	}
+	changed := false
+	m.warning = ""
+	for idx, opt := range m.options {
+		if opt.Disabled || m.checked[idx] {
+			continue
+		}
+		if !m.canCheck() {
+			break
+		}
+		m.checked[idx] = true
+		changed = true
+	}
+	m.afterBulkChange(changed)
+}
+
+// DeselectAll clears every checked option.
+func (m *MultiSelect) DeselectAll() {
+	if m == nil {
+		return
+	}
+	changed := m.checkedCount() > 0
+	m.checked = map[int]bool{}
+	m.warning = ""
+	m.afterBulkChange(changed)
+}
+
+// Bind attaches app services.
+func (m *MultiSelect) Bind(services runtime.Services) {
+	if m == nil {
+		return
+	}
+	m.services = services
+}
+
+// Unbind releases app services.
+func (m *MultiSelect) Unbind() {
+	if m == nil {
+		return
+	}
+	m.services = runtime.Services{}
 }
 
 // SetOnChange registers a change callback.
@@ -113,6 +190,9 @@ func (m *MultiSelect) Measure(constraints runtime.Constraints) runtime.Size {
 		if height < 1 {
 			height = 1
 		}
+		if m.showStatus() {
+			height++
+		}
 		return contentConstraints.Constrain(runtime.Size{Width: width, Height: height})
 	})
 }
@@ -133,8 +213,19 @@ func (m *MultiSelect) Render(ctx runtime.RenderContext) {
 	if content.Width <= 0 || content.Height <= 0 {
 		return
 	}
-	m.ensureVisible(content.Height)
-	for i := 0; i < content.Height; i++ {
+	listHeight := content.Height
+	if m.showStatus() && content.Height > 1 {
+		listHeight--
+		status := fmt.Sprintf("(%d/%d)", m.checkedCount(), len(m.options))
+		statusStyle := baseStyle.Dim(true)
+		if m.warning != "" {
+			status += " " + m.warning
+			statusStyle = mergeBackendStyles(baseStyle, m.warningStyle)
+		}
+		writePadded(ctx.Buffer, content.X, content.Y+listHeight, content.Width, truncateString(status, content.Width), statusStyle)
+	}
+	m.ensureVisible(listHeight)
+	for i := 0; i < listHeight; i++ {
 		idx := m.offset + i
 		if idx < 0 || idx >= len(m.options) {
 			break
@@ -168,6 +259,24 @@ func (m *MultiSelect) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if !ok {
 		return runtime.Unhandled()
 	}
+	if key.Key == terminal.KeyCtrlD {
+		m.DeselectAll()
+		return runtime.Handled()
+	}
+	if key.Ctrl && (key.Key == terminal.KeyRune || key.Key == terminal.KeyNone) {
+		switch unicode.ToLower(key.Rune) {
+		case 'a':
+			if key.Shift || unicode.IsUpper(key.Rune) {
+				m.DeselectAll()
+			} else {
+				m.SelectAll()
+			}
+			return runtime.Handled()
+		case 'd':
+			m.DeselectAll()
+			return runtime.Handled()
+		}
+	}
 	switch key.Key {
 	case terminal.KeyUp:
 		m.moveSelection(-1)
@@ -214,13 +323,78 @@ func (m *MultiSelect) toggleSelected() {
 	if m.options[m.selected].Disabled {
 		return
 	}
+	hadStatus := m.showStatus()
+	if !m.checked[m.selected] && !m.canCheck() {
+		m.announceWarning()
+		if !hadStatus {
+			m.relayout()
+		}
+		m.Invalidate()
+		return
+	}
+	m.warning = ""
 	m.checked[m.selected] = !m.checked[m.selected]
 	m.syncA11y()
+	if m.showStatus() != hadStatus {
+		m.relayout()
+	}
+	m.services.AnnounceChange(m)
+	if m.onChange != nil {
+		m.onChange(m.SelectedOptions())
+	}
+}
+
+// afterBulkChange refreshes state after SelectAll or DeselectAll.
+func (m *MultiSelect) afterBulkChange(changed bool) {
+	if m.warning != "" {
+		m.announceWarning()
+	}
+	m.syncA11y()
+	m.relayout()
+	if !changed {
+		return
+	}
+	m.services.AnnounceChange(m)
 	if m.onChange != nil {
 		m.onChange(m.SelectedOptions())
 	}
 }
 
+// canCheck reports whether another option may be checked, recording a
+// warning when the limit is reached.
+func (m *MultiSelect) canCheck() bool {
+	if m.maxSelected <= 0 || m.checkedCount() < m.maxSelected {
+		return true
+	}
+	m.warning = fmt.Sprintf("Limit of %d reached", m.maxSelected)
+	return false
+}
+
+func (m *MultiSelect) checkedCount() int {
+	count := 0
+	for idx, ok := range m.checked {
+		if ok && idx >= 0 && idx < len(m.options) {
+			count++
+		}
+	}
+	return count
+}
+
+func (m *MultiSelect) showStatus() bool {
+	return m.warning != "" || m.checkedCount() > 0
+}
+
+func (m *MultiSelect) announceWarning() {
+	if announcer := m.services.Announcer(); announcer != nil {
+		announcer.Announce(m.warning, accessibility.PriorityAssertive)
+	}
+}
+
+func (m *MultiSelect) relayout() {
+	m.Invalidate()
+	m.services.Relayout()
+}
+
 func (m *MultiSelect) ensureVisible(height int) {
 	if height <= 0 {
 		return
@@ -253,7 +427,14 @@ func (m *MultiSelect) syncA11y() {
 	}
 	m.Base.Label = label
 	m.Base.Description = "multi-select list"
+	if count := m.checkedCount(); count > 0 {
+		m.Base.Value = &accessibility.ValueInfo{Text: fmt.Sprintf("%d of %d selected", count, len(m.options))}
+	} else {
+		m.Base.Value = nil
+	}
 }
 
 var _ runtime.Widget = (*MultiSelect)(nil)
 var _ runtime.Focusable = (*MultiSelect)(nil)
+var _ runtime.Bindable = (*MultiSelect)(nil)
+var _ runtime.Unbindable = (*MultiSelect)(nil)
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/compositor"
 	"github.com/odvcencio/fluffyui/forms"
+	"github.com/odvcencio/fluffyui/markdown"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/terminal"
 	flufftest "github.com/odvcencio/fluffyui/testing"
@@ -49,6 +51,48 @@ func TestMultiSelectToggle(t *testing.T) {
 	}
 }
 
+func TestMultiSelectBulkSelectionAndLimit(t *testing.T) {
+	ms := NewMultiSelect(
+		MultiSelectOption{Label: "One"},
+		MultiSelectOption{Label: "Two", Disabled: true},
+		MultiSelectOption{Label: "Three"},
+		MultiSelectOption{Label: "Four"},
+	)
+	ms.Focus()
+	changes := 0
+	ms.SetOnChange(func([]MultiSelectOption) { changes++ })
+
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'a', Ctrl: true})
+	if got := len(ms.SelectedOptions()); got != 3 {
+		t.Fatalf("selected after Ctrl+A = %d, want every enabled option", got)
+	}
+	_, rows := renderRows(t, ms, 20, 5)
+	if rows[4] != "(3/4)" {
+		t.Fatalf("status row = %q, want (3/4)", rows[4])
+	}
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlD})
+	if got := len(ms.SelectedOptions()); got != 0 || changes != 2 {
+		t.Fatalf("after Ctrl+D: %d selected, %d changes", got, changes)
+	}
+
+	ms.SetMaxSelected(1)
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if got := len(ms.SelectedOptions()); got != 1 {
+		t.Fatalf("selected past the limit: %d", got)
+	}
+	_, rows = renderRows(t, ms, 30, 5)
+	if rows[4] != "(1/4) Limit of 1 reached" {
+		t.Fatalf("status row = %q, want limit warning", rows[4])
+	}
+	ms.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'A', Ctrl: true, Shift: true})
+	if got := len(ms.SelectedOptions()); got != 0 {
+		t.Fatalf("selected after Ctrl+Shift+A = %d, want 0", got)
+	}
+}
+
 func TestDateRangePickerSetRange(t *testing.T) {
 	picker := NewDateRangePicker()
 	start := time.Date(2026, time.January, 10, 0, 0, 0, 0, time.UTC)
@@ -75,6 +119,41 @@ func TestTimePickerFormat(t *testing.T) {
 	}
 }
 
+func TestTimePickerTwelveHour(t *testing.T) {
+	picker := NewTimePicker()
+	picker.SetHourFormat(Hour12)
+	picker.SetValue(time.Date(2026, time.January, 1, 0, 30, 0, 0, time.UTC))
+	picker.Focus()
+	picker.Layout(runtime.Rect{Width: 8, Height: 1})
+	if got := flufftest.RenderToString(picker, 8, 1); !strings.Contains(got, "12:30 AM") {
+		t.Fatalf("expected midnight as 12:30 AM, got %q", got)
+	}
+
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if picker.Value().Hour() != 1 {
+		t.Fatalf("expected 12 AM to step to 1 AM, got hour %d", picker.Value().Hour())
+	}
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	if picker.Value().Hour() != 11 {
+		t.Fatalf("expected hours to wrap within AM, got hour %d", picker.Value().Hour())
+	}
+
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'p'})
+	if picker.Value().Hour() != 23 {
+		t.Fatalf("expected p to switch to PM, got hour %d", picker.Value().Hour())
+	}
+	picker.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if picker.Value().Hour() != 11 {
+		t.Fatalf("expected Up on the period to toggle it, got hour %d", picker.Value().Hour())
+	}
+	if picker.Time().Format(picker.format()) != "11:30 AM" {
+		t.Fatalf("unexpected 12-hour format %q", picker.Time().Format(picker.format()))
+	}
+}
+
 func TestRichTextRender(t *testing.T) {
 	view := NewRichText("Hello **World**")
 	output := flufftest.RenderToString(view, 20, 3)
@@ -83,6 +162,30 @@ func TestRichTextRender(t *testing.T) {
 	}
 }
 
+func TestRichTextTablesAndCodeTheme(t *testing.T) {
+	view := NewRichText("| Name | Qty |\n|------|-----|\n| pear | 3 |\n\n```go\nfunc main() {}\n```\n")
+	output := flufftest.RenderToString(view, 30, 12)
+	if !strings.Contains(output, "pear") || !strings.Contains(output, "─") {
+		t.Fatalf("expected a bordered table:\n%s", output)
+	}
+
+	red := compositor.Hex(0xff0000)
+	scheme := markdown.CodeThemeFromTheme(nil)
+	scheme.Keyword = compositor.DefaultStyle().WithFG(red)
+	view.SetCodeTheme(scheme)
+	found := false
+	for _, line := range view.lines {
+		for _, span := range line.Spans {
+			if span.Text == "func" && span.Style.FG == red {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected the keyword to use the code theme")
+	}
+}
+
 func TestDataGridEditingCommit(t *testing.T) {
 	grid := NewDataGrid(
 		TableColumn{Title: "Name"},
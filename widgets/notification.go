@@ -0,0 +1,329 @@
+package widgets
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+const (
+	// DefaultNotificationDuration is how long a notification stays visible.
+	DefaultNotificationDuration = 5 * time.Second
+
+	notificationMaxWidth = 40
+	notificationMinWidth = 16
+	notificationMargin   = 1
+)
+
+// notificationStackKey is the runtime.Services.AppValue key of an app's
+// notificationStack.
+type notificationStackKey struct{}
+
+// notificationStack tracks the notifications shown in one app so each one
+// floats past those shown before it.
+type notificationStack struct {
+	sync.Mutex
+	shown []*Notification
+}
+
+// Notification is a system-level popup that floats in a screen corner,
+// separate from the app's toasts. Push it as a non-modal overlay:
+//
+//	return runtime.WithCommand(runtime.PushOverlay{Widget: note})
+//
+// It does not take focus. It dismisses itself after its duration, on
+// Escape, or when clicked; notifications at the same position stack.
+type Notification struct {
+	Base
+	title      string
+	body       string
+	icon       rune
+	position   ToastPosition
+	stack      *notificationStack
+	duration   time.Duration
+	shownAt    time.Time
+	dismissed  bool
+	onDismiss  func()
+	services   runtime.Services
+	style      backend.Style
+	titleStyle backend.Style
+}
+
+// NotificationOption configures a notification.
+type NotificationOption = Option[Notification]
+
+// WithNotificationPosition sets where on the screen the notification
+// floats, using the toast positions. The default is ToastTopRight.
+func WithNotificationPosition(position ToastPosition) NotificationOption {
+	return func(n *Notification) {
+		n.position = position
+	}
+}
+
+// WithDuration sets how long the notification stays visible. Zero or less
+// keeps it until it is dismissed.
+func WithDuration(d time.Duration) NotificationOption {
+	return func(n *Notification) {
+		n.duration = d
+	}
+}
+
+// WithIcon shows an icon, such as an emoji, before the title.
+func WithIcon(icon rune) NotificationOption {
+	return func(n *Notification) {
+		n.icon = icon
+	}
+}
+
+// NewNotification creates a notification with a title and body.
+func NewNotification(title, body string, opts ...NotificationOption) *Notification {
+	n := &Notification{
+		title:      title,
+		body:       body,
+		position:   ToastTopRight,
+		duration:   DefaultNotificationDuration,
+		style:      backend.DefaultStyle(),
+		titleStyle: backend.DefaultStyle().Bold(true),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(n)
+		}
+	}
+	n.Base.Role = accessibility.RoleAlert
+	n.syncA11y()
+	return n
+}
+
+// OnDismiss registers a handler called once when the notification is
+// dismissed or expires.
+func (n *Notification) OnDismiss(fn func()) {
+	if n == nil {
+		return
+	}
+	n.onDismiss = fn
+}
+
+// SetStyles updates the box and title styles.
+func (n *Notification) SetStyles(box, title backend.Style) {
+	if n == nil {
+		return
+	}
+	n.style = box
+	n.titleStyle = title
+	n.Invalidate()
+}
+
+// StyleType returns the selector type name.
+func (n *Notification) StyleType() string {
+	return "Notification"
+}
+
+// Dismissed reports whether the notification was dismissed.
+func (n *Notification) Dismissed() bool {
+	return n != nil && n.dismissed
+}
+
+// Bind attaches app services.
+func (n *Notification) Bind(services runtime.Services) {
+	if n == nil {
+		return
+	}
+	n.services = services
+}
+
+// Unbind releases app services.
+func (n *Notification) Unbind() {
+	if n == nil {
+		return
+	}
+	n.services = runtime.Services{}
+}
+
+// Mount stacks the notification after those already shown in its app.
+func (n *Notification) Mount() {
+	if n == nil {
+		return
+	}
+	n.stack, _ = n.services.AppValue(notificationStackKey{}, func() any {
+		return &notificationStack{}
+	}).(*notificationStack)
+	if n.stack == nil {
+		return
+	}
+	n.stack.Lock()
+	defer n.stack.Unlock()
+	n.stack.shown = append(n.stack.shown, n)
+}
+
+// Unmount frees the notification's place in the stack. It uses the stack
+// from Mount, so it works whether or not Unbind ran first.
+func (n *Notification) Unmount() {
+	if n == nil || n.stack == nil {
+		return
+	}
+	stack := n.stack
+	n.stack = nil
+	stack.Lock()
+	defer stack.Unlock()
+	for i, other := range stack.shown {
+		if other == n {
+			stack.shown = append(stack.shown[:i:i], stack.shown[i+1:]...)
+			break
+		}
+	}
+}
+
+// lines returns the title line and the body lines.
+func (n *Notification) lines() []string {
+	title := strings.TrimSpace(n.title)
+	if n.icon != 0 {
+		title = strings.TrimSpace(string(n.icon) + " " + title)
+	}
+	lines := []string{title}
+	if body := strings.TrimSpace(n.body); body != "" {
+		lines = append(lines, strings.Split(body, "\n")...)
+	}
+	return lines
+}
+
+// boxSize returns the size of the notification box on a screen of the
+// given width.
+func (n *Notification) boxSize(screenWidth int) runtime.Size {
+	lines := n.lines()
+	width := max(maxLineLen(lines)+4, notificationMinWidth)
+	width = min(width, notificationMaxWidth, screenWidth-2*notificationMargin)
+	return runtime.Size{Width: max(width, 0), Height: len(lines) + 2}
+}
+
+// Measure fills the available space; Layout places the box.
+func (n *Notification) Measure(constraints runtime.Constraints) runtime.Size {
+	return constraints.MaxSize()
+}
+
+// Layout places the box at its position in bounds, past the
+// notifications shown before it at the same position.
+func (n *Notification) Layout(bounds runtime.Rect) {
+	if n == nil {
+		return
+	}
+	offset := notificationMargin
+	if n.stack != nil {
+		n.stack.Lock()
+		for _, other := range n.stack.shown {
+			if other == n {
+				break
+			}
+			if other.position == n.position {
+				offset += other.boxSize(bounds.Width).Height
+			}
+		}
+		n.stack.Unlock()
+	}
+
+	size := n.boxSize(bounds.Width)
+	box := runtime.Rect{Width: size.Width, Height: size.Height}
+	switch n.position {
+	case ToastTopLeft, ToastBottomLeft:
+		box.X = bounds.X + notificationMargin
+	case ToastTopCenter, ToastBottomCenter:
+		box.X = bounds.X + (bounds.Width-size.Width)/2
+	default:
+		box.X = bounds.X + bounds.Width - size.Width - notificationMargin
+	}
+	if n.position.top() {
+		box.Y = bounds.Y + offset
+	} else {
+		box.Y = bounds.Y + bounds.Height - offset - size.Height
+	}
+	n.Base.Layout(box.Intersection(bounds))
+}
+
+// Render draws the notification box.
+func (n *Notification) Render(ctx runtime.RenderContext) {
+	if n == nil {
+		return
+	}
+	n.syncA11y()
+	bounds := n.bounds
+	if bounds.Width < 4 || bounds.Height < 3 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, n, backend.DefaultStyle(), false), n.style)
+	titleStyle := mergeBackendStyles(style, n.titleStyle)
+	ctx.Buffer.Fill(bounds, ' ', style)
+	ctx.Buffer.DrawRoundedBox(bounds, style)
+	room := bounds.Width - 4
+	for i, line := range n.lines() {
+		y := bounds.Y + 1 + i
+		if y >= bounds.Y+bounds.Height-1 {
+			break
+		}
+		lineStyle := style
+		if i == 0 {
+			lineStyle = titleStyle
+		}
+		ctx.Buffer.SetString(bounds.X+2, y, truncateString(line, room), lineStyle)
+	}
+}
+
+// HandleMessage expires the notification on ticks and dismisses it on
+// Escape or a click.
+func (n *Notification) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if n == nil || n.dismissed {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.TickMsg:
+		if n.shownAt.IsZero() {
+			n.shownAt = m.Time
+		}
+		if n.duration > 0 && m.Time.Sub(n.shownAt) >= n.duration {
+			// Other layers still see the tick.
+			return runtime.HandleResult{Commands: []runtime.Command{n.dismiss()}}
+		}
+	case runtime.KeyMsg:
+		if m.Key == terminal.KeyEscape {
+			return runtime.WithCommand(n.dismiss())
+		}
+	case runtime.MouseMsg:
+		if m.Action == runtime.MousePress && m.Button == runtime.MouseLeft && n.bounds.Contains(m.X, m.Y) {
+			return runtime.WithCommand(n.dismiss())
+		}
+	}
+	return runtime.Unhandled()
+}
+
+// dismiss marks the notification dismissed and returns the command that
+// removes its overlay.
+func (n *Notification) dismiss() runtime.Command {
+	n.dismissed = true
+	if n.onDismiss != nil {
+		n.onDismiss()
+	}
+	return runtime.RemoveOverlay{Widget: n}
+}
+
+func (n *Notification) syncA11y() {
+	if n == nil {
+		return
+	}
+	if n.Base.Role == "" {
+		n.Base.Role = accessibility.RoleAlert
+	}
+	label := strings.TrimSpace(n.title)
+	if label == "" {
+		label = "Notification"
+	}
+	n.Base.Label = label
+	n.Base.Description = strings.TrimSpace(n.body)
+}
+
+var _ runtime.Widget = (*Notification)(nil)
+var _ runtime.Bindable = (*Notification)(nil)
+var _ runtime.Lifecycle = (*Notification)(nil)
@@ -0,0 +1,112 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestNotificationStacksInCorner(t *testing.T) {
+	app := runtime.NewApp(runtime.AppConfig{})
+	screen := runtime.NewScreen(40, 12)
+	screen.SetServices(app.Services())
+	input := NewInput()
+	screen.SetRoot(input)
+	screen.SetAutoRegisterFocus(true)
+	screen.RefreshFocusables()
+
+	first := NewNotification("Build", "passed", WithIcon('✓'))
+	second := NewNotification("Deploy", "started")
+	corner := NewNotification("Sync", "", WithNotificationPosition(ToastBottomLeft))
+	for _, n := range []*Notification{first, second, corner} {
+		screen.PushLayer(n, false)
+	}
+	defer func() {
+		for screen.PopLayer() {
+		}
+	}()
+
+	if got := first.Bounds(); got != (runtime.Rect{X: 23, Y: 1, Width: 16, Height: 4}) {
+		t.Fatalf("first bounds = %+v", got)
+	}
+	if got := second.Bounds(); got.Y != 5 || got.X != 23 {
+		t.Fatalf("second bounds = %+v, want it stacked below the first", got)
+	}
+	if got := corner.Bounds(); got != (runtime.Rect{X: 1, Y: 8, Width: 16, Height: 3}) {
+		t.Fatalf("corner bounds = %+v", got)
+	}
+	if !input.IsFocused() || screen.FocusScope() != screen.BaseFocusScope() {
+		t.Fatal("notifications should not take focus")
+	}
+
+	screen.Render()
+	buf := screen.Buffer()
+	if got := buf.Get(25, 2).Rune; got != '✓' {
+		t.Fatalf("icon = %q", got)
+	}
+
+	screen.RemoveLayer(first)
+	if got := second.Bounds(); got.Y != 1 {
+		t.Fatalf("second bounds = %+v, want it to move into the free slot", got)
+	}
+
+	// A notification unbound before it unmounts still leaves the stack.
+	second.Unbind()
+	second.Unmount()
+	other := runtime.NewScreen(40, 12)
+	other.SetServices(app.Services())
+	late := NewNotification("Late", "")
+	other.PushLayer(late, false)
+	if got := late.Bounds(); got.Y != 1 {
+		t.Fatalf("late bounds = %+v, want the first slot once the others left", got)
+	}
+}
+
+func TestNotificationStacksPerApp(t *testing.T) {
+	var notes []*Notification
+	for range 2 {
+		screen := runtime.NewScreen(40, 12)
+		screen.SetServices(runtime.NewApp(runtime.AppConfig{}).Services())
+		note := NewNotification("Build", "passed")
+		screen.PushLayer(note, false)
+		notes = append(notes, note)
+	}
+	for _, note := range notes {
+		if got := note.Bounds(); got.Y != 1 {
+			t.Fatalf("bounds = %+v, want each app to stack on its own", got)
+		}
+	}
+}
+
+func TestNotificationDismissal(t *testing.T) {
+	screen := runtime.NewScreen(40, 12)
+	screen.SetRoot(NewLabel("app"))
+	timed := NewNotification("Saved", "", WithDuration(2*time.Second))
+	sticky := NewNotification("Offline", "Retrying", WithDuration(0))
+	dismissed := 0
+	timed.OnDismiss(func() { dismissed++ })
+	screen.PushLayer(timed, false)
+	screen.PushLayer(sticky, false)
+
+	start := time.Unix(100, 0)
+	screen.HandleMessage(runtime.TickMsg{Time: start})
+	screen.HandleMessage(runtime.TickMsg{Time: start.Add(time.Second)})
+	if screen.LayerCount() != 3 {
+		t.Fatalf("layers = %d, notification expired early", screen.LayerCount())
+	}
+	screen.HandleMessage(runtime.TickMsg{Time: start.Add(2 * time.Second)})
+	if screen.LayerCount() != 2 || screen.TopLayer().Root != sticky || dismissed != 1 {
+		t.Fatalf("expected only the timed notification to expire, layers = %d", screen.LayerCount())
+	}
+
+	screen.HandleMessage(runtime.TickMsg{Time: start.Add(time.Hour)})
+	screen.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if screen.LayerCount() != 1 || !sticky.Dismissed() {
+		t.Fatal("expected Esc to dismiss the notification")
+	}
+	if dismissed != 1 {
+		t.Fatalf("OnDismiss ran %d times", dismissed)
+	}
+}
@@ -0,0 +1,585 @@
+package widgets
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// NumberMode selects whether a NumberInput holds whole numbers or decimals.
+type NumberMode int
+
+const (
+	// NumberInteger rounds values to whole numbers.
+	NumberInteger NumberMode = iota
+	// NumberFloat keeps decimals.
+	NumberFloat
+)
+
+const (
+	numberButtonWidth  = 3
+	numberPageSteps    = 10
+	numberAutoDecimals = 10
+	// Key repeats closer together than numberRepeatWindow count as a held
+	// key; every numberRepeatRamp repeats add one step, up to
+	// numberMaxRepeat steps per key.
+	numberRepeatWindow = 150 * time.Millisecond
+	numberRepeatRamp   = 8
+	numberMaxRepeat    = 10
+)
+
+// NumberInputOption configures a NumberInput.
+type NumberInputOption = Option[NumberInput]
+
+// NumberInput is a numeric field with a range and step. Up/Down and the
+// [-] and [+] buttons step the value, Page Up/Down step ten times as far,
+// and Home/End jump to the bounds; holding an arrow key speeds up. Typing a
+// number edits the value: Enter applies it, and Esc or leaving the field
+// with an invalid entry restores the previous value.
+type NumberInput struct {
+	FocusableBase
+
+	value      float64
+	min        float64
+	max        float64
+	step       float64
+	mode       NumberMode
+	precision  int // decimals shown in float mode, or -1 for as many as needed
+	wrap       bool
+	label      string
+	style      backend.Style
+	focusStyle backend.Style
+	onChange   func(value float64)
+	services   runtime.Services
+
+	editing bool
+	text    []rune
+
+	lastStep time.Time
+	stepDir  int
+	streak   int
+	now      func() time.Time
+}
+
+// NewNumberInput creates an integer input starting at 0 with no bounds and
+// a step of 1.
+func NewNumberInput(opts ...NumberInputOption) *NumberInput {
+	n := &NumberInput{
+		min:        math.Inf(-1),
+		max:        math.Inf(1),
+		step:       1,
+		precision:  -1,
+		label:      "Number",
+		style:      backend.DefaultStyle(),
+		focusStyle: backend.DefaultStyle().Reverse(true),
+	}
+	n.Base.Role = accessibility.RoleSpinButton
+	for _, opt := range opts {
+		if opt != nil {
+			opt(n)
+		}
+	}
+	n.value = n.normalize(n.value)
+	n.syncA11y()
+	return n
+}
+
+// WithNumberValue sets the starting value.
+func WithNumberValue(value float64) NumberInputOption {
+	return func(n *NumberInput) {
+		n.value = value
+	}
+}
+
+// WithNumberRange sets the lowest and highest values.
+func WithNumberRange(min, max float64) NumberInputOption {
+	return func(n *NumberInput) {
+		n.min, n.max = orderedRange(min, max)
+	}
+}
+
+// WithNumberStep sets how far Up/Down and the buttons move the value.
+func WithNumberStep(step float64) NumberInputOption {
+	return func(n *NumberInput) {
+		if step > 0 {
+			n.step = step
+		}
+	}
+}
+
+// WithNumberMode selects integer or float values.
+func WithNumberMode(mode NumberMode) NumberInputOption {
+	return func(n *NumberInput) {
+		n.mode = mode
+	}
+}
+
+// WithNumberPrecision sets how many decimals float values keep and show.
+// A negative precision keeps as many as the value needs.
+func WithNumberPrecision(decimals int) NumberInputOption {
+	return func(n *NumberInput) {
+		n.precision = decimals
+	}
+}
+
+// WithNumberWrap makes stepping past one bound continue from the other.
+func WithNumberWrap(wrap bool) NumberInputOption {
+	return func(n *NumberInput) {
+		n.wrap = wrap
+	}
+}
+
+// SetValue sets the value, rounded for the mode and clamped to the range.
+func (n *NumberInput) SetValue(value float64) {
+	if n == nil {
+		return
+	}
+	n.cancelEdit()
+	n.setValue(value)
+}
+
+// Value returns the current value.
+func (n *NumberInput) Value() float64 {
+	if n == nil {
+		return 0
+	}
+	return n.value
+}
+
+// SetRange sets the lowest and highest values, clamping the current value.
+// Use math.Inf for an open end.
+func (n *NumberInput) SetRange(min, max float64) {
+	if n == nil {
+		return
+	}
+	n.min, n.max = orderedRange(min, max)
+	n.setValue(n.value)
+}
+
+// SetStep sets how far Up/Down and the buttons move the value. Steps that
+// are not positive are ignored.
+func (n *NumberInput) SetStep(step float64) {
+	if n == nil || step <= 0 {
+		return
+	}
+	n.step = step
+}
+
+// SetMode selects integer or float values.
+func (n *NumberInput) SetMode(mode NumberMode) {
+	if n == nil {
+		return
+	}
+	n.mode = mode
+	n.setValue(n.value)
+}
+
+// SetWrap makes stepping past one bound continue from the other.
+func (n *NumberInput) SetWrap(wrap bool) {
+	if n == nil {
+		return
+	}
+	n.wrap = wrap
+}
+
+// SetOnChange registers a callback for value changes.
+func (n *NumberInput) SetOnChange(fn func(value float64)) {
+	if n == nil {
+		return
+	}
+	n.onChange = fn
+}
+
+// OnChange is an alias for SetOnChange.
+func (n *NumberInput) OnChange(fn func(value float64)) {
+	n.SetOnChange(fn)
+}
+
+// SetLabel updates the accessibility label.
+func (n *NumberInput) SetLabel(label string) {
+	if n == nil {
+		return
+	}
+	n.label = label
+	n.syncA11y()
+}
+
+// SetStyle sets the base style.
+func (n *NumberInput) SetStyle(style backend.Style) {
+	if n == nil {
+		return
+	}
+	n.style = style
+}
+
+// SetFocusStyle sets the style of the value while focused.
+func (n *NumberInput) SetFocusStyle(style backend.Style) {
+	if n == nil {
+		return
+	}
+	n.focusStyle = style
+}
+
+// Text returns the value as displayed, or the typed entry while editing.
+func (n *NumberInput) Text() string {
+	if n == nil {
+		return ""
+	}
+	if n.editing {
+		return string(n.text)
+	}
+	return n.format(n.value)
+}
+
+// StyleType returns the selector type name.
+func (n *NumberInput) StyleType() string {
+	return "NumberInput"
+}
+
+// Bind attaches app services.
+func (n *NumberInput) Bind(services runtime.Services) {
+	if n == nil {
+		return
+	}
+	n.services = services
+}
+
+// Unbind releases app services.
+func (n *NumberInput) Unbind() {
+	if n == nil {
+		return
+	}
+	n.services = runtime.Services{}
+}
+
+// Blur applies a valid typed entry, restores the value otherwise, and
+// clears focus.
+func (n *NumberInput) Blur() {
+	if n == nil {
+		return
+	}
+	n.commitEdit()
+	n.FocusableBase.Blur()
+}
+
+// Measure fits the buttons and the widest of the value and the bounds.
+func (n *NumberInput) Measure(constraints runtime.Constraints) runtime.Size {
+	return n.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := max(textWidth(n.Text()), 4)
+		for _, bound := range []float64{n.min, n.max} {
+			if !math.IsInf(bound, 0) {
+				width = max(width, textWidth(n.format(bound)))
+			}
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width + 2*numberButtonWidth + 2, Height: 1})
+	})
+}
+
+// Render draws "[-] value [+]".
+func (n *NumberInput) Render(ctx runtime.RenderContext) {
+	if n == nil {
+		return
+	}
+	n.syncA11y()
+	outer := n.bounds
+	content := n.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	baseStyle := mergeBackendStyles(resolveBaseStyle(ctx, n, backend.DefaultStyle(), false), n.style)
+	ctx.Buffer.Fill(outer, ' ', baseStyle)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	fieldStyle := baseStyle
+	if n.focused {
+		fieldStyle = mergeBackendStyles(baseStyle, n.focusStyle)
+	}
+	field := content
+	field.Height = 1
+	if content.Width >= 2*numberButtonWidth+3 {
+		ctx.Buffer.SetString(content.X, content.Y, "[-]", baseStyle)
+		ctx.Buffer.SetString(content.X+content.Width-numberButtonWidth, content.Y, "[+]", baseStyle)
+		field.X += numberButtonWidth + 1
+		field.Width -= 2*numberButtonWidth + 2
+	}
+	ctx.Buffer.Fill(field, ' ', fieldStyle)
+	text := n.Text()
+	if n.editing {
+		// Keep the end of the entry, where the cursor is, in view.
+		runes := []rune(text)
+		if len(runes) >= field.Width {
+			text = string(runes[len(runes)-field.Width+1:])
+		}
+		writePadded(ctx.Buffer, field.X, field.Y, field.Width, text, fieldStyle)
+		if cursor := field.X + textWidth(text); cursor < field.X+field.Width {
+			ctx.Buffer.Set(cursor, field.Y, '_', fieldStyle)
+		}
+		return
+	}
+	text = truncateString(text, field.Width)
+	writePadded(ctx.Buffer, field.X+field.Width-textWidth(text), field.Y, textWidth(text), text, fieldStyle)
+}
+
+// HandleMessage steps, edits, and clicks the buttons.
+func (n *NumberInput) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if n == nil {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		return n.handleMouse(m)
+	case runtime.KeyMsg:
+		if !n.focused {
+			return runtime.Unhandled()
+		}
+		return n.handleKey(m)
+	}
+	return runtime.Unhandled()
+}
+
+func (n *NumberInput) handleKey(key runtime.KeyMsg) runtime.HandleResult {
+	switch key.Key {
+	case terminal.KeyUp:
+		n.stepBy(1, n.repeatSteps(1))
+	case terminal.KeyDown:
+		n.stepBy(-1, n.repeatSteps(-1))
+	case terminal.KeyPageUp:
+		n.stepBy(1, numberPageSteps)
+	case terminal.KeyPageDown:
+		n.stepBy(-1, numberPageSteps)
+	case terminal.KeyHome:
+		if math.IsInf(n.min, 0) {
+			return runtime.Unhandled()
+		}
+		n.SetValue(n.min)
+	case terminal.KeyEnd:
+		if math.IsInf(n.max, 0) {
+			return runtime.Unhandled()
+		}
+		n.SetValue(n.max)
+	case terminal.KeyEnter:
+		if !n.editing {
+			return runtime.Unhandled()
+		}
+		n.commitEdit()
+	case terminal.KeyEscape:
+		if !n.editing {
+			return runtime.Unhandled()
+		}
+		n.cancelEdit()
+	case terminal.KeyBackspace:
+		if !n.editing {
+			n.editing = true
+			n.text = []rune(n.format(n.value))
+		}
+		if len(n.text) > 0 {
+			n.text = n.text[:len(n.text)-1]
+		}
+		n.syncA11y()
+		n.Invalidate()
+	case terminal.KeyRune:
+		if !n.acceptsRune(key.Rune) {
+			return runtime.Unhandled()
+		}
+		if !n.editing {
+			n.editing = true
+			n.text = nil
+		}
+		n.text = append(n.text, key.Rune)
+		n.syncA11y()
+		n.Invalidate()
+	default:
+		return runtime.Unhandled()
+	}
+	return runtime.Handled()
+}
+
+// handleMouse steps the value when a button is pressed.
+func (n *NumberInput) handleMouse(m runtime.MouseMsg) runtime.HandleResult {
+	content := n.ContentBounds()
+	if m.Button != runtime.MouseLeft || m.Action != runtime.MousePress || !content.Contains(m.X, m.Y) {
+		return runtime.Unhandled()
+	}
+	if content.Width < 2*numberButtonWidth+3 {
+		return runtime.Unhandled()
+	}
+	switch {
+	case m.X < content.X+numberButtonWidth:
+		n.stepBy(-1, n.repeatSteps(-1))
+	case m.X >= content.X+content.Width-numberButtonWidth:
+		n.stepBy(1, n.repeatSteps(1))
+	default:
+		return runtime.Unhandled()
+	}
+	return runtime.Handled()
+}
+
+// acceptsRune reports whether r can be typed into a number.
+func (n *NumberInput) acceptsRune(r rune) bool {
+	switch {
+	case r >= '0' && r <= '9', r == '-':
+		return true
+	case r == '.':
+		return n.mode == NumberFloat
+	}
+	return false
+}
+
+// repeatSteps returns how many steps a press in dir moves, growing while
+// the key is held.
+func (n *NumberInput) repeatSteps(dir int) float64 {
+	now := time.Now()
+	if n.now != nil {
+		now = n.now()
+	}
+	if dir == n.stepDir && !n.lastStep.IsZero() && now.Sub(n.lastStep) <= numberRepeatWindow {
+		n.streak++
+	} else {
+		n.streak = 0
+	}
+	n.stepDir = dir
+	n.lastStep = now
+	return float64(min(1+n.streak/numberRepeatRamp, numberMaxRepeat))
+}
+
+// stepBy moves the value by steps in dir, applying any typed entry first.
+// With wrap, stepping from one bound continues from the other.
+func (n *NumberInput) stepBy(dir int, steps float64) {
+	n.commitEdit()
+	target := n.value + float64(dir)*steps*n.step
+	if n.wrap && !math.IsInf(n.min, 0) && !math.IsInf(n.max, 0) {
+		switch {
+		case dir > 0 && n.value >= n.max:
+			target = n.min
+		case dir < 0 && n.value <= n.min:
+			target = n.max
+		}
+	}
+	n.setValue(target)
+}
+
+// setValue stores a normalized value and reports a change.
+func (n *NumberInput) setValue(value float64) {
+	value = n.normalize(value)
+	if value == n.value {
+		return
+	}
+	n.value = value
+	n.syncA11y()
+	n.Invalidate()
+	n.services.AnnounceChange(n)
+	if n.onChange != nil {
+		n.onChange(value)
+	}
+}
+
+// normalize rounds value for the mode and clamps it to the range. NaN
+// keeps the current value.
+func (n *NumberInput) normalize(value float64) float64 {
+	if math.IsNaN(value) {
+		return n.value
+	}
+	switch {
+	case n.mode == NumberInteger:
+		value = math.Round(value)
+	case !math.IsInf(value, 0):
+		decimals := n.precision
+		if decimals < 0 {
+			decimals = numberAutoDecimals
+		}
+		scale := math.Pow(10, float64(decimals))
+		value = math.Round(value*scale) / scale
+	}
+	return math.Min(math.Max(value, n.min), n.max)
+}
+
+func (n *NumberInput) format(value float64) string {
+	switch {
+	case n.mode == NumberInteger:
+		return strconv.FormatFloat(value, 'f', 0, 64)
+	case n.precision >= 0:
+		return strconv.FormatFloat(value, 'f', n.precision, 64)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// parseEdit parses the typed entry.
+func (n *NumberInput) parseEdit() (float64, bool) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(string(n.text)), 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+	return value, true
+}
+
+// commitEdit applies a valid typed entry and drops an invalid one.
+func (n *NumberInput) commitEdit() {
+	if !n.editing {
+		return
+	}
+	value, ok := n.parseEdit()
+	n.cancelEdit()
+	if ok {
+		n.setValue(value)
+	}
+}
+
+// cancelEdit drops the typed entry.
+func (n *NumberInput) cancelEdit() {
+	if !n.editing {
+		return
+	}
+	n.editing = false
+	n.text = nil
+	n.syncA11y()
+	n.Invalidate()
+}
+
+func (n *NumberInput) syncA11y() {
+	if n == nil {
+		return
+	}
+	if n.Base.Role == "" {
+		n.Base.Role = accessibility.RoleSpinButton
+	}
+	label := strings.TrimSpace(n.label)
+	if label == "" {
+		label = "Number"
+	}
+	n.Base.Label = label
+	value := &accessibility.ValueInfo{Current: n.value, Text: n.Text()}
+	if !math.IsInf(n.min, 0) {
+		value.Min = n.min
+	}
+	if !math.IsInf(n.max, 0) {
+		value.Max = n.max
+	}
+	n.Base.Value = value
+	_, valid := n.parseEdit()
+	n.Base.State.Invalid = n.editing && !valid
+}
+
+// orderedRange returns min and max in order, ignoring NaN bounds.
+func orderedRange(min, max float64) (float64, float64) {
+	if math.IsNaN(min) {
+		min = math.Inf(-1)
+	}
+	if math.IsNaN(max) {
+		max = math.Inf(1)
+	}
+	if min > max {
+		min, max = max, min
+	}
+	return min, max
+}
+
+var _ runtime.Widget = (*NumberInput)(nil)
+var _ runtime.Focusable = (*NumberInput)(nil)
+var _ runtime.Bindable = (*NumberInput)(nil)
@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestNumberInputStepsAndWraps(t *testing.T) {
+	var changes []float64
+	n := NewNumberInput(WithNumberRange(0, 10), WithNumberStep(4), WithNumberValue(20))
+	n.OnChange(func(value float64) { changes = append(changes, value) })
+	if n.Value() != 10 {
+		t.Fatalf("Value = %v, want the starting value clamped to 10", n.Value())
+	}
+	n.Focus()
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDown})
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if n.Value() != 10 || len(changes) != 2 {
+		t.Fatalf("Value = %v, changes = %v; want clamping at 10 without wrap", n.Value(), changes)
+	}
+
+	n.SetWrap(true)
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if n.Value() != 0 {
+		t.Fatalf("Value = %v, want wrap from 10 to 0", n.Value())
+	}
+
+	renderRows(t, n, 14, 1)
+	n.HandleMessage(runtime.MouseMsg{X: 13, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if n.Value() != 4 {
+		t.Fatalf("Value = %v, want [+] to step to 4", n.Value())
+	}
+	if _, rows := renderRows(t, n, 14, 1); rows[0] != "[-]      4 [+]" {
+		t.Fatalf("row = %q", rows[0])
+	}
+}
+
+func TestNumberInputTypedEntry(t *testing.T) {
+	n := NewNumberInput(WithNumberMode(NumberFloat), WithNumberRange(-5, 5))
+	n.Focus()
+	for _, r := range "2.5" {
+		n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if n.Text() != "2.5" || n.Value() != 0 {
+		t.Fatalf("Text = %q, Value = %v; want the entry pending", n.Text(), n.Value())
+	}
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if n.Value() != 2.5 {
+		t.Fatalf("Value = %v, want 2.5", n.Value())
+	}
+
+	for _, r := range "1-" {
+		n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r})
+	}
+	if !n.AccessibleState().Invalid {
+		t.Fatal("expected an invalid entry to be marked invalid")
+	}
+	n.Blur()
+	if n.Value() != 2.5 || n.Text() != "2.5" {
+		t.Fatalf("Value = %v, Text = %q; want the invalid entry reverted on blur", n.Value(), n.Text())
+	}
+
+	n.Focus()
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '9'})
+	n.Blur()
+	if n.Value() != 5 {
+		t.Fatalf("Value = %v, want a valid entry applied and clamped on blur", n.Value())
+	}
+	if n.AccessibleRole() != accessibility.RoleSpinButton {
+		t.Fatalf("role = %q", n.AccessibleRole())
+	}
+}
+
+func TestNumberInputHeldKeyAccelerates(t *testing.T) {
+	clock := time.Unix(100, 0)
+	n := NewNumberInput()
+	n.now = func() time.Time { return clock }
+	n.Focus()
+	for i := 0; i < numberRepeatRamp*2; i++ {
+		n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+		clock = clock.Add(30 * time.Millisecond)
+	}
+	// Eight single steps, then eight double steps.
+	if n.Value() != 24 {
+		t.Fatalf("Value = %v, want 24", n.Value())
+	}
+	clock = clock.Add(time.Second)
+	n.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp})
+	if n.Value() != 25 {
+		t.Fatalf("Value = %v, want a single step after a pause", n.Value())
+	}
+}
@@ -0,0 +1,354 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// paginatorControl identifies a clickable part of a Paginator.
+type paginatorControl int
+
+const (
+	paginatorFirst paginatorControl = iota
+	paginatorPrev
+	paginatorIndicator
+	paginatorNext
+	paginatorLast
+)
+
+// paginatorSegment is a control laid out on the paginator row.
+type paginatorSegment struct {
+	control paginatorControl
+	text    string
+	x       int
+}
+
+// Paginator renders first/prev/next/last controls and a page indicator
+// for data split into pages. Pages are numbered from zero and shown from
+// one.
+type Paginator struct {
+	FocusableBase
+	total         int
+	pageSize      int
+	page          int
+	onPageChange  func(page, offset, limit int)
+	label         string
+	style         backend.Style
+	disabledStyle backend.Style
+}
+
+// NewPaginator creates a paginator over total items split into pages of
+// pageSize.
+func NewPaginator(total, pageSize int) *Paginator {
+	p := &Paginator{
+		total:         max(total, 0),
+		pageSize:      max(pageSize, 1),
+		label:         "Pagination",
+		style:         backend.DefaultStyle(),
+		disabledStyle: backend.DefaultStyle().Dim(true),
+	}
+	p.Base.Role = accessibility.RoleGroup
+	p.syncA11y()
+	return p
+}
+
+// OnPageChange registers a handler called with the page and its window
+// of items whenever the window changes.
+func (p *Paginator) OnPageChange(fn func(page, offset, limit int)) {
+	if p == nil {
+		return
+	}
+	p.onPageChange = fn
+}
+
+// SetLabel updates the accessibility label.
+func (p *Paginator) SetLabel(label string) {
+	if p == nil {
+		return
+	}
+	p.label = label
+	p.syncA11y()
+}
+
+// SetStyles updates the control and disabled control styles.
+func (p *Paginator) SetStyles(normal, disabled backend.Style) {
+	if p == nil {
+		return
+	}
+	p.style = normal
+	p.disabledStyle = disabled
+	p.Invalidate()
+}
+
+// StyleType returns the selector type name.
+func (p *Paginator) StyleType() string {
+	return "Paginator"
+}
+
+// Total returns the number of items.
+func (p *Paginator) Total() int {
+	if p == nil {
+		return 0
+	}
+	return p.total
+}
+
+// SetTotal updates the number of items, keeping the page within range.
+func (p *Paginator) SetTotal(total int) {
+	if p == nil {
+		return
+	}
+	p.update(func() { p.total = max(total, 0) })
+}
+
+// PageSize returns the number of items per page.
+func (p *Paginator) PageSize() int {
+	if p == nil {
+		return 0
+	}
+	return p.pageSize
+}
+
+// SetPageSize updates the number of items per page, keeping the first
+// item of the current page in view.
+func (p *Paginator) SetPageSize(size int) {
+	if p == nil {
+		return
+	}
+	p.update(func() {
+		offset := p.page * p.pageSize
+		p.pageSize = max(size, 1)
+		p.page = offset / p.pageSize
+	})
+}
+
+// Page returns the current page.
+func (p *Paginator) Page() int {
+	if p == nil {
+		return 0
+	}
+	return p.page
+}
+
+// SetPage moves to page, clamped to the available pages.
+func (p *Paginator) SetPage(page int) {
+	if p == nil {
+		return
+	}
+	p.update(func() { p.page = page })
+}
+
+// PageCount returns the number of pages, at least one.
+func (p *Paginator) PageCount() int {
+	if p == nil || p.total == 0 {
+		return 1
+	}
+	return (p.total + p.pageSize - 1) / p.pageSize
+}
+
+// Window returns the offset of the first item on the current page and
+// the number of items on it.
+func (p *Paginator) Window() (offset, limit int) {
+	if p == nil {
+		return 0, 0
+	}
+	offset = p.page * p.pageSize
+	limit = clampInt(p.total-offset, 0, p.pageSize)
+	return offset, limit
+}
+
+// First moves to the first page.
+func (p *Paginator) First() { p.SetPage(0) }
+
+// Prev moves to the previous page.
+func (p *Paginator) Prev() { p.SetPage(p.Page() - 1) }
+
+// Next moves to the next page.
+func (p *Paginator) Next() { p.SetPage(p.Page() + 1) }
+
+// Last moves to the last page.
+func (p *Paginator) Last() { p.SetPage(p.PageCount() - 1) }
+
+// update applies a change, clamps the page and reports a new window.
+func (p *Paginator) update(change func()) {
+	page := p.page
+	offset, limit := p.Window()
+	change()
+	p.page = clampInt(p.page, 0, p.PageCount()-1)
+	newOffset, newLimit := p.Window()
+	if p.page == page && newOffset == offset && newLimit == limit {
+		return
+	}
+	p.syncA11y()
+	p.Invalidate()
+	if p.onPageChange != nil {
+		p.onPageChange(p.page, newOffset, newLimit)
+	}
+}
+
+// segments lays out the controls that fit in width.
+func (p *Paginator) segments(width int) []paginatorSegment {
+	count := p.PageCount()
+	indicator := fmt.Sprintf("Page %d of %d", p.page+1, count)
+	if textWidth(indicator)+8 > width {
+		indicator = fmt.Sprintf("%d/%d", p.page+1, count)
+	}
+	full := []paginatorSegment{
+		{control: paginatorFirst, text: "«"},
+		{control: paginatorPrev, text: "‹"},
+		{control: paginatorIndicator, text: indicator},
+		{control: paginatorNext, text: "›"},
+		{control: paginatorLast, text: "»"},
+	}
+	for _, segs := range [][]paginatorSegment{full, full[1:4], full[2:3]} {
+		x := 0
+		out := make([]paginatorSegment, 0, len(segs))
+		for i, seg := range segs {
+			if i > 0 {
+				x++
+			}
+			seg.x = x
+			x += textWidth(seg.text)
+			out = append(out, seg)
+		}
+		if x <= width {
+			return out
+		}
+	}
+	return nil
+}
+
+// enabled reports whether a control would change the page.
+func (p *Paginator) enabled(control paginatorControl) bool {
+	switch control {
+	case paginatorFirst, paginatorPrev:
+		return p.page > 0
+	case paginatorNext, paginatorLast:
+		return p.page < p.PageCount()-1
+	}
+	return true
+}
+
+// activate runs a control.
+func (p *Paginator) activate(control paginatorControl) {
+	switch control {
+	case paginatorFirst:
+		p.First()
+	case paginatorPrev:
+		p.Prev()
+	case paginatorNext:
+		p.Next()
+	case paginatorLast:
+		p.Last()
+	}
+}
+
+// Measure returns the width of the full controls on a single row.
+func (p *Paginator) Measure(constraints runtime.Constraints) runtime.Size {
+	return p.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := 0
+		for _, seg := range p.segments(contentConstraints.MaxWidth) {
+			width = seg.x + textWidth(seg.text)
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
+	})
+}
+
+// Render draws the controls, dimming those at the first or last page.
+func (p *Paginator) Render(ctx runtime.RenderContext) {
+	if p == nil {
+		return
+	}
+	p.syncA11y()
+	outer := p.bounds
+	content := p.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, p, backend.DefaultStyle(), false), p.style)
+	disabled := mergeBackendStyles(style, p.disabledStyle)
+	indicator := style
+	if p.focused {
+		indicator = style.Reverse(true)
+	}
+	ctx.Buffer.Fill(outer, ' ', style)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+	for _, seg := range p.segments(content.Width) {
+		segStyle := style
+		switch {
+		case seg.control == paginatorIndicator:
+			segStyle = indicator
+		case !p.enabled(seg.control):
+			segStyle = disabled
+		}
+		ctx.Buffer.SetString(content.X+seg.x, content.Y, seg.text, segStyle)
+	}
+}
+
+// HandleMessage changes pages from keys and clicks on the controls.
+func (p *Paginator) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if p == nil {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		if m.Action != runtime.MousePress || m.Button != runtime.MouseLeft {
+			return runtime.Unhandled()
+		}
+		content := p.ContentBounds()
+		if m.Y != content.Y {
+			return runtime.Unhandled()
+		}
+		for _, seg := range p.segments(content.Width) {
+			x := content.X + seg.x
+			if m.X >= x && m.X < x+textWidth(seg.text) {
+				p.activate(seg.control)
+				return runtime.Handled()
+			}
+		}
+	case runtime.KeyMsg:
+		if !p.focused {
+			return runtime.Unhandled()
+		}
+		switch m.Key {
+		case terminal.KeyPageUp, terminal.KeyLeft:
+			p.Prev()
+		case terminal.KeyPageDown, terminal.KeyRight:
+			p.Next()
+		case terminal.KeyHome:
+			p.First()
+		case terminal.KeyEnd:
+			p.Last()
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+func (p *Paginator) syncA11y() {
+	if p == nil {
+		return
+	}
+	if p.Base.Role == "" {
+		p.Base.Role = accessibility.RoleGroup
+	}
+	label := strings.TrimSpace(p.label)
+	if label == "" {
+		label = "Pagination"
+	}
+	p.Base.Label = label
+	p.Base.Value = &accessibility.ValueInfo{Text: fmt.Sprintf("Page %d of %d", p.page+1, p.PageCount())}
+	p.Base.Description = fmt.Sprintf("%d items", p.total)
+}
+
+var _ runtime.Widget = (*Paginator)(nil)
+var _ runtime.Focusable = (*Paginator)(nil)
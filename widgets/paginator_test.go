@@ -0,0 +1,97 @@
+package widgets
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestPaginatorWindowAndClamping(t *testing.T) {
+	p := NewPaginator(45, 10)
+	var windows [][3]int
+	p.OnPageChange(func(page, offset, limit int) {
+		windows = append(windows, [3]int{page, offset, limit})
+	})
+	if p.PageCount() != 5 {
+		t.Fatalf("page count = %d, want 5", p.PageCount())
+	}
+
+	p.Prev()
+	p.Last()
+	p.Next()
+	if offset, limit := p.Window(); offset != 40 || limit != 5 {
+		t.Fatalf("window = %d,%d, want 40,5", offset, limit)
+	}
+
+	p.SetTotal(25)
+	if p.Page() != 2 {
+		t.Fatalf("page = %d, want 2 after shrinking the total", p.Page())
+	}
+	want := [][3]int{{4, 40, 5}, {2, 20, 5}}
+	if fmt.Sprint(windows) != fmt.Sprint(want) {
+		t.Fatalf("windows = %v, want %v", windows, want)
+	}
+}
+
+func TestPaginatorKeysAndClicks(t *testing.T) {
+	p := NewPaginator(100, 10)
+	if _, rows := renderRows(t, p, 30, 1); rows[0] != "« ‹ Page 1 of 10 › »" {
+		t.Fatalf("controls = %q", rows[0])
+	}
+
+	p.Focus()
+	p.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	p.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	p.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageUp})
+	if p.Page() != 1 {
+		t.Fatalf("page = %d, want 1", p.Page())
+	}
+
+	p.HandleMessage(runtime.MouseMsg{X: 19, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if p.Page() != 9 {
+		t.Fatalf("page = %d, want the last page after clicking »", p.Page())
+	}
+	p.HandleMessage(runtime.MouseMsg{X: 0, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if p.Page() != 0 {
+		t.Fatalf("page = %d, want the first page after clicking «", p.Page())
+	}
+
+	if _, rows := renderRows(t, p, 8, 1); rows[0] != "‹ 1/10 ›" {
+		t.Fatalf("narrow controls = %q", rows[0])
+	}
+}
+
+func TestTableShowsPaginatorWindow(t *testing.T) {
+	rows := make([][]string, 7)
+	for i := range rows {
+		rows[i] = []string{fmt.Sprintf("row %d", i)}
+	}
+	table := NewTable(TableColumn{Title: "Name"})
+	table.SetRows(rows)
+	pager := NewPaginator(0, 3)
+	table.SetPaginator(pager)
+	if pager.Total() != 7 {
+		t.Fatalf("paginator total = %d, want the table row count", pager.Total())
+	}
+
+	table.Focus()
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyPageDown})
+	_, lines := renderRows(t, table, 10, 6)
+	if lines[1] != "row 3" || lines[3] != "row 5" || lines[4] != "" {
+		t.Fatalf("page 2 rows = %q", lines)
+	}
+	if table.SelectedIndex() != 3 {
+		t.Fatalf("selected = %d, want the first row of the page", table.SelectedIndex())
+	}
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd})
+	if table.SelectedIndex() != 5 {
+		t.Fatalf("selected = %d, End should stay on the page", table.SelectedIndex())
+	}
+
+	table.SetSelected(6)
+	if pager.Page() != 2 {
+		t.Fatalf("page = %d, want the page showing row 6", pager.Page())
+	}
+}
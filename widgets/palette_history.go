@@ -0,0 +1,126 @@
+package widgets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const (
+	// paletteHistoryHalfLife is how long a use takes to lose half its weight.
+	paletteHistoryHalfLife = 7 * 24 * time.Hour
+	// paletteHistoryLimit caps how many commands the history remembers.
+	paletteHistoryLimit = 200
+	// paletteRecentLimit caps the Recent group shown in the palette.
+	paletteRecentLimit = 10
+)
+
+// PaletteUsage records how often and how recently a command was run.
+type PaletteUsage struct {
+	Count    int       `json:"count"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// Frecency scores usage at now: the use count weighted by how recently the
+// command was last run, halving every week.
+func (u PaletteUsage) Frecency(now time.Time) float64 {
+	if u.Count <= 0 {
+		return 0
+	}
+	age := now.Sub(u.LastUsed)
+	if age < 0 {
+		age = 0
+	}
+	return float64(u.Count) * math.Pow(0.5, float64(age)/float64(paletteHistoryHalfLife))
+}
+
+// PaletteHistoryStore persists palette usage between runs, keyed by command ID.
+type PaletteHistoryStore interface {
+	Load() (map[string]PaletteUsage, error)
+	Save(history map[string]PaletteUsage) error
+}
+
+// FilePaletteHistory stores palette usage as a JSON file.
+type FilePaletteHistory struct {
+	Path string
+}
+
+// NewFilePaletteHistory creates a store backed by the file at path.
+func NewFilePaletteHistory(path string) *FilePaletteHistory {
+	return &FilePaletteHistory{Path: path}
+}
+
+// Load reads the history file. A missing file is an empty history.
+func (s *FilePaletteHistory) Load() (map[string]PaletteUsage, error) {
+	if s == nil || s.Path == "" {
+		return nil, nil
+	}
+	payload, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read palette history: %w", err)
+	}
+	var history map[string]PaletteUsage
+	if err := json.Unmarshal(payload, &history); err != nil {
+		return nil, fmt.Errorf("decode palette history: %w", err)
+	}
+	return history, nil
+}
+
+// Save writes the history file, creating its directory if needed.
+func (s *FilePaletteHistory) Save(history map[string]PaletteUsage) error {
+	if s == nil || s.Path == "" {
+		return nil
+	}
+	payload, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode palette history: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0o755); err != nil {
+		return fmt.Errorf("write palette history: %w", err)
+	}
+	return os.WriteFile(s.Path, payload, 0o600)
+}
+
+// rankHistory returns command IDs from history, highest frecency first.
+func rankHistory(history map[string]PaletteUsage, now time.Time) []string {
+	ids := make([]string, 0, len(history))
+	scores := make(map[string]float64, len(history))
+	for id, usage := range history {
+		if id == "" || usage.Count <= 0 {
+			continue
+		}
+		ids = append(ids, id)
+		scores[id] = usage.Frecency(now)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := ids[i], ids[j]
+		if scores[a] != scores[b] {
+			return scores[a] > scores[b]
+		}
+		if ta, tb := history[a].LastUsed, history[b].LastUsed; !ta.Equal(tb) {
+			return ta.After(tb)
+		}
+		return a < b
+	})
+	return ids
+}
+
+// pruneHistory drops the lowest scoring commands beyond the history limit.
+func pruneHistory(history map[string]PaletteUsage, now time.Time) {
+	ranked := rankHistory(history, now)
+	if len(ranked) <= paletteHistoryLimit {
+		return
+	}
+	for _, id := range ranked[paletteHistoryLimit:] {
+		delete(history, id)
+	}
+}
@@ -0,0 +1,98 @@
+package widgets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/keybind"
+)
+
+func newHistoryPalette(now *time.Time) *EnhancedPalette {
+	registry := keybind.NewRegistry()
+	registry.RegisterAll(
+		keybind.Command{ID: "file.open", Title: "Open"},
+		keybind.Command{ID: "file.save", Title: "Save"},
+		keybind.Command{ID: "view.zoom", Title: "Zoom"},
+	)
+	palette := NewEnhancedPalette(registry)
+	palette.now = func() time.Time { return *now }
+	return palette
+}
+
+func recentIDs(p *EnhancedPalette) []string {
+	var ids []string
+	for _, item := range p.Widget.items {
+		if item.Category == "Recent" {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+func TestEnhancedPalette_FrecencyPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "palette.json")
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	first := newHistoryPalette(&now)
+	first.SetHistoryStore(NewFilePaletteHistory(path))
+	// Save was used often a month ago; Zoom once today.
+	for i := 0; i < 5; i++ {
+		first.Record("file.save")
+	}
+	now = now.Add(30 * 24 * time.Hour)
+	first.Record("view.zoom")
+	first.Record("file.open")
+	first.Record("file.open")
+
+	second := newHistoryPalette(&now)
+	second.SetHistoryStore(NewFilePaletteHistory(path))
+	got := recentIDs(second)
+	want := []string{"file.open", "view.zoom", "file.save"}
+	if len(got) != len(want) {
+		t.Fatalf("recent = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("recent = %v, want %v", got, want)
+		}
+	}
+
+	second.ResetHistory()
+	if ids := recentIDs(second); len(ids) != 0 {
+		t.Fatalf("recent after reset = %v", ids)
+	}
+	third := newHistoryPalette(&now)
+	third.SetHistoryStore(NewFilePaletteHistory(path))
+	if ids := recentIDs(third); len(ids) != 0 {
+		t.Fatalf("reset was not persisted: %v", ids)
+	}
+}
+
+func TestFilePaletteHistory_FailsSoft(t *testing.T) {
+	dir := t.TempDir()
+	missing := NewFilePaletteHistory(filepath.Join(dir, "missing.json"))
+	if history, err := missing.Load(); err != nil || len(history) != 0 {
+		t.Fatalf("missing file: history=%v err=%v", history, err)
+	}
+
+	corruptPath := filepath.Join(dir, "corrupt.json")
+	if err := os.WriteFile(corruptPath, []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	corrupt := NewFilePaletteHistory(corruptPath)
+	if _, err := corrupt.Load(); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	now := time.Now()
+	palette := newHistoryPalette(&now)
+	palette.SetHistoryStore(corrupt)
+	if ids := recentIDs(palette); len(ids) != 0 {
+		t.Fatalf("recent = %v, want empty", ids)
+	}
+	palette.Record("file.save")
+	if history, err := corrupt.Load(); err != nil || history["file.save"].Count != 1 {
+		t.Fatalf("expected corrupt file to be replaced: history=%v err=%v", history, err)
+	}
+}
@@ -1,15 +1,24 @@
 package widgets
 
 import (
+	"math"
 	"strings"
 
 	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/animation"
 	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/keybind"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/style"
 )
 
 // Panel is a container widget with optional border and background.
+//
+// A collapsible panel shows a toggle at the right of its title bar, the top
+// border or, without a border, a header row. Clicking it or pressing Ctrl+M
+// collapses the panel to the title bar; the height change is animated with
+// a spring when the app has an animator. Terminals that send Ctrl+M as a
+// plain Enter cannot toggle it from the keyboard.
 type Panel struct {
 	Base
 	child          runtime.Widget
@@ -20,8 +29,25 @@ type Panel struct {
 	label          string
 	styleSet       bool
 	borderStyleSet bool
+
+	services    runtime.Services
+	collapsible bool
+	expanded    bool
+	spring      *animation.Spring // open fraction, 0 collapsed to 1 expanded
+	onToggle    func(expanded bool)
 }
 
+const (
+	panelExpandedIndicator  = "▾"
+	panelCollapsedIndicator = "▸"
+)
+
+// panelToggleKey collapses and expands a collapsible panel. A bare Ctrl+M
+// is a carriage return, which backends deliver as Enter, so the key only
+// reaches the panel from terminals that report modifiers (kitty keyboard
+// protocol, xterm modifyOtherKeys); tcell then sends it as a Ctrl+M rune.
+var panelToggleKey = keybind.MustParseKeySequence("ctrl+m")
+
 // PanelOption configures a Panel widget.
 type PanelOption = Option[Panel]
 
@@ -65,7 +91,13 @@ func NewPanel(child runtime.Widget, opts ...PanelOption) *Panel {
 		borderStyle: backend.DefaultStyle(),
 		hasBorder:   false,
 		label:       "Panel",
+		expanded:    true,
+	}
+	cfg := animation.SpringDefault
+	cfg.OnUpdate = func(float64) {
+		panel.services.Relayout()
 	}
+	panel.spring = animation.NewSpring(1, cfg)
 	panel.Base.Role = accessibility.RoleGroup
 	for _, opt := range opts {
 		if opt == nil {
@@ -140,24 +172,133 @@ func (p *Panel) WithTitle(title string) *Panel {
 	return p
 }
 
+// SetCollapsible shows or hides the collapse toggle. A panel that is no
+// longer collapsible expands.
+func (p *Panel) SetCollapsible(collapsible bool) {
+	if p == nil {
+		return
+	}
+	p.collapsible = collapsible
+	if !collapsible {
+		p.setExpanded(true, false)
+	}
+	p.services.Relayout()
+}
+
+// SetExpanded expands or collapses a collapsible panel.
+func (p *Panel) SetExpanded(expanded bool) {
+	if p == nil || !p.collapsible {
+		return
+	}
+	p.setExpanded(expanded, true)
+}
+
+// Expanded reports whether the panel is expanded.
+func (p *Panel) Expanded() bool {
+	if p == nil {
+		return false
+	}
+	return p.expanded
+}
+
+// OnToggle registers a handler called when the panel expands or collapses.
+func (p *Panel) OnToggle(fn func(expanded bool)) {
+	if p == nil {
+		return
+	}
+	p.onToggle = fn
+}
+
+// SetCollapseSpring sets the stiffness and damping of the collapse
+// animation. Stiffer springs move faster; less damping overshoots more.
+func (p *Panel) SetCollapseSpring(stiffness, damping float64) {
+	if p == nil {
+		return
+	}
+	if stiffness > 0 {
+		p.spring.Tension = stiffness
+	}
+	if damping > 0 {
+		p.spring.Friction = damping
+	}
+}
+
+// Bind attaches app services.
+func (p *Panel) Bind(services runtime.Services) {
+	if p == nil {
+		return
+	}
+	p.services = services
+}
+
+// Unbind releases app services.
+func (p *Panel) Unbind() {
+	if p == nil {
+		return
+	}
+	p.services = runtime.Services{}
+}
+
+func (p *Panel) setExpanded(expanded, animate bool) {
+	if p.expanded == expanded {
+		return
+	}
+	p.expanded = expanded
+	target := 0.0
+	if expanded {
+		target = 1
+	}
+	if animator := p.services.Animator(); animate && animator != nil && !p.services.ReducedMotion() {
+		animator.AnimateSpring(p, "expanded", p.spring, target)
+	} else {
+		p.spring.Value = target
+		p.spring.Target = target
+		p.spring.Velocity = 0
+	}
+	p.syncA11y()
+	if p.onToggle != nil {
+		p.onToggle(expanded)
+	}
+	p.Invalidate()
+	p.services.Relayout()
+}
+
+// openFraction returns how far open the panel is, from 0 to 1.
+func (p *Panel) openFraction() float64 {
+	if !p.collapsible {
+		return 1
+	}
+	return math.Max(0, math.Min(1, p.spring.Value))
+}
+
+// insets returns the rows and columns the border and header take around
+// the child.
+func (p *Panel) insets() (top, side, bottom int) {
+	if p.hasBorder && p.layoutMetrics.border == 0 {
+		return 1, 1, 1
+	}
+	if p.collapsible && !p.hasBorder && p.layoutMetrics.border == 0 {
+		// Without a border, the title bar is a header row.
+		return 1, 0, 0
+	}
+	return 0, 0, 0
+}
+
 // Measure returns the size needed for the panel.
 func (p *Panel) Measure(constraints runtime.Constraints) runtime.Size {
 	return p.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		extraBorder := 0
-		if p.hasBorder && p.layoutMetrics.border == 0 {
-			extraBorder = 1
-		}
-
-		childConstraints := shrinkConstraints(contentConstraints, extraBorder, extraBorder, extraBorder, extraBorder)
-		if p.child == nil {
-			size := runtime.Size{Width: extraBorder * 2, Height: extraBorder * 2}
-			return contentConstraints.Constrain(size)
+		top, side, bottom := p.insets()
+
+		childConstraints := shrinkConstraints(contentConstraints, top, side, bottom, side)
+		size := runtime.Size{Width: side * 2, Height: top + bottom}
+		if p.child != nil {
+			childSize := p.child.Measure(childConstraints)
+			size.Width += childSize.Width
+			size.Height += childSize.Height
 		}
-
-		childSize := p.child.Measure(childConstraints)
-		size := runtime.Size{
-			Width:  childSize.Width + extraBorder*2,
-			Height: childSize.Height + extraBorder*2,
+		if open := p.openFraction(); open < 1 {
+			// Collapse toward the title bar row.
+			size.Height = 1 + int(math.Round(float64(max(0, size.Height-1))*open))
 		}
 		return contentConstraints.Constrain(size)
 	})
@@ -171,13 +312,27 @@ func (p *Panel) Layout(bounds runtime.Rect) {
 		return
 	}
 
-	childBounds := p.ContentBounds()
-	if p.hasBorder && p.layoutMetrics.border == 0 {
-		childBounds = childBounds.Inset(1, 1, 1, 1)
+	top, side, bottom := p.insets()
+	childBounds := p.ContentBounds().Inset(top, side, bottom, side)
+	if p.collapsed() {
+		childBounds.Height = 0
 	}
 	p.child.Layout(childBounds)
 }
 
+// collapsed reports whether the panel has finished collapsing.
+func (p *Panel) collapsed() bool {
+	return p.collapsible && !p.expanded && p.openFraction() == 0
+}
+
+// toggleBounds returns the cells of the collapse toggle in the title bar.
+func (p *Panel) toggleBounds() runtime.Rect {
+	if !p.collapsible || p.bounds.Width < 4 {
+		return runtime.Rect{}
+	}
+	return runtime.Rect{X: p.bounds.X + p.bounds.Width - 4, Y: p.bounds.Y, Width: 3, Height: 1}
+}
+
 // Render draws the panel.
 func (p *Panel) Render(ctx runtime.RenderContext) {
 	bounds := p.bounds
@@ -202,6 +357,8 @@ func (p *Panel) Render(ctx runtime.RenderContext) {
 		if p.borderStyleSet {
 			borderStyle = p.borderStyle
 		}
+	} else if !p.borderStyleSet {
+		borderStyle = themeStyle("border")
 	}
 
 	// Fill background
@@ -230,25 +387,77 @@ func (p *Panel) Render(ctx runtime.RenderContext) {
 			drawn = true
 		}
 
+		if bounds.Height == 1 {
+			// Collapsed to the top border.
+			for x := bounds.X; x < bounds.X+bounds.Width; x++ {
+				ctx.Buffer.Set(x, bounds.Y, '─', drawStyle)
+			}
+			drawn = true
+		}
+
 		// Draw title in top border
 		if drawn && p.title != "" {
 			title := " " + p.title + " "
-			if textWidth(title) > bounds.Width-4 {
-				title = clipString(title, bounds.Width-4)
+			room := bounds.Width - 4
+			if p.collapsible {
+				room -= 4
+			}
+			if textWidth(title) > room {
+				title = clipString(title, room)
 			}
 			x := bounds.X + 2
 			ctx.Buffer.SetString(x, bounds.Y, title, drawStyle)
 		}
+		if drawn {
+			p.renderToggle(ctx, drawStyle)
+		}
+	} else if p.collapsible {
+		header := background.Bold(true)
+		ctx.Buffer.SetString(bounds.X, bounds.Y, clipString(p.title, bounds.Width-4), header)
+		p.renderToggle(ctx, header)
 	}
 
 	// Render child
-	runtime.RenderChild(ctx, p.child)
+	if !p.collapsed() {
+		runtime.RenderChild(ctx, p.child)
+	}
 }
 
-// HandleMessage delegates to child.
+// renderToggle draws the collapse toggle in the title bar.
+func (p *Panel) renderToggle(ctx runtime.RenderContext, style backend.Style) {
+	toggle := p.toggleBounds()
+	if toggle.Width == 0 {
+		return
+	}
+	indicator := panelExpandedIndicator
+	if !p.expanded {
+		indicator = panelCollapsedIndicator
+	}
+	ctx.Buffer.SetString(toggle.X, toggle.Y, " "+indicator+" ", style)
+}
+
+// HandleMessage delegates to child, then toggles a collapsible panel on a
+// click on its toggle or Ctrl+M.
 func (p *Panel) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if p.child != nil {
-		return p.child.HandleMessage(msg)
+	if p.child != nil && !p.collapsed() {
+		if result := p.child.HandleMessage(msg); result.Handled {
+			return result
+		}
+	}
+	if !p.collapsible {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		if m.Action == runtime.MousePress && m.Button == runtime.MouseLeft && p.toggleBounds().Contains(m.X, m.Y) {
+			p.SetExpanded(!p.expanded)
+			return runtime.Handled()
+		}
+	case runtime.KeyMsg:
+		if panelToggleKey.Matches([]keybind.KeyPress{keybind.KeyPressFromKeyMsg(m)}) {
+			p.SetExpanded(!p.expanded)
+			return runtime.Handled()
+		}
 	}
 	return runtime.Unhandled()
 }
@@ -276,6 +485,11 @@ func (p *Panel) syncA11y() {
 		label = "Panel"
 	}
 	p.Base.Label = label
+	if p.collapsible {
+		p.Base.State.Expanded = accessibility.BoolPtr(p.expanded)
+	} else {
+		p.Base.State.Expanded = nil
+	}
 }
 
 // Box is a simple container that fills its background.
@@ -406,4 +620,5 @@ func (b *Box) syncA11y() {
 }
 
 var _ runtime.Widget = (*Panel)(nil)
+var _ runtime.Bindable = (*Panel)(nil)
 var _ runtime.Widget = (*Box)(nil)
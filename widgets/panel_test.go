@@ -0,0 +1,89 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/animation"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestPanelCollapseToggle(t *testing.T) {
+	panel := NewPanel(threeLines(), WithPanelBorder(backend.DefaultStyle()), WithPanelTitle("Logs"))
+	panel.SetCollapsible(true)
+	var toggles []bool
+	panel.OnToggle(func(expanded bool) { toggles = append(toggles, expanded) })
+
+	if got := panel.Measure(runtime.Loose(20, 10)).Height; got != 5 {
+		t.Fatalf("expanded height = %d, want 5", got)
+	}
+	_, rows := renderRows(t, panel, 20, 5)
+	if rows[0] != "╭─ Logs ──────── ▾ ╮" {
+		t.Fatalf("title bar = %q", rows[0])
+	}
+
+	result := panel.HandleMessage(runtime.MouseMsg{X: 17, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if !result.Handled || panel.Expanded() {
+		t.Fatalf("expected a click on the toggle to collapse the panel")
+	}
+	if got := panel.Measure(runtime.Loose(20, 10)).Height; got != 1 {
+		t.Fatalf("collapsed height = %d, want the title bar only", got)
+	}
+	_, rows = renderRows(t, panel, 20, 1)
+	if rows[0] != "── Logs ──────── ▸ ─" {
+		t.Fatalf("collapsed row = %q", rows[0])
+	}
+
+	// A bare Ctrl+M arrives as Enter and must stay Enter.
+	if result := panel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter}); result.Handled || panel.Expanded() {
+		t.Fatalf("expected Enter to pass through a collapsed panel")
+	}
+	// Terminals that report the modifier deliver Ctrl+M as a Ctrl rune.
+	panel.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'm', Ctrl: true})
+	if !panel.Expanded() {
+		t.Fatalf("expected Ctrl+M to expand the panel")
+	}
+	panel.SetExpanded(true)
+	if len(toggles) != 2 || toggles[0] || !toggles[1] {
+		t.Fatalf("toggles = %v, want [false true]", toggles)
+	}
+}
+
+func TestPanelCollapseWithoutBorderUsesHeader(t *testing.T) {
+	panel := NewPanel(threeLines(), WithPanelTitle("Notes"))
+	panel.SetCollapsible(true)
+	_, rows := renderRows(t, panel, 12, 4)
+	if rows[0] != "Notes    ▾" || rows[1] != "a" {
+		t.Fatalf("rows = %q, want a header row above the content", rows)
+	}
+	panel.SetExpanded(false)
+	if got := panel.Measure(runtime.Loose(12, 10)).Height; got != 1 {
+		t.Fatalf("collapsed height = %d, want 1", got)
+	}
+}
+
+func TestPanelCollapseAnimatesWithSpring(t *testing.T) {
+	panel := NewPanel(threeLines(), WithPanelBorder(backend.DefaultStyle()))
+	panel.SetCollapsible(true)
+	panel.SetCollapseSpring(300, 40)
+	animator := animation.NewAnimator()
+	panel.Bind(runtime.NewApp(runtime.AppConfig{Animator: animator}).Services())
+	defer panel.Unbind()
+
+	panel.SetExpanded(false)
+	if got := panel.Measure(runtime.Loose(20, 10)).Height; got != 5 {
+		t.Fatalf("height = %d, want the animation to start from 5", got)
+	}
+	animator.Update(0.05)
+	mid := panel.Measure(runtime.Loose(20, 10)).Height
+	if mid <= 1 || mid >= 5 {
+		t.Fatalf("height mid-animation = %d, want between 1 and 5", mid)
+	}
+	for i := 0; i < 200 && animator.HasActiveAnimations(); i++ {
+		animator.Update(0.016)
+	}
+	if got := panel.Measure(runtime.Loose(20, 10)).Height; got != 1 {
+		t.Fatalf("height after the spring settles = %d, want 1", got)
+	}
+}
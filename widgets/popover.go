@@ -13,6 +13,9 @@ const (
 	PopoverAuto PopoverPlacement = iota
 	PopoverBelow
 	PopoverAbove
+	// PopoverRight opens beside the anchor, top-aligned with it, and flips
+	// to the left when there is no room on the right.
+	PopoverRight
 )
 
 // Popover positions a child widget relative to an anchor rect.
@@ -170,6 +173,12 @@ func (p *Popover) Layout(bounds runtime.Rect) {
 		height = 0
 	}
 
+	if p.Placement == PopoverRight {
+		p.childBounds = p.sideBounds(content, width, height)
+		p.Child.Layout(p.childBounds)
+		return
+	}
+
 	x := p.Anchor.X
 	if x < content.X {
 		x = content.X
@@ -207,6 +216,23 @@ func (p *Popover) Layout(bounds runtime.Rect) {
 	p.Child.Layout(p.childBounds)
 }
 
+// sideBounds places a width×height child beside the anchor within content.
+func (p *Popover) sideBounds(content runtime.Rect, width, height int) runtime.Rect {
+	right := content.X + content.Width
+	x := p.Anchor.X + p.Anchor.Width + p.Gap
+	if x+width > right {
+		if left := p.Anchor.X - width - p.Gap; left >= content.X {
+			x = left
+		} else {
+			x = right - width
+		}
+	}
+	x = max(x, content.X)
+	y := min(p.Anchor.Y, content.Y+content.Height-height)
+	y = max(y, content.Y)
+	return runtime.Rect{X: x, Y: y, Width: width, Height: height}
+}
+
 // Render draws the child widget.
 func (p *Popover) Render(ctx runtime.RenderContext) {
 	if p == nil || p.Child == nil {
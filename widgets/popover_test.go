@@ -46,3 +46,27 @@ func TestPopoverLayoutAboveWhenNoSpace(t *testing.T) {
 		t.Errorf("expected popover to flip above anchor, got y=%d", got.Y)
 	}
 }
+
+func TestPopoverLayoutRightFlipsLeft(t *testing.T) {
+	child := NewSimpleWidget()
+	var got runtime.Rect
+	child.MeasureFunc = func(runtime.Constraints) runtime.Size {
+		return runtime.Size{Width: 8, Height: 3}
+	}
+	child.LayoutFunc = func(bounds runtime.Rect) {
+		got = bounds
+	}
+
+	anchor := runtime.Rect{X: 2, Y: 4, Width: 6, Height: 1}
+	popover := NewPopover(anchor, child, WithPopoverPlacement(PopoverRight))
+	popover.Layout(runtime.Rect{X: 0, Y: 0, Width: 40, Height: 20})
+	if got.X != 8 || got.Y != 4 {
+		t.Errorf("expected popover beside anchor at (8,4), got (%d,%d)", got.X, got.Y)
+	}
+
+	popover.Anchor = runtime.Rect{X: 30, Y: 19, Width: 6, Height: 1}
+	popover.Layout(runtime.Rect{X: 0, Y: 0, Width: 40, Height: 20})
+	if got.X != 22 || got.Y != 17 {
+		t.Errorf("expected popover flipped left and kept on screen at (22,17), got (%d,%d)", got.X, got.Y)
+	}
+}
@@ -3,12 +3,37 @@ package widgets
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
 )
 
+// progressRateSmoothing is the weight of the newest sample in the rate's
+// exponential moving average.
+const progressRateSmoothing = 0.3
+
+// progressMilestones is how many equal steps of progress are announced,
+// so a screen reader hears 25%, 50%, 75%, and 100% rather than every
+// update.
+const progressMilestones = 4
+
+// progressDefaultSegments is the segment count used in segment mode until
+// SetSegments is called.
+const progressDefaultSegments = 10
+
+// ProgressMode selects how a Progress draws its value.
+type ProgressMode int
+
+const (
+	// ProgressModeBar fills a continuous bar.
+	ProgressModeBar ProgressMode = iota
+	// ProgressModeSegments splits the bar into equal segments that light up
+	// one at a time, one per completed step.
+	ProgressModeSegments
+)
+
 // Progress displays a determinate progress bar.
 type Progress struct {
 	Base
@@ -17,6 +42,20 @@ type Progress struct {
 	Label       string
 	ShowPercent bool
 	Style       GaugeStyle
+
+	showETA    bool
+	formatUnit func(float64) string
+	now        func() time.Time
+	rate       float64
+	lastValue  float64
+	lastTime   time.Time
+	sampled    bool
+	milestone  int
+	services   runtime.Services
+
+	mode          ProgressMode
+	segments      int
+	segmentColors []backend.Color
 }
 
 // NewProgress creates a progress widget.
@@ -25,6 +64,7 @@ func NewProgress() *Progress {
 		Max:         100,
 		ShowPercent: true,
 		Style:       GaugeStyle{EmptyStyle: backend.DefaultStyle()},
+		now:         time.Now,
 	}
 	p.Base.Role = accessibility.RoleProgressBar
 	p.syncA11y()
@@ -36,6 +76,184 @@ func (p *Progress) StyleType() string {
 	return "Progress"
 }
 
+// SetValue updates the value and records a sample for the rate and ETA.
+// Assigning Value directly also works; it is sampled when rendered.
+func (p *Progress) SetValue(value float64) {
+	if p == nil {
+		return
+	}
+	p.Value = value
+	p.sample()
+	p.announceMilestone()
+}
+
+// SetMode switches between a continuous bar and lit segments.
+func (p *Progress) SetMode(mode ProgressMode) {
+	if p == nil {
+		return
+	}
+	p.mode = mode
+	p.syncA11y()
+	p.Invalidate()
+}
+
+// Mode returns the display mode.
+func (p *Progress) Mode() ProgressMode {
+	if p == nil {
+		return ProgressModeBar
+	}
+	return p.mode
+}
+
+// SetSegments sets how many equal segments the bar is split into in
+// ProgressModeSegments. Each lights up once Value reaches its share of Max.
+func (p *Progress) SetSegments(n int) {
+	if p == nil {
+		return
+	}
+	p.segments = max(n, 1)
+	p.syncA11y()
+	p.Invalidate()
+}
+
+// Segments returns the segment count used in ProgressModeSegments.
+func (p *Progress) Segments() int {
+	if p == nil {
+		return 0
+	}
+	if p.segments <= 0 {
+		return progressDefaultSegments
+	}
+	return p.segments
+}
+
+// SetSegmentColors colors lit segments in order, repeating the colors when
+// there are more segments than colors. Without colors lit segments use the
+// fill style.
+func (p *Progress) SetSegmentColors(colors []backend.Color) {
+	if p == nil {
+		return
+	}
+	p.segmentColors = append([]backend.Color(nil), colors...)
+	p.Invalidate()
+}
+
+// litSegments returns how many of n segments ratio completes.
+func litSegments(ratio float64, n int) int {
+	// The epsilon keeps exact step values such as 1/3 from rounding down.
+	return clampInt(int(ratio*float64(n)+1e-9), 0, n)
+}
+
+// Bind attaches app services.
+func (p *Progress) Bind(services runtime.Services) {
+	if p == nil {
+		return
+	}
+	p.services = services
+}
+
+// Unbind releases app services.
+func (p *Progress) Unbind() {
+	if p == nil {
+		return
+	}
+	p.services = runtime.Services{}
+}
+
+// announceMilestone announces the progress when it reaches the next
+// milestone. Progress moving backwards rearms the milestones it fell below.
+func (p *Progress) announceMilestone() {
+	max := p.Max
+	if max <= 0 {
+		max = 1
+	}
+	milestone := clampInt(int(p.Value/max*progressMilestones), 0, progressMilestones)
+	if milestone <= p.milestone {
+		p.milestone = milestone
+		return
+	}
+	p.milestone = milestone
+	p.syncA11y()
+	p.services.AnnounceChange(p)
+}
+
+// SetShowETA toggles the throughput and time-remaining suffix, rendered
+// like "45% • 2.3 MB/s • 12s".
+func (p *Progress) SetShowETA(show bool) {
+	if p == nil {
+		return
+	}
+	p.showETA = show
+}
+
+// SetUnitFormatter sets how the rate is displayed, such as FormatBytes for
+// downloads. The "/s" suffix is added by the widget.
+func (p *Progress) SetUnitFormatter(fn func(float64) string) {
+	if p == nil {
+		return
+	}
+	p.formatUnit = fn
+}
+
+// Rate returns the smoothed rate in units per second.
+func (p *Progress) Rate() float64 {
+	if p == nil {
+		return 0
+	}
+	return p.rate
+}
+
+// ETA returns the estimated time remaining. It reports false until a rate
+// is known.
+func (p *Progress) ETA() (time.Duration, bool) {
+	if p == nil {
+		return 0, false
+	}
+	remaining := p.Max - p.Value
+	if remaining <= 0 {
+		return 0, true
+	}
+	if p.rate <= 0 {
+		return 0, false
+	}
+	return time.Duration(remaining / p.rate * float64(time.Second)), true
+}
+
+// ResetRate forgets previous samples, e.g. when a new transfer starts.
+func (p *Progress) ResetRate() {
+	if p == nil {
+		return
+	}
+	p.rate = 0
+	p.sampled = false
+}
+
+func (p *Progress) sample() {
+	now := time.Now()
+	if p.now != nil {
+		now = p.now()
+	}
+	if !p.sampled || p.Value < p.lastValue {
+		p.rate = 0
+		p.lastValue = p.Value
+		p.lastTime = now
+		p.sampled = true
+		return
+	}
+	elapsed := now.Sub(p.lastTime).Seconds()
+	if p.Value == p.lastValue || elapsed <= 0 {
+		return
+	}
+	instant := (p.Value - p.lastValue) / elapsed
+	if p.rate == 0 {
+		p.rate = instant
+	} else {
+		p.rate = progressRateSmoothing*instant + (1-progressRateSmoothing)*p.rate
+	}
+	p.lastValue = p.Value
+	p.lastTime = now
+}
+
 // Measure returns desired size.
 func (p *Progress) Measure(constraints runtime.Constraints) runtime.Size {
 	return p.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
@@ -49,6 +267,7 @@ func (p *Progress) Render(ctx runtime.RenderContext) {
 		return
 	}
 	p.syncA11y()
+	p.announceMilestone()
 	bounds := p.ContentBounds()
 	if bounds.Width <= 0 || bounds.Height <= 0 {
 		return
@@ -66,13 +285,112 @@ func (p *Progress) Render(ctx runtime.RenderContext) {
 		ratio = 1
 	}
 	style := mergeGaugeStyle(baseStyle, p.Style)
-	DrawGauge(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, ratio, style)
-	if p.ShowPercent && bounds.Width >= 4 {
-		text := fmt.Sprintf("%3.0f%%", ratio*100)
+	if p.mode == ProgressModeSegments {
+		p.drawSegments(ctx.Buffer, bounds, ratio, baseStyle, style)
+	} else {
+		DrawGauge(ctx.Buffer, bounds.X, bounds.Y, bounds.Width, ratio, style)
+	}
+	if p.showETA {
+		p.sample()
+	}
+	text := p.suffix(ratio)
+	if text != "" && textWidth(text) > bounds.Width-1 && p.ShowPercent {
+		text = fmt.Sprintf("%3.0f%%", ratio*100)
+	}
+	if text != "" && bounds.Width >= 4 && textWidth(text) <= bounds.Width {
 		ctx.Buffer.SetString(bounds.X+bounds.Width-textWidth(text), bounds.Y, text, baseStyle)
 	}
 }
 
+// drawSegments splits the row into equal segments, separated by a blank
+// cell when there is room, and fills those ratio completes.
+func (p *Progress) drawSegments(buf *runtime.Buffer, bounds runtime.Rect, ratio float64, baseStyle backend.Style, style GaugeStyle) {
+	// Narrow bars draw fewer segments; light them for the ones drawn.
+	n := min(p.Segments(), bounds.Width)
+	lit := litSegments(ratio, n)
+	gap := 0
+	if bounds.Width >= 2*n-1 && n > 1 {
+		gap = 1
+	}
+	fillChar := style.FillChar
+	if fillChar == 0 {
+		fillChar = '█'
+	}
+	emptyChar := style.EmptyChar
+	if emptyChar == 0 {
+		emptyChar = '░'
+	}
+	buf.Fill(runtime.Rect{X: bounds.X, Y: bounds.Y, Width: bounds.Width, Height: 1}, ' ', baseStyle)
+	avail := bounds.Width - gap*(n-1)
+	for i := 0; i < n; i++ {
+		start := bounds.X + i*avail/n + i*gap
+		end := bounds.X + (i+1)*avail/n + i*gap
+		ch, cellStyle := emptyChar, style.EmptyStyle
+		if i < lit {
+			ch = fillChar
+			cellStyle = baseStyle
+			if len(style.Thresholds) > 0 {
+				cellStyle = styleForRatio(float64(i)/float64(n), style.Thresholds)
+			}
+			if len(p.segmentColors) > 0 {
+				cellStyle = cellStyle.Foreground(p.segmentColors[i%len(p.segmentColors)])
+			}
+		}
+		for x := start; x < end; x++ {
+			buf.Set(x, bounds.Y, ch, cellStyle)
+		}
+	}
+}
+
+func (p *Progress) suffix(ratio float64) string {
+	var parts []string
+	if p.ShowPercent {
+		parts = append(parts, fmt.Sprintf("%3.0f%%", ratio*100))
+	}
+	if p.showETA && p.rate > 0 {
+		format := p.formatUnit
+		if format == nil {
+			format = formatProgressUnit
+		}
+		parts = append(parts, format(p.rate)+"/s")
+		if eta, ok := p.ETA(); ok {
+			parts = append(parts, formatETA(eta))
+		}
+	}
+	return strings.Join(parts, " • ")
+}
+
+func formatProgressUnit(v float64) string {
+	return fmt.Sprintf("%.1f", v)
+}
+
+// FormatBytes formats a byte count with binary units, like "2.3 MB".
+func FormatBytes(n float64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	unit := 0
+	for n >= 1024 && unit < len(units)-1 {
+		n /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%.0f %s", n, units[unit])
+	}
+	return fmt.Sprintf("%.1f %s", n, units[unit])
+}
+
+// formatETA renders a duration compactly: "12s", "3m05s", "1h02m".
+func formatETA(d time.Duration) string {
+	secs := int(d.Round(time.Second) / time.Second)
+	switch {
+	case secs < 60:
+		return fmt.Sprintf("%ds", secs)
+	case secs < 3600:
+		return fmt.Sprintf("%dm%02ds", secs/60, secs%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", secs/3600, secs%3600/60)
+	}
+}
+
 // HandleMessage returns unhandled.
 func (p *Progress) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
@@ -102,11 +420,15 @@ func (p *Progress) syncA11y() {
 		value = max
 	}
 	ratio := value / max
+	text := fmt.Sprintf("%3.0f%%", ratio*100)
+	if p.mode == ProgressModeSegments {
+		text = fmt.Sprintf("%d of %d", litSegments(ratio, p.Segments()), p.Segments())
+	}
 	p.Base.Value = &accessibility.ValueInfo{
 		Min:     0,
 		Max:     max,
 		Current: value,
-		Text:    fmt.Sprintf("%3.0f%%", ratio*100),
+		Text:    text,
 	}
 }
 
@@ -127,3 +449,4 @@ func mergeGaugeStyle(base backend.Style, style GaugeStyle) GaugeStyle {
 }
 
 var _ runtime.Widget = (*Progress)(nil)
+var _ runtime.Bindable = (*Progress)(nil)
@@ -0,0 +1,153 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestProgressRateAndETA(t *testing.T) {
+	clock := time.Unix(0, 0)
+	p := NewProgress()
+	p.now = func() time.Time { return clock }
+	p.Max = 1000
+
+	p.SetValue(0)
+	if _, ok := p.ETA(); ok {
+		t.Fatalf("expected no ETA before a rate is known")
+	}
+	clock = clock.Add(time.Second)
+	p.SetValue(100)
+	if p.Rate() != 100 {
+		t.Fatalf("rate = %v, want 100", p.Rate())
+	}
+	clock = clock.Add(time.Second)
+	p.SetValue(300)
+	// 0.3*200 + 0.7*100
+	if got := p.Rate(); got < 129.9 || got > 130.1 {
+		t.Fatalf("smoothed rate = %v, want 130", got)
+	}
+	eta, ok := p.ETA()
+	if !ok || eta.Round(time.Second) != 5*time.Second {
+		t.Fatalf("eta = %v %v, want ~5s", eta, ok)
+	}
+
+	p.SetValue(10)
+	if p.Rate() != 0 {
+		t.Fatalf("expected a lower value to reset the rate")
+	}
+}
+
+func TestProgressETASuffix(t *testing.T) {
+	clock := time.Unix(0, 0)
+	p := NewProgress()
+	p.now = func() time.Time { return clock }
+	p.Max = 10 * 1024 * 1024
+	p.SetShowETA(true)
+	p.SetUnitFormatter(FormatBytes)
+	p.SetValue(0)
+	clock = clock.Add(time.Second)
+	p.Value = 2 * 1024 * 1024
+
+	buf := runtime.NewBuffer(60, 1)
+	p.Layout(runtime.Rect{Width: 60, Height: 1})
+	p.Render(runtime.RenderContext{Buffer: buf, Bounds: p.Bounds()})
+	var line strings.Builder
+	for x := 0; x < 60; x++ {
+		if cell := buf.Get(x, 0); cell.Rune != 0 {
+			line.WriteRune(cell.Rune)
+		}
+	}
+	if !strings.HasSuffix(line.String(), " 20% • 2.0 MB/s • 4s") {
+		t.Fatalf("rendered %q", line.String())
+	}
+
+	narrow := runtime.NewBuffer(8, 1)
+	p.Layout(runtime.Rect{Width: 8, Height: 1})
+	p.Render(runtime.RenderContext{Buffer: narrow, Bounds: p.Bounds()})
+	if got := string(narrow.Get(7, 0).Rune); got != "%" {
+		t.Fatalf("expected percent fallback in narrow bar, got %q", got)
+	}
+}
+
+func TestFormatETAAndBytes(t *testing.T) {
+	cases := map[time.Duration]string{
+		12 * time.Second:  "12s",
+		185 * time.Second: "3m05s",
+		time.Hour + 2*time.Minute + 10*time.Second: "1h02m",
+	}
+	for d, want := range cases {
+		if got := formatETA(d); got != want {
+			t.Fatalf("formatETA(%v) = %q, want %q", d, got, want)
+		}
+	}
+	if FormatBytes(512) != "512 B" || FormatBytes(2.5*1024*1024) != "2.5 MB" {
+		t.Fatalf("unexpected byte formatting %q %q", FormatBytes(512), FormatBytes(2.5*1024*1024))
+	}
+}
+
+func TestProgressAnnouncesMilestones(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	p := NewProgress()
+	p.Label = "Upload"
+	p.Bind(runtime.NewApp(runtime.AppConfig{Announcer: announcer}).Services())
+
+	for _, value := range []float64{10, 20, 30, 60, 61, 100, 100} {
+		p.SetValue(value)
+	}
+	var got []string
+	for _, entry := range announcer.History() {
+		got = append(got, entry.Message)
+		if entry.Priority != accessibility.PriorityPolite {
+			t.Fatalf("priority = %v, want polite", entry.Priority)
+		}
+	}
+	want := []string{"Upload, progressbar, 30%", "Upload, progressbar, 60%", "Upload, progressbar, 100%"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("announcements = %q, want %q", got, want)
+	}
+
+	p.SetValue(0)
+	p.SetValue(25)
+	if n := len(announcer.History()); n != 4 {
+		t.Fatalf("announcements = %d, want a restart to announce again", n)
+	}
+}
+
+func TestProgressSegments(t *testing.T) {
+	p := NewProgress()
+	p.ShowPercent = false
+	p.SetMode(ProgressModeSegments)
+	p.SetSegments(4)
+	p.SetSegmentColors([]backend.Color{backend.ColorGreen, backend.ColorBlue})
+	p.SetValue(74)
+
+	buf, rows := renderRows(t, p, 11, 1)
+	if rows[0] != "██ ██ ░░ ░░" {
+		t.Fatalf("row = %q, want two of four segments lit", rows[0])
+	}
+	if fg, _, _ := buf.Get(3, 0).Style.Decompose(); fg != backend.ColorBlue {
+		t.Fatalf("second segment fg = %v, want the second color", fg)
+	}
+	if text := p.AccessibleValue().Text; text != "2 of 4" {
+		t.Fatalf("a11y value = %q", text)
+	}
+
+	p.SetValue(75)
+	if _, rows = renderRows(t, p, 11, 1); rows[0] != "██ ██ ██ ░░" {
+		t.Fatalf("row = %q, want a segment lit exactly at its step", rows[0])
+	}
+	if _, rows = renderRows(t, p, 4, 1); rows[0] != "███░" {
+		t.Fatalf("row = %q, want segments without gaps when narrow", rows[0])
+	}
+
+	p.SetSegments(20)
+	p.SetValue(50)
+	if _, rows = renderRows(t, p, 10, 1); rows[0] != "█████░░░░░" {
+		t.Fatalf("row = %q, want half of the drawn segments lit when narrower than the count", rows[0])
+	}
+}
@@ -68,7 +68,24 @@ func WithRichTextRenderer(renderer *markdown.Renderer) RichTextOption {
 	}
 }
 
-// RichText renders markdown content with scrolling.
+// WithRichTextCodeTheme sets the syntax color scheme for fenced code blocks.
+func WithRichTextCodeTheme(scheme CodeTheme) RichTextOption {
+	return func(r *RichText) {
+		if r == nil {
+			return
+		}
+		r.codeTheme = &scheme
+	}
+}
+
+// CodeTheme is a syntax color scheme for fenced code blocks. Build one from
+// a UI theme with markdown.CodeThemeFromTheme or from a chroma style with
+// markdown.CodeThemeFromChroma.
+type CodeTheme = markdown.CodeTheme
+
+// RichText renders markdown content with scrolling. Markdown tables are
+// drawn with box borders and fenced code blocks are syntax highlighted.
+// Content is parsed when it is set, not on each render.
 type RichText struct {
 	FocusableBase
 
@@ -84,6 +101,7 @@ type RichText struct {
 	showBar       bool
 	scrollbar     scroll.Scrollbar
 	renderer      *markdown.Renderer
+	codeTheme     *CodeTheme
 	contentSize   runtime.Size
 	anchorOffsets map[string]int
 	pendingAnchor string
@@ -158,6 +176,20 @@ func (r *RichText) SetRenderer(renderer *markdown.Renderer) {
 	r.resetLayout()
 }
 
+// SetCodeTheme sets the syntax color scheme for fenced code blocks and
+// re-renders the content.
+func (r *RichText) SetCodeTheme(scheme CodeTheme) {
+	if r == nil {
+		return
+	}
+	r.codeTheme = &scheme
+	if r.content == "" {
+		return
+	}
+	r.renderContent()
+	r.resetLayout()
+}
+
 // SetLabel updates the accessibility label.
 func (r *RichText) SetLabel(label string) {
 	if r == nil {
@@ -386,7 +418,11 @@ func (r *RichText) renderContent() {
 		r.lines = nil
 		return
 	}
-	r.lines = r.renderer.Render(r.source, r.content)
+	renderer := r.renderer
+	if r.codeTheme != nil {
+		renderer = renderer.WithCodeTheme(*r.codeTheme)
+	}
+	r.lines = renderer.Render(r.source, r.content)
 }
 
 func (r *RichText) resetLayout() {
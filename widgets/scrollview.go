@@ -21,6 +21,7 @@ type ScrollView struct {
 	behavior   scroll.ScrollBehavior
 	style      backend.Style
 	services   runtime.Services
+	wheel      runtime.WheelScroller
 	label      string
 	vScrollbar scroll.Scrollbar
 	hScrollbar scroll.Scrollbar
@@ -297,12 +298,8 @@ func (s *ScrollView) HandleMessage(msg runtime.Message) runtime.HandleResult {
 			return runtime.Handled()
 		}
 	case runtime.MouseMsg:
-		if ev.Button == runtime.MouseWheelUp {
-			s.ScrollBy(0, -s.behavior.MouseWheel)
-			return runtime.Handled()
-		}
-		if ev.Button == runtime.MouseWheelDown {
-			s.ScrollBy(0, s.behavior.MouseWheel)
+		if delta, ok := s.wheel.Delta(s.services.ScrollConfig(), ev, s.behavior.MouseWheel); ok {
+			s.ScrollBy(0, delta)
 			return runtime.Handled()
 		}
 	}
@@ -0,0 +1,306 @@
+package widgets
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+// SegmentedControl is a single-choice control drawn as joined segments,
+// such as [ Day | Week | Month ]. Left and Right move the selection, and
+// clicking a segment selects it. The selected segment uses the theme's
+// selection style unless SetSelectedStyle overrides it.
+type SegmentedControl struct {
+	FocusableBase
+
+	options  []string
+	selected int
+	label    string
+	onChange func(index int)
+	services runtime.Services
+	segments []segmentSpan
+
+	style         backend.Style
+	selectedStyle backend.Style
+	focusStyle    backend.Style
+	styleSet      bool
+	selectedSet   bool
+	focusSet      bool
+}
+
+// segmentSpan is where a segment was drawn, in cells.
+type segmentSpan struct {
+	x, width int
+}
+
+// NewSegmentedControl creates a control with the first option selected.
+func NewSegmentedControl(options ...string) *SegmentedControl {
+	s := &SegmentedControl{
+		options:    options,
+		label:      "Segmented Control",
+		style:      backend.DefaultStyle(),
+		focusStyle: backend.DefaultStyle().Underline(true),
+	}
+	if len(options) == 0 {
+		s.selected = -1
+	}
+	s.Base.Role = accessibility.RoleRadioGroup
+	s.syncA11y()
+	return s
+}
+
+// SetOptions replaces the options, keeping the selection when it still
+// fits.
+func (s *SegmentedControl) SetOptions(options ...string) {
+	if s == nil {
+		return
+	}
+	s.options = options
+	switch {
+	case len(options) == 0:
+		s.selected = -1
+	case s.selected < 0 || s.selected >= len(options):
+		s.selected = 0
+	}
+	s.syncA11y()
+	s.Invalidate()
+	s.services.Relayout()
+}
+
+// Options returns the segment labels.
+func (s *SegmentedControl) Options() []string {
+	if s == nil {
+		return nil
+	}
+	return s.options
+}
+
+// SetSelected selects the segment at index. Out-of-range indexes are
+// ignored.
+func (s *SegmentedControl) SetSelected(index int) {
+	if s == nil || index < 0 || index >= len(s.options) || index == s.selected {
+		return
+	}
+	s.selected = index
+	s.syncA11y()
+	s.Invalidate()
+	s.services.Invalidate()
+	s.services.AnnounceChange(s)
+	if s.onChange != nil {
+		s.onChange(index)
+	}
+}
+
+// Selected returns the selected index, or -1 when there are no options.
+func (s *SegmentedControl) Selected() int {
+	if s == nil {
+		return -1
+	}
+	return s.selected
+}
+
+// SetOnChange registers a callback for selection changes.
+func (s *SegmentedControl) SetOnChange(fn func(index int)) {
+	if s == nil {
+		return
+	}
+	s.onChange = fn
+}
+
+// OnChange is an alias for SetOnChange.
+func (s *SegmentedControl) OnChange(fn func(index int)) {
+	s.SetOnChange(fn)
+}
+
+// SetLabel updates the accessibility label.
+func (s *SegmentedControl) SetLabel(label string) {
+	if s == nil {
+		return
+	}
+	s.label = label
+	s.syncA11y()
+}
+
+// SetStyle sets the base style.
+func (s *SegmentedControl) SetStyle(style backend.Style) {
+	if s == nil {
+		return
+	}
+	s.style = style
+	s.styleSet = true
+}
+
+// SetSelectedStyle overrides the theme style of the selected segment.
+func (s *SegmentedControl) SetSelectedStyle(style backend.Style) {
+	if s == nil {
+		return
+	}
+	s.selectedStyle = style
+	s.selectedSet = true
+}
+
+// SetFocusStyle sets the style added to the selected segment while focused.
+func (s *SegmentedControl) SetFocusStyle(style backend.Style) {
+	if s == nil {
+		return
+	}
+	s.focusStyle = style
+	s.focusSet = true
+}
+
+// Bind attaches app services.
+func (s *SegmentedControl) Bind(services runtime.Services) {
+	if s == nil {
+		return
+	}
+	s.services = services
+}
+
+// Unbind releases app services.
+func (s *SegmentedControl) Unbind() {
+	if s == nil {
+		return
+	}
+	s.services = runtime.Services{}
+}
+
+// StyleType returns the selector type name.
+func (s *SegmentedControl) StyleType() string {
+	return "SegmentedControl"
+}
+
+// Measure returns the width of all segments on one row.
+func (s *SegmentedControl) Measure(constraints runtime.Constraints) runtime.Size {
+	return s.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := 1
+		for _, option := range s.options {
+			width += textWidth(option) + 3
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
+	})
+}
+
+// Render draws the joined segments.
+func (s *SegmentedControl) Render(ctx runtime.RenderContext) {
+	if s == nil {
+		return
+	}
+	s.syncA11y()
+	outer := s.bounds
+	content := s.ContentBounds()
+	s.segments = s.segments[:0]
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	baseStyle := resolveBaseStyle(ctx, s, s.style, s.styleSet)
+	ctx.Buffer.Fill(outer, ' ', baseStyle)
+	if content.Width <= 0 || content.Height <= 0 || len(s.options) == 0 {
+		return
+	}
+
+	selectedStyle := mergeBackendStyles(baseStyle, themeStyle("selection")).Bold(true)
+	if s.selectedSet {
+		selectedStyle = mergeBackendStyles(baseStyle, s.selectedStyle)
+	}
+	if s.focused {
+		if s.focusSet {
+			selectedStyle = mergeBackendStyles(selectedStyle, s.focusStyle)
+		} else {
+			selectedStyle = selectedStyle.Underline(true)
+		}
+	}
+
+	right := content.X + content.Width
+	x := content.X
+	ctx.Buffer.Set(x, content.Y, '[', baseStyle)
+	x++
+	for i, option := range s.options {
+		if x >= right {
+			break
+		}
+		if i > 0 {
+			ctx.Buffer.Set(x, content.Y, '|', baseStyle)
+			x++
+		}
+		text := " " + option + " "
+		width := min(textWidth(text), max(0, right-x))
+		style := baseStyle
+		if i == s.selected {
+			style = selectedStyle
+		}
+		ctx.Buffer.SetString(x, content.Y, truncateString(text, width), style)
+		s.segments = append(s.segments, segmentSpan{x: x, width: width})
+		x += width
+	}
+	if x < right {
+		ctx.Buffer.Set(x, content.Y, ']', baseStyle)
+	}
+}
+
+// HandleMessage moves the selection with arrow keys and mouse clicks.
+func (s *SegmentedControl) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if s == nil {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.MouseMsg:
+		if m.Action != runtime.MousePress || m.Button != runtime.MouseLeft || !s.bounds.Contains(m.X, m.Y) {
+			return runtime.Unhandled()
+		}
+		for i, span := range s.segments {
+			if m.X >= span.x && m.X < span.x+span.width {
+				s.SetSelected(i)
+				return runtime.Handled()
+			}
+		}
+		return runtime.Handled()
+	case runtime.KeyMsg:
+		if !s.focused || len(s.options) == 0 {
+			return runtime.Unhandled()
+		}
+		switch m.Key {
+		case terminal.KeyLeft:
+			s.SetSelected(max(0, s.selected-1))
+		case terminal.KeyRight:
+			s.SetSelected(min(len(s.options)-1, s.selected+1))
+		case terminal.KeyHome:
+			s.SetSelected(0)
+		case terminal.KeyEnd:
+			s.SetSelected(len(s.options) - 1)
+		default:
+			return runtime.Unhandled()
+		}
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+func (s *SegmentedControl) syncA11y() {
+	if s == nil {
+		return
+	}
+	if s.Base.Role == "" {
+		s.Base.Role = accessibility.RoleRadioGroup
+	}
+	label := strings.TrimSpace(s.label)
+	if label == "" {
+		label = "Segmented Control"
+	}
+	s.Base.Label = label
+	if s.selected >= 0 && s.selected < len(s.options) {
+		s.Base.Value = &accessibility.ValueInfo{
+			Text: fmt.Sprintf("%s, %d of %d", s.options[s.selected], s.selected+1, len(s.options)),
+		}
+	} else {
+		s.Base.Value = nil
+	}
+}
+
+var _ runtime.Widget = (*SegmentedControl)(nil)
+var _ runtime.Focusable = (*SegmentedControl)(nil)
+var _ runtime.Bindable = (*SegmentedControl)(nil)
+var _ runtime.Unbindable = (*SegmentedControl)(nil)
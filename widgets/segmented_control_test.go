@@ -0,0 +1,41 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestSegmentedControlKeysAndClicks(t *testing.T) {
+	control := NewSegmentedControl("Day", "Week", "Month")
+	control.Focus()
+	var changes []int
+	control.SetOnChange(func(index int) { changes = append(changes, index) })
+
+	if got := control.Measure(runtime.Constraints{MaxWidth: 80, MaxHeight: 1}).Width; got != 22 {
+		t.Fatalf("width = %d, want 22", got)
+	}
+	if _, rows := renderRows(t, control, 22, 1); rows[0] != "[ Day | Week | Month ]" {
+		t.Fatalf("row = %q", rows[0])
+	}
+
+	control.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	control.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	control.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	control.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if control.Selected() != 2 {
+		t.Fatalf("selected = %d, want 2", control.Selected())
+	}
+
+	control.HandleMessage(runtime.MouseMsg{X: 9, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if control.Selected() != 1 {
+		t.Fatalf("selected after click = %d, want Week", control.Selected())
+	}
+	if len(changes) != 3 || changes[0] != 1 || changes[1] != 2 || changes[2] != 1 {
+		t.Fatalf("changes = %v, want [1 2 1]", changes)
+	}
+	if got := control.AccessibleValue().Text; got != "Week, 2 of 3" {
+		t.Fatalf("value = %q", got)
+	}
+}
@@ -43,6 +43,7 @@ type Select struct {
 	services     runtime.Services
 	mode         SelectMode
 	dropdownOpen bool
+	searchable   bool
 }
 
 // NewSelect creates a select widget.
@@ -98,6 +99,24 @@ func (s *Select) SetMode(mode SelectMode) {
 	s.mode = mode
 }
 
+// SetSearchable enables filtering the open dropdown by typing. The query
+// is shown above the options, Backspace removes its last character, and
+// Escape clears it before closing the dropdown.
+func (s *Select) SetSearchable(searchable bool) {
+	if s == nil {
+		return
+	}
+	s.searchable = searchable
+}
+
+// Searchable reports whether the dropdown filters as the user types.
+func (s *Select) Searchable() bool {
+	if s == nil {
+		return false
+	}
+	return s.searchable
+}
+
 // Bind attaches app services.
 func (s *Select) Bind(services runtime.Services) {
 	if s == nil {
@@ -178,9 +197,13 @@ func (s *Select) SetSelected(index int) {
 	if s.options[index].Disabled {
 		return
 	}
+	changed := s.selected != index
 	s.selected = index
 	s.syncState()
 	s.relayout()
+	if changed {
+		s.services.AnnounceChange(s)
+	}
 	if s.onChange != nil {
 		s.onChange(s.options[index])
 	}
@@ -9,12 +9,17 @@ import (
 	"github.com/odvcencio/fluffyui/terminal"
 )
 
+const selectNoResults = "No results"
+
 type selectDropdown struct {
 	FocusableBase
 
 	options       []SelectOption
+	visible       []int
 	selected      int
 	offset        int
+	searchable    bool
+	query         string
 	label         string
 	style         backend.Style
 	selectedStyle backend.Style
@@ -36,7 +41,9 @@ func newSelectDropdown(parent *Select) *selectDropdown {
 		disabledStyle: backend.DefaultStyle().Dim(true),
 		styleSet:      parent.styleSet,
 		selectedSet:   parent.focusSet,
+		searchable:    parent.searchable,
 	}
+	drop.filter()
 	drop.Base.Role = accessibility.RoleList
 	drop.Base.Label = strings.TrimSpace(parent.label)
 	drop.Base.Description = "Select options"
@@ -60,6 +67,9 @@ func (d *selectDropdown) Measure(constraints runtime.Constraints) runtime.Size {
 				width = w
 			}
 		}
+		if d.searchable {
+			width = max(width, textWidth(selectNoResults)+2)
+		}
 		if width < 4 {
 			width = 4
 		}
@@ -67,6 +77,9 @@ func (d *selectDropdown) Measure(constraints runtime.Constraints) runtime.Size {
 		if height < 1 {
 			height = 1
 		}
+		if d.searchable {
+			height++
+		}
 		return contentConstraints.Constrain(runtime.Size{Width: width, Height: height})
 	})
 }
@@ -89,8 +102,18 @@ func (d *selectDropdown) Render(ctx runtime.RenderContext) {
 		return
 	}
 
+	list := content
+	if d.searchable {
+		writePadded(ctx.Buffer, content.X, content.Y, content.Width, truncateString(" Search: "+d.query, content.Width), baseStyle)
+		list.Y++
+		list.Height--
+		if list.Height <= 0 {
+			return
+		}
+	}
+
 	d.ensureSelectable()
-	d.ensureVisible(content.Height)
+	d.ensureVisible(list.Height)
 
 	var selectedStyle backend.Style
 	if d.selectedSet {
@@ -102,15 +125,22 @@ func (d *selectDropdown) Render(ctx runtime.RenderContext) {
 		d.disabledStyle = baseStyle.Dim(true)
 	}
 
-	for i := 0; i < content.Height; i++ {
-		index := d.offset + i
-		if index < 0 || index >= len(d.options) {
+	if len(d.visible) == 0 && d.searchable {
+		line := truncateString(" "+selectNoResults, list.Width)
+		writePadded(ctx.Buffer, list.X, list.Y, list.Width, line, baseStyle.Dim(true))
+		return
+	}
+
+	for i := 0; i < list.Height; i++ {
+		row := d.offset + i
+		if row < 0 || row >= len(d.visible) {
 			break
 		}
+		index := d.visible[row]
 		option := d.options[index]
 		line := " " + option.Label
-		if textWidth(line) > content.Width {
-			line = truncateString(line, content.Width)
+		if textWidth(line) > list.Width {
+			line = truncateString(line, list.Width)
 		}
 		style := baseStyle
 		if index == d.selected {
@@ -123,7 +153,7 @@ func (d *selectDropdown) Render(ctx runtime.RenderContext) {
 				style = style.Dim(true)
 			}
 		}
-		writePadded(ctx.Buffer, content.X, content.Y+i, content.Width, line, style)
+		writePadded(ctx.Buffer, list.X, list.Y+i, list.Width, line, style)
 	}
 }
 
@@ -154,8 +184,24 @@ func (d *selectDropdown) HandleMessage(msg runtime.Message) runtime.HandleResult
 			d.close()
 			return runtime.WithCommand(runtime.PopOverlay{})
 		case terminal.KeyEscape:
+			if d.query != "" {
+				d.setQuery("")
+				return runtime.Handled()
+			}
 			d.close()
 			return runtime.WithCommand(runtime.PopOverlay{})
+		case terminal.KeyBackspace:
+			if d.searchable {
+				if runes := []rune(d.query); len(runes) > 0 {
+					d.setQuery(string(runes[:len(runes)-1]))
+				}
+				return runtime.Handled()
+			}
+		case terminal.KeyRune:
+			if d.searchable && key.Rune != 0 && !key.Ctrl && !key.Alt {
+				d.setQuery(d.query + string(key.Rune))
+				return runtime.Handled()
+			}
 		}
 	}
 
@@ -163,8 +209,13 @@ func (d *selectDropdown) HandleMessage(msg runtime.Message) runtime.HandleResult
 		if mouse.Action == runtime.MousePress && mouse.Button == runtime.MouseLeft {
 			content := d.ContentBounds()
 			if content.Contains(mouse.X, mouse.Y) {
-				index := d.offset + (mouse.Y - content.Y)
-				if index >= 0 && index < len(d.options) {
+				top := content.Y
+				if d.searchable {
+					top++
+				}
+				row := d.offset + (mouse.Y - top)
+				if mouse.Y >= top && row >= 0 && row < len(d.visible) {
+					index := d.visible[row]
 					if !d.options[index].Disabled {
 						d.selected = index
 						if d.onSelect != nil {
@@ -182,22 +233,57 @@ func (d *selectDropdown) HandleMessage(msg runtime.Message) runtime.HandleResult
 	return runtime.Unhandled()
 }
 
+// setQuery replaces the search query and refilters the list.
+func (d *selectDropdown) setQuery(query string) {
+	d.query = query
+	d.offset = 0
+	d.filter()
+	d.Invalidate()
+}
+
+// filter rebuilds the visible option indices from the query, matching
+// labels case-insensitively, and keeps the selection on a visible option.
+func (d *selectDropdown) filter() {
+	d.visible = d.visible[:0]
+	needle := strings.ToLower(d.query)
+	for i, option := range d.options {
+		if needle == "" || strings.Contains(strings.ToLower(option.Label), needle) {
+			d.visible = append(d.visible, i)
+		}
+	}
+	d.ensureSelectable()
+	d.syncA11y()
+}
+
+// row returns the position of the selection in the visible list, or -1.
+func (d *selectDropdown) row() int {
+	for row, index := range d.visible {
+		if index == d.selected {
+			return row
+		}
+	}
+	return -1
+}
+
 func (d *selectDropdown) moveSelection(delta int) bool {
-	if len(d.options) == 0 {
+	if len(d.visible) == 0 {
 		return false
 	}
-	index := d.selected
-	if index < 0 || index >= len(d.options) {
-		index = 0
+	row := d.row()
+	if row < 0 {
+		row = 0
+		if delta > 0 {
+			row = -1
+		}
 	}
-	for i := 0; i < len(d.options); i++ {
-		index += delta
-		if index < 0 {
-			index = len(d.options) - 1
-		} else if index >= len(d.options) {
-			index = 0
+	for i := 0; i < len(d.visible); i++ {
+		row += delta
+		if row < 0 {
+			row = len(d.visible) - 1
+		} else if row >= len(d.visible) {
+			row = 0
 		}
-		if !d.options[index].Disabled {
+		if index := d.visible[row]; !d.options[index].Disabled {
 			d.selected = index
 			d.syncA11y()
 			return true
@@ -207,29 +293,30 @@ func (d *selectDropdown) moveSelection(delta int) bool {
 }
 
 func (d *selectDropdown) ensureSelectable() {
-	if len(d.options) == 0 {
+	if len(d.visible) == 0 {
 		d.selected = -1
 		return
 	}
-	if d.selected < 0 || d.selected >= len(d.options) {
-		d.selected = 0
+	if d.row() < 0 {
+		d.selected = -1
+	} else if !d.options[d.selected].Disabled {
+		return
 	}
-	if d.options[d.selected].Disabled {
-		if !d.moveSelection(1) {
-			d.selected = -1
-		}
+	if !d.moveSelection(1) {
+		d.selected = -1
 	}
 }
 
 func (d *selectDropdown) ensureVisible(height int) {
-	if d.selected < 0 {
+	row := d.row()
+	if row < 0 {
 		d.offset = 0
 		return
 	}
-	if d.selected < d.offset {
-		d.offset = d.selected
-	} else if d.selected >= d.offset+height {
-		d.offset = d.selected - height + 1
+	if row < d.offset {
+		d.offset = row
+	} else if row >= d.offset+height {
+		d.offset = row - height + 1
 	}
 	if d.offset < 0 {
 		d.offset = 0
@@ -3,6 +3,7 @@ package widgets
 import (
 	"testing"
 
+	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/terminal"
 )
@@ -49,3 +50,64 @@ func TestSelect_DropdownModeMouseOpensOverlay(t *testing.T) {
 		t.Fatalf("expected PushOverlay command, got %T", result.Commands[0])
 	}
 }
+
+func TestSelect_AnnouncesChange(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	selecter := NewSelect(SelectOption{Label: "One"}, SelectOption{Label: "Two"})
+	selecter.SetLabel("Count")
+	selecter.Bind(runtime.NewApp(runtime.AppConfig{Announcer: announcer}).Services())
+
+	selecter.SetSelected(1)
+	selecter.SetSelected(1)
+	history := announcer.History()
+	if len(history) != 1 || history[0].Message != "Count, list, Two" {
+		t.Fatalf("announcements = %v, want one for the change", history)
+	}
+}
+
+func TestSelect_SearchableDropdownFilters(t *testing.T) {
+	selecter := NewSelect(
+		SelectOption{Label: "Apple"},
+		SelectOption{Label: "Banana"},
+		SelectOption{Label: "Cherry"},
+	).Apply(WithDropdownMode())
+	selecter.SetSearchable(true)
+	drop := newSelectDropdown(selecter)
+	drop.Focus()
+
+	for _, r := range "AN" {
+		if !drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: r}).Handled {
+			t.Fatalf("expected rune %q to be handled", r)
+		}
+	}
+	_, rows := renderRows(t, drop, 14, 4)
+	if rows[0] != " Search: AN" || rows[1] != " Banana" || rows[2] != "" {
+		t.Fatalf("rows = %q, want query and Banana only", rows)
+	}
+
+	drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'x'})
+	if _, rows := renderRows(t, drop, 14, 4); rows[1] != " No results" {
+		t.Fatalf("rows = %q, want No results", rows)
+	}
+
+	drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyBackspace})
+	if drop.query != "AN" {
+		t.Fatalf("query = %q after backspace, want AN", drop.query)
+	}
+	drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if selecter.Selected() != 1 {
+		t.Fatalf("selected = %d, want Banana", selecter.Selected())
+	}
+
+	drop = newSelectDropdown(selecter)
+	drop.Focus()
+	drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: 'c'})
+	result := drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if len(result.Commands) != 0 || drop.query != "" || len(drop.visible) != 3 {
+		t.Fatalf("escape should clear the filter first, got query %q, %d visible", drop.query, len(drop.visible))
+	}
+	result = drop.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEscape})
+	if len(result.Commands) != 1 {
+		t.Fatal("second escape should close the dropdown")
+	}
+}
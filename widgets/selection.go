@@ -1,5 +1,7 @@
 package widgets
 
+import "github.com/odvcencio/fluffyui/backend"
+
 // Selection represents a text selection range.
 type Selection struct {
 	Start int
@@ -51,3 +53,17 @@ type Selectable interface {
 	// GetSelectedText returns the currently selected text.
 	GetSelectedText() string
 }
+
+// selectionAnchor returns the fixed end of sel given the cursor position.
+func selectionAnchor(sel Selection, cursor int) int {
+	if cursor == sel.Start {
+		return sel.End
+	}
+	return sel.Start
+}
+
+// selectionStyleFor returns the style for selected text drawn over style,
+// flipping reverse video so selections stay visible on reversed widgets.
+func selectionStyleFor(style backend.Style) backend.Style {
+	return style.Reverse(style.Attributes()&backend.AttrReverse == 0)
+}
@@ -3,6 +3,8 @@ package widgets
 import (
 	"testing"
 
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/clipboard"
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/terminal"
 )
@@ -202,3 +204,123 @@ var _ Selectable = (*Input)(nil)
 
 // Verify MultilineInput implements Selectable
 var _ Selectable = (*MultilineInput)(nil)
+
+func TestInputShiftSelection(t *testing.T) {
+	mem := &clipboard.MemoryClipboard{}
+	app := runtime.NewApp(runtime.AppConfig{Clipboard: mem})
+	input := NewInput()
+	input.Bind(app.Services())
+	input.Focus()
+	input.SetText("hello world")
+	input.SetCursorOffset(5)
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Shift: true})
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Shift: true, Ctrl: true})
+	if start, end := input.Selection(); start != 0 || end != 5 {
+		t.Fatalf("Selection = (%d, %d), want (0, 5)", start, end)
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Shift: true})
+	if got := input.SelectedText(); got != "ello" {
+		t.Fatalf("SelectedText = %q, want %q", got, "ello")
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlC})
+	if got, _ := mem.Read(); got != "ello" {
+		t.Fatalf("clipboard = %q, want %q", got, "ello")
+	}
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlX})
+	if input.Text() != "h world" || input.HasSelection() {
+		t.Fatalf("after cut: text = %q", input.Text())
+	}
+
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd, Shift: true})
+	_ = mem.Write("ey")
+	input.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlV})
+	if input.Text() != "hey" {
+		t.Fatalf("paste over selection = %q, want %q", input.Text(), "hey")
+	}
+}
+
+func TestTextAreaSelection(t *testing.T) {
+	mem := &clipboard.MemoryClipboard{}
+	app := runtime.NewApp(runtime.AppConfig{Clipboard: mem})
+	area := NewTextArea()
+	area.Bind(app.Services())
+	area.Focus()
+	area.SetText("one two\nthree")
+	area.SetCursorOffset(4)
+
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Shift: true, Ctrl: true})
+	if got := area.SelectedText(); got != "two\n" {
+		t.Fatalf("after Shift+Ctrl+Right SelectedText = %q", got)
+	}
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnd, Shift: true})
+	if start, end := area.Selection(); start != 4 || end != 13 {
+		t.Fatalf("Selection = (%d, %d), want (4, 13)", start, end)
+	}
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyUp, Shift: true})
+	if got := area.SelectedText(); got != "t" {
+		t.Fatalf("after Shift+Up SelectedText = %q", got)
+	}
+
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyCtrlX})
+	if got, _ := mem.Read(); got != "t" {
+		t.Fatalf("clipboard = %q", got)
+	}
+	if area.Text() != "one wo\nthree" {
+		t.Fatalf("after cut: text = %q", area.Text())
+	}
+
+	area.SetSelection(Selection{Start: 4, End: 13})
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: '2'})
+	if area.Text() != "one 2" {
+		t.Fatalf("typing over selection = %q", area.Text())
+	}
+
+	area.SetText("abc")
+	area.SetSelection(Selection{Start: 0, End: 2})
+	area.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if area.HasSelection() || area.CursorOffset() != 0 {
+		t.Fatalf("Left should collapse the selection to its start")
+	}
+}
+
+func TestTextAreaSelectionRender(t *testing.T) {
+	area := NewTextArea()
+	area.SetStyle(backend.DefaultStyle())
+	area.SetText("abcd")
+	area.SetSelection(Selection{Start: 1, End: 3})
+	buf := runtime.NewBuffer(6, 1)
+	area.Layout(runtime.Rect{Width: 6, Height: 1})
+	area.Render(runtime.RenderContext{Buffer: buf})
+
+	for x, wantSelected := range []bool{false, true, true, false} {
+		_, _, attrs := buf.Get(x, 0).Style.Decompose()
+		if selected := attrs&backend.AttrReverse != 0; selected != wantSelected {
+			t.Fatalf("cell %d selected = %v, want %v", x, selected, wantSelected)
+		}
+	}
+}
+
+func TestTextAreaClickCounts(t *testing.T) {
+	area := NewTextArea()
+	area.SetText("one two\nthree four")
+	area.Layout(runtime.Rect{Width: 20, Height: 3})
+	area.Render(runtime.RenderContext{Buffer: runtime.NewBuffer(20, 3)})
+
+	click := func(x, y, count int) {
+		area.HandleMessage(runtime.MouseMsg{X: x, Y: y, Button: runtime.MouseLeft, Action: runtime.MousePress, Count: count})
+	}
+	click(5, 0, 1)
+	if area.CursorOffset() != 5 || area.HasSelection() {
+		t.Fatalf("single click: cursor = %d, selection = %v", area.CursorOffset(), area.HasSelection())
+	}
+	click(5, 0, 2)
+	if got := area.SelectedText(); got != "two" {
+		t.Fatalf("double click selected %q, want %q", got, "two")
+	}
+	click(2, 1, 3)
+	if got := area.SelectedText(); got != "three four" {
+		t.Fatalf("triple click selected %q, want %q", got, "three four")
+	}
+}
@@ -27,6 +27,7 @@ const (
 	sliderFillCharV         = '|'
 	sliderThumbChar         = 'O'
 	sliderThumbCharInactive = 'o'
+	sliderTickChar          = '+'
 )
 
 // SliderOption configures slider behavior.
@@ -46,12 +47,16 @@ type Slider struct {
 	orientation Orientation
 	showValue   bool
 	valueFormat string
+	ticks       float64
+	onChange    func(value float64)
 
 	label      string
 	trackStyle backend.Style
 	thumbStyle backend.Style
 	fillStyle  backend.Style
 	style      backend.Style
+	focusStyle backend.Style
+	focusSet   bool
 
 	dragging bool
 	services runtime.Services
@@ -120,6 +125,20 @@ func WithSliderValueFormat(format string) SliderOption {
 	}
 }
 
+// WithSliderStep sets the step values snap to. Zero disables snapping.
+func WithSliderStep(step float64) SliderOption {
+	return func(s *Slider) {
+		s.step = step
+	}
+}
+
+// WithSliderTicks draws tick marks on the track every interval.
+func WithSliderTicks(interval float64) SliderOption {
+	return func(s *Slider) {
+		s.ticks = interval
+	}
+}
+
 // WithSliderStyles configures styles.
 func WithSliderStyles(track, thumb, fill backend.Style) SliderOption {
 	return func(s *Slider) {
@@ -182,15 +201,73 @@ func (s *Slider) SetRange(min, max, step float64) {
 	s.SetValue(s.Value())
 }
 
+// SetStep sets the step values snap to and arrow keys move by. Zero
+// disables snapping and steps by a hundredth of the range.
+func (s *Slider) SetStep(step float64) {
+	if s == nil {
+		return
+	}
+	s.step = step
+	s.SetValue(s.Value())
+}
+
+// SetTicks draws tick marks on the track every interval. Zero hides them.
+// Ticks are skipped when they would be denser than the track's cells.
+func (s *Slider) SetTicks(interval float64) {
+	if s == nil {
+		return
+	}
+	s.ticks = interval
+	s.services.Invalidate()
+}
+
+// SetOrientation sets whether the slider runs horizontally or vertically.
+func (s *Slider) SetOrientation(orientation Orientation) {
+	if s == nil {
+		return
+	}
+	s.orientation = orientation
+	s.services.Relayout()
+}
+
+// SetShowValue toggles the value label.
+func (s *Slider) SetShowValue(show bool) {
+	if s == nil {
+		return
+	}
+	s.showValue = show
+	s.services.Invalidate()
+}
+
 // SetValue updates the slider value.
 func (s *Slider) SetValue(value float64) {
 	if s == nil || s.value == nil {
 		return
 	}
 	value = s.clampValue(value)
+	changed := s.value.Get() != value
 	s.value.Set(value)
 	s.syncA11y()
 	s.services.Invalidate()
+	if changed {
+		s.services.AnnounceChange(s)
+		if s.onChange != nil {
+			s.onChange(value)
+		}
+	}
+}
+
+// SetOnChange registers a callback for value changes.
+func (s *Slider) SetOnChange(fn func(value float64)) {
+	if s == nil {
+		return
+	}
+	s.onChange = fn
+}
+
+// OnChange is an alias for SetOnChange.
+func (s *Slider) OnChange(fn func(value float64)) {
+	s.SetOnChange(fn)
 }
 
 // Value returns the current value.
@@ -221,6 +298,15 @@ func (s *Slider) SetStyles(base, track, thumb, fill backend.Style) {
 	s.fillStyle = fill
 }
 
+// SetFocusStyle sets the thumb style while focused.
+func (s *Slider) SetFocusStyle(style backend.Style) {
+	if s == nil {
+		return
+	}
+	s.focusStyle = style
+	s.focusSet = true
+}
+
 // StyleType returns the selector type name.
 func (s *Slider) StyleType() string {
 	return "Slider"
@@ -284,6 +370,13 @@ func (s *Slider) Render(ctx runtime.RenderContext) {
 	trackStyle := mergeBackendStyles(baseStyle, s.trackStyle)
 	thumbStyle := mergeBackendStyles(baseStyle, s.thumbStyle)
 	fillStyle := mergeBackendStyles(baseStyle, s.fillStyle)
+	if s.focused {
+		if s.focusSet {
+			thumbStyle = mergeBackendStyles(thumbStyle, s.focusStyle)
+		} else {
+			thumbStyle = thumbStyle.Reverse(true)
+		}
+	}
 	ctx.Buffer.Fill(outer, ' ', baseStyle)
 	if content.Width <= 0 || content.Height <= 0 {
 		return
@@ -311,8 +404,12 @@ func (s *Slider) renderHorizontal(ctx runtime.RenderContext, trackRect, valueRec
 		return
 	}
 	pos := s.valueToPos(length)
+	ticks := s.tickPositions(length)
 	for i := 0; i < length; i++ {
 		ch := sliderTrackCharH
+		if ticks[i] {
+			ch = sliderTickChar
+		}
 		style := trackStyle
 		if i <= pos {
 			ch = sliderFillCharH
@@ -332,9 +429,13 @@ func (s *Slider) renderVertical(ctx runtime.RenderContext, trackRect, valueRect
 		return
 	}
 	pos := s.valueToPos(length)
+	ticks := s.tickPositions(length)
 	for i := 0; i < length; i++ {
 		y := trackRect.Y + (length - 1 - i)
 		ch := sliderTrackCharV
+		if ticks[i] {
+			ch = sliderTickChar
+		}
 		style := trackStyle
 		if i <= pos {
 			ch = sliderFillCharV
@@ -369,6 +470,9 @@ func (s *Slider) HandleMessage(msg runtime.Message) runtime.HandleResult {
 func (s *Slider) handleKey(key runtime.KeyMsg) runtime.HandleResult {
 	step := s.stepSize()
 	page := s.pageStep()
+	if key.Shift {
+		step = page
+	}
 	switch key.Key {
 	case terminal.KeyLeft, terminal.KeyDown:
 		s.SetValue(s.Value() - step)
@@ -454,6 +558,24 @@ func (s *Slider) valueFromPoint(x, y int, trackRect runtime.Rect) float64 {
 	return s.posToValue(offset, length)
 }
 
+// tickPositions marks the track cells that get a tick mark, marking none
+// when ticks are off or need more cells than the track has.
+func (s *Slider) tickPositions(length int) []bool {
+	marks := make([]bool, length)
+	if s.ticks <= 0 || s.max <= s.min || length <= 1 {
+		return marks
+	}
+	count := int(math.Floor((s.max-s.min)/s.ticks + 1e-9))
+	if count+1 > length {
+		return marks
+	}
+	for i := 0; i <= count; i++ {
+		ratio := float64(i) * s.ticks / (s.max - s.min)
+		marks[int(math.Round(ratio*float64(length-1)))] = true
+	}
+	return marks
+}
+
 func (s *Slider) valueToPos(length int) int {
 	if length <= 1 {
 		return 0
@@ -32,3 +32,46 @@ func TestRangeSliderKeyAdjust(t *testing.T) {
 		t.Fatalf("min value = %v, want 3", got)
 	}
 }
+
+func TestSliderStepShiftAndOnChange(t *testing.T) {
+	slider := NewSlider(nil, WithSliderRange(0, 100, 1))
+	slider.SetStep(5)
+	slider.Focus()
+	var changes []float64
+	slider.SetOnChange(func(value float64) { changes = append(changes, value) })
+
+	slider.SetValue(12)
+	if got := slider.Value(); got != 10 {
+		t.Fatalf("value = %v, want snapped to 10", got)
+	}
+	slider.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Shift: true})
+	if got := slider.Value(); got != 60 {
+		t.Fatalf("value after shift+right = %v, want 60", got)
+	}
+	slider.SetValue(500)
+	slider.SetValue(100)
+	if len(changes) != 3 || changes[2] != 100 {
+		t.Fatalf("changes = %v, want one per distinct value", changes)
+	}
+}
+
+func TestSliderTicksAndDrag(t *testing.T) {
+	slider := NewSlider(nil, WithSliderRange(0, 10, 1), WithSliderTicks(5))
+	_, rows := renderRows(t, slider, 11, 1)
+	if rows[0] != "O----+----+" {
+		t.Fatalf("track = %q, want ticks at 0, 5 and 10", rows[0])
+	}
+
+	slider.HandleMessage(runtime.MouseMsg{X: 3, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	slider.HandleMessage(runtime.MouseMsg{X: 7, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseMove})
+	slider.HandleMessage(runtime.MouseMsg{X: 7, Y: 0, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if got := slider.Value(); got != 7 {
+		t.Fatalf("value after drag = %v, want 7", got)
+	}
+
+	vertical := NewSlider(state.NewSignal(10.0), WithSliderRange(0, 10, 1), WithSliderOrientation(Vertical))
+	_, rows = renderRows(t, vertical, 1, 3)
+	if rows[0] != "O" || rows[2] != "|" {
+		t.Fatalf("vertical rows = %q, want thumb at the top", rows)
+	}
+}
@@ -93,6 +93,7 @@ func TestWidgetSmokeRender(t *testing.T) {
 			grid.Add(NewLabel("B"), 1, 1, 1, 1)
 			return grid
 		}(), 12, 4},
+		{"Heatmap", NewHeatmap([][]float64{{1, 2}, {3, 4}}), 8, 4},
 		{"Input", NewInput(), 12, 1},
 		{"LineChart", func() runtime.Widget {
 			chart := NewLineChart()
@@ -1,24 +1,75 @@
 package widgets
 
 import (
+	"sort"
+	"time"
+
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
 )
 
-// Spinner is an animated loading indicator.
+// Spinner preset names for SetPreset and SpinnerPreset.
+const (
+	SpinnerDots   = "dots"
+	SpinnerLine   = "line"
+	SpinnerBounce = "bounce"
+	SpinnerClock  = "clock"
+	SpinnerMoon   = "moon"
+	SpinnerArc    = "arc"
+)
+
+// SpinnerFrames holds the frames of each named preset. Entries added here
+// become available to SetPreset; SpinnerPreset returns copies, so prefer it
+// for reading.
+var SpinnerFrames = map[string][]string{
+	SpinnerDots:   {"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	SpinnerLine:   {"-", "\\", "|", "/"},
+	SpinnerBounce: {"⠁", "⠂", "⠄", "⠂"},
+	SpinnerClock:  {"🕛", "🕐", "🕑", "🕒", "🕓", "🕔", "🕕", "🕖", "🕗", "🕘", "🕙", "🕚"},
+	SpinnerMoon:   {"🌑", "🌒", "🌓", "🌔", "🌕", "🌖", "🌗", "🌘"},
+	SpinnerArc:    {"◜", "◠", "◝", "◞", "◡", "◟"},
+}
+
+// SpinnerPreset returns a copy of the frames for a named preset.
+func SpinnerPreset(name string) ([]string, bool) {
+	frames, ok := SpinnerFrames[name]
+	if !ok {
+		return nil, false
+	}
+	return append([]string(nil), frames...), true
+}
+
+// SpinnerPresets returns the preset names in sorted order.
+func SpinnerPresets() []string {
+	names := make([]string, 0, len(SpinnerFrames))
+	for name := range SpinnerFrames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Spinner is an animated loading indicator with an optional trailing
+// label. SetDone replaces it with a static completion message.
 type Spinner struct {
 	Base
-	Frames []string
-	index  int
-	style  backend.Style
+	Frames   []string
+	index    int
+	style    backend.Style
 	styleSet bool
+	interval time.Duration
+	last     time.Time
+	label    string
+	done     bool
+	doneText string
 }
 
-// NewSpinner creates a spinner.
+// NewSpinner creates a spinner using the line preset.
 func NewSpinner() *Spinner {
+	frames, _ := SpinnerPreset(SpinnerLine)
 	spinner := &Spinner{
-		Frames: []string{"-", "\\", "|", "/"},
+		Frames: frames,
 		style:  backend.DefaultStyle(),
 	}
 	spinner.Base.Role = accessibility.RoleStatus
@@ -35,6 +86,112 @@ func (s *Spinner) SetStyle(style backend.Style) {
 	s.styleSet = true
 }
 
+// SetFrames replaces the animation frames and restarts from the first one.
+// An empty slice leaves the spinner blank.
+func (s *Spinner) SetFrames(frames []string) {
+	if s == nil {
+		return
+	}
+	s.Frames = append([]string(nil), frames...)
+	s.index = 0
+}
+
+// SetPreset switches to a named preset such as SpinnerDots. It reports
+// false and leaves the frames unchanged for unknown names.
+func (s *Spinner) SetPreset(name string) bool {
+	frames, ok := SpinnerPreset(name)
+	if !ok {
+		return false
+	}
+	s.SetFrames(frames)
+	return true
+}
+
+// SetInterval sets how long each frame shows, independent of the app tick
+// rate. Zero advances one frame per tick.
+func (s *Spinner) SetInterval(d time.Duration) {
+	if s == nil {
+		return
+	}
+	if d < 0 {
+		d = 0
+	}
+	s.interval = d
+	s.last = time.Time{}
+}
+
+// SetFPS sets the animation speed in frames per second. Zero or less
+// advances one frame per tick.
+func (s *Spinner) SetFPS(fps float64) {
+	if fps <= 0 {
+		s.SetInterval(0)
+		return
+	}
+	s.SetInterval(time.Duration(float64(time.Second) / fps))
+}
+
+// SetLabel sets the text drawn after the frame, such as "Loading files".
+func (s *Spinner) SetLabel(text string) {
+	if s == nil {
+		return
+	}
+	if text == "" && s.Base.Label == s.label {
+		s.Base.Label = ""
+	}
+	s.label = text
+	s.syncA11y()
+	s.Invalidate()
+}
+
+// Label returns the trailing label.
+func (s *Spinner) Label() string {
+	if s == nil {
+		return ""
+	}
+	return s.label
+}
+
+// SetDone stops the animation and shows text in place of the frame and
+// label. Restart resumes the animation.
+func (s *Spinner) SetDone(text string) {
+	if s == nil {
+		return
+	}
+	s.done = true
+	s.doneText = text
+	s.syncA11y()
+	s.Invalidate()
+}
+
+// Restart resumes the animation from the first frame after SetDone.
+func (s *Spinner) Restart() {
+	if s == nil {
+		return
+	}
+	if s.Base.Label == s.doneText {
+		s.Base.Label = ""
+	}
+	s.done = false
+	s.doneText = ""
+	s.index = 0
+	s.last = time.Time{}
+	s.syncA11y()
+	s.Invalidate()
+}
+
+// Done reports whether SetDone stopped the spinner.
+func (s *Spinner) Done() bool {
+	return s != nil && s.done
+}
+
+// Interval returns the frame interval.
+func (s *Spinner) Interval() time.Duration {
+	if s == nil {
+		return 0
+	}
+	return s.interval
+}
+
 // StyleType returns the selector type name.
 func (s *Spinner) StyleType() string {
 	return "Spinner"
@@ -48,16 +205,50 @@ func (s *Spinner) Advance() {
 	s.index = (s.index + 1) % len(s.Frames)
 }
 
-// Measure returns desired size.
+// tick advances by the number of intervals elapsed since the last frame.
+func (s *Spinner) tick(now time.Time) {
+	if s.interval <= 0 {
+		s.Advance()
+		return
+	}
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if s.last.IsZero() {
+		s.last = now
+		return
+	}
+	steps := int(now.Sub(s.last) / s.interval)
+	if steps <= 0 {
+		return
+	}
+	s.last = s.last.Add(time.Duration(steps) * s.interval)
+	if len(s.Frames) > 0 {
+		s.index = (s.index + steps) % len(s.Frames)
+	}
+}
+
+// Measure returns desired size: the widest frame plus the label, or the
+// done text once finished.
 func (s *Spinner) Measure(constraints runtime.Constraints) runtime.Size {
 	return s.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		return contentConstraints.Constrain(runtime.Size{Width: 1, Height: 1})
+		width := 0
+		for _, frame := range s.Frames {
+			width = max(width, textWidth(frame))
+		}
+		if s.label != "" {
+			width += 1 + textWidth(s.label)
+		}
+		if s.done {
+			width = textWidth(s.doneText)
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
 	})
 }
 
-// Render draws the spinner frame.
+// Render draws the spinner frame and label, or the done text.
 func (s *Spinner) Render(ctx runtime.RenderContext) {
-	if s == nil || len(s.Frames) == 0 {
+	if s == nil {
 		return
 	}
 	s.syncA11y()
@@ -65,10 +256,22 @@ func (s *Spinner) Render(ctx runtime.RenderContext) {
 	if bounds.Width <= 0 || bounds.Height <= 0 {
 		return
 	}
-	frame := s.Frames[s.index%len(s.Frames)]
-	frame = truncateString(frame, bounds.Width)
 	style := resolveBaseStyle(ctx, s, s.style, s.styleSet)
-	ctx.Buffer.SetString(bounds.X, bounds.Y, frame, style)
+	if s.done {
+		ctx.Buffer.SetString(bounds.X, bounds.Y, truncateString(s.doneText, bounds.Width), style)
+		return
+	}
+	text := ""
+	if len(s.Frames) > 0 {
+		text = s.Frames[s.index%len(s.Frames)]
+	}
+	if s.label != "" {
+		if text != "" {
+			text += " "
+		}
+		text += s.label
+	}
+	ctx.Buffer.SetString(bounds.X, bounds.Y, truncateString(text, bounds.Width), style)
 }
 
 // HandleMessage advances on ticks.
@@ -76,8 +279,8 @@ func (s *Spinner) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if s == nil {
 		return runtime.Unhandled()
 	}
-	if _, ok := msg.(runtime.TickMsg); ok {
-		s.Advance()
+	if tick, ok := msg.(runtime.TickMsg); ok && !s.done {
+		s.tick(tick.Time)
 		return runtime.Handled()
 	}
 	return runtime.Unhandled()
@@ -90,7 +293,12 @@ func (s *Spinner) syncA11y() {
 	if s.Base.Role == "" {
 		s.Base.Role = accessibility.RoleStatus
 	}
-	if s.Base.Label == "" {
+	switch {
+	case s.done:
+		s.Base.Label = s.doneText
+	case s.label != "":
+		s.Base.Label = s.label
+	case s.Base.Label == "":
 		s.Base.Label = "Loading"
 	}
 }
@@ -0,0 +1,114 @@
+package widgets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestSpinnerPresets(t *testing.T) {
+	spinner := NewSpinner()
+	for _, name := range []string{SpinnerDots, SpinnerLine, SpinnerBounce, SpinnerClock, SpinnerMoon, SpinnerArc} {
+		if !spinner.SetPreset(name) || len(spinner.Frames) == 0 {
+			t.Fatalf("preset %q missing", name)
+		}
+	}
+	if spinner.SetPreset("missing") {
+		t.Fatalf("expected unknown preset to be rejected")
+	}
+	if len(SpinnerPresets()) != 6 {
+		t.Fatalf("unexpected presets %v", SpinnerPresets())
+	}
+	frames, _ := SpinnerPreset(SpinnerDots)
+	frames[0] = "x"
+	if again, _ := SpinnerPreset(SpinnerDots); again[0] == "x" {
+		t.Fatalf("SpinnerPreset must return a copy")
+	}
+	if size := spinner.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 1}); size.Width != 1 {
+		t.Fatalf("arc width = %d, want 1", size.Width)
+	}
+	spinner.SetPreset(SpinnerMoon)
+	if size := spinner.Measure(runtime.Constraints{MaxWidth: 10, MaxHeight: 1}); size.Width != 2 {
+		t.Fatalf("moon width = %d, want 2", size.Width)
+	}
+}
+
+func TestSpinnerInterval(t *testing.T) {
+	spinner := NewSpinner()
+	spinner.SetFrames([]string{"a", "b", "c", "d"})
+	spinner.SetInterval(50 * time.Millisecond)
+	start := time.Unix(100, 0)
+
+	spinner.HandleMessage(runtime.TickMsg{Time: start})
+	if spinner.index != 0 {
+		t.Fatalf("first tick should only start the clock, index = %d", spinner.index)
+	}
+	spinner.HandleMessage(runtime.TickMsg{Time: start.Add(30 * time.Millisecond)})
+	if spinner.index != 0 {
+		t.Fatalf("advanced before the interval elapsed")
+	}
+	spinner.HandleMessage(runtime.TickMsg{Time: start.Add(160 * time.Millisecond)})
+	if spinner.index != 3 {
+		t.Fatalf("index = %d, want 3 after three intervals", spinner.index)
+	}
+	spinner.HandleMessage(runtime.TickMsg{Time: start.Add(200 * time.Millisecond)})
+	if spinner.index != 0 {
+		t.Fatalf("index = %d, want wrap to 0", spinner.index)
+	}
+
+	spinner.SetInterval(0)
+	spinner.HandleMessage(runtime.TickMsg{Time: start})
+	if spinner.index != 1 {
+		t.Fatalf("zero interval should advance per tick, index = %d", spinner.index)
+	}
+}
+
+func TestSpinnerEmptyFrames(t *testing.T) {
+	spinner := NewSpinner()
+	spinner.SetFrames(nil)
+	spinner.SetInterval(time.Millisecond)
+	spinner.HandleMessage(runtime.TickMsg{Time: time.Unix(1, 0)})
+	spinner.HandleMessage(runtime.TickMsg{Time: time.Unix(2, 0)})
+	spinner.Advance()
+	spinner.Layout(runtime.Rect{Width: 4, Height: 1})
+	spinner.Render(runtime.RenderContext{Buffer: runtime.NewBuffer(4, 1)})
+	if size := spinner.Measure(runtime.Constraints{MaxWidth: 4, MaxHeight: 1}); size.Width != 0 {
+		t.Fatalf("empty spinner width = %d", size.Width)
+	}
+}
+
+func TestSpinnerLabelFPSAndDone(t *testing.T) {
+	spinner := NewSpinner()
+	if !spinner.SetPreset(SpinnerClock) || len(spinner.Frames) != 12 {
+		t.Fatalf("clock preset frames = %v", spinner.Frames)
+	}
+	spinner.SetFrames([]string{"a", "b"})
+	spinner.SetLabel("Fetching")
+	spinner.SetFPS(4)
+	if spinner.Interval() != 250*time.Millisecond {
+		t.Fatalf("interval = %v, want 250ms at 4 fps", spinner.Interval())
+	}
+	if _, rows := renderRows(t, spinner, 20, 1); rows[0] != "a Fetching" {
+		t.Fatalf("row = %q", rows[0])
+	}
+	if spinner.AccessibleLabel() != "Fetching" {
+		t.Fatalf("a11y label = %q", spinner.AccessibleLabel())
+	}
+
+	spinner.SetDone("✓ Fetched 3 files")
+	start := time.Unix(100, 0)
+	if spinner.HandleMessage(runtime.TickMsg{Time: start}).Handled {
+		t.Fatal("expected a done spinner to ignore ticks")
+	}
+	if _, rows := renderRows(t, spinner, 20, 1); rows[0] != "✓ Fetched 3 files" {
+		t.Fatalf("row = %q", rows[0])
+	}
+
+	spinner.Restart()
+	spinner.HandleMessage(runtime.TickMsg{Time: start})
+	spinner.HandleMessage(runtime.TickMsg{Time: start.Add(300 * time.Millisecond)})
+	if spinner.Done() || spinner.index != 1 {
+		t.Fatalf("expected Restart to resume the animation, index = %d", spinner.index)
+	}
+}
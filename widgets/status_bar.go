@@ -0,0 +1,237 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+// StatusSegment is a piece of text in a StatusBar. Its style is merged
+// over the bar style.
+type StatusSegment struct {
+	Text    string
+	Style   backend.Style
+	OnClick func()
+}
+
+// statusHit is where a clickable segment was drawn.
+type statusHit struct {
+	rect    runtime.Rect
+	onClick func()
+}
+
+// StatusBar is a one-row, full-width bar for application status, usually
+// placed at the bottom of the screen. Left segments are left-aligned,
+// right segments right-aligned, and the mode, such as a modal keymap
+// indicator, is centered between them.
+type StatusBar struct {
+	Base
+	left  []StatusSegment
+	right []StatusSegment
+	mode  StatusSegment
+	label string
+	style backend.Style
+	hits  []statusHit
+}
+
+// NewStatusBar creates an empty status bar.
+func NewStatusBar() *StatusBar {
+	bar := &StatusBar{
+		label: "Status bar",
+		style: backend.DefaultStyle().Reverse(true),
+	}
+	bar.Base.Role = accessibility.RoleStatus
+	bar.syncA11y()
+	return bar
+}
+
+// SetLeft replaces the left-aligned segments.
+func (b *StatusBar) SetLeft(segments ...StatusSegment) {
+	if b == nil {
+		return
+	}
+	b.left = segments
+	b.syncA11y()
+	b.Invalidate()
+}
+
+// SetRight replaces the right-aligned segments.
+func (b *StatusBar) SetRight(segments ...StatusSegment) {
+	if b == nil {
+		return
+	}
+	b.right = segments
+	b.syncA11y()
+	b.Invalidate()
+}
+
+// SetMode sets the centered mode segment, such as "-- INSERT --" from a
+// keybind.ModalKeymap. Empty text hides it.
+func (b *StatusBar) SetMode(text string, style backend.Style) {
+	if b == nil {
+		return
+	}
+	b.mode = StatusSegment{Text: text, Style: style}
+	b.syncA11y()
+	b.Invalidate()
+}
+
+// Mode returns the mode text.
+func (b *StatusBar) Mode() string {
+	if b == nil {
+		return ""
+	}
+	return b.mode.Text
+}
+
+// SetStyle sets the bar style.
+func (b *StatusBar) SetStyle(style backend.Style) {
+	if b == nil {
+		return
+	}
+	b.style = style
+	b.Invalidate()
+}
+
+// SetLabel updates the accessibility label.
+func (b *StatusBar) SetLabel(label string) {
+	if b == nil {
+		return
+	}
+	b.label = label
+	b.syncA11y()
+}
+
+// StyleType returns the selector type name.
+func (b *StatusBar) StyleType() string {
+	return "StatusBar"
+}
+
+// Measure fills the available width with a single row.
+func (b *StatusBar) Measure(constraints runtime.Constraints) runtime.Size {
+	return b.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		return contentConstraints.Constrain(runtime.Size{Width: contentConstraints.MaxWidth, Height: 1})
+	})
+}
+
+// segmentWidth is the width of a segment with a space on either side.
+func segmentWidth(segment StatusSegment) int {
+	if segment.Text == "" {
+		return 0
+	}
+	return textWidth(segment.Text) + 2
+}
+
+// Render draws the segments. The left segments take priority, then the
+// right, then the mode; segments that do not fit are dropped.
+func (b *StatusBar) Render(ctx runtime.RenderContext) {
+	if b == nil {
+		return
+	}
+	b.syncA11y()
+	b.hits = b.hits[:0]
+	outer := b.bounds
+	content := b.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	style := mergeBackendStyles(resolveBaseStyle(ctx, b, backend.DefaultStyle(), false), b.style)
+	ctx.Buffer.Fill(outer, ' ', style)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+
+	start, end := content.X, content.X+content.Width
+	for _, segment := range b.left {
+		width := segmentWidth(segment)
+		if width == 0 {
+			continue
+		}
+		if start+width > end {
+			width = end - start
+			if width <= 2 {
+				break
+			}
+			segment.Text = truncateString(segment.Text, width-2)
+		}
+		b.drawSegment(ctx, segment, start, content.Y, width, style)
+		start += width
+	}
+	for i := len(b.right) - 1; i >= 0; i-- {
+		segment := b.right[i]
+		width := segmentWidth(segment)
+		if width == 0 {
+			continue
+		}
+		if end-width < start {
+			break
+		}
+		end -= width
+		b.drawSegment(ctx, segment, end, content.Y, width, style)
+	}
+	if width := segmentWidth(b.mode); width > 0 && width <= end-start {
+		x := content.X + (content.Width-width)/2
+		x = clampInt(x, start, end-width)
+		b.drawSegment(ctx, b.mode, x, content.Y, width, style)
+	}
+}
+
+func (b *StatusBar) drawSegment(ctx runtime.RenderContext, segment StatusSegment, x, y, width int, base backend.Style) {
+	style := mergeBackendStyles(base, segment.Style)
+	rect := runtime.Rect{X: x, Y: y, Width: width, Height: 1}
+	ctx.Buffer.Fill(rect, ' ', style)
+	ctx.Buffer.SetString(x+1, y, segment.Text, style)
+	if segment.OnClick != nil {
+		b.hits = append(b.hits, statusHit{rect: rect, onClick: segment.OnClick})
+	}
+}
+
+// HandleMessage runs the OnClick of a clicked segment.
+func (b *StatusBar) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if b == nil {
+		return runtime.Unhandled()
+	}
+	mouse, ok := msg.(runtime.MouseMsg)
+	if !ok || mouse.Action != runtime.MousePress || mouse.Button != runtime.MouseLeft {
+		return runtime.Unhandled()
+	}
+	for _, hit := range b.hits {
+		if hit.rect.Contains(mouse.X, mouse.Y) {
+			hit.onClick()
+			return runtime.Handled()
+		}
+	}
+	return runtime.Unhandled()
+}
+
+func (b *StatusBar) syncA11y() {
+	if b == nil {
+		return
+	}
+	if b.Base.Role == "" {
+		b.Base.Role = accessibility.RoleStatus
+	}
+	label := strings.TrimSpace(b.label)
+	if label == "" {
+		label = "Status bar"
+	}
+	b.Base.Label = label
+	parts := make([]string, 0, len(b.left)+len(b.right)+1)
+	for _, segment := range b.left {
+		parts = append(parts, segment.Text)
+	}
+	parts = append(parts, b.mode.Text)
+	for _, segment := range b.right {
+		parts = append(parts, segment.Text)
+	}
+	text := summarizeRow(parts)
+	if text == "" {
+		b.Base.Value = nil
+		return
+	}
+	b.Base.Value = &accessibility.ValueInfo{Text: text}
+}
+
+var _ runtime.Widget = (*StatusBar)(nil)
@@ -0,0 +1,44 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+)
+
+func TestStatusBarAlignsSegments(t *testing.T) {
+	bar := NewStatusBar()
+	bar.SetLeft(StatusSegment{Text: "main"}, StatusSegment{Text: "2 errors"})
+	bar.SetRight(StatusSegment{Text: "UTF-8"}, StatusSegment{Text: "Ln 4"})
+	bar.SetMode("NORMAL", backend.DefaultStyle().Bold(true))
+
+	buf, rows := renderRows(t, bar, 50, 1)
+	if want := " main  2 errors       NORMAL          UTF-8  Ln 4"; rows[0] != want {
+		t.Fatalf("bar = %q, want %q", rows[0], want)
+	}
+	if buf.Get(49, 0).Style.Attributes()&backend.AttrReverse == 0 {
+		t.Fatal("expected the bar to fill its width in reverse video")
+	}
+	if buf.Get(22, 0).Style.Attributes()&backend.AttrBold == 0 {
+		t.Fatal("expected the mode style to merge over the bar")
+	}
+
+	_, rows = renderRows(t, bar, 20, 1)
+	if want := " main  2 errors"; rows[0] != want {
+		t.Fatalf("narrow bar = %q, want the left segments to win", rows[0])
+	}
+}
+
+func TestStatusBarSegmentClick(t *testing.T) {
+	bar := NewStatusBar()
+	clicks := 0
+	bar.SetRight(StatusSegment{Text: "Ln 4", OnClick: func() { clicks++ }})
+	renderRows(t, bar, 20, 1)
+
+	bar.HandleMessage(runtime.MouseMsg{X: 2, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	result := bar.HandleMessage(runtime.MouseMsg{X: 16, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if !result.Handled || clicks != 1 {
+		t.Fatalf("clicks = %d, want only the segment click to count", clicks)
+	}
+}
@@ -17,6 +17,8 @@ const (
 	StepActive
 	StepCompleted
 	StepError
+	// StepSkipped marks a step whose condition rules it out.
+	StepSkipped
 )
 
 // Step describes a step in a stepper.
@@ -25,23 +27,129 @@ type Step struct {
 	State StepState
 }
 
-// Stepper renders a sequence of steps.
+// Stepper renders a sequence of steps. Next, Prev, and Jump move the
+// active step; steps whose condition fails are skipped over and shown as
+// StepSkipped.
 type Stepper struct {
 	Base
-	Steps []Step
-	style backend.Style
-	label string
-	styleSet bool
+	Steps        []Step
+	style        backend.Style
+	label        string
+	styleSet     bool
+	current      int
+	conditions   map[int]func() bool
+	onBeforeNext func(fromIndex int) bool
 }
 
-// NewStepper creates a stepper.
+// NewStepper creates a stepper. The first step marked StepActive is the
+// current one; with none, Next starts at the first reachable step.
 func NewStepper(steps ...Step) *Stepper {
-	stepper := &Stepper{Steps: steps, style: backend.DefaultStyle(), label: "Steps"}
+	stepper := &Stepper{Steps: steps, style: backend.DefaultStyle(), label: "Steps", current: -1}
+	for i, step := range steps {
+		if step.State == StepActive {
+			stepper.current = i
+			break
+		}
+	}
 	stepper.Base.Role = accessibility.RoleList
 	stepper.syncA11y()
 	return stepper
 }
 
+// SetStepCondition sets whether step index is reachable, usually from
+// answers given in earlier steps. The condition is checked whenever the
+// stepper moves or renders; a nil condition makes the step always
+// reachable.
+func (s *Stepper) SetStepCondition(index int, cond func() bool) {
+	if s == nil || index < 0 || index >= len(s.Steps) {
+		return
+	}
+	if cond == nil {
+		delete(s.conditions, index)
+	} else {
+		if s.conditions == nil {
+			s.conditions = make(map[int]func() bool)
+		}
+		s.conditions[index] = cond
+	}
+	s.refreshSkipped()
+	s.Invalidate()
+}
+
+// SetOnBeforeNext registers a check run before moving forward from
+// fromIndex. Returning false cancels the move, for example when the
+// current step fails validation.
+func (s *Stepper) SetOnBeforeNext(fn func(fromIndex int) bool) {
+	if s == nil {
+		return
+	}
+	s.onBeforeNext = fn
+}
+
+// Current returns the index of the active step, or -1 before the first.
+func (s *Stepper) Current() int {
+	if s == nil {
+		return -1
+	}
+	return s.current
+}
+
+// Next completes the current step and activates the next reachable one.
+// It reports false when the before-next check cancels the move or no
+// reachable step follows.
+func (s *Stepper) Next() bool {
+	if s == nil {
+		return false
+	}
+	s.refreshSkipped()
+	next := s.reachableAfter(s.current)
+	if next < 0 || !s.allowNext() {
+		return false
+	}
+	s.moveTo(next)
+	return true
+}
+
+// Prev activates the previous reachable step.
+func (s *Stepper) Prev() bool {
+	if s == nil {
+		return false
+	}
+	s.refreshSkipped()
+	for i := s.current - 1; i >= 0; i-- {
+		if s.reachable(i) {
+			s.moveTo(i)
+			return true
+		}
+	}
+	return false
+}
+
+// Jump activates step index if it is unlocked: reachable, and either
+// before the current step or with every reachable step before it
+// completed. Jumping forward runs the before-next check.
+func (s *Stepper) Jump(index int) bool {
+	if s == nil || index < 0 || index >= len(s.Steps) || index == s.current {
+		return false
+	}
+	s.refreshSkipped()
+	if !s.reachable(index) {
+		return false
+	}
+	if index > s.current {
+		for i := max(0, s.current+1); i < index; i++ {
+			if s.reachable(i) && s.Steps[i].State != StepCompleted {
+				return false
+			}
+		}
+		if !s.allowNext() {
+			return false
+		}
+	}
+	s.moveTo(index)
+	return true
+}
+
 // SetStyle updates the stepper style.
 func (s *Stepper) SetStyle(style backend.Style) {
 	if s == nil {
@@ -78,6 +186,7 @@ func (s *Stepper) Render(ctx runtime.RenderContext) {
 	if s == nil {
 		return
 	}
+	s.refreshSkipped()
 	s.syncA11y()
 	outer := s.bounds
 	content := s.ContentBounds()
@@ -95,6 +204,8 @@ func (s *Stepper) Render(ctx runtime.RenderContext) {
 			prefix = "[x]"
 		case StepError:
 			prefix = "[!]"
+		case StepSkipped:
+			prefix = "[-]"
 		}
 		if i > 0 {
 			text += " -> "
@@ -109,6 +220,56 @@ func (s *Stepper) Render(ctx runtime.RenderContext) {
 	writePadded(ctx.Buffer, content.X, content.Y, content.Width, text, style)
 }
 
+func (s *Stepper) allowNext() bool {
+	return s.onBeforeNext == nil || s.current < 0 || s.onBeforeNext(s.current)
+}
+
+// moveTo activates index, completing the old step when moving forward and
+// returning it to pending when moving back.
+func (s *Stepper) moveTo(index int) {
+	if s.current >= 0 && s.current < len(s.Steps) {
+		if index > s.current {
+			s.Steps[s.current].State = StepCompleted
+		} else {
+			s.Steps[s.current].State = StepPending
+		}
+	}
+	s.current = index
+	s.Steps[index].State = StepActive
+	s.syncA11y()
+	s.Invalidate()
+}
+
+func (s *Stepper) reachable(index int) bool {
+	cond := s.conditions[index]
+	return cond == nil || cond()
+}
+
+func (s *Stepper) reachableAfter(index int) int {
+	for i := index + 1; i < len(s.Steps); i++ {
+		if s.reachable(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// refreshSkipped marks steps whose condition fails as skipped and puts
+// steps that became reachable again back to pending.
+func (s *Stepper) refreshSkipped() {
+	for i := range s.Steps {
+		if _, ok := s.conditions[i]; !ok || i == s.current {
+			continue
+		}
+		switch ok := s.reachable(i); {
+		case !ok:
+			s.Steps[i].State = StepSkipped
+		case s.Steps[i].State == StepSkipped:
+			s.Steps[i].State = StepPending
+		}
+	}
+}
+
 // HandleMessage returns unhandled.
 func (s *Stepper) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
@@ -0,0 +1,60 @@
+package widgets
+
+import "testing"
+
+func TestStepperConditionsSkipSteps(t *testing.T) {
+	stepper := NewStepper(Step{Title: "Account"}, Step{Title: "Company"}, Step{Title: "Billing"}, Step{Title: "Done"})
+	business := false
+	stepper.SetStepCondition(1, func() bool { return business })
+
+	if !stepper.Next() || stepper.Current() != 0 {
+		t.Fatalf("expected Next to start at the first step, current %d", stepper.Current())
+	}
+	if !stepper.Next() || stepper.Current() != 2 {
+		t.Fatalf("expected the company step to be skipped, current %d", stepper.Current())
+	}
+	if stepper.Steps[0].State != StepCompleted || stepper.Steps[1].State != StepSkipped {
+		t.Fatalf("states = %v, %v", stepper.Steps[0].State, stepper.Steps[1].State)
+	}
+	if _, rows := renderRows(t, stepper, 60, 1); rows[0] != "[x] Account -> [-] Company -> [>] Billing -> [ ] Done" {
+		t.Fatalf("row = %q", rows[0])
+	}
+
+	business = true
+	if !stepper.Prev() || stepper.Current() != 1 {
+		t.Fatalf("expected Prev to reach the now reachable company step, current %d", stepper.Current())
+	}
+	if stepper.Steps[2].State != StepPending {
+		t.Fatalf("expected the left step back to pending, got %v", stepper.Steps[2].State)
+	}
+}
+
+func TestStepperBeforeNextAndJump(t *testing.T) {
+	stepper := NewStepper(Step{Title: "One", State: StepActive}, Step{Title: "Two"}, Step{Title: "Three"})
+	valid := false
+	var checked []int
+	stepper.SetOnBeforeNext(func(from int) bool {
+		checked = append(checked, from)
+		return valid
+	})
+
+	if stepper.Next() || stepper.Current() != 0 {
+		t.Fatal("expected a failed check to cancel Next")
+	}
+	if stepper.Jump(2) {
+		t.Fatal("expected a jump past an unfinished step to be refused")
+	}
+	valid = true
+	if !stepper.Jump(1) || stepper.Current() != 1 {
+		t.Fatalf("expected a jump to the next step, current %d", stepper.Current())
+	}
+	if !stepper.Jump(0) || stepper.Steps[1].State != StepPending {
+		t.Fatal("expected a jump back to an earlier step")
+	}
+	if len(checked) != 2 || checked[0] != 0 || checked[1] != 0 {
+		t.Fatalf("before-next checks = %v, want only forward moves", checked)
+	}
+	if stepper.Jump(5) {
+		t.Fatal("expected an out-of-range jump to fail")
+	}
+}
@@ -32,6 +32,16 @@ type Table struct {
 	cachedWidths  []int
 	cachedTotal   int
 	cachedSig     uint32
+
+	rowFilter   func(row []string, query string) bool
+	filterQuery string
+	visible     []int // row indexes matching the filter, nil when unfiltered
+	filterCount int   // row count when visible was built
+
+	paginator  *Paginator
+	onActivate func(index int, row []string)
+	services   runtime.Services
+	wheel      runtime.WheelScroller
 }
 
 // NewTable creates a table with columns.
@@ -82,8 +92,11 @@ func (t *Table) SetRows(rows [][]string) {
 	if t == nil {
 		return
 	}
+	selected := t.rowIndex(t.selected)
 	t.dataSource = nil
 	t.Rows = rows
+	t.refilter(selected)
+	t.syncPaginator()
 	t.syncA11y()
 }
 
@@ -92,7 +105,10 @@ func (t *Table) SetDataSource(source TabularDataSource) {
 	if t == nil {
 		return
 	}
+	selected := t.rowIndex(t.selected)
 	t.dataSource = source
+	t.refilter(selected)
+	t.syncPaginator()
 	t.syncA11y()
 	t.Invalidate()
 }
@@ -119,15 +135,179 @@ func (t *Table) SelectedIndex() int {
 	if t == nil {
 		return 0
 	}
-	return t.selected
+	if t.visible != nil && len(t.visible) == 0 {
+		return -1
+	}
+	return t.rowIndex(t.selected)
 }
 
-// SetSelected updates the selected row index.
+// SetSelected updates the selected row index. While filtered, a row that
+// is filtered out is not selected.
 func (t *Table) SetSelected(index int) {
 	if t == nil {
 		return
 	}
-	t.setSelected(index)
+	view := t.viewRowOf(index)
+	if view < 0 {
+		return
+	}
+	if t.paginator != nil {
+		t.paginator.SetPage(view / t.paginator.PageSize())
+	}
+	t.setSelected(view)
+}
+
+// SetPaginator pages the table with p, showing only the rows in its current
+// window. The table keeps the paginator total in step with its row count,
+// and PgUp/PgDn turn pages. Nil shows every row again.
+func (t *Table) SetPaginator(p *Paginator) {
+	if t == nil {
+		return
+	}
+	t.paginator = p
+	t.syncPaginator()
+	t.Invalidate()
+}
+
+// Paginator returns the paginator paging the table, if any.
+func (t *Table) Paginator() *Paginator {
+	if t == nil {
+		return nil
+	}
+	return t.paginator
+}
+
+// syncPaginator updates the paginator total to the rows shown.
+func (t *Table) syncPaginator() {
+	if t.paginator != nil {
+		t.paginator.SetTotal(t.viewCount())
+	}
+}
+
+// pageRange returns the view rows on the current page.
+func (t *Table) pageRange() (start, end int) {
+	count := t.viewCount()
+	if t.paginator == nil {
+		return 0, count
+	}
+	offset, limit := t.paginator.Window()
+	start = clampInt(offset, 0, count)
+	return start, clampInt(offset+limit, start, count)
+}
+
+// SetRowFilter sets the predicate a filter query narrows the rows with, as
+// from a FilterBar. Rows are filtered only while the query is not empty.
+func (t *Table) SetRowFilter(fn func(row []string, query string) bool) {
+	if t == nil {
+		return
+	}
+	t.rowFilter = fn
+	t.Refilter()
+}
+
+// SetFilterQuery filters the table to the rows matching query, keeping the
+// selected row selected when it still matches.
+func (t *Table) SetFilterQuery(query string) {
+	if t == nil {
+		return
+	}
+	t.filterQuery = query
+	t.Refilter()
+}
+
+// FilterQuery returns the current filter query.
+func (t *Table) FilterQuery() string {
+	if t == nil {
+		return ""
+	}
+	return t.filterQuery
+}
+
+// FilterCount returns how many rows match the filter out of all rows.
+func (t *Table) FilterCount() (shown, total int) {
+	if t == nil {
+		return 0, 0
+	}
+	return t.viewCount(), t.rowCount()
+}
+
+// Refilter reapplies the filter, e.g. after cells change in place.
+func (t *Table) Refilter() {
+	if t == nil {
+		return
+	}
+	t.refilter(t.rowIndex(t.selected))
+	t.syncPaginator()
+	t.syncA11y()
+	t.Invalidate()
+}
+
+// refilter rebuilds the matching rows and selects the view row of row, or
+// the first when row no longer matches.
+func (t *Table) refilter(row int) {
+	t.visible = nil
+	count := t.rowCount()
+	t.filterCount = count
+	if t.rowFilter == nil || t.filterQuery == "" {
+		t.selected = max(row, 0)
+		return
+	}
+	t.visible = make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		if t.rowFilter(t.rowCells(i), t.filterQuery) {
+			t.visible = append(t.visible, i)
+		}
+	}
+	t.selected = max(t.viewRowOf(row), 0)
+	t.offset = 0
+}
+
+// rowCells returns the cells of a data row.
+func (t *Table) rowCells(row int) []string {
+	if provider, ok := t.dataSource.(TabularRowProvider); ok {
+		return provider.Row(row)
+	}
+	if t.dataSource != nil {
+		cells := make([]string, len(t.Columns))
+		for col := range cells {
+			cells[col] = t.dataSource.Cell(row, col)
+		}
+		return cells
+	}
+	return t.Rows[row]
+}
+
+// viewCount returns the number of rows shown.
+func (t *Table) viewCount() int {
+	if t.visible != nil {
+		return len(t.visible)
+	}
+	return t.rowCount()
+}
+
+// rowIndex returns the data row shown at a view row, or -1.
+func (t *Table) rowIndex(view int) int {
+	if t.visible == nil {
+		return view
+	}
+	if view < 0 || view >= len(t.visible) {
+		return -1
+	}
+	return t.visible[view]
+}
+
+// viewRowOf returns the view row showing a data row, or -1 when it is
+// filtered out.
+func (t *Table) viewRowOf(row int) int {
+	if t.visible == nil {
+		return row
+	}
+	for view, index := range t.visible {
+		if index == row {
+			return view
+		}
+	}
+	return -1
 }
 
 // RowCount returns the number of rows.
@@ -148,16 +328,20 @@ func (t *Table) ColumnCount() int {
 
 // SelectedRow returns the currently selected row data, or nil if no selection.
 func (t *Table) SelectedRow() []string {
-	if t == nil || t.selected < 0 || t.selected >= t.rowCount() {
+	if t == nil {
+		return nil
+	}
+	row := t.rowIndex(t.selected)
+	if row < 0 || row >= t.rowCount() {
 		return nil
 	}
 	if provider, ok := t.dataSource.(TabularRowProvider); ok {
-		return provider.Row(t.selected)
+		return provider.Row(row)
 	}
 	if t.dataSource != nil {
 		return nil
 	}
-	return t.Rows[t.selected]
+	return t.Rows[row]
 }
 
 // GetCell returns the cell value at the given row and column.
@@ -196,7 +380,8 @@ func (t *Table) SetCell(row, col int, value string) {
 // Measure returns the desired size.
 func (t *Table) Measure(constraints runtime.Constraints) runtime.Size {
 	return t.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
-		height := min(t.rowCount()+1, contentConstraints.MaxHeight)
+		start, end := t.pageRange()
+		height := min(end-start+1, contentConstraints.MaxHeight)
 		if height <= 0 {
 			height = contentConstraints.MinHeight
 		}
@@ -242,12 +427,16 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 	if rowArea <= 0 {
 		return
 	}
-	rowCount := t.rowCount()
-	if t.selected < 0 {
-		t.selected = 0
+	if t.visible != nil && t.rowCount() != t.filterCount {
+		t.refilter(t.rowIndex(t.selected))
 	}
-	if t.selected >= rowCount {
-		t.selected = rowCount - 1
+	t.syncPaginator()
+	start, end := t.pageRange()
+	if t.selected < start {
+		t.selected = start
+	}
+	if t.selected >= end {
+		t.selected = max(end-1, 0)
 	}
 	if t.selected < t.offset {
 		t.offset = t.selected
@@ -255,9 +444,10 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 	if t.selected >= t.offset+rowArea {
 		t.offset = t.selected - rowArea + 1
 	}
+	t.offset = max(t.offset, start)
 	for row := 0; row < rowArea; row++ {
 		rowIndex := t.offset + row
-		if rowIndex < 0 || rowIndex >= rowCount {
+		if rowIndex < 0 || rowIndex >= end {
 			break
 		}
 		style := baseStyle
@@ -269,7 +459,7 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 			if x >= content.X+content.Width {
 				break
 			}
-			cell := t.GetCell(rowIndex, colIndex)
+			cell := t.GetCell(t.rowIndex(rowIndex), colIndex)
 			cell = truncateString(cell, width)
 			writePadded(ctx.Buffer, x, content.Y+1+row, width, cell, style)
 			x += width + 1
@@ -277,9 +467,57 @@ func (t *Table) Render(ctx runtime.RenderContext) {
 	}
 }
 
-// HandleMessage handles row navigation.
+// Bind attaches app services.
+func (t *Table) Bind(services runtime.Services) {
+	if t == nil {
+		return
+	}
+	t.services = services
+}
+
+// Unbind releases app services.
+func (t *Table) Unbind() {
+	if t == nil {
+		return
+	}
+	t.services = runtime.Services{}
+}
+
+// SetOnActivate sets the callback run when a row is opened with Enter or
+// a double click. It receives the row's index in Rows and its cells.
+func (t *Table) SetOnActivate(fn func(index int, row []string)) {
+	if t == nil {
+		return
+	}
+	t.onActivate = fn
+}
+
+// HandleMessage handles row navigation, clicks, and the mouse wheel. A
+// click selects a row and a double click activates it.
 func (t *Table) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if t == nil || !t.focused {
+	if t == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		if delta, ok := t.wheel.Delta(t.services.ScrollConfig(), mouse, runtime.DefaultWheelLines); ok {
+			t.ScrollBy(0, delta)
+			return runtime.Handled()
+		}
+		if mouse.Button != runtime.MouseLeft || mouse.Action != runtime.MousePress {
+			return runtime.Unhandled()
+		}
+		row, ok := t.rowAt(mouse.X, mouse.Y)
+		if !ok {
+			return runtime.Unhandled()
+		}
+		t.setSelected(row)
+		t.Invalidate()
+		if mouse.Count == 2 {
+			t.activate()
+		}
+		return runtime.Handled()
+	}
+	if !t.focused {
 		return runtime.Unhandled()
 	}
 	key, ok := msg.(runtime.KeyMsg)
@@ -294,35 +532,81 @@ func (t *Table) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		t.setSelected(t.selected + 1)
 		return runtime.Handled()
 	case terminal.KeyPageUp:
+		if t.paginator != nil {
+			t.turnPage(-1)
+			return runtime.Handled()
+		}
 		t.setSelected(t.selected - t.bounds.Height)
 		return runtime.Handled()
 	case terminal.KeyPageDown:
+		if t.paginator != nil {
+			t.turnPage(1)
+			return runtime.Handled()
+		}
 		t.setSelected(t.selected + t.bounds.Height)
 		return runtime.Handled()
 	case terminal.KeyHome:
-		t.setSelected(0)
+		start, _ := t.pageRange()
+		t.setSelected(start)
 		return runtime.Handled()
 	case terminal.KeyEnd:
-		t.setSelected(t.rowCount() - 1)
+		_, end := t.pageRange()
+		t.setSelected(end - 1)
 		return runtime.Handled()
+	case terminal.KeyEnter:
+		if t.onActivate != nil {
+			t.activate()
+			return runtime.Handled()
+		}
 	}
 	return runtime.Unhandled()
 }
 
+// rowAt returns the shown row under x, y as of the last render.
+func (t *Table) rowAt(x, y int) (int, bool) {
+	content := t.ContentBounds()
+	if !content.Contains(x, y) || y == content.Y {
+		return 0, false
+	}
+	row := t.offset + y - content.Y - 1
+	start, end := t.pageRange()
+	if row < start || row >= end {
+		return 0, false
+	}
+	return row, true
+}
+
+// activate reports the selected row to the activate callback.
+func (t *Table) activate() {
+	if t.onActivate == nil || t.rowCount() == 0 {
+		return
+	}
+	t.onActivate(t.rowIndex(t.selected), t.SelectedRow())
+}
+
+// turnPage moves the paginator by delta pages and selects the first row
+// of the new page.
+func (t *Table) turnPage(delta int) {
+	t.paginator.SetPage(t.paginator.Page() + delta)
+	start, _ := t.pageRange()
+	t.setSelected(start)
+	t.Invalidate()
+}
+
 func (t *Table) setSelected(index int) {
 	if t == nil {
 		return
 	}
-	rowCount := t.rowCount()
-	if rowCount == 0 {
-		t.selected = 0
+	start, end := t.pageRange()
+	if end <= start {
+		t.selected = start
 		return
 	}
-	if index < 0 {
-		index = 0
+	if index < start {
+		index = start
 	}
-	if index >= rowCount {
-		index = rowCount - 1
+	if index >= end {
+		index = end - 1
 	}
 	t.selected = index
 	t.syncA11y()
@@ -340,8 +624,12 @@ func (t *Table) syncA11y() {
 		label = "Table"
 	}
 	t.Base.Label = label
-	t.Base.Description = fmt.Sprintf("%d rows, %d columns", t.rowCount(), len(t.Columns))
-	if t.selected >= 0 && t.selected < t.rowCount() {
+	if t.visible != nil {
+		t.Base.Description = fmt.Sprintf("%d of %d rows, %d columns", t.viewCount(), t.rowCount(), len(t.Columns))
+	} else {
+		t.Base.Description = fmt.Sprintf("%d rows, %d columns", t.rowCount(), len(t.Columns))
+	}
+	if t.selected >= 0 && t.selected < t.viewCount() {
 		t.Base.Value = &accessibility.ValueInfo{Text: t.selectedRowSummary()}
 	} else {
 		t.Base.Value = nil
@@ -349,16 +637,20 @@ func (t *Table) syncA11y() {
 }
 
 func (t *Table) selectedRowSummary() string {
-	if t == nil || t.selected < 0 || t.selected >= t.rowCount() {
+	if t == nil {
+		return ""
+	}
+	row := t.rowIndex(t.selected)
+	if row < 0 || row >= t.rowCount() {
 		return ""
 	}
 	if provider, ok := t.dataSource.(TabularRowProvider); ok {
-		return summarizeRow(provider.Row(t.selected))
+		return summarizeRow(provider.Row(row))
 	}
 	if t.dataSource != nil {
 		cells := make([]string, 0, len(t.Columns))
 		for col := range t.Columns {
-			cell := strings.TrimSpace(t.dataSource.Cell(t.selected, col))
+			cell := strings.TrimSpace(t.dataSource.Cell(row, col))
 			if cell == "" {
 				continue
 			}
@@ -366,7 +658,7 @@ func (t *Table) selectedRowSummary() string {
 		}
 		return strings.Join(cells, " | ")
 	}
-	return summarizeRow(t.Rows[t.selected])
+	return summarizeRow(t.Rows[row])
 }
 
 func (t *Table) rowCount() int {
@@ -498,7 +790,7 @@ func (t *Table) ScrollToEnd() {
 	if t == nil || t.rowCount() == 0 {
 		return
 	}
-	t.setSelected(t.rowCount() - 1)
+	t.setSelected(t.viewCount() - 1)
 	t.Invalidate()
 }
 
@@ -506,3 +798,4 @@ var _ scroll.Controller = (*Table)(nil)
 
 var _ runtime.Widget = (*Table)(nil)
 var _ runtime.Focusable = (*Table)(nil)
+var _ runtime.Bindable = (*Table)(nil)
@@ -0,0 +1,36 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestTableClickSelectsAndDoubleClickActivates(t *testing.T) {
+	table := NewTable(TableColumn{Title: "Name"})
+	table.SetRows([][]string{{"alpha"}, {"beta"}, {"gamma"}})
+	var opened []string
+	table.SetOnActivate(func(index int, row []string) {
+		opened = append(opened, row[0])
+	})
+	renderRows(t, table, 10, 4)
+
+	table.HandleMessage(runtime.MouseMsg{X: 1, Y: 2, Button: runtime.MouseLeft, Action: runtime.MousePress, Count: 1})
+	if table.SelectedIndex() != 1 || len(opened) != 0 {
+		t.Fatalf("selected = %d, opened = %v; want row 1 selected without opening", table.SelectedIndex(), opened)
+	}
+	table.HandleMessage(runtime.MouseMsg{X: 1, Y: 3, Button: runtime.MouseLeft, Action: runtime.MousePress, Count: 2})
+	if table.SelectedIndex() != 2 || len(opened) != 1 || opened[0] != "gamma" {
+		t.Fatalf("selected = %d, opened = %v; want gamma opened", table.SelectedIndex(), opened)
+	}
+	if result := table.HandleMessage(runtime.MouseMsg{X: 1, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress, Count: 1}); result.Handled {
+		t.Fatal("expected a header click to be ignored")
+	}
+
+	table.Focus()
+	table.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	if len(opened) != 2 || opened[1] != "gamma" {
+		t.Fatalf("opened = %v, want Enter to open the selected row", opened)
+	}
+}
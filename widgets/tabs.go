@@ -26,6 +26,12 @@ type Tabs struct {
 	selectedStyle backend.Style
 	services      runtime.Services
 	mounted       bool
+	wrap          bool
+	closeable     bool
+	reorderable   bool
+	onClose       func(index int) bool
+	onReorder     func(fromIndex, toIndex int)
+	closeCols     []int // x of each tab's close button, -1 when clipped
 }
 
 // NewTabs creates a tab container.
@@ -90,6 +96,103 @@ func (t *Tabs) SetSelectedStyle(style backend.Style) {
 	t.selectedStyle = style
 }
 
+// SetWrap makes Left on the first tab select the last and Right on the
+// last tab select the first.
+func (t *Tabs) SetWrap(wrap bool) {
+	if t == nil {
+		return
+	}
+	t.wrap = wrap
+}
+
+// SetCloseable shows a close button on each tab. Delete or Ctrl+W closes the
+// selected tab, as does clicking its close button.
+func (t *Tabs) SetCloseable(closeable bool) {
+	if t == nil {
+		return
+	}
+	t.closeable = closeable
+	t.Invalidate()
+}
+
+// SetReorderable lets Alt+Left and Alt+Right move the selected tab.
+func (t *Tabs) SetReorderable(reorderable bool) {
+	if t == nil {
+		return
+	}
+	t.reorderable = reorderable
+}
+
+// OnClose registers a callback run before a tab closes. Returning false
+// keeps the tab open.
+func (t *Tabs) OnClose(fn func(index int) bool) {
+	if t == nil {
+		return
+	}
+	t.onClose = fn
+}
+
+// OnReorder registers a callback run after a tab moves.
+func (t *Tabs) OnReorder(fn func(fromIndex, toIndex int)) {
+	if t == nil {
+		return
+	}
+	t.onReorder = fn
+}
+
+// CloseTab removes the tab at index, unless the OnClose callback cancels
+// it. It reports whether the tab was removed.
+func (t *Tabs) CloseTab(index int) bool {
+	if t == nil || index < 0 || index >= len(t.Tabs) {
+		return false
+	}
+	if t.onClose != nil && !t.onClose(index) {
+		return false
+	}
+	prev := t.selectedTab()
+	var prevContent runtime.Widget
+	if prev != nil {
+		prevContent = prev.Content
+	}
+	t.Tabs = append(t.Tabs[:index], t.Tabs[index+1:]...)
+	if index < t.selected || t.selected >= len(t.Tabs) {
+		t.selected = max(0, t.selected-1)
+	}
+	var nextContent runtime.Widget
+	if next := t.selectedTab(); next != nil {
+		nextContent = next.Content
+	}
+	t.swapContent(prevContent, nextContent)
+	t.layoutSelected()
+	t.syncA11y()
+	t.relayout()
+	return true
+}
+
+// MoveTab moves the tab at from to index to. The selected tab stays
+// selected wherever it ends up.
+func (t *Tabs) MoveTab(from, to int) {
+	if t == nil || from == to || from < 0 || to < 0 || from >= len(t.Tabs) || to >= len(t.Tabs) {
+		return
+	}
+	tab := t.Tabs[from]
+	t.Tabs = append(t.Tabs[:from], t.Tabs[from+1:]...)
+	t.Tabs = append(t.Tabs[:to], append([]Tab{tab}, t.Tabs[to:]...)...)
+	switch {
+	case t.selected == from:
+		t.selected = to
+	case from < t.selected && to >= t.selected:
+		t.selected--
+	case from > t.selected && to <= t.selected:
+		t.selected++
+	}
+	t.syncA11y()
+	t.Invalidate()
+	if t.onReorder != nil {
+		t.onReorder(from, to)
+	}
+}
+
 // StyleType returns the selector type name.
 func (t *Tabs) StyleType() string {
 	return "Tabs"
@@ -143,18 +246,28 @@ func (t *Tabs) Render(ctx runtime.RenderContext) {
 		return
 	}
 	x := content.X
+	t.closeCols = t.closeCols[:0]
 	for i, tab := range t.Tabs {
 		label := " " + tab.Title + " "
+		if t.closeable {
+			label += "× "
+		}
 		style := baseStyle
 		if i == t.selected {
 			style = mergeBackendStyles(baseStyle, t.selectedStyle)
 		}
+		closeCol := -1
 		if x < content.X+content.Width {
 			available := content.Width - (x - content.X)
+			full := textWidth(label)
 			label = truncateString(label, available)
 			ctx.Buffer.SetString(x, content.Y, label, style)
+			if t.closeable && textWidth(label) == full {
+				closeCol = x + full - 2
+			}
 			x += textWidth(label)
 		}
+		t.closeCols = append(t.closeCols, closeCol)
 	}
 	selected := t.selectedTab()
 	if selected != nil && selected.Content != nil {
@@ -162,9 +275,15 @@ func (t *Tabs) Render(ctx runtime.RenderContext) {
 	}
 }
 
-// HandleMessage switches tabs.
+// HandleMessage switches, closes, and reorders tabs.
 func (t *Tabs) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if t == nil || !t.focused {
+	if t == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		return t.handleMouse(mouse)
+	}
+	if !t.focused {
 		return runtime.Unhandled()
 	}
 	key, ok := msg.(runtime.KeyMsg)
@@ -173,15 +292,59 @@ func (t *Tabs) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	}
 	switch key.Key {
 	case terminal.KeyLeft:
-		t.setSelected(t.selected - 1)
+		if key.Alt && t.reorderable {
+			t.MoveTab(t.selected, t.selected-1)
+		} else {
+			t.setSelected(t.step(-1))
+		}
 		return runtime.Handled()
 	case terminal.KeyRight:
-		t.setSelected(t.selected + 1)
+		if key.Alt && t.reorderable {
+			t.MoveTab(t.selected, t.selected+1)
+		} else {
+			t.setSelected(t.step(1))
+		}
 		return runtime.Handled()
+	case terminal.KeyDelete:
+		if t.closeable {
+			t.CloseTab(t.selected)
+			return runtime.Handled()
+		}
+	}
+	if press := editingKeyPress(key); t.closeable && press.Key == terminal.KeyRune && press.Ctrl && press.Rune == 'w' {
+		t.CloseTab(t.selected)
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
+// handleMouse closes the tab whose close button was clicked.
+func (t *Tabs) handleMouse(mouse runtime.MouseMsg) runtime.HandleResult {
+	if !t.closeable || mouse.Button != runtime.MouseLeft || mouse.Action != runtime.MousePress {
+		return runtime.Unhandled()
+	}
+	if mouse.Y != t.ContentBounds().Y {
+		return runtime.Unhandled()
+	}
+	for i, col := range t.closeCols {
+		if col >= 0 && mouse.X == col {
+			t.CloseTab(i)
+			return runtime.Handled()
+		}
 	}
 	return runtime.Unhandled()
 }
 
+// step returns the index delta tabs away from the selection, wrapping when
+// SetWrap is on.
+func (t *Tabs) step(delta int) int {
+	index := t.selected + delta
+	if t.wrap && len(t.Tabs) > 0 {
+		index = (index%len(t.Tabs) + len(t.Tabs)) % len(t.Tabs)
+	}
+	return index
+}
+
 // SelectedIndex returns the current tab index.
 func (t *Tabs) SelectedIndex() int {
 	if t == nil || len(t.Tabs) == 0 {
@@ -272,6 +435,7 @@ func (t *Tabs) setSelected(index int) {
 	t.selected = index
 	t.layoutSelected()
 	t.syncA11y()
+	t.services.AnnounceChange(t)
 	var nextContent runtime.Widget
 	if next := t.selectedTab(); next != nil {
 		nextContent = next.Content
@@ -0,0 +1,137 @@
+package widgets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+	fluffytest "github.com/odvcencio/fluffyui/testing"
+)
+
+func newTestTabs(titles ...string) *Tabs {
+	tabs := make([]Tab, 0, len(titles))
+	for _, title := range titles {
+		tabs = append(tabs, Tab{Title: title, Content: NewLabel(title + " content")})
+	}
+	t := NewTabs(tabs...)
+	t.Focus()
+	return t
+}
+
+func tabTitles(t *Tabs) string {
+	titles := make([]string, 0, len(t.Tabs))
+	for _, tab := range t.Tabs {
+		titles = append(titles, tab.Title)
+	}
+	return strings.Join(titles, ",")
+}
+
+func TestTabsWrap(t *testing.T) {
+	tabs := newTestTabs("A", "B", "C")
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if tabs.SelectedIndex() != 0 {
+		t.Fatalf("expected left on first tab to stay without wrap, got %d", tabs.SelectedIndex())
+	}
+	tabs.SetWrap(true)
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if tabs.SelectedIndex() != 2 {
+		t.Fatalf("expected wrap to last tab, got %d", tabs.SelectedIndex())
+	}
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if tabs.SelectedIndex() != 0 {
+		t.Fatalf("expected wrap to first tab, got %d", tabs.SelectedIndex())
+	}
+}
+
+func TestTabsCloseWithKeys(t *testing.T) {
+	tabs := newTestTabs("A", "B", "C")
+	tabs.SetSelected(1)
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDelete})
+	if len(tabs.Tabs) != 3 {
+		t.Fatalf("expected Delete to do nothing unless closeable")
+	}
+
+	tabs.SetCloseable(true)
+	var closed []int
+	tabs.OnClose(func(index int) bool {
+		closed = append(closed, index)
+		return index != 0
+	})
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDelete})
+	if got := tabTitles(tabs); got != "A,C" {
+		t.Fatalf("tabs after Delete = %q, want A,C", got)
+	}
+	if tabs.SelectedIndex() != 1 {
+		t.Fatalf("expected selection to move to the next tab, got %d", tabs.SelectedIndex())
+	}
+	tabs.HandleMessage(runtime.KeyMsg{Rune: 'w', Ctrl: true})
+	if got := tabTitles(tabs); got != "A" {
+		t.Fatalf("tabs after Ctrl+W = %q, want A", got)
+	}
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyDelete})
+	if got := tabTitles(tabs); got != "A" {
+		t.Fatalf("expected OnClose returning false to keep the tab, got %q", got)
+	}
+	if len(closed) != 3 || closed[0] != 1 || closed[1] != 1 || closed[2] != 0 {
+		t.Fatalf("OnClose indexes = %v", closed)
+	}
+}
+
+func TestTabsCloseButton(t *testing.T) {
+	tabs := newTestTabs("One", "Two")
+	tabs.SetCloseable(true)
+	output := fluffytest.RenderToString(tabs, 30, 3)
+	if !strings.Contains(output, " One × ") {
+		t.Fatalf("expected close button in tab bar, got %q", output)
+	}
+	// " One × " spans columns 0-6 with the button at column 5.
+	result := tabs.HandleMessage(runtime.MouseMsg{X: 5, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if !result.Handled || tabTitles(tabs) != "Two" {
+		t.Fatalf("expected click on close button to close One, got %q", tabTitles(tabs))
+	}
+}
+
+func TestTabsReorder(t *testing.T) {
+	tabs := newTestTabs("A", "B", "C")
+	var moves [][2]int
+	tabs.OnReorder(func(from, to int) {
+		moves = append(moves, [2]int{from, to})
+	})
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Alt: true})
+	if tabTitles(tabs) != "A,B,C" || tabs.SelectedIndex() != 1 {
+		t.Fatalf("expected Alt+Right to select without reorderable, got %q", tabTitles(tabs))
+	}
+
+	tabs.SetReorderable(true)
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Alt: true})
+	if got := tabTitles(tabs); got != "A,C,B" {
+		t.Fatalf("tabs after Alt+Right = %q, want A,C,B", got)
+	}
+	if tabs.SelectedIndex() != 2 {
+		t.Fatalf("expected moved tab to stay selected, got %d", tabs.SelectedIndex())
+	}
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Alt: true})
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Alt: true})
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft, Alt: true})
+	if got := tabTitles(tabs); got != "B,A,C" {
+		t.Fatalf("tabs after Alt+Left twice = %q, want B,A,C", got)
+	}
+	if len(moves) != 3 || moves[0] != [2]int{1, 2} || moves[2] != [2]int{1, 0} {
+		t.Fatalf("OnReorder moves = %v", moves)
+	}
+}
+
+func TestTabsAnnounceSwitch(t *testing.T) {
+	announcer := &accessibility.SimpleAnnouncer{}
+	tabs := newTestTabs("A", "B")
+	tabs.Bind(runtime.NewApp(runtime.AppConfig{Announcer: announcer}).Services())
+
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	tabs.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	history := announcer.History()
+	if len(history) != 1 || !strings.HasSuffix(history[0].Message, ", B") {
+		t.Fatalf("announcements = %v, want one for the switch to B", history)
+	}
+}
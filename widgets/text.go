@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"strings"
+	"time"
 
 	"github.com/odvcencio/fluffyui/accessibility"
 	"github.com/odvcencio/fluffyui/backend"
@@ -187,8 +188,18 @@ type Label struct {
 	alignment Alignment
 	a11yLabel string
 	styleSet  bool
+
+	typeSpeed  time.Duration
+	typeLast   time.Time
+	typed      int
+	typeDone   bool
+	onTypeDone func()
+	showCursor bool
 }
 
+// labelTypeCursor is drawn after the revealed text while typing.
+const labelTypeCursor = "▌"
+
 // LabelOption configures a Label widget.
 type LabelOption = Option[Label]
 
@@ -250,12 +261,53 @@ func NewLabel(text string, opts ...LabelOption) *Label {
 	return l
 }
 
-// SetText updates the label text.
+// SetText updates the label text, restarting the typewriter effect if
+// one is set.
 func (l *Label) SetText(text string) {
 	l.text = text
+	l.restartTypewriter()
 	l.syncA11y()
 }
 
+// SetTypewriter reveals the text one character every speed, driven by
+// TickMsg. A speed of zero or less shows the whole text at once.
+func (l *Label) SetTypewriter(speed time.Duration) {
+	if l == nil {
+		return
+	}
+	l.typeSpeed = max(speed, 0)
+	l.restartTypewriter()
+}
+
+// SetOnTypewriterDone registers a callback for when the typewriter effect
+// has revealed every character.
+func (l *Label) SetOnTypewriterDone(fn func()) {
+	if l == nil {
+		return
+	}
+	l.onTypeDone = fn
+}
+
+// OnTypewriterDone is an alias for SetOnTypewriterDone.
+func (l *Label) OnTypewriterDone(fn func()) {
+	l.SetOnTypewriterDone(fn)
+}
+
+// SetShowCursor draws a cursor after the revealed text while the
+// typewriter effect runs.
+func (l *Label) SetShowCursor(show bool) {
+	if l == nil {
+		return
+	}
+	l.showCursor = show
+	l.Invalidate()
+}
+
+// Typing reports whether the typewriter effect is still revealing text.
+func (l *Label) Typing() bool {
+	return l != nil && l.typeSpeed > 0 && !l.typeDone
+}
+
 // SetA11yLabel overrides the accessibility label without changing visible text.
 func (l *Label) SetA11yLabel(label string) {
 	l.a11yLabel = label
@@ -291,6 +343,58 @@ func (l *Label) WithAlignment(align Alignment) *Label {
 	return l
 }
 
+// restartTypewriter hides the text again so typing starts over.
+func (l *Label) restartTypewriter() {
+	if l == nil {
+		return
+	}
+	l.typed = 0
+	l.typeLast = time.Time{}
+	l.typeDone = false
+	l.Invalidate()
+}
+
+// typewriterTick reveals one character for each speed elapsed since the
+// last one and reports whether any were revealed.
+func (l *Label) typewriterTick(now time.Time) bool {
+	if !l.Typing() {
+		return false
+	}
+	if now.IsZero() {
+		now = time.Now()
+	}
+	if l.typeLast.IsZero() {
+		l.typeLast = now
+		return false
+	}
+	steps := int(now.Sub(l.typeLast) / l.typeSpeed)
+	if steps <= 0 {
+		return false
+	}
+	l.typeLast = l.typeLast.Add(time.Duration(steps) * l.typeSpeed)
+	total := clusterCount(l.text)
+	l.typed = min(l.typed+steps, total)
+	l.Invalidate()
+	if l.typed >= total {
+		l.typeDone = true
+		if l.onTypeDone != nil {
+			l.onTypeDone()
+		}
+	}
+	return true
+}
+
+// HandleMessage advances the typewriter effect on ticks.
+func (l *Label) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if l == nil {
+		return runtime.Unhandled()
+	}
+	if tick, ok := msg.(runtime.TickMsg); ok && l.typewriterTick(tick.Time) {
+		return runtime.Handled()
+	}
+	return runtime.Unhandled()
+}
+
 // Measure returns the size needed for the label.
 func (l *Label) Measure(constraints runtime.Constraints) runtime.Size {
 	return l.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
@@ -333,7 +437,40 @@ func (l *Label) Render(ctx runtime.RenderContext) {
 		}
 		style = final.ToBackend()
 	}
+	if !l.Typing() {
+		ctx.Buffer.SetString(x, bounds.Y, text, style)
+		return
+	}
+	// Position from the full text so revealed characters stay put.
+	text = clusterPrefix(text, l.typed)
 	ctx.Buffer.SetString(x, bounds.Y, text, style)
+	if cursorX := x + textWidth(text); l.showCursor && cursorX < bounds.X+bounds.Width {
+		ctx.Buffer.SetString(cursorX, bounds.Y, labelTypeCursor, style)
+	}
+}
+
+// clusterCount returns the number of grapheme clusters in s.
+func clusterCount(s string) int {
+	count := 0
+	backend.ForEachCluster(s, func(string, int) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// clusterPrefix returns the first n grapheme clusters of s.
+func clusterPrefix(s string, n int) string {
+	end := 0
+	backend.ForEachCluster(s, func(cluster string, _ int) bool {
+		if n <= 0 {
+			return false
+		}
+		end += len(cluster)
+		n--
+		return true
+	})
+	return s[:end]
 }
 
 func (l *Label) syncA11y() {
@@ -18,6 +18,7 @@ type TextArea struct {
 
 	text        []rune
 	cursor      int
+	selection   Selection
 	scrollY     int
 	label       string
 	style       backend.Style
@@ -60,6 +61,7 @@ func (t *TextArea) SetText(text string) {
 	}
 	t.text = []rune(text)
 	t.cursor = len(t.text)
+	t.selection = Selection{}
 	t.syncValue()
 }
 
@@ -302,6 +304,7 @@ func (t *TextArea) Render(ctx runtime.RenderContext) {
 			lineText = lineText[:content.Width]
 		}
 		writePadded(ctx.Buffer, content.X, content.Y+row, content.Width, lineText, style)
+		t.renderSelection(ctx.Buffer, content, row, lineStarts[lineIndex], lineLengths[lineIndex], scrollX, style)
 	}
 
 	if t.focused {
@@ -320,9 +323,15 @@ func (t *TextArea) Render(ctx runtime.RenderContext) {
 	}
 }
 
-// HandleMessage processes keyboard input.
+// HandleMessage processes keyboard input and mouse clicks.
 func (t *TextArea) HandleMessage(msg runtime.Message) runtime.HandleResult {
-	if t == nil || !t.focused {
+	if t == nil {
+		return runtime.Unhandled()
+	}
+	if mouse, ok := msg.(runtime.MouseMsg); ok {
+		return t.handleMouse(mouse)
+	}
+	if !t.focused {
 		return runtime.Unhandled()
 	}
 	key, ok := msg.(runtime.KeyMsg)
@@ -344,42 +353,72 @@ func (t *TextArea) HandleMessage(msg runtime.Message) runtime.HandleResult {
 			return runtime.Handled()
 		}
 	case terminal.KeyEnter:
+		t.deleteSelection()
 		t.insertRune('\n')
 		return runtime.Handled()
 	case terminal.KeyBackspace:
+		if t.deleteSelection() {
+			return runtime.Handled()
+		}
 		if t.cursor > 0 {
 			t.deleteRune(t.cursor - 1)
 		}
 		return runtime.Handled()
 	case terminal.KeyDelete:
+		if t.deleteSelection() {
+			return runtime.Handled()
+		}
 		if t.cursor < len(t.text) {
 			t.deleteRune(t.cursor)
 		}
 		return runtime.Handled()
 	case terminal.KeyLeft:
-		if t.cursor > 0 {
+		if !key.Shift && t.collapseSelection(true) {
+			return runtime.Handled()
+		}
+		prev := t.cursor
+		if key.Ctrl {
+			t.cursor = textAreaWordBoundaryLeft(t.text, t.cursor)
+		} else if t.cursor > 0 {
 			t.cursor--
 		}
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyRight:
-		if t.cursor < len(t.text) {
+		if !key.Shift && t.collapseSelection(false) {
+			return runtime.Handled()
+		}
+		prev := t.cursor
+		if key.Ctrl {
+			t.cursor = textAreaWordBoundaryRight(t.text, t.cursor)
+		} else if t.cursor < len(t.text) {
 			t.cursor++
 		}
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyUp:
+		prev := t.cursor
 		t.moveVertical(-1)
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyDown:
+		prev := t.cursor
 		t.moveVertical(1)
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyHome:
+		prev := t.cursor
 		t.moveLineBoundary(true)
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyEnd:
+		prev := t.cursor
 		t.moveLineBoundary(false)
+		t.finishMove(prev, key.Shift)
 		return runtime.Handled()
 	case terminal.KeyRune:
-		if key.Rune != 0 {
+		if key.Rune != 0 && !key.Ctrl {
+			t.deleteSelection()
 			t.insertRune(key.Rune)
 			return runtime.Handled()
 		}
@@ -387,6 +426,31 @@ func (t *TextArea) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
 }
 
+// handleMouse moves the cursor to a left click, selecting the word under it
+// on a double click and its line on a triple click.
+func (t *TextArea) handleMouse(mouse runtime.MouseMsg) runtime.HandleResult {
+	if mouse.Button != runtime.MouseLeft || mouse.Action != runtime.MousePress {
+		return runtime.Unhandled()
+	}
+	content := t.ContentBounds()
+	if !content.Contains(mouse.X, mouse.Y) {
+		return runtime.Unhandled()
+	}
+	lineStarts, lineLengths := t.lineMeta()
+	_, col := t.cursorLineCol(lineStarts, lineLengths)
+	scrollX := max(0, col-content.Width+1)
+	t.SetCursorPosition(mouse.X-content.X+scrollX, mouse.Y-content.Y+t.scrollY)
+	switch {
+	case mouse.Count == 2:
+		t.SelectWord()
+	case mouse.Count >= 3:
+		t.SelectLine()
+	default:
+		t.SelectNone()
+	}
+	return runtime.Handled()
+}
+
 func (t *TextArea) insertRune(r rune) {
 	t.text = append(t.text[:t.cursor], append([]rune{r}, t.text[t.cursor:]...)...)
 	t.cursor++
@@ -549,19 +613,28 @@ func (t *TextArea) syncA11y() {
 	t.Base.Value = &accessibility.ValueInfo{Text: t.Text()}
 }
 
-// ClipboardCopy returns the current text.
+// ClipboardCopy returns selected text, or all text if no selection.
 func (t *TextArea) ClipboardCopy() (string, bool) {
 	if t == nil {
 		return "", false
 	}
+	if t.HasSelection() {
+		return t.GetSelectedText(), true
+	}
 	return t.Text(), true
 }
 
-// ClipboardCut returns the current text and clears it.
+// ClipboardCut returns selected text and deletes it, or all text if no
+// selection.
 func (t *TextArea) ClipboardCut() (string, bool) {
 	if t == nil {
 		return "", false
 	}
+	if t.HasSelection() {
+		text := t.GetSelectedText()
+		t.deleteSelection()
+		return text, true
+	}
 	text := t.Text()
 	t.text = nil
 	t.cursor = 0
@@ -570,11 +643,12 @@ func (t *TextArea) ClipboardCut() (string, bool) {
 	return text, true
 }
 
-// ClipboardPaste inserts text at the cursor.
+// ClipboardPaste inserts text at the cursor, replacing any selection.
 func (t *TextArea) ClipboardPaste(text string) bool {
 	if t == nil || text == "" {
 		return false
 	}
+	t.deleteSelection()
 	t.insertText(text)
 	return true
 }
@@ -619,6 +693,167 @@ func (t *TextArea) pasteFromClipboard() bool {
 
 var _ clipboard.Target = (*TextArea)(nil)
 
+// GetSelection returns the current selection range.
+func (t *TextArea) GetSelection() Selection {
+	if t == nil {
+		return Selection{}
+	}
+	return t.selection
+}
+
+// SetSelection sets the selection range.
+func (t *TextArea) SetSelection(sel Selection) {
+	if t == nil {
+		return
+	}
+	sel.Start = clampInt(sel.Start, 0, len(t.text))
+	sel.End = clampInt(sel.End, 0, len(t.text))
+	t.selection = sel
+	t.services.Invalidate()
+}
+
+// SelectAll selects all text.
+func (t *TextArea) SelectAll() {
+	t.SetSelection(Selection{Start: 0, End: len(t.text)})
+}
+
+// SelectNone clears the selection.
+func (t *TextArea) SelectNone() {
+	t.SetSelection(Selection{})
+}
+
+// SelectWord selects the word at the cursor position.
+func (t *TextArea) SelectWord() {
+	if t == nil || len(t.text) == 0 {
+		return
+	}
+	start, end := findWordBoundaries(t.text, t.cursor)
+	t.SetSelection(Selection{Start: start, End: end})
+}
+
+// SelectLine selects the line containing the cursor.
+func (t *TextArea) SelectLine() {
+	if t == nil {
+		return
+	}
+	lineStarts, lineLengths := t.lineMeta()
+	line, _ := t.cursorLineCol(lineStarts, lineLengths)
+	start := lineStarts[line]
+	t.SetSelection(Selection{Start: start, End: start + lineLengths[line]})
+}
+
+// HasSelection returns true if text is selected.
+func (t *TextArea) HasSelection() bool {
+	if t == nil {
+		return false
+	}
+	return !t.selection.IsEmpty()
+}
+
+// GetSelectedText returns the currently selected text.
+func (t *TextArea) GetSelectedText() string {
+	if t == nil || t.selection.IsEmpty() {
+		return ""
+	}
+	sel := t.selection.Normalize()
+	sel.End = min(sel.End, len(t.text))
+	if sel.Start >= sel.End {
+		return ""
+	}
+	return string(t.text[sel.Start:sel.End])
+}
+
+// Selection returns the selected rune range with start <= end. Both are
+// equal to the cursor offset when nothing is selected.
+func (t *TextArea) Selection() (start, end int) {
+	if t == nil {
+		return 0, 0
+	}
+	if t.selection.IsEmpty() {
+		return t.cursor, t.cursor
+	}
+	sel := t.selection.Normalize()
+	return sel.Start, sel.End
+}
+
+// SelectedText returns the selected text.
+func (t *TextArea) SelectedText() string {
+	return t.GetSelectedText()
+}
+
+// finishMove updates the selection after the cursor moved from prev. With
+// extend the selection grows from its anchor; otherwise it is cleared.
+func (t *TextArea) finishMove(prev int, extend bool) {
+	if !extend {
+		t.selection = Selection{}
+		return
+	}
+	anchor := prev
+	if !t.selection.IsEmpty() {
+		anchor = selectionAnchor(t.selection, prev)
+	}
+	t.selection = Selection{Start: anchor, End: t.cursor}
+}
+
+func (t *TextArea) collapseSelection(toStart bool) bool {
+	if t.selection.IsEmpty() {
+		return false
+	}
+	sel := t.selection.Normalize()
+	if toStart {
+		t.cursor = sel.Start
+	} else {
+		t.cursor = sel.End
+	}
+	t.selection = Selection{}
+	return true
+}
+
+// deleteSelection removes the selected text. It reports whether anything
+// was selected.
+func (t *TextArea) deleteSelection() bool {
+	if t.selection.IsEmpty() {
+		return false
+	}
+	sel := t.selection.Normalize()
+	sel.Start = clampInt(sel.Start, 0, len(t.text))
+	sel.End = clampInt(sel.End, sel.Start, len(t.text))
+	t.text = append(t.text[:sel.Start], t.text[sel.End:]...)
+	t.cursor = sel.Start
+	t.selection = Selection{}
+	t.syncValue()
+	return true
+}
+
+// renderSelection highlights the selected part of one visible line. A
+// selected line break shows as a highlighted cell after the line.
+func (t *TextArea) renderSelection(buf *runtime.Buffer, content runtime.Rect, row, lineStart, lineLen, scrollX int, style backend.Style) {
+	if t.selection.IsEmpty() {
+		return
+	}
+	sel := t.selection.Normalize()
+	if sel.End <= lineStart || sel.Start > lineStart+lineLen {
+		return
+	}
+	selStyle := selectionStyleFor(style)
+	for col := max(sel.Start-lineStart, scrollX); col <= lineLen; col++ {
+		idx := lineStart + col
+		if idx >= sel.End {
+			break
+		}
+		x := col - scrollX
+		if x >= content.Width {
+			break
+		}
+		ch := ' '
+		if col < lineLen {
+			ch = t.text[idx]
+		}
+		buf.Set(content.X+x, content.Y+row, ch, selStyle)
+	}
+}
+
 var _ runtime.Widget = (*TextArea)(nil)
 var _ runtime.Focusable = (*TextArea)(nil)
 var _ Validatable = (*TextArea)(nil)
+var _ Selectable = (*TextArea)(nil)
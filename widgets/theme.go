@@ -0,0 +1,17 @@
+package widgets
+
+import (
+	"github.com/odvcencio/fluffyui/backend"
+	uistyle "github.com/odvcencio/fluffyui/style"
+	"github.com/odvcencio/fluffyui/theme"
+)
+
+// themeStyle returns a token from the current theme as a backend style.
+// Widgets use it when neither a stylesheet nor an explicit style applies.
+func themeStyle(token string) backend.Style {
+	st, ok := theme.Current().Token(token)
+	if !ok {
+		return backend.DefaultStyle()
+	}
+	return uistyle.ToBackend(st)
+}
@@ -0,0 +1,49 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/runtime"
+	uistyle "github.com/odvcencio/fluffyui/style"
+	"github.com/odvcencio/fluffyui/theme"
+)
+
+func renderForThemeTest(w runtime.Widget, width, height int) *runtime.Buffer {
+	bounds := runtime.Rect{Width: width, Height: height}
+	w.Measure(runtime.Constraints{MaxWidth: width, MaxHeight: height})
+	w.Layout(bounds)
+	buf := runtime.NewBuffer(width, height)
+	w.Render(runtime.RenderContext{Buffer: buf, Bounds: bounds})
+	return buf
+}
+
+func TestWidgetsResolveColorsThroughTheme(t *testing.T) {
+	defer theme.Set(nil)
+	light := theme.LightTheme()
+	theme.Set(light)
+
+	btn := NewButton("OK", WithVariant(VariantDanger))
+	buf := renderForThemeTest(btn, 6, 1)
+	if got, want := buf.Get(1, 0).Style.FG(), uistyle.ToBackend(light.Error).FG(); got != want {
+		t.Fatalf("danger button FG = %v, want %v", got, want)
+	}
+
+	alert := NewAlert("Saved", AlertSuccess)
+	buf = renderForThemeTest(alert, 10, 1)
+	if got, want := buf.Get(0, 0).Style.FG(), uistyle.ToBackend(light.Success).FG(); got != want {
+		t.Fatalf("success alert FG = %v, want %v", got, want)
+	}
+
+	panel := NewPanel(nil)
+	panel.SetBorder(true)
+	buf = renderForThemeTest(panel, 4, 3)
+	if got, want := buf.Get(0, 0).Style.FG(), uistyle.ToBackend(light.Border).FG(); got != want {
+		t.Fatalf("panel border FG = %v, want %v", got, want)
+	}
+
+	theme.Set(theme.DefaultTheme())
+	buf = renderForThemeTest(btn, 6, 1)
+	if got, want := buf.Get(1, 0).Style.FG(), uistyle.ToBackend(theme.DefaultTheme().Error).FG(); got != want {
+		t.Fatalf("expected button to follow theme switch, FG = %v want %v", got, want)
+	}
+}
@@ -11,6 +11,16 @@ import (
 	"github.com/odvcencio/fluffyui/terminal"
 )
 
+// HourFormat selects 24-hour or 12-hour display for a TimePicker.
+type HourFormat int
+
+const (
+	// Hour24 shows hours 00-23.
+	Hour24 HourFormat = iota
+	// Hour12 shows hours 1-12 followed by an AM/PM column.
+	Hour12
+)
+
 // TimePicker allows selecting a time of day.
 type TimePicker struct {
 	FocusableBase
@@ -19,6 +29,7 @@ type TimePicker struct {
 	minute      int
 	second      int
 	showSeconds bool
+	hourFormat  HourFormat
 	selected    int
 	label       string
 	style       backend.Style
@@ -59,10 +70,37 @@ func (t *TimePicker) SetShowSeconds(show bool) {
 	if t == nil {
 		return
 	}
+	onPeriod := t.selected == t.periodField()
 	t.showSeconds = show
-	if !show && t.selected > 1 {
-		t.selected = 1
+	if onPeriod {
+		t.selected = t.periodField()
+	} else if t.selected > t.maxFieldIndex() {
+		t.selected = t.maxFieldIndex()
+	}
+	t.syncA11y()
+}
+
+// SetHourFormat switches between 24-hour and 12-hour display. In 12-hour
+// mode an AM/PM column follows the time; a and p set it while it is
+// selected.
+func (t *TimePicker) SetHourFormat(format HourFormat) {
+	if t == nil {
+		return
+	}
+	onPeriod := t.selected >= 0 && t.selected == t.periodField()
+	t.hourFormat = format
+	if onPeriod || t.selected > t.maxFieldIndex() {
+		t.selected = t.maxFieldIndex()
+	}
+	t.syncA11y()
+}
+
+// HourFormat returns the hour display format.
+func (t *TimePicker) HourFormat() HourFormat {
+	if t == nil {
+		return Hour24
 	}
+	return t.hourFormat
 }
 
 // SetLabel updates the accessibility label.
@@ -102,6 +140,27 @@ func (t *TimePicker) SetTime(value time.Time) {
 	t.syncA11y()
 }
 
+// SetValue sets the hour, minute, and second from value, ignoring its
+// date.
+func (t *TimePicker) SetValue(value time.Time) {
+	if t == nil {
+		return
+	}
+	t.hour = value.Hour()
+	t.minute = value.Minute()
+	t.second = value.Second()
+	t.syncA11y()
+}
+
+// Value returns the selected time of day on today's date.
+func (t *TimePicker) Value() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	today := t.now()
+	return time.Date(today.Year(), today.Month(), today.Day(), t.hour, t.minute, t.second, 0, today.Location())
+}
+
 // Time returns the current time selection.
 func (t *TimePicker) Time() time.Time {
 	if t == nil {
@@ -121,6 +180,9 @@ func (t *TimePicker) Measure(constraints runtime.Constraints) runtime.Size {
 	if t.showSeconds {
 		width = 8
 	}
+	if t.hourFormat == Hour12 {
+		width += 3
+	}
 	return constraints.Constrain(runtime.Size{Width: width, Height: 1})
 }
 
@@ -139,7 +201,11 @@ func (t *TimePicker) Render(ctx runtime.RenderContext) {
 	ctx.Buffer.Fill(outer, ' ', style)
 
 	x := outer.X
-	fields := []string{fmt.Sprintf("%02d", t.hour), fmt.Sprintf("%02d", t.minute)}
+	hour := t.hour
+	if t.hourFormat == Hour12 {
+		hour = hour12(t.hour)
+	}
+	fields := []string{fmt.Sprintf("%02d", hour), fmt.Sprintf("%02d", t.minute)}
 	if t.showSeconds {
 		fields = append(fields, fmt.Sprintf("%02d", t.second))
 	}
@@ -155,6 +221,13 @@ func (t *TimePicker) Render(ctx runtime.RenderContext) {
 			x += 1
 		}
 	}
+	if t.hourFormat == Hour12 {
+		periodStyle := style
+		if t.selected == t.periodField() {
+			periodStyle = selectedStyle
+		}
+		ctx.Buffer.SetString(x+1, outer.Y, t.period(), periodStyle)
+	}
 }
 
 // HandleMessage processes keyboard input.
@@ -189,6 +262,17 @@ func (t *TimePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		}
 		return runtime.Handled()
 	case terminal.KeyRune:
+		if t.selected == t.periodField() {
+			switch key.Rune {
+			case 'a', 'A':
+				t.setPM(false)
+				return runtime.Handled()
+			case 'p', 'P':
+				t.setPM(true)
+				return runtime.Handled()
+			}
+			break
+		}
 		if key.Rune >= '0' && key.Rune <= '9' {
 			t.handleDigit(int(key.Rune - '0'))
 			return runtime.Handled()
@@ -198,12 +282,54 @@ func (t *TimePicker) HandleMessage(msg runtime.Message) runtime.HandleResult {
 }
 
 func (t *TimePicker) maxFieldIndex() int {
+	if t.hourFormat == Hour12 {
+		return t.periodField()
+	}
 	if t.showSeconds {
 		return 2
 	}
 	return 1
 }
 
+// periodField returns the index of the AM/PM column, or -1 in 24-hour
+// mode.
+func (t *TimePicker) periodField() int {
+	if t.hourFormat != Hour12 {
+		return -1
+	}
+	if t.showSeconds {
+		return 3
+	}
+	return 2
+}
+
+func (t *TimePicker) period() string {
+	if t.hour >= 12 {
+		return "PM"
+	}
+	return "AM"
+}
+
+func (t *TimePicker) setPM(pm bool) {
+	if pm == (t.hour >= 12) {
+		return
+	}
+	if pm {
+		t.hour += 12
+	} else {
+		t.hour -= 12
+	}
+	t.notifyChange()
+}
+
+// hour12 converts a 0-23 hour to the 1-12 clock.
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
 func (t *TimePicker) incrementField(delta int) {
 	if t == nil {
 		return
@@ -216,7 +342,15 @@ func (t *TimePicker) incrementField(delta int) {
 		return value % max
 	}
 	switch t.selected {
+	case t.periodField():
+		t.setPM(t.hour < 12)
+		return
 	case 0:
+		if t.hourFormat == Hour12 {
+			// Wrap within 1-12 and keep the period.
+			t.hour = apply(t.hour%12, 12) + t.hour/12*12
+			break
+		}
 		t.hour = apply(t.hour, 24)
 	case 1:
 		t.minute = apply(t.minute, 60)
@@ -257,6 +391,10 @@ func (t *TimePicker) handleDigit(digit int) {
 func (t *TimePicker) setFieldValue(value int) {
 	switch t.selected {
 	case 0:
+		if t.hourFormat == Hour12 {
+			t.hour = clampInt(value, 1, 12)%12 + t.hour/12*12
+			break
+		}
 		t.hour = clampInt(value, 0, 23)
 	case 1:
 		t.minute = clampInt(value, 0, 59)
@@ -289,6 +427,12 @@ func (t *TimePicker) syncA11y() {
 }
 
 func (t *TimePicker) format() string {
+	if t.hourFormat == Hour12 {
+		if t.showSeconds {
+			return "3:04:05 PM"
+		}
+		return "3:04 PM"
+	}
 	if t.showSeconds {
 		return "15:04:05"
 	}
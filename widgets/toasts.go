@@ -23,18 +23,48 @@ const (
 	toastSlideOff = 1
 )
 
+// ToastPosition selects the corner or edge a ToastStack anchors to.
+type ToastPosition int
+
+const (
+	ToastTopRight ToastPosition = iota
+	ToastTopLeft
+	ToastBottomRight
+	ToastBottomLeft
+	ToastTopCenter
+	ToastBottomCenter
+)
+
+func (p ToastPosition) top() bool {
+	return p == ToastTopRight || p == ToastTopLeft || p == ToastTopCenter
+}
+
 type toastRect struct {
 	id     string
 	bounds runtime.Rect
+	action runtime.Rect
 	toast  *toast.Toast
 }
 
+// toastPlacement remembers where a toast was drawn so it can glide to a
+// new row when the stack reflows.
+type toastPlacement struct {
+	target int
+	from   int
+	moved  time.Time
+}
+
 // ToastStack renders toast notifications.
 type ToastStack struct {
 	Base
 	toasts     []*toast.Toast
 	onDismiss  func(id string)
+	onAction   func(id string)
 	toastRects []toastRect
+	moreRect   runtime.Rect
+	placements map[string]toastPlacement
+	position   ToastPosition
+	maxVisible int
 	now        time.Time
 	animate    bool
 	label      string
@@ -51,6 +81,8 @@ type ToastStack struct {
 func NewToastStack() *ToastStack {
 	stack := &ToastStack{
 		label:        "Toasts",
+		position:     ToastBottomRight,
+		placements:   make(map[string]toastPlacement),
 		bgStyle:      backend.DefaultStyle(),
 		textStyle:    backend.DefaultStyle(),
 		infoStyle:    backend.DefaultStyle(),
@@ -86,6 +118,26 @@ func (t *ToastStack) SetOnDismiss(fn func(id string)) {
 	t.onDismiss = fn
 }
 
+// SetOnAction registers a handler for toast action buttons. Without one, a
+// click runs the action's OnClick and then dismisses the toast.
+func (t *ToastStack) SetOnAction(fn func(id string)) {
+	t.onAction = fn
+}
+
+// SetPosition anchors the stack to a corner or edge. The newest toast sits
+// closest to the anchor. The default is ToastBottomRight.
+func (t *ToastStack) SetPosition(position ToastPosition) {
+	t.position = position
+	t.Invalidate()
+}
+
+// SetMaxVisible caps the number of toasts drawn. The rest collapse into a
+// "+N more" line. Zero shows as many as fit.
+func (t *ToastStack) SetMaxVisible(n int) {
+	t.maxVisible = max(n, 0)
+	t.Invalidate()
+}
+
 // SetNow updates the animation timestamp.
 func (t *ToastStack) SetNow(now time.Time) {
 	t.now = now
@@ -113,6 +165,14 @@ func (t *ToastStack) Measure(constraints runtime.Constraints) runtime.Size {
 	})
 }
 
+// toastLayout is a toast sized and placed for drawing.
+type toastLayout struct {
+	toast  *toast.Toast
+	lines  []string
+	prefix string
+	rect   runtime.Rect
+}
+
 // Render draws the toast stack.
 func (t *ToastStack) Render(ctx runtime.RenderContext) {
 	bounds := t.ContentBounds()
@@ -130,19 +190,9 @@ func (t *ToastStack) Render(ctx runtime.RenderContext) {
 	baseError := mergeBackendStyles(baseStyle, t.errorStyle)
 
 	t.toastRects = t.toastRects[:0]
+	t.moreRect = runtime.Rect{}
 	if len(t.toasts) == 0 {
-		return
-	}
-
-	availableWidth := bounds.Width - 2*toastMargin
-	if availableWidth <= 0 {
-		return
-	}
-	maxWidth := min(toastMaxWidth, availableWidth)
-	if maxWidth < toastMinWidth {
-		maxWidth = availableWidth
-	}
-	if maxWidth <= 0 {
+		clear(t.placements)
 		return
 	}
 
@@ -150,54 +200,39 @@ func (t *ToastStack) Render(ctx runtime.RenderContext) {
 	if now.IsZero() {
 		now = time.Now()
 	}
+	layouts, hidden := t.layoutToasts(bounds)
+	t.reflow(layouts, now)
+
 	slideDuration := time.Duration(toastSlideMs) * time.Millisecond
 	fadeDuration := time.Duration(toastFadeMs) * time.Millisecond
-	y := bounds.Y + bounds.Height - 1 - toastMargin
-	for i := len(t.toasts) - 1; i >= 0; i-- {
-		toast := t.toasts[i]
-		if toast == nil {
-			continue
-		}
-		lines, prefix := t.toastLines(toast, maxWidth-2*toastPaddingX)
-		if len(lines) == 0 {
-			continue
-		}
-		width := maxLineLen(lines) + 2*toastPaddingX
-		if width < toastMinWidth {
-			width = toastMinWidth
-		}
-		if width > maxWidth {
-			width = maxWidth
-		}
-		height := len(lines)
-
-		yStart := y - height + 1
+	for _, layout := range layouts {
+		toast := layout.toast
+		rect := layout.rect
+		rect.Y = t.animatedRow(toast.ID, now)
 		age := now.Sub(toast.CreatedAt)
 		remaining := toast.Duration - age
-		slideOffset := 0
 		fade := false
 		if t.animate && !toast.CreatedAt.IsZero() {
 			if age < slideDuration {
-				progress := float64(age) / float64(slideDuration)
-				slideOffset = int(math.Round(float64(toastSlideOff) * (1 - progress)))
-				if slideOffset < 0 {
-					slideOffset = 0
+				progress := max(float64(age)/float64(slideDuration), 0)
+				slideOffset := max(int(math.Round(float64(toastSlideOff)*(1-progress))), 0)
+				if t.position.top() {
+					rect.Y -= slideOffset
+				} else {
+					rect.Y += slideOffset
 				}
 			}
-			if remaining > 0 && remaining < fadeDuration {
+			if toast.Duration > 0 && remaining > 0 && remaining < fadeDuration {
 				fade = true
 			}
 		}
-		yStart += slideOffset
-		if yStart < bounds.Y {
-			break
-		}
-		x := bounds.X + bounds.Width - width - toastMargin
-		rect := runtime.Rect{X: x, Y: yStart, Width: width, Height: height}
-		t.toastRects = append(t.toastRects, toastRect{id: toast.ID, bounds: rect, toast: toast})
+		hit := toastRect{id: toast.ID, bounds: rect, toast: toast}
 
-		for lineIdx, line := range lines {
+		for lineIdx, line := range layout.lines {
 			row := runtime.Rect{X: rect.X, Y: rect.Y + lineIdx, Width: rect.Width, Height: 1}
+			if row.Y < bounds.Y || row.Y >= bounds.Y+bounds.Height {
+				continue
+			}
 			bgStyle := baseBG
 			textStyle := baseText
 			infoStyle := baseInfo
@@ -217,20 +252,169 @@ func (t *ToastStack) Render(ctx runtime.RenderContext) {
 				continue
 			}
 			startX := rect.X + toastPaddingX
-			if lineIdx == 0 && prefix != "" {
-				prefixWidth := textWidth(prefix)
-				ctx.Buffer.SetString(startX, row.Y, prefix, levelStyle(toast.Level, infoStyle, successStyle, warnStyle, errorStyle))
-				ctx.Buffer.SetString(startX+prefixWidth, row.Y, line[len(prefix):], textStyle)
-			} else {
+			accent := levelStyle(toast.Level, infoStyle, successStyle, warnStyle, errorStyle)
+			switch {
+			case lineIdx == 0 && layout.prefix != "":
+				prefixWidth := textWidth(layout.prefix)
+				ctx.Buffer.SetString(startX, row.Y, layout.prefix, accent)
+				ctx.Buffer.SetString(startX+prefixWidth, row.Y, line[len(layout.prefix):], textStyle)
+			case lineIdx == len(layout.lines)-1 && hasToastAction(toast):
+				width := textWidth(line)
+				actionX := rect.X + rect.Width - toastPaddingX - width
+				ctx.Buffer.SetString(actionX, row.Y, line, accent.Bold(true))
+				hit.action = runtime.Rect{X: actionX, Y: row.Y, Width: width, Height: 1}
+			default:
 				ctx.Buffer.SetString(startX, row.Y, line, textStyle)
 			}
 		}
+		t.toastRects = append(t.toastRects, hit)
+	}
+
+	if hidden > 0 {
+		t.renderMore(ctx, bounds, layouts, hidden, baseBG, baseText)
+	}
+}
+
+// layoutToasts places the newest toasts outward from the anchor and
+// reports how many did not fit or exceed the visible limit.
+func (t *ToastStack) layoutToasts(bounds runtime.Rect) ([]toastLayout, int) {
+	var candidates []*toast.Toast
+	for i := len(t.toasts) - 1; i >= 0; i-- {
+		if t.toasts[i] != nil {
+			candidates = append(candidates, t.toasts[i])
+		}
+	}
+	availableWidth := bounds.Width - 2*toastMargin
+	if availableWidth <= 0 {
+		return nil, len(candidates)
+	}
+	maxWidth := min(toastMaxWidth, availableWidth)
+	if maxWidth < toastMinWidth {
+		maxWidth = availableWidth
+	}
 
-		y = yStart - toastSpacing
-		if y < bounds.Y {
+	limit := len(candidates)
+	if t.maxVisible > 0 {
+		limit = min(limit, t.maxVisible)
+	}
+	top := t.position.top()
+	space := bounds.Height - toastMargin
+	cursor := bounds.Y + toastMargin
+	if !top {
+		cursor = bounds.Y + bounds.Height - toastMargin
+	}
+	var layouts []toastLayout
+	for i := 0; i < limit; i++ {
+		toast := candidates[i]
+		lines, prefix := t.toastLines(toast, maxWidth-2*toastPaddingX)
+		if len(lines) == 0 {
+			continue
+		}
+		height := len(lines)
+		// Keep a row for the "+N more" line when toasts remain after this one.
+		reserve := 0
+		if i < len(candidates)-1 {
+			reserve = 1
+		}
+		if height+reserve > space {
 			break
 		}
+		width := min(max(maxLineLen(lines)+2*toastPaddingX, toastMinWidth), maxWidth)
+		rect := runtime.Rect{X: t.toastX(bounds, width), Width: width, Height: height}
+		if top {
+			rect.Y = cursor
+			cursor += height + toastSpacing
+		} else {
+			rect.Y = cursor - height
+			cursor = rect.Y - toastSpacing
+		}
+		space -= height + toastSpacing
+		layouts = append(layouts, toastLayout{toast: toast, lines: lines, prefix: prefix, rect: rect})
+	}
+	return layouts, len(candidates) - len(layouts)
+}
+
+func (t *ToastStack) toastX(bounds runtime.Rect, width int) int {
+	switch t.position {
+	case ToastTopLeft, ToastBottomLeft:
+		return bounds.X + toastMargin
+	case ToastTopCenter, ToastBottomCenter:
+		return bounds.X + (bounds.Width-width)/2
+	default:
+		return bounds.X + bounds.Width - width - toastMargin
+	}
+}
+
+// reflow records each toast's target row and starts a glide from the row
+// it was drawn at when the target changes.
+func (t *ToastStack) reflow(layouts []toastLayout, now time.Time) {
+	if t.placements == nil {
+		t.placements = make(map[string]toastPlacement)
+	}
+	seen := make(map[string]bool, len(layouts))
+	for _, layout := range layouts {
+		id := layout.toast.ID
+		seen[id] = true
+		target := layout.rect.Y
+		placement, ok := t.placements[id]
+		switch {
+		case !ok || !t.animate:
+			placement = toastPlacement{target: target, from: target}
+		case placement.target != target:
+			placement = toastPlacement{target: target, from: t.animatedRow(id, now), moved: now}
+		}
+		t.placements[id] = placement
+	}
+	for id := range t.placements {
+		if !seen[id] {
+			delete(t.placements, id)
+		}
+	}
+}
+
+// animatedRow returns the row a toast is drawn at while it glides to its
+// target.
+func (t *ToastStack) animatedRow(id string, now time.Time) int {
+	placement := t.placements[id]
+	slideDuration := time.Duration(toastSlideMs) * time.Millisecond
+	age := now.Sub(placement.moved)
+	if placement.moved.IsZero() || age >= slideDuration || age < 0 {
+		return placement.target
+	}
+	progress := float64(age) / float64(slideDuration)
+	return placement.from + int(math.Round(float64(placement.target-placement.from)*progress))
+}
+
+// renderMore draws the overflow indicator just past the last visible
+// toast.
+func (t *ToastStack) renderMore(ctx runtime.RenderContext, bounds runtime.Rect, layouts []toastLayout, hidden int, bg, text backend.Style) {
+	label := truncateString(fmt.Sprintf("+%d more", hidden), max(bounds.Width-2*toastMargin-2*toastPaddingX, 0))
+	if label == "" {
+		return
+	}
+	width := textWidth(label) + 2*toastPaddingX
+	y := bounds.Y + bounds.Height - 1 - toastMargin
+	if t.position.top() {
+		y = bounds.Y + toastMargin
+	}
+	if len(layouts) > 0 {
+		last := layouts[len(layouts)-1].rect
+		if t.position.top() {
+			y = last.Y + last.Height
+		} else {
+			y = last.Y - 1
+		}
+	}
+	if y < bounds.Y || y >= bounds.Y+bounds.Height {
+		return
 	}
+	t.moreRect = runtime.Rect{X: t.toastX(bounds, width), Y: y, Width: width, Height: 1}
+	ctx.Buffer.Fill(t.moreRect, ' ', bg)
+	ctx.Buffer.SetString(t.moreRect.X+toastPaddingX, y, label, text.Dim(true))
+}
+
+func hasToastAction(toast *toast.Toast) bool {
+	return toast.Action != nil && strings.TrimSpace(toast.Action.Label) != ""
 }
 
 func (t *ToastStack) syncA11y() {
@@ -258,8 +442,17 @@ func (t *ToastStack) syncA11y() {
 	t.Base.Value = &accessibility.ValueInfo{Text: text}
 }
 
-// HandleMessage handles dismiss clicks.
+// HandleMessage advances animations on ticks and handles action and
+// dismiss clicks.
 func (t *ToastStack) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if tick, ok := msg.(runtime.TickMsg); ok {
+		t.now = tick.Time
+		if !t.HasActiveAnimations(tick.Time) {
+			return runtime.Unhandled()
+		}
+		t.Invalidate()
+		return runtime.Handled()
+	}
 	mouse, ok := msg.(runtime.MouseMsg)
 	if !ok {
 		return runtime.Unhandled()
@@ -268,6 +461,10 @@ func (t *ToastStack) HandleMessage(msg runtime.Message) runtime.HandleResult {
 		return runtime.Unhandled()
 	}
 	for _, rect := range t.toastRects {
+		if rect.action.Contains(mouse.X, mouse.Y) {
+			t.invokeAction(rect)
+			return runtime.Handled()
+		}
 		if rect.bounds.Contains(mouse.X, mouse.Y) {
 			if t.onDismiss != nil {
 				t.onDismiss(rect.id)
@@ -278,6 +475,19 @@ func (t *ToastStack) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	return runtime.Unhandled()
 }
 
+func (t *ToastStack) invokeAction(rect toastRect) {
+	if t.onAction != nil {
+		t.onAction(rect.id)
+		return
+	}
+	if rect.toast.Action.OnClick != nil {
+		rect.toast.Action.OnClick()
+	}
+	if t.onDismiss != nil {
+		t.onDismiss(rect.id)
+	}
+}
+
 // ToastAt returns the toast under the given point.
 func (t *ToastStack) ToastAt(x, y int) (*toast.Toast, bool) {
 	for _, rect := range t.toastRects {
@@ -304,7 +514,12 @@ func (t *ToastStack) HasActiveAnimations(now time.Time) bool {
 			return true
 		}
 		remaining := toast.Duration - age
-		if remaining > 0 && remaining < fadeDuration {
+		if toast.Duration > 0 && remaining > 0 && remaining < fadeDuration {
+			return true
+		}
+	}
+	for _, placement := range t.placements {
+		if !placement.moved.IsZero() && now.Sub(placement.moved) < slideDuration {
 			return true
 		}
 	}
@@ -325,13 +540,13 @@ func (t *ToastStack) toastLines(toast *toast.Toast, maxWidth int) ([]string, str
 
 	lines := []string{titleLine}
 
-	message := strings.TrimSpace(toast.Message)
+	message := strings.TrimSpace(toast.DisplayMessage())
 	if message != "" {
-		if toast.Action != nil && strings.TrimSpace(toast.Action.Label) != "" {
-			message = message + " [" + strings.TrimSpace(toast.Action.Label) + "]"
-		}
 		lines = append(lines, truncateString(message, maxWidth))
 	}
+	if hasToastAction(toast) {
+		lines = append(lines, truncateString("["+strings.TrimSpace(toast.Action.Label)+"]", maxWidth))
+	}
 
 	return lines, prefix
 }
@@ -1,7 +1,9 @@
 package widgets
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/odvcencio/fluffyui/runtime"
 	"github.com/odvcencio/fluffyui/toast"
@@ -48,3 +50,194 @@ func TestToastStackUnhandledWithoutToasts(t *testing.T) {
 		t.Fatal("expected unhandled without toasts")
 	}
 }
+
+func renderToastStack(stack *ToastStack, width, height int) *runtime.Buffer {
+	stack.Layout(runtime.Rect{X: 0, Y: 0, Width: width, Height: height})
+	buf := runtime.NewBuffer(width, height)
+	stack.Render(runtime.RenderContext{Buffer: buf})
+	return buf
+}
+
+func TestToastStackPositions(t *testing.T) {
+	tests := []struct {
+		position ToastPosition
+		x, y     int
+	}{
+		{ToastTopLeft, 1, 1},
+		{ToastTopRight, 19, 1},
+		{ToastTopCenter, 10, 1},
+		{ToastBottomLeft, 1, 7},
+		{ToastBottomRight, 19, 7},
+		{ToastBottomCenter, 10, 7},
+	}
+	for _, tt := range tests {
+		stack := NewToastStack()
+		stack.SetPosition(tt.position)
+		stack.SetToasts([]*toast.Toast{{ID: "a", Title: "Saved", Message: "Done"}})
+		renderToastStack(stack, 40, 10)
+		if len(stack.toastRects) != 1 {
+			t.Fatalf("position %d: expected 1 toast, got %d", tt.position, len(stack.toastRects))
+		}
+		if got := stack.toastRects[0].bounds; got.X != tt.x || got.Y != tt.y {
+			t.Fatalf("position %d: toast at (%d,%d), want (%d,%d)", tt.position, got.X, got.Y, tt.x, tt.y)
+		}
+	}
+}
+
+func TestToastStackNewestNearestAnchor(t *testing.T) {
+	stack := NewToastStack()
+	stack.SetPosition(ToastTopRight)
+	stack.SetToasts([]*toast.Toast{
+		{ID: "old", Title: "Old"},
+		{ID: "new", Title: "New"},
+	})
+	renderToastStack(stack, 40, 10)
+	if len(stack.toastRects) != 2 {
+		t.Fatalf("expected 2 toasts, got %d", len(stack.toastRects))
+	}
+	if stack.toastRects[0].id != "new" || stack.toastRects[0].bounds.Y != 1 {
+		t.Fatalf("newest toast should be first at the top, got %+v", stack.toastRects[0])
+	}
+	if stack.toastRects[1].bounds.Y != 3 {
+		t.Fatalf("older toast should sit below with spacing, got y=%d", stack.toastRects[1].bounds.Y)
+	}
+}
+
+func TestToastStackMaxVisibleCollapses(t *testing.T) {
+	stack := NewToastStack()
+	stack.SetMaxVisible(2)
+	stack.SetToasts([]*toast.Toast{
+		{ID: "1", Title: "One"},
+		{ID: "2", Title: "Two"},
+		{ID: "3", Title: "Three"},
+		{ID: "4", Title: "Four"},
+	})
+	buf := renderToastStack(stack, 40, 12)
+	if len(stack.toastRects) != 2 {
+		t.Fatalf("expected 2 visible toasts, got %d", len(stack.toastRects))
+	}
+	if stack.toastRects[0].id != "4" || stack.toastRects[1].id != "3" {
+		t.Fatalf("expected newest toasts visible, got %s and %s", stack.toastRects[0].id, stack.toastRects[1].id)
+	}
+	more := stack.moreRect
+	if more.Width == 0 || more.Y != stack.toastRects[1].bounds.Y-1 {
+		t.Fatalf("expected +N more above the last toast, got %+v", more)
+	}
+	if got := bufferRow(buf, more.Y); !strings.Contains(got, "+2 more") {
+		t.Fatalf("expected overflow indicator, got %q", got)
+	}
+}
+
+func TestToastStackSmallTerminal(t *testing.T) {
+	stack := NewToastStack()
+	stack.SetToasts([]*toast.Toast{
+		{ID: "1", Title: "One", Message: "first"},
+		{ID: "2", Title: "Two", Message: "second"},
+		{ID: "3", Title: "Three", Message: "third"},
+	})
+	buf := renderToastStack(stack, 12, 4)
+	if len(stack.toastRects) != 1 {
+		t.Fatalf("expected 1 toast to fit, got %d", len(stack.toastRects))
+	}
+	rect := stack.toastRects[0].bounds
+	if rect.X < 0 || rect.X+rect.Width > 12 || rect.Y < 0 || rect.Y+rect.Height > 4 {
+		t.Fatalf("toast %+v escapes a 12x4 screen", rect)
+	}
+	if got := bufferRow(buf, stack.moreRect.Y); !strings.Contains(got, "+2 more") {
+		t.Fatalf("expected overflow indicator, got %q", got)
+	}
+
+	tiny := NewToastStack()
+	tiny.SetToasts([]*toast.Toast{{ID: "1", Title: "One", Message: "first"}})
+	renderToastStack(tiny, 2, 1)
+	if len(tiny.toastRects) != 0 {
+		t.Fatalf("expected nothing drawn on a 2x1 screen, got %d toasts", len(tiny.toastRects))
+	}
+}
+
+func TestToastStackActionClick(t *testing.T) {
+	stack := NewToastStack()
+	var clicked bool
+	var dismissed string
+	stack.SetOnDismiss(func(id string) { dismissed = id })
+	stack.SetToasts([]*toast.Toast{{
+		ID:      "a",
+		Title:   "Deleted",
+		Message: "1 file",
+		Action:  &toast.ToastAction{Label: "Undo", OnClick: func() { clicked = true }},
+	}})
+	buf := renderToastStack(stack, 40, 10)
+
+	action := stack.toastRects[0].action
+	if action.Width != len("[Undo]") {
+		t.Fatalf("expected action button rect, got %+v", action)
+	}
+	if got := bufferRow(buf, action.Y); !strings.Contains(got, "[Undo]") {
+		t.Fatalf("expected action button, got %q", got)
+	}
+	stack.HandleMessage(runtime.MouseMsg{X: action.X + 1, Y: action.Y, Button: runtime.MouseLeft, Action: runtime.MouseRelease})
+	if !clicked || dismissed != "a" {
+		t.Fatalf("clicked=%v dismissed=%q, want action run and toast dismissed", clicked, dismissed)
+	}
+}
+
+func TestToastStackSlideAndReflowOnTick(t *testing.T) {
+	start := time.Now()
+	stack := NewToastStack()
+	stack.SetNow(start)
+	stack.SetToasts([]*toast.Toast{
+		{ID: "old", Title: "Old", Duration: time.Hour, CreatedAt: start.Add(-time.Minute)},
+		{ID: "new", Title: "New", Duration: time.Hour, CreatedAt: start.Add(-time.Minute)},
+	})
+	renderToastStack(stack, 40, 10)
+	oldY := stack.toastRects[1].bounds.Y
+
+	// Dismissing the newest toast moves the older one to the anchor.
+	stack.SetToasts([]*toast.Toast{{ID: "old", Title: "Old", Duration: time.Hour, CreatedAt: start.Add(-time.Minute)}})
+	renderToastStack(stack, 40, 10)
+	if got := stack.toastRects[0].bounds.Y; got != oldY {
+		t.Fatalf("reflow should start from the previous row %d, got %d", oldY, got)
+	}
+	if !stack.HasActiveAnimations(start) {
+		t.Fatal("expected reflow to be animating")
+	}
+
+	done := start.Add(time.Second)
+	if result := stack.HandleMessage(runtime.TickMsg{Time: done}); result.Handled {
+		t.Fatal("expected tick after the animation to be unhandled")
+	}
+	renderToastStack(stack, 40, 10)
+	if got := stack.toastRects[0].bounds.Y; got != 8 {
+		t.Fatalf("expected toast at the anchor row 8, got %d", got)
+	}
+
+	fresh := &toast.Toast{ID: "fresh", Title: "Fresh", Duration: time.Hour, CreatedAt: done}
+	stack.SetToasts([]*toast.Toast{fresh})
+	if result := stack.HandleMessage(runtime.TickMsg{Time: done}); !result.Handled {
+		t.Fatal("expected tick during slide-in to be handled")
+	}
+	renderToastStack(stack, 40, 10)
+	if got := stack.toastRects[0].bounds.Y; got != 9 {
+		t.Fatalf("new toast should slide in from below, got y=%d", got)
+	}
+}
+
+func bufferRow(buf *runtime.Buffer, y int) string {
+	w, _ := buf.Size()
+	var b strings.Builder
+	for x := 0; x < w; x++ {
+		b.WriteRune(buf.Get(x, y).Rune)
+	}
+	return b.String()
+}
+
+func TestToastStackRendersCountBadge(t *testing.T) {
+	stack := NewToastStack()
+	stack.SetToasts([]*toast.Toast{
+		{ID: "toast-1", Level: toast.ToastInfo, Title: "Sync", Message: "Saved", Count: 3},
+	})
+	_, rows := renderRows(t, stack, 40, 10)
+	if !strings.Contains(strings.Join(rows, "\n"), "Saved (3)") {
+		t.Fatalf("expected count badge in output:\n%s", strings.Join(rows, "\n"))
+	}
+}
@@ -0,0 +1,290 @@
+package widgets
+
+import (
+	"strings"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+const (
+	toggleTrackWidth = 3
+	toggleThumbChar  = '●'
+)
+
+// Toggle is an on/off switch drawn as a sliding thumb, [●  ] when off and
+// [  ●] when on. Space, Enter, or a click flips it, and while bound to a
+// running app the thumb slides across on ticks unless reduced motion is
+// set.
+type Toggle struct {
+	FocusableBase
+
+	label    string
+	on       bool
+	thumb    int
+	onChange func(on bool)
+	services runtime.Services
+
+	style      backend.Style
+	onStyle    backend.Style
+	focusStyle backend.Style
+	styleSet   bool
+	focusSet   bool
+}
+
+// ToggleOption configures a toggle.
+type ToggleOption = Option[Toggle]
+
+// WithToggleOn sets the initial state.
+func WithToggleOn(on bool) ToggleOption {
+	return func(t *Toggle) {
+		if t == nil {
+			return
+		}
+		t.on = on
+		t.thumb = t.target()
+	}
+}
+
+// NewToggle creates an off switch with a label.
+func NewToggle(label string, opts ...ToggleOption) *Toggle {
+	t := &Toggle{
+		label:      label,
+		style:      backend.DefaultStyle(),
+		onStyle:    backend.DefaultStyle().Foreground(backend.ColorGreen),
+		focusStyle: backend.DefaultStyle().Reverse(true),
+	}
+	t.Base.Role = accessibility.RoleSwitch
+	for _, opt := range opts {
+		if opt != nil {
+			opt(t)
+		}
+	}
+	t.syncA11y()
+	return t
+}
+
+// SetOn switches the toggle on or off.
+func (t *Toggle) SetOn(on bool) {
+	if t == nil || t.on == on {
+		return
+	}
+	t.on = on
+	if !t.animated() {
+		t.thumb = t.target()
+	}
+	t.syncA11y()
+	t.Invalidate()
+	t.services.Invalidate()
+	t.services.AnnounceChange(t)
+	if t.onChange != nil {
+		t.onChange(on)
+	}
+}
+
+// IsOn reports whether the toggle is on.
+func (t *Toggle) IsOn() bool {
+	if t == nil {
+		return false
+	}
+	return t.on
+}
+
+// Toggle flips the switch.
+func (t *Toggle) Toggle() {
+	if t == nil {
+		return
+	}
+	t.SetOn(!t.on)
+}
+
+// SetOnChange registers a callback for state changes.
+func (t *Toggle) SetOnChange(fn func(on bool)) {
+	if t == nil {
+		return
+	}
+	t.onChange = fn
+}
+
+// OnChange is an alias for SetOnChange.
+func (t *Toggle) OnChange(fn func(on bool)) {
+	t.SetOnChange(fn)
+}
+
+// SetLabel updates the label.
+func (t *Toggle) SetLabel(label string) {
+	if t == nil {
+		return
+	}
+	t.label = label
+	t.syncA11y()
+	t.services.Relayout()
+}
+
+// SetStyle sets the normal style.
+func (t *Toggle) SetStyle(style backend.Style) {
+	if t == nil {
+		return
+	}
+	t.style = style
+	t.styleSet = true
+}
+
+// SetOnStyle sets the style of the switch while on.
+func (t *Toggle) SetOnStyle(style backend.Style) {
+	if t == nil {
+		return
+	}
+	t.onStyle = style
+}
+
+// SetFocusStyle sets the focused style.
+func (t *Toggle) SetFocusStyle(style backend.Style) {
+	if t == nil {
+		return
+	}
+	t.focusStyle = style
+	t.focusSet = true
+}
+
+// Bind attaches app services.
+func (t *Toggle) Bind(services runtime.Services) {
+	if t == nil {
+		return
+	}
+	t.services = services
+}
+
+// Unbind releases app services.
+func (t *Toggle) Unbind() {
+	if t == nil {
+		return
+	}
+	t.services = runtime.Services{}
+	t.thumb = t.target()
+}
+
+// StyleType returns the selector type name.
+func (t *Toggle) StyleType() string {
+	return "Toggle"
+}
+
+// Measure returns the size of the switch and its label.
+func (t *Toggle) Measure(constraints runtime.Constraints) runtime.Size {
+	return t.measureWithStyle(constraints, func(contentConstraints runtime.Constraints) runtime.Size {
+		width := toggleTrackWidth + 2
+		if label := strings.TrimSpace(t.label); label != "" {
+			width += 1 + textWidth(label)
+		}
+		return contentConstraints.Constrain(runtime.Size{Width: width, Height: 1})
+	})
+}
+
+// Render draws the switch and its label.
+func (t *Toggle) Render(ctx runtime.RenderContext) {
+	if t == nil {
+		return
+	}
+	t.syncA11y()
+	outer := t.bounds
+	content := t.ContentBounds()
+	if outer.Width <= 0 || outer.Height <= 0 {
+		return
+	}
+	baseStyle := resolveBaseStyle(ctx, t, t.style, t.styleSet)
+	ctx.Buffer.Fill(outer, ' ', baseStyle)
+	if content.Width <= 0 || content.Height <= 0 {
+		return
+	}
+
+	track := []rune(strings.Repeat(" ", toggleTrackWidth))
+	track[clampInt(t.thumb, 0, toggleTrackWidth-1)] = toggleThumbChar
+	switchStyle := baseStyle
+	if t.on {
+		switchStyle = mergeBackendStyles(switchStyle, t.onStyle)
+	}
+	if t.focused {
+		if t.focusSet {
+			switchStyle = mergeBackendStyles(switchStyle, t.focusStyle)
+		} else {
+			switchStyle = switchStyle.Reverse(true)
+		}
+	}
+	text := "[" + string(track) + "]"
+	ctx.Buffer.SetString(content.X, content.Y, truncateString(text, content.Width), switchStyle)
+
+	labelX := content.X + toggleTrackWidth + 3
+	if available := content.X + content.Width - labelX; available > 0 && t.label != "" {
+		ctx.Buffer.SetString(labelX, content.Y, truncateString(t.label, available), baseStyle)
+	}
+}
+
+// HandleMessage flips the switch and advances the thumb on ticks.
+func (t *Toggle) HandleMessage(msg runtime.Message) runtime.HandleResult {
+	if t == nil {
+		return runtime.Unhandled()
+	}
+	switch m := msg.(type) {
+	case runtime.TickMsg:
+		if t.thumb == t.target() {
+			return runtime.Unhandled()
+		}
+		if t.thumb < t.target() {
+			t.thumb++
+		} else {
+			t.thumb--
+		}
+		t.Invalidate()
+		return runtime.Handled()
+	case runtime.MouseMsg:
+		if m.Action == runtime.MousePress && m.Button == runtime.MouseLeft && t.bounds.Contains(m.X, m.Y) {
+			t.Toggle()
+			return runtime.Handled()
+		}
+	case runtime.KeyMsg:
+		if !t.focused {
+			return runtime.Unhandled()
+		}
+		if m.Key == terminal.KeyEnter || (m.Key == terminal.KeyRune && m.Rune == ' ') {
+			t.Toggle()
+			return runtime.Handled()
+		}
+	}
+	return runtime.Unhandled()
+}
+
+// target returns the thumb cell for the current state.
+func (t *Toggle) target() int {
+	if t.on {
+		return toggleTrackWidth - 1
+	}
+	return 0
+}
+
+// animated reports whether the thumb should slide rather than jump.
+func (t *Toggle) animated() bool {
+	return t.services != (runtime.Services{}) && !t.services.ReducedMotion()
+}
+
+func (t *Toggle) syncA11y() {
+	if t == nil {
+		return
+	}
+	if t.Base.Role == "" {
+		t.Base.Role = accessibility.RoleSwitch
+	}
+	label := strings.TrimSpace(t.label)
+	if label == "" {
+		label = "Switch"
+	}
+	t.Base.Label = label
+	on := t.on
+	t.Base.State.Checked = &on
+}
+
+var _ runtime.Widget = (*Toggle)(nil)
+var _ runtime.Focusable = (*Toggle)(nil)
+var _ runtime.Bindable = (*Toggle)(nil)
+var _ runtime.Unbindable = (*Toggle)(nil)
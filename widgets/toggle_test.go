@@ -0,0 +1,54 @@
+package widgets
+
+import (
+	"testing"
+
+	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
+)
+
+func TestToggleKeysAndAccessibility(t *testing.T) {
+	toggle := NewToggle("Wi-Fi")
+	toggle.Focus()
+	var changes []bool
+	toggle.SetOnChange(func(on bool) { changes = append(changes, on) })
+
+	if _, rows := renderRows(t, toggle, 12, 1); rows[0] != "[●  ] Wi-Fi" {
+		t.Fatalf("off row = %q", rows[0])
+	}
+	toggle.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRune, Rune: ' '})
+	if !toggle.IsOn() {
+		t.Fatal("expected space to switch the toggle on")
+	}
+	if _, rows := renderRows(t, toggle, 12, 1); rows[0] != "[  ●] Wi-Fi" {
+		t.Fatalf("on row = %q", rows[0])
+	}
+	toggle.HandleMessage(runtime.KeyMsg{Key: terminal.KeyEnter})
+	toggle.SetOn(false)
+	if len(changes) != 2 || changes[0] != true || changes[1] != false {
+		t.Fatalf("changes = %v, want [true false]", changes)
+	}
+	if toggle.AccessibleRole() != accessibility.RoleSwitch {
+		t.Fatalf("role = %q, want switch", toggle.AccessibleRole())
+	}
+}
+
+func TestToggleThumbSlidesOnTicks(t *testing.T) {
+	toggle := NewToggle("Dark mode")
+	toggle.Bind(runtime.NewApp(runtime.AppConfig{}).Services())
+	toggle.SetOn(true)
+
+	want := []string{"[●  ]", "[ ● ]", "[  ●]"}
+	for i, track := range want {
+		if i > 0 && !toggle.HandleMessage(runtime.TickMsg{}).Handled {
+			t.Fatalf("tick %d not handled while sliding", i)
+		}
+		if _, rows := renderRows(t, toggle, 5, 1); rows[0] != track {
+			t.Fatalf("after %d ticks row = %q, want %q", i, rows[0], track)
+		}
+	}
+	if toggle.HandleMessage(runtime.TickMsg{}).Handled {
+		t.Fatal("tick should be ignored once the thumb has arrived")
+	}
+}
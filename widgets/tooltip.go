@@ -1,10 +1,110 @@
 package widgets
 
 import (
+	"strings"
+
 	"github.com/odvcencio/fluffyui/accessibility"
+	"github.com/odvcencio/fluffyui/backend"
+	"github.com/odvcencio/fluffyui/fur"
 	"github.com/odvcencio/fluffyui/runtime"
 )
 
+// WithTooltip sets the tooltip of w and returns it, for use inline while
+// building a tree. The runtime shows the text after the pointer or focus
+// rests on the widget; see runtime.TooltipProvider.
+func WithTooltip[W interface{ SetTooltip(text string) }](w W, text string) W {
+	w.SetTooltip(text)
+	return w
+}
+
+// TooltipRenderer draws the runtime's hover and focus hints as
+// TooltipLabels, so their text may use fur markup. fluffy.NewApp installs
+// it as AppConfig.TooltipRenderer.
+type TooltipRenderer struct{}
+
+// TooltipWidget returns a TooltipLabel for text.
+func (TooltipRenderer) TooltipWidget(text string) runtime.Widget {
+	return NewTooltipLabel(text)
+}
+
+// TooltipLabel draws tooltip text in reverse video with a column of
+// padding on each side. The text may use fur markup, such as
+// "[bold]Ctrl+S[/] saves"; markup styles keep the reverse video.
+type TooltipLabel struct {
+	Base
+
+	text  string
+	lines []fur.Line
+}
+
+// NewTooltipLabel creates tooltip content showing text.
+func NewTooltipLabel(text string) *TooltipLabel {
+	l := &TooltipLabel{}
+	l.Base.Role = accessibility.RoleText
+	l.SetText(text)
+	return l
+}
+
+// SetText replaces the tooltip text.
+func (l *TooltipLabel) SetText(text string) {
+	if l == nil {
+		return
+	}
+	l.text = strings.TrimSpace(text)
+	l.lines = fur.DefaultMarkupParser().Parse(l.text)
+	l.Base.Label = l.text
+	l.Invalidate()
+}
+
+// Text returns the tooltip text with its markup.
+func (l *TooltipLabel) Text() string {
+	if l == nil {
+		return ""
+	}
+	return l.text
+}
+
+// Measure returns the widest line plus padding by the line count.
+func (l *TooltipLabel) Measure(constraints runtime.Constraints) runtime.Size {
+	if l == nil {
+		return constraints.MinSize()
+	}
+	width := 0
+	for _, line := range l.lines {
+		lineWidth := 0
+		for _, span := range line {
+			lineWidth += backend.StringWidth(span.Text)
+		}
+		width = max(width, lineWidth)
+	}
+	return constraints.Constrain(runtime.Size{Width: width + 2, Height: len(l.lines)})
+}
+
+// Render draws the tooltip text.
+func (l *TooltipLabel) Render(ctx runtime.RenderContext) {
+	if l == nil || ctx.Buffer == nil {
+		return
+	}
+	bounds := l.Bounds()
+	ctx.Buffer.Fill(bounds, ' ', backend.DefaultStyle().Reverse(true))
+	for i, line := range l.lines {
+		if i >= bounds.Height {
+			break
+		}
+		x := bounds.X + 1
+		for _, span := range line {
+			ctx.Buffer.SetString(x, bounds.Y+i, span.Text, span.Style.ToBackend().Reverse(true))
+			x += backend.StringWidth(span.Text)
+		}
+	}
+}
+
+// NewTextTooltip creates a tooltip over target whose content is a
+// TooltipLabel, matching the runtime's hover and focus hints.
+func NewTextTooltip(target runtime.Widget, text string, opts ...TooltipOption) *Tooltip {
+	return NewTooltip(target, NewTooltipLabel(text), opts...)
+}
+
 // TooltipTrigger describes how a tooltip is activated.
 type TooltipTrigger int
 
@@ -253,6 +353,9 @@ func commandResult(cmd runtime.Command) runtime.HandleResult {
 	return runtime.HandleResult{Handled: false, Commands: []runtime.Command{cmd}}
 }
 
+var _ runtime.TooltipProvider = (*Base)(nil)
+var _ runtime.TooltipRenderer = TooltipRenderer{}
+var _ runtime.Widget = (*TooltipLabel)(nil)
 var _ runtime.Widget = (*Tooltip)(nil)
 var _ runtime.ChildProvider = (*Tooltip)(nil)
 var _ runtime.HitSelfProvider = (*Tooltip)(nil)
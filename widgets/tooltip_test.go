@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"testing"
+	"time"
 
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
@@ -87,3 +88,50 @@ func TestTooltipFocusOpens(t *testing.T) {
 		t.Fatalf("expected PopOverlay, got %T", close.Commands[0])
 	}
 }
+
+func TestWithTooltipShowsOnHoverDwell(t *testing.T) {
+	screen := runtime.NewScreen(30, 5)
+	panel := NewPanel(WithTooltip(NewButton("Save"), "Write to disk"))
+	panel.SetTooltip("Editor actions")
+	screen.SetRoot(panel)
+
+	start := time.Unix(100, 0)
+	screen.HandleMessage(runtime.MouseMsg{X: 1, Y: 0, Action: runtime.MouseMove})
+	screen.HandleMessage(runtime.TickMsg{Time: start})
+	screen.HandleMessage(runtime.TickMsg{Time: start.Add(runtime.DefaultTooltipDelay)})
+	if got := screen.TooltipText(); got != "Write to disk" {
+		t.Fatalf("tooltip = %q, want the button's own hint", got)
+	}
+	screen.HandleMessage(runtime.MouseMsg{X: 1, Y: 0, Button: runtime.MouseLeft, Action: runtime.MousePress})
+	if screen.TooltipText() != "" {
+		t.Fatal("a click should dismiss the tooltip")
+	}
+}
+
+func TestTooltipRendererDrawsMarkup(t *testing.T) {
+	app := runtime.NewApp(runtime.AppConfig{TooltipRenderer: TooltipRenderer{}})
+	screen := runtime.NewScreen(30, 10)
+	screen.SetServices(app.Services())
+	save := WithTooltip(NewLabel("Save"), "[bold]Ctrl+S[/] saves")
+	screen.SetRoot(runtime.VBox(runtime.Fixed(save)))
+
+	screen.HandleMessage(runtime.MouseMsg{X: 1, Y: 0, Action: runtime.MouseMove})
+	screen.HandleMessage(runtime.TickMsg{Time: time.Unix(100, 0)})
+	screen.HandleMessage(runtime.TickMsg{Time: time.Unix(101, 0)})
+	screen.Render()
+
+	buf := screen.Buffer()
+	var row []rune
+	for x := 0; x < 14; x++ {
+		row = append(row, buf.Get(x, 1).Rune)
+	}
+	if got := string(row); got != " Ctrl+S saves " {
+		t.Fatalf("tooltip row = %q, want the markup tags stripped", got)
+	}
+	if attrs := buf.Get(1, 1).Style.Attributes(); attrs&backend.AttrBold == 0 || attrs&backend.AttrReverse == 0 {
+		t.Fatal("expected bold markup over the reverse-video tooltip")
+	}
+	if buf.Get(8, 1).Style.Attributes()&backend.AttrBold != 0 {
+		t.Fatal("expected the closing tag to end the bold span")
+	}
+}
@@ -22,6 +22,11 @@ func validateValue(value any, validators []forms.Validator) ([]forms.ValidationE
 	if len(errs) == 0 {
 		return nil, nil
 	}
+	return errs, validationMessages(errs)
+}
+
+// validationMessages returns the non-empty messages of errs.
+func validationMessages(errs []forms.ValidationError) []string {
 	messages := make([]string, 0, len(errs))
 	for _, err := range errs {
 		msg := strings.TrimSpace(err.Message)
@@ -30,5 +35,5 @@ func validateValue(value any, validators []forms.Validator) ([]forms.ValidationE
 		}
 		messages = append(messages, msg)
 	}
-	return errs, messages
+	return messages
 }
@@ -1,6 +1,7 @@
 package widgets
 
 import (
+	"context"
 	"image"
 	"math"
 	"sync"
@@ -8,10 +9,22 @@ import (
 
 	"github.com/odvcencio/fluffyui/graphics"
 	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
 	"github.com/odvcencio/fluffyui/video"
 )
 
-// VideoPlayer renders video frames onto a canvas.
+const (
+	// videoBufferAhead is how much playback time is decoded ahead of the
+	// current frame.
+	videoBufferAhead   = 2 * time.Second
+	videoSeekStep      = 10 * time.Second
+	videoSeekStepLarge = 60 * time.Second
+)
+
+// VideoPlayer renders video frames onto a canvas. Frames are decoded in the
+// background, staying about two seconds of playback ahead of the current
+// frame. Space toggles play, Left/Right seek 10 seconds, and Shift+Left/Right
+// seek a minute.
 type VideoPlayer struct {
 	Component
 
@@ -22,10 +35,22 @@ type VideoPlayer struct {
 	cellHeight    int
 	frameRate     float64
 	frameDuration time.Duration
+	duration      time.Duration
+	rate          float64
 
+	// frames holds the decoded window starting at frame index frameBase.
+	// The loader keeps aheadFrames decoded past readFrame and waits on wake
+	// when it is that far ahead; loadGen discards frames from a loader
+	// replaced by a seek.
 	frames        []image.Image
+	frameBase     int
 	framesDone    bool
 	framesDropped int64
+	readFrame     int
+	aheadFrames   int
+	loadGen       int
+	loadCancel    context.CancelFunc
+	wake          chan struct{}
 	framesMu      sync.RWMutex
 
 	playing      bool
@@ -76,7 +101,7 @@ func NewVideoPlayer(path string, opts ...VideoPlayerOption) (*VideoPlayer, error
 		}
 		opt(player)
 	}
-	if err := player.startFrameLoader(); err != nil {
+	if err := player.startFrameLoader(0); err != nil {
 		return nil, err
 	}
 	return player, nil
@@ -122,17 +147,75 @@ func (v *VideoPlayer) Pause() {
 	v.playing = false
 }
 
-// Seek moves the playhead to the given position.
+// Seek moves the playhead to the given position, ignoring decoder errors.
+// Prefer SeekTo.
 func (v *VideoPlayer) Seek(pos time.Duration) {
+	_ = v.SeekTo(pos)
+}
+
+// SeekTo moves the playhead to t, clamped to the video, and shows the frame
+// at that time. Seeking outside the buffered frames restarts decoding at t;
+// the error reports a decoder that could not be restarted.
+func (v *VideoPlayer) SeekTo(t time.Duration) error {
 	if v == nil {
-		return
+		return nil
+	}
+	if t < 0 {
+		t = 0
 	}
-	if pos < 0 {
-		pos = 0
+	index := v.frameIndexFor(t)
+	if duration, ok := v.Duration(); ok {
+		t = min(t, duration)
+		index = min(v.frameIndexFor(t), max(0, v.frameIndexFor(duration-1)))
 	}
-	v.playhead = pos
+	v.framesMu.RLock()
+	end := v.frameBase + len(v.frames)
+	buffered := index >= v.frameBase && (index <= end || v.framesDone)
+	v.framesMu.RUnlock()
+	if !buffered && v.decoder != nil {
+		if err := v.startFrameLoader(index); err != nil {
+			return err
+		}
+	}
+	v.playhead = t
 	v.lastTick = time.Time{}
-	v.currentFrame = v.frameIndexFor(pos)
+	v.showFrame(index)
+	v.Invalidate()
+	return nil
+}
+
+// CurrentTime returns the playhead position.
+func (v *VideoPlayer) CurrentTime() time.Duration {
+	if v == nil {
+		return 0
+	}
+	return v.playhead
+}
+
+// Duration returns the length of the video and whether it is known.
+func (v *VideoPlayer) Duration() (time.Duration, bool) {
+	if v == nil || v.duration <= 0 {
+		return 0, false
+	}
+	return v.duration, true
+}
+
+// SetPlaybackRate sets the playback speed, where 1 is normal speed, 0.5 is
+// half speed, and 2 is double. Rates that are not positive are ignored.
+func (v *VideoPlayer) SetPlaybackRate(rate float64) {
+	if v == nil || rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return
+	}
+	v.rate = rate
+	v.showFrame(v.currentFrame)
+}
+
+// PlaybackRate returns the playback speed.
+func (v *VideoPlayer) PlaybackRate() float64 {
+	if v == nil || v.rate <= 0 {
+		return 1
+	}
+	return v.rate
 }
 
 // IsPlaying reports whether the player is currently playing.
@@ -208,7 +291,8 @@ func (v *VideoPlayer) Render(ctx runtime.RenderContext) {
 	v.canvas.Render(ctx.Buffer, content.X, content.Y)
 }
 
-// HandleMessage advances playback on ticks and toggles play on spacebar.
+// HandleMessage advances playback on ticks, toggles play on spacebar, and
+// seeks with the arrow keys.
 func (v *VideoPlayer) HandleMessage(msg runtime.Message) runtime.HandleResult {
 	if v == nil {
 		return runtime.Unhandled()
@@ -223,12 +307,23 @@ func (v *VideoPlayer) HandleMessage(msg runtime.Message) runtime.HandleResult {
 			return runtime.Handled()
 		}
 		if m.Time.After(v.lastTick) {
-			v.playhead += m.Time.Sub(v.lastTick)
+			v.playhead += time.Duration(float64(m.Time.Sub(v.lastTick)) * v.PlaybackRate())
 		}
 		v.lastTick = m.Time
 		v.advanceFrame()
 		return runtime.Handled()
 	case runtime.KeyMsg:
+		if m.Key == terminal.KeyLeft || m.Key == terminal.KeyRight {
+			step := videoSeekStep
+			if m.Shift {
+				step = videoSeekStepLarge
+			}
+			if m.Key == terminal.KeyLeft {
+				step = -step
+			}
+			_ = v.SeekTo(v.playhead + step)
+			return runtime.Handled()
+		}
 		if m.Rune == ' ' {
 			if v.playing {
 				v.Pause()
@@ -247,19 +342,57 @@ func (v *VideoPlayer) initTiming(info video.VideoInfo) {
 		v.frameRate = 30
 	}
 	v.frameDuration = time.Duration(float64(time.Second) / v.frameRate)
+	v.duration = info.Duration
 }
 
-func (v *VideoPlayer) startFrameLoader() error {
+// startFrameLoader decodes frames from index start in the background,
+// replacing any buffered frames and stopping the previous loader.
+func (v *VideoPlayer) startFrameLoader(start int) error {
 	if v.decoder == nil {
 		return nil
 	}
-	frames, err := v.decoder.ExtractFrames(v.frameRate)
+	ctx, cancel := context.WithCancel(context.Background())
+	frames, err := v.decoder.ExtractFramesFrom(ctx, time.Duration(start)*v.frameDuration, v.frameRate)
 	if err != nil {
+		cancel()
 		return err
 	}
+	wake := make(chan struct{}, 1)
+	v.framesMu.Lock()
+	if v.loadCancel != nil {
+		v.loadCancel()
+	}
+	v.loadCancel = cancel
+	v.loadGen++
+	gen := v.loadGen
+	v.frames = nil
+	v.frameBase = start
+	v.framesDone = false
+	v.readFrame = start
+	v.aheadFrames = v.bufferFrames()
+	v.wake = wake
+	v.framesMu.Unlock()
+
 	go func() {
-		for frame := range frames {
+		for v.waitForRoom(ctx, gen, wake) {
+			var frame image.Image
+			var ok bool
+			select {
+			case frame, ok = <-frames:
+			case <-ctx.Done():
+				return
+			}
 			v.framesMu.Lock()
+			if gen != v.loadGen {
+				v.framesMu.Unlock()
+				return
+			}
+			if !ok {
+				v.framesDone = true
+				v.framesMu.Unlock()
+				v.Invalidate()
+				return
+			}
 			wasEmpty := len(v.frames) == 0
 			v.frames = append(v.frames, frame)
 			v.framesMu.Unlock()
@@ -267,26 +400,83 @@ func (v *VideoPlayer) startFrameLoader() error {
 				v.Invalidate()
 			}
 		}
-		v.framesMu.Lock()
-		v.framesDone = true
-		v.framesMu.Unlock()
-		v.Invalidate()
 	}()
 	return nil
 }
 
+// waitForRoom blocks while the loader is a full buffer ahead of playback.
+// It returns false once the loader has been replaced or cancelled.
+func (v *VideoPlayer) waitForRoom(ctx context.Context, gen int, wake <-chan struct{}) bool {
+	for {
+		v.framesMu.RLock()
+		stale := gen != v.loadGen
+		full := v.frameBase+len(v.frames)-v.readFrame > v.aheadFrames
+		v.framesMu.RUnlock()
+		if stale {
+			return false
+		}
+		if !full {
+			return true
+		}
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// bufferFrames returns how many frames cover videoBufferAhead of playback
+// at the current rate.
+func (v *VideoPlayer) bufferFrames() int {
+	if v.frameDuration <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(videoBufferAhead) * v.PlaybackRate() / float64(v.frameDuration)))
+}
+
+// showFrame makes index the current frame, drops frames more than a buffer
+// behind it, and lets the loader decode further ahead.
+func (v *VideoPlayer) showFrame(index int) {
+	v.currentFrame = index
+	ahead := v.bufferFrames()
+	v.framesMu.Lock()
+	v.readFrame = index
+	v.aheadFrames = ahead
+	if drop := min(index-ahead-v.frameBase, len(v.frames)); drop > 0 {
+		clear(v.frames[:drop])
+		v.frames = v.frames[drop:]
+		v.frameBase += drop
+	}
+	wake := v.wake
+	v.framesMu.Unlock()
+	if wake != nil {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (v *VideoPlayer) advanceFrame() {
 	if v == nil || v.frameDuration <= 0 {
 		return
 	}
 	target := v.frameIndexFor(v.playhead)
-	count, done := v.frameSnapshot()
+	base, count, done := v.frameSnapshot()
 	if count == 0 {
 		return
 	}
-	if target >= count {
-		v.currentFrame = count - 1
-		if done && v.playing {
+	end := base + count
+	if target >= end {
+		v.showFrame(end - 1)
+		if !done {
+			// Hold the playhead at the last decoded frame until the loader
+			// catches up.
+			v.playhead = min(v.playhead, time.Duration(end)*v.frameDuration)
+			return
+		}
+		if v.playing {
 			v.playing = false
 			if v.onEnd != nil {
 				v.onEnd()
@@ -294,13 +484,13 @@ func (v *VideoPlayer) advanceFrame() {
 		}
 		return
 	}
-	v.currentFrame = target
+	v.showFrame(target)
 }
 
-func (v *VideoPlayer) frameSnapshot() (int, bool) {
+func (v *VideoPlayer) frameSnapshot() (int, int, bool) {
 	v.framesMu.RLock()
 	defer v.framesMu.RUnlock()
-	return len(v.frames), v.framesDone
+	return v.frameBase, len(v.frames), v.framesDone
 }
 
 func (v *VideoPlayer) frameIndexFor(pos time.Duration) int {
@@ -320,13 +510,14 @@ func (v *VideoPlayer) currentFrameImage() image.Image {
 	if len(v.frames) == 0 {
 		return nil
 	}
-	if v.currentFrame < 0 {
+	index := v.currentFrame - v.frameBase
+	if index < 0 {
 		return v.frames[0]
 	}
-	if v.currentFrame >= len(v.frames) {
+	if index >= len(v.frames) {
 		return v.frames[len(v.frames)-1]
 	}
-	return v.frames[v.currentFrame]
+	return v.frames[index]
 }
 
 func (v *VideoPlayer) drawFrame(frame image.Image) {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/odvcencio/fluffyui/runtime"
+	"github.com/odvcencio/fluffyui/terminal"
 )
 
 func TestVideoPlayerSeek(t *testing.T) {
@@ -73,3 +74,64 @@ func TestVideoPlayerTogglePlay(t *testing.T) {
 		t.Fatalf("playing = true, want false")
 	}
 }
+
+func TestVideoPlayerSeekKeysAndBuffer(t *testing.T) {
+	player := &VideoPlayer{
+		frameDuration: time.Second,
+		duration:      120 * time.Second,
+		frames:        make([]image.Image, 120),
+		framesDone:    true,
+	}
+	player.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight})
+	if got := player.CurrentTime(); got != 10*time.Second {
+		t.Fatalf("CurrentTime = %s, want 10s", got)
+	}
+	player.HandleMessage(runtime.KeyMsg{Key: terminal.KeyRight, Shift: true})
+	if got := player.CurrentTime(); got != 70*time.Second {
+		t.Fatalf("CurrentTime = %s, want 70s", got)
+	}
+	if player.frameBase != 68 || len(player.frames) != 52 {
+		t.Fatalf("buffer = %d frames from %d, want 52 from 68", len(player.frames), player.frameBase)
+	}
+	player.HandleMessage(runtime.KeyMsg{Key: terminal.KeyLeft})
+	if got := player.CurrentTime(); got != 60*time.Second {
+		t.Fatalf("CurrentTime = %s, want 60s", got)
+	}
+	if err := player.SeekTo(10 * time.Minute); err != nil {
+		t.Fatalf("SeekTo error: %v", err)
+	}
+	if got := player.CurrentTime(); got != 120*time.Second {
+		t.Fatalf("CurrentTime = %s, want 120s", got)
+	}
+	if player.currentFrame != 119 {
+		t.Fatalf("currentFrame = %d, want 119", player.currentFrame)
+	}
+	if d, ok := player.Duration(); !ok || d != 120*time.Second {
+		t.Fatalf("Duration = %s, %v, want 120s, true", d, ok)
+	}
+}
+
+func TestVideoPlayerPlaybackRate(t *testing.T) {
+	player := &VideoPlayer{
+		frameDuration: 500 * time.Millisecond,
+		frames:        make([]image.Image, 20),
+		playing:       true,
+	}
+	player.SetPlaybackRate(2)
+	player.SetPlaybackRate(0)
+	if player.PlaybackRate() != 2 {
+		t.Fatalf("PlaybackRate = %v, want 2", player.PlaybackRate())
+	}
+	start := time.Now()
+	player.HandleMessage(runtime.TickMsg{Time: start})
+	player.HandleMessage(runtime.TickMsg{Time: start.Add(time.Second)})
+	if got := player.CurrentTime(); got != 2*time.Second {
+		t.Fatalf("CurrentTime = %s, want 2s", got)
+	}
+	if player.currentFrame != 4 {
+		t.Fatalf("currentFrame = %d, want 4", player.currentFrame)
+	}
+	if _, ok := player.Duration(); ok {
+		t.Fatalf("Duration known without decoder info")
+	}
+}
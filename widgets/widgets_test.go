@@ -2,6 +2,7 @@ package widgets
 
 import (
 	"testing"
+	"time"
 
 	"github.com/odvcencio/fluffyui/backend"
 	"github.com/odvcencio/fluffyui/runtime"
@@ -815,6 +816,36 @@ func TestLabel_WithStyle(t *testing.T) {
 	}
 }
 
+func TestLabel_Typewriter(t *testing.T) {
+	label := NewLabel("Hi 世界")
+	label.SetTypewriter(10 * time.Millisecond)
+	label.SetShowCursor(true)
+	done := 0
+	label.OnTypewriterDone(func() { done++ })
+
+	start := time.Unix(0, 0)
+	label.HandleMessage(runtime.TickMsg{Time: start})
+	if _, rows := renderRows(t, label, 10, 1); rows[0] != "▌" {
+		t.Fatalf("row = %q, want only the cursor before the first interval", rows[0])
+	}
+	label.HandleMessage(runtime.TickMsg{Time: start.Add(40 * time.Millisecond)})
+	if _, rows := renderRows(t, label, 10, 1); rows[0] != "Hi 世▌" {
+		t.Fatalf("row = %q, want four characters revealed", rows[0])
+	}
+	label.HandleMessage(runtime.TickMsg{Time: start.Add(90 * time.Millisecond)})
+	if _, rows := renderRows(t, label, 10, 1); rows[0] != "Hi 世界" || done != 1 || label.Typing() {
+		t.Fatalf("row = %q done = %d, want the full text and one done call", rows[0], done)
+	}
+
+	label.SetText("Bye")
+	if !label.Typing() {
+		t.Fatal("expected SetText to restart typing")
+	}
+	if _, rows := renderRows(t, label, 10, 1); rows[0] != "▌" {
+		t.Fatalf("row = %q, want the new text hidden", rows[0])
+	}
+}
+
 // Test Panel additional methods
 func TestPanel_SetStyle(t *testing.T) {
 	label := NewLabel("test")